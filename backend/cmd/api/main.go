@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -30,6 +31,36 @@ func main() {
 	// サーバーを初期化
 	r := InitializeServer(db, cfg)
 
+	// ゴミ箱の完全削除・期限切れの冪等性キーの削除・期限リマインダーの配信・スヌーズの解除・完了したタスクの自動アーカイブをバックグラウンドで開始
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	purgeWorker := InitializeTaskPurgeWorker(db, cfg)
+	idempotencyKeyPurgeWorker := InitializeIdempotencyKeyPurgeWorker(db, cfg)
+	reminderWorker := InitializeReminderWorker(db, cfg)
+	snoozeWorker := InitializeSnoozeWorker(db, cfg)
+	autoArchiveWorker := InitializeAutoArchiveWorker(db, cfg)
+	var workers sync.WaitGroup
+	workers.Add(5)
+	go func() {
+		defer workers.Done()
+		purgeWorker.Run(workerCtx)
+	}()
+	go func() {
+		defer workers.Done()
+		idempotencyKeyPurgeWorker.Run(workerCtx)
+	}()
+	go func() {
+		defer workers.Done()
+		reminderWorker.Run(workerCtx)
+	}()
+	go func() {
+		defer workers.Done()
+		snoozeWorker.Run(workerCtx)
+	}()
+	go func() {
+		defer workers.Done()
+		autoArchiveWorker.Run(workerCtx)
+	}()
+
 	// HTTPサーバーを作成
 	addr := fmt.Sprintf(":%s", cfg.Port)
 	srv := &http.Server{
@@ -64,6 +95,10 @@ func main() {
 		log.Println("✅ Server exited gracefully")
 	}
 
+	// バックグラウンド処理を停止（DB接続を閉じる前に完了を待つ）
+	stopWorker()
+	workers.Wait()
+
 	// データベース接続を閉じる
 	if err := db.Close(); err != nil {
 		log.Printf("Error closing database connection: %v", err)
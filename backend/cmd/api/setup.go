@@ -2,6 +2,7 @@ package main
 
 import (
 	"database/sql"
+	"log"
 	"log/slog"
 
 	"github.com/gin-gonic/gin"
@@ -10,10 +11,13 @@ import (
 	"github.com/yoshioka0101/ai_plan_chat/internal/http"
 	"github.com/yoshioka0101/ai_plan_chat/internal/http/handler"
 	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
 	"github.com/yoshioka0101/ai_plan_chat/internal/middleware"
 	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
 	"github.com/yoshioka0101/ai_plan_chat/internal/service"
+	"github.com/yoshioka0101/ai_plan_chat/internal/storage"
 	"github.com/yoshioka0101/ai_plan_chat/internal/usecase"
+	"github.com/yoshioka0101/ai_plan_chat/internal/worker"
 )
 
 // initializeHealthHandler はHealthHandlerを初期化します
@@ -21,13 +25,189 @@ func initializeHealthHandler() *handler.HealthHandler {
 	return handler.NewHealthHandler()
 }
 
+// initializeTaskUsecase はTaskUsecaseとその依存関係を初期化します
+func initializeTaskUsecase(db *sql.DB, logger *slog.Logger) interfaces.TaskUsecase {
+	// Repository → Usecase
+	taskRepo := repository.NewTaskRepository(db, logger)
+	taskDependencyRepo := repository.NewTaskDependencyRepository(db, logger)
+	projectRepo := repository.NewProjectRepository(db, logger)
+	taskEventRepo := repository.NewTaskEventRepository(db, logger)
+	timeEntryRepo := repository.NewTimeEntryRepository(db, logger)
+	taskStatusRepo := repository.NewTaskStatusRepository(db, logger)
+	userSettingRepo := repository.NewUserSettingRepository(db, logger)
+	return usecase.NewTaskUsecase(db, taskRepo, taskDependencyRepo, projectRepo, taskEventRepo, timeEntryRepo, taskStatusRepo, userSettingRepo, logger)
+}
+
 // initializeTaskHandler はTaskHandlerとその依存関係を初期化します
 func initializeTaskHandler(db *sql.DB, logger *slog.Logger) *handler.TaskHandler {
+	// Usecase → Presenter → Handler
+	taskUsecase := initializeTaskUsecase(db, logger)
+	taskPresenter := presenter.NewTaskPresenter()
+	return handler.NewTaskHandler(taskUsecase, taskPresenter)
+}
+
+// initializeProjectHandler はProjectHandlerとその依存関係を初期化します
+func initializeProjectHandler(db *sql.DB, logger *slog.Logger) *handler.ProjectHandler {
 	// Repository → Usecase → Presenter → Handler
+	projectRepo := repository.NewProjectRepository(db, logger)
+	projectUsecase := usecase.NewProjectUsecase(projectRepo, logger)
+	projectPresenter := presenter.NewProjectPresenter()
+	return handler.NewProjectHandler(projectUsecase, projectPresenter)
+}
+
+// initializeTaskTemplateHandler はTaskTemplateHandlerとその依存関係を初期化します
+func initializeTaskTemplateHandler(db *sql.DB, logger *slog.Logger) *handler.TaskTemplateHandler {
+	// Repository → Usecase → Presenter → Handler
+	taskTemplateRepo := repository.NewTaskTemplateRepository(db, logger)
 	taskRepo := repository.NewTaskRepository(db, logger)
-	taskUsecase := usecase.NewTaskUsecase(taskRepo, logger)
+	taskDependencyRepo := repository.NewTaskDependencyRepository(db, logger)
+	projectRepo := repository.NewProjectRepository(db, logger)
+	taskTemplateUsecase := usecase.NewTaskTemplateUsecase(db, taskTemplateRepo, taskRepo, taskDependencyRepo, projectRepo, logger)
+	taskTemplatePresenter := presenter.NewTaskTemplatePresenter()
 	taskPresenter := presenter.NewTaskPresenter()
-	return handler.NewTaskHandler(taskUsecase, taskPresenter)
+	return handler.NewTaskTemplateHandler(taskTemplateUsecase, taskTemplatePresenter, taskPresenter)
+}
+
+// initializeTaskStatusHandler はTaskStatusHandlerとその依存関係を初期化します
+func initializeTaskStatusHandler(db *sql.DB, logger *slog.Logger) *handler.TaskStatusHandler {
+	// Repository → Usecase → Presenter → Handler
+	taskStatusRepo := repository.NewTaskStatusRepository(db, logger)
+	taskRepo := repository.NewTaskRepository(db, logger)
+	taskStatusUsecase := usecase.NewTaskStatusUsecase(db, taskStatusRepo, taskRepo, logger)
+	taskStatusPresenter := presenter.NewTaskStatusPresenter()
+	return handler.NewTaskStatusHandler(taskStatusUsecase, taskStatusPresenter)
+}
+
+// initializeTaskViewHandler はTaskViewHandlerとその依存関係を初期化します
+func initializeTaskViewHandler(db *sql.DB, logger *slog.Logger) *handler.TaskViewHandler {
+	// Repository → Usecase → Presenter → Handler
+	taskViewRepo := repository.NewTaskViewRepository(db, logger)
+	taskRepo := repository.NewTaskRepository(db, logger)
+	taskViewUsecase := usecase.NewTaskViewUsecase(taskViewRepo, taskRepo, logger)
+	taskViewPresenter := presenter.NewTaskViewPresenter()
+	taskUsecase := initializeTaskUsecase(db, logger)
+	taskPresenter := presenter.NewTaskPresenter()
+	return handler.NewTaskViewHandler(taskViewUsecase, taskViewPresenter, taskUsecase, taskPresenter)
+}
+
+// initializeTimeEntryUsecase はTimeEntryUsecaseとその依存関係を初期化します
+func initializeTimeEntryUsecase(db *sql.DB, logger *slog.Logger) interfaces.TimeEntryUsecase {
+	// Repository → Usecase
+	timeEntryRepo := repository.NewTimeEntryRepository(db, logger)
+	taskRepo := repository.NewTaskRepository(db, logger)
+	projectRepo := repository.NewProjectRepository(db, logger)
+	interpretationItemRepo := repository.NewInterpretationItemRepository(bob.NewDB(db), logger)
+	return usecase.NewTimeEntryUsecase(db, timeEntryRepo, taskRepo, projectRepo, interpretationItemRepo, logger)
+}
+
+// initializeNotificationUsecase はNotificationUsecaseとその依存関係を初期化します
+func initializeNotificationUsecase(db *sql.DB, logger *slog.Logger) interfaces.NotificationUsecase {
+	// Repository → Usecase
+	notificationRepo := repository.NewNotificationRepository(db, logger)
+	return usecase.NewNotificationUsecase(notificationRepo, logger)
+}
+
+// initializeNotificationHandler はNotificationHandlerとその依存関係を初期化します
+func initializeNotificationHandler(db *sql.DB, logger *slog.Logger) *handler.NotificationHandler {
+	// Usecase → Presenter → Handler
+	notificationUsecase := initializeNotificationUsecase(db, logger)
+	notificationPresenter := presenter.NewNotificationPresenter()
+	return handler.NewNotificationHandler(notificationUsecase, notificationPresenter)
+}
+
+// initializeCalendarFeedHandler はCalendarFeedHandlerとその依存関係を初期化します
+func initializeCalendarFeedHandler(db *sql.DB, config *config.Config, logger *slog.Logger) *handler.CalendarFeedHandler {
+	// Repository → Usecase → Presenter → Handler
+	calendarFeedRepo := repository.NewCalendarFeedRepository(db, logger)
+	taskRepo := repository.NewTaskRepository(db, logger)
+	calendarFeedUsecase := usecase.NewCalendarFeedUsecase(db, calendarFeedRepo, taskRepo, logger)
+	calendarFeedPresenter := presenter.NewCalendarFeedPresenter(config.PublicBaseURL)
+	return handler.NewCalendarFeedHandler(calendarFeedUsecase, calendarFeedPresenter)
+}
+
+// initializeImportHandler はImportHandlerとその依存関係を初期化します
+func initializeImportHandler(db *sql.DB, logger *slog.Logger) *handler.ImportHandler {
+	// Repository → Usecase → Presenter → Handler
+	taskImportRepo := repository.NewTaskImportRepository(db, logger)
+	projectRepo := repository.NewProjectRepository(db, logger)
+	importUsecase := usecase.NewImportUsecase(db, taskImportRepo, projectRepo, logger)
+	importPresenter := presenter.NewImportPresenter()
+	return handler.NewImportHandler(importUsecase, importPresenter)
+}
+
+// initializeAccountArchiveHandler はAccountArchiveHandlerとその依存関係を初期化します
+func initializeAccountArchiveHandler(db *sql.DB, logger *slog.Logger) *handler.AccountArchiveHandler {
+	// Repository → Usecase → Presenter → Handler
+	userRepo := repository.NewUserRepository(db)
+	accountArchiveUsecase := usecase.NewAccountArchiveUsecase(db, userRepo, logger)
+	accountArchivePresenter := presenter.NewAccountArchivePresenter()
+	return handler.NewAccountArchiveHandler(accountArchiveUsecase, accountArchivePresenter)
+}
+
+// initializeUserSettingHandler はUserSettingHandlerとその依存関係を初期化します
+func initializeUserSettingHandler(db *sql.DB, logger *slog.Logger) *handler.UserSettingHandler {
+	// Repository → Usecase → Presenter → Handler
+	userSettingRepo := repository.NewUserSettingRepository(db, logger)
+	userSettingUsecase := usecase.NewUserSettingUsecase(userSettingRepo, logger)
+	userSettingPresenter := presenter.NewUserSettingPresenter()
+	return handler.NewUserSettingHandler(userSettingUsecase, userSettingPresenter)
+}
+
+// initializeAttachmentStorage は設定に応じた添付ファイルのストレージを初期化します
+func initializeAttachmentStorage(config *config.Config) interfaces.AttachmentStorage {
+	var attachmentStorage interfaces.AttachmentStorage
+	var err error
+	switch config.Attachment.Storage {
+	case "s3":
+		s3 := config.Attachment.S3
+		attachmentStorage, err = storage.NewS3Storage(storage.S3Options{
+			Endpoint:        s3.Endpoint,
+			Region:          s3.Region,
+			Bucket:          s3.Bucket,
+			AccessKeyID:     s3.AccessKeyID,
+			SecretAccessKey: s3.SecretAccessKey,
+			UsePathStyle:    s3.UsePathStyle,
+		})
+	default:
+		attachmentStorage, err = storage.NewLocalStorage(config.Attachment.LocalDir)
+	}
+	if err != nil {
+		log.Fatalf("Failed to initialize attachment storage: %v", err)
+	}
+	return attachmentStorage
+}
+
+// initializeAttachmentUsecase はAttachmentUsecaseとその依存関係を初期化します
+func initializeAttachmentUsecase(db *sql.DB, config *config.Config, logger *slog.Logger) interfaces.AttachmentUsecase {
+	// Repository → Usecase
+	attachmentRepo := repository.NewTaskAttachmentRepository(db, logger)
+	taskRepo := repository.NewTaskRepository(db, logger)
+	return usecase.NewAttachmentUsecase(attachmentRepo, taskRepo, initializeAttachmentStorage(config), config.Attachment.MaxSize, logger)
+}
+
+// initializeAttachmentHandler はAttachmentHandlerとその依存関係を初期化します
+func initializeAttachmentHandler(db *sql.DB, config *config.Config, logger *slog.Logger) *handler.AttachmentHandler {
+	// Usecase → Presenter → Handler
+	attachmentUsecase := initializeAttachmentUsecase(db, config, logger)
+	attachmentPresenter := presenter.NewAttachmentPresenter()
+	return handler.NewAttachmentHandler(attachmentUsecase, attachmentPresenter, config.Attachment.MaxSize)
+}
+
+// initializeTimeEntryHandler はTimeEntryHandlerとその依存関係を初期化します
+func initializeTimeEntryHandler(db *sql.DB, logger *slog.Logger) *handler.TimeEntryHandler {
+	// Usecase → Presenter → Handler
+	timeEntryUsecase := initializeTimeEntryUsecase(db, logger)
+	timeEntryPresenter := presenter.NewTimeEntryPresenter()
+	return handler.NewTimeEntryHandler(timeEntryUsecase, timeEntryPresenter)
+}
+
+// initializeStatsHandler はStatsHandlerとその依存関係を初期化します
+func initializeStatsHandler(db *sql.DB, logger *slog.Logger) *handler.StatsHandler {
+	// Repository → Usecase → Presenter → Handler
+	taskStatsRepo := repository.NewTaskStatsRepository(db, logger)
+	statsUsecase := usecase.NewStatsUsecase(taskStatsRepo, logger)
+	statsPresenter := presenter.NewStatsPresenter()
+	return handler.NewStatsHandler(statsUsecase, statsPresenter)
 }
 
 // initializeAuthHandler はAuthHandlerとその依存関係を初期化します
@@ -67,7 +247,9 @@ func initializeGeminiService(config *config.Config) *service.GeminiService {
 func initializeInterpretationHandler(db *sql.DB, logger *slog.Logger, geminiService *service.GeminiService) *handler.InterpretationHandler {
 	interpretationRepo := repository.NewInterpretationRepository(db, logger)
 	interpretationItemRepo := repository.NewInterpretationItemRepository(bob.NewDB(db), logger)
-	return handler.NewInterpretationHandler(geminiService, interpretationRepo, interpretationItemRepo)
+	projectRepo := repository.NewProjectRepository(db, logger)
+	timeEntryUsecase := initializeTimeEntryUsecase(db, logger)
+	return handler.NewInterpretationHandler(geminiService, interpretationRepo, interpretationItemRepo, projectRepo, timeEntryUsecase)
 }
 
 // initializeInterpretationItemHandler はInterpretationItemHandlerを初期化します
@@ -76,6 +258,75 @@ func initializeInterpretationItemHandler(db *sql.DB, logger *slog.Logger) *handl
 	return handler.NewInterpretationItemHandler(itemUseCase)
 }
 
+// InitializeTaskPurgeWorker はゴミ箱の完全削除を行うバックグラウンドワーカーを初期化します
+func InitializeTaskPurgeWorker(db *sql.DB, config *config.Config) *worker.TaskPurgeWorker {
+	logger := middleware.NewLogger()
+	taskUsecase := initializeTaskUsecase(db, logger)
+	attachmentUsecase := initializeAttachmentUsecase(db, config, logger)
+	return worker.NewTaskPurgeWorker(taskUsecase, attachmentUsecase, config.Task.TrashRetention, config.Task.TrashPurgeInterval, logger)
+}
+
+// InitializeReminderWorker は期限リマインダーを配信するバックグラウンドワーカーを初期化します
+// アプリ内通知は常に配信し、メールとWebhookは設定されている場合のみ配信します
+func InitializeReminderWorker(db *sql.DB, config *config.Config) *worker.ReminderWorker {
+	logger := middleware.NewLogger()
+
+	channels := []interfaces.NotificationChannel{
+		service.NewInAppNotificationChannel(initializeNotificationUsecase(db, logger)),
+	}
+	if smtp := config.Reminder.SMTP; smtp.Host != "" {
+		channels = append(channels, service.NewEmailNotificationChannel(smtp.Host, smtp.Port, smtp.Username, smtp.Password, smtp.From))
+	}
+	if config.Reminder.WebhookURL != "" {
+		channels = append(channels, service.NewWebhookNotificationChannel(config.Reminder.WebhookURL, config.Reminder.WebhookSecret))
+	}
+
+	reminderUsecase := usecase.NewReminderUsecase(
+		repository.NewTaskRepository(db, logger),
+		repository.NewTaskReminderRepository(db, logger),
+		repository.NewUserRepository(db),
+		channels,
+		config.Reminder.Offsets,
+		logger,
+	)
+	return worker.NewReminderWorker(reminderUsecase, config.Reminder.ScanInterval, logger)
+}
+
+// InitializeSnoozeWorker は解除日時を過ぎたタスクのスヌーズを解除するバックグラウンドワーカーを初期化します
+func InitializeSnoozeWorker(db *sql.DB, config *config.Config) *worker.SnoozeWorker {
+	logger := middleware.NewLogger()
+	snoozeUsecase := usecase.NewSnoozeUsecase(
+		repository.NewTaskRepository(db, logger),
+		initializeNotificationUsecase(db, logger),
+		logger,
+	)
+	return worker.NewSnoozeWorker(snoozeUsecase, config.Task.SnoozeWakeInterval, logger)
+}
+
+// InitializeAutoArchiveWorker は完了したタスクを自動でアーカイブするバックグラウンドワーカーを初期化します
+func InitializeAutoArchiveWorker(db *sql.DB, config *config.Config) *worker.AutoArchiveWorker {
+	logger := middleware.NewLogger()
+	autoArchiveUsecase := usecase.NewAutoArchiveUsecase(
+		repository.NewTaskRepository(db, logger),
+		repository.NewUserSettingRepository(db, logger),
+		logger,
+	)
+	return worker.NewAutoArchiveWorker(autoArchiveUsecase, config.Task.AutoArchiveInterval, logger)
+}
+
+// initializeIdempotencyUsecase はIdempotencyUsecaseとその依存関係を初期化します
+func initializeIdempotencyUsecase(db *sql.DB, config *config.Config, logger *slog.Logger) interfaces.IdempotencyUsecase {
+	idempotencyKeyRepo := repository.NewIdempotencyKeyRepository(db, logger)
+	return usecase.NewIdempotencyUsecase(idempotencyKeyRepo, config.Idempotency.KeyTTL, logger)
+}
+
+// InitializeIdempotencyKeyPurgeWorker は期限切れの冪等性キーを削除するバックグラウンドワーカーを初期化します
+func InitializeIdempotencyKeyPurgeWorker(db *sql.DB, config *config.Config) *worker.IdempotencyKeyPurgeWorker {
+	logger := middleware.NewLogger()
+	idempotencyUsecase := initializeIdempotencyUsecase(db, config, logger)
+	return worker.NewIdempotencyKeyPurgeWorker(idempotencyUsecase, config.Idempotency.PurgeInterval, logger)
+}
+
 // InitializeServer は全ての依存性注入を行い、Ginルーターを返します
 func InitializeServer(db *sql.DB, config *config.Config) *gin.Engine {
 
@@ -88,6 +339,18 @@ func InitializeServer(db *sql.DB, config *config.Config) *gin.Engine {
 	// 各ハンドラーを初期化
 	healthHandler := initializeHealthHandler()
 	taskHandler := initializeTaskHandler(db, logger)
+	projectHandler := initializeProjectHandler(db, logger)
+	taskStatusHandler := initializeTaskStatusHandler(db, logger)
+	taskTemplateHandler := initializeTaskTemplateHandler(db, logger)
+	taskViewHandler := initializeTaskViewHandler(db, logger)
+	timeEntryHandler := initializeTimeEntryHandler(db, logger)
+	statsHandler := initializeStatsHandler(db, logger)
+	attachmentHandler := initializeAttachmentHandler(db, config, logger)
+	notificationHandler := initializeNotificationHandler(db, logger)
+	calendarFeedHandler := initializeCalendarFeedHandler(db, config, logger)
+	importHandler := initializeImportHandler(db, logger)
+	accountArchiveHandler := initializeAccountArchiveHandler(db, logger)
+	userSettingHandler := initializeUserSettingHandler(db, logger)
 	authHandler, authService := initializeAuthHandler(db, config)
 	interpretationHandler := initializeInterpretationHandler(db, logger, geminiService)
 	interpretationItemHandler := initializeInterpretationItemHandler(db, logger)
@@ -95,9 +358,12 @@ func InitializeServer(db *sql.DB, config *config.Config) *gin.Engine {
 	// 認証ミドルウェアを初期化
 	authMiddleware := middleware.NewAuthMiddleware(authService)
 
+	// 冪等性キーミドルウェアを初期化
+	idempotencyMiddleware := middleware.NewIdempotencyMiddleware(initializeIdempotencyUsecase(db, config, logger), config.Idempotency.MaxBodySize)
+
 	// 統合ハンドラーを作成
-	server := http.NewServer(healthHandler, taskHandler, authHandler, interpretationHandler, interpretationItemHandler)
+	server := http.NewServer(healthHandler, taskHandler, projectHandler, taskStatusHandler, taskTemplateHandler, taskViewHandler, timeEntryHandler, statsHandler, attachmentHandler, notificationHandler, calendarFeedHandler, importHandler, accountArchiveHandler, userSettingHandler, authHandler, interpretationHandler, interpretationItemHandler)
 
 	// ルーターをセットアップ（OpenAPI仕様に基づく）
-	return http.SetupRoutes(server, authMiddleware)
+	return http.SetupRoutes(server, authMiddleware, idempotencyMiddleware)
 }
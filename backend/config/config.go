@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -12,6 +15,8 @@ import (
 type Config struct {
 	// サーバー設定
 	Port string
+	// PublicBaseURL 外部から見たAPIサーバーのURL（iCalendar購読URL等の組み立てに使用）
+	PublicBaseURL string
 
 	// データベース設定
 	Database DatabaseConfig
@@ -21,6 +26,18 @@ type Config struct {
 
 	// AI設定
 	AI AIConfig
+
+	// タスク設定
+	Task TaskConfig
+
+	// 冪等性キー設定
+	Idempotency IdempotencyConfig
+
+	// リマインダー設定
+	Reminder ReminderConfig
+
+	// 添付ファイル設定
+	Attachment AttachmentConfig
 }
 
 // DatabaseConfig データベース接続設定
@@ -48,6 +65,74 @@ type AIConfig struct {
 	GeminiModel  string `json:"gemini_model"`
 }
 
+// TaskConfig タスク設定
+type TaskConfig struct {
+	// TrashRetention ゴミ箱内のタスクを完全削除するまでの保持期間
+	TrashRetention time.Duration `json:"trash_retention"`
+	// TrashPurgeInterval ゴミ箱の完全削除を実行する間隔
+	TrashPurgeInterval time.Duration `json:"trash_purge_interval"`
+	// SnoozeWakeInterval 解除日時を過ぎたスヌーズの解除を実行する間隔
+	SnoozeWakeInterval time.Duration `json:"snooze_wake_interval"`
+	// AutoArchiveInterval 完了したタスクの自動アーカイブを実行する間隔
+	AutoArchiveInterval time.Duration `json:"auto_archive_interval"`
+}
+
+// IdempotencyConfig 冪等性キー設定
+type IdempotencyConfig struct {
+	// KeyTTL 冪等性キーを保持して同じレスポンスを返す期間
+	KeyTTL time.Duration `json:"key_ttl"`
+	// PurgeInterval 期限切れの冪等性キーの削除を実行する間隔
+	PurgeInterval time.Duration `json:"purge_interval"`
+	// MaxBodySize Idempotency-Key付きのリクエストでフィンガープリントのために読み込むボディの最大サイズ（バイト）
+	MaxBodySize int64 `json:"max_body_size"`
+}
+
+// ReminderConfig 期限リマインダー設定
+type ReminderConfig struct {
+	// Offsets 期限のどれだけ前にリマインダーを配信するか（0は期限到来時）
+	Offsets []time.Duration `json:"offsets"`
+	// ScanInterval リマインダーの対象タスクを走査する間隔
+	ScanInterval time.Duration `json:"scan_interval"`
+	// WebhookURL リマインダーを送信するWebhookのURL（空の場合はWebhook配信なし）
+	WebhookURL string `json:"-"`
+	// WebhookSecret Webhookの署名に使う秘密鍵（空の場合は署名なし）
+	WebhookSecret string `json:"-"`
+	// SMTP メール配信の設定（Hostが空の場合はメール配信なし）
+	SMTP SMTPConfig `json:"smtp"`
+}
+
+// SMTPConfig メール送信設定
+type SMTPConfig struct {
+	Host     string `json:"smtp_host"`
+	Port     string `json:"smtp_port"`
+	Username string `json:"-"`
+	Password string `json:"-"`
+	From     string `json:"smtp_from"`
+}
+
+// AttachmentConfig 添付ファイル設定
+type AttachmentConfig struct {
+	// MaxSize 添付ファイル1件あたりの最大サイズ（バイト）
+	MaxSize int64 `json:"max_size"`
+	// Storage ファイル本体の保存先（local または s3）
+	Storage string `json:"storage"`
+	// LocalDir ローカルファイルシステムに保存する場合のディレクトリ
+	LocalDir string `json:"local_dir"`
+	// S3 S3互換ストレージに保存する場合の設定
+	S3 S3Config `json:"s3"`
+}
+
+// S3Config S3互換ストレージ（AWS S3、MinIO等）の接続設定
+type S3Config struct {
+	Endpoint        string `json:"s3_endpoint"`
+	Region          string `json:"s3_region"`
+	Bucket          string `json:"s3_bucket"`
+	AccessKeyID     string `json:"-"`
+	SecretAccessKey string `json:"-"`
+	// UsePathStyle バケット名をホスト名ではなくパスに含める（MinIO等で使用）
+	UsePathStyle bool `json:"s3_use_path_style"`
+}
+
 // Load 環境変数から設定を読み込む
 func Load() *Config {
 	// .envファイルを読み込む（エラーは無視 - 環境変数が直接設定されている場合もあるため）
@@ -145,8 +230,168 @@ func Load() *Config {
 		log.Printf("GEMINI_MODEL not set, using default: %s", geminiModel)
 	}
 
+	// ゴミ箱の保持期間（日数、デフォルト30日）
+	trashRetentionDays := 30
+	if value := os.Getenv("TASK_TRASH_RETENTION_DAYS"); value != "" {
+		days, err := strconv.Atoi(value)
+		if err != nil || days < 1 {
+			log.Fatalf("TASK_TRASH_RETENTION_DAYS must be a positive integer: %s", value)
+		}
+		trashRetentionDays = days
+	}
+
+	// ゴミ箱の完全削除の実行間隔（デフォルト1時間）
+	trashPurgeInterval := time.Hour
+	if value := os.Getenv("TASK_TRASH_PURGE_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			log.Fatalf("TASK_TRASH_PURGE_INTERVAL must be a positive duration (e.g. 1h): %s", value)
+		}
+		trashPurgeInterval = interval
+	}
+
+	// スヌーズの解除の実行間隔（デフォルト1分）
+	snoozeWakeInterval := time.Minute
+	if value := os.Getenv("TASK_SNOOZE_WAKE_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			log.Fatalf("TASK_SNOOZE_WAKE_INTERVAL must be a positive duration (e.g. 1m): %s", value)
+		}
+		snoozeWakeInterval = interval
+	}
+
+	// 完了したタスクの自動アーカイブの実行間隔（デフォルト1時間）
+	autoArchiveInterval := time.Hour
+	if value := os.Getenv("TASK_AUTO_ARCHIVE_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			log.Fatalf("TASK_AUTO_ARCHIVE_INTERVAL must be a positive duration (e.g. 1h): %s", value)
+		}
+		autoArchiveInterval = interval
+	}
+
+	// 冪等性キーの有効期間（デフォルト24時間）
+	idempotencyKeyTTL := 24 * time.Hour
+	if value := os.Getenv("IDEMPOTENCY_KEY_TTL"); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl <= 0 {
+			log.Fatalf("IDEMPOTENCY_KEY_TTL must be a positive duration (e.g. 24h): %s", value)
+		}
+		idempotencyKeyTTL = ttl
+	}
+
+	// 期限切れの冪等性キーの削除間隔（デフォルト1時間）
+	idempotencyPurgeInterval := time.Hour
+	if value := os.Getenv("IDEMPOTENCY_KEY_PURGE_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			log.Fatalf("IDEMPOTENCY_KEY_PURGE_INTERVAL must be a positive duration (e.g. 1h): %s", value)
+		}
+		idempotencyPurgeInterval = interval
+	}
+
+	// Idempotency-Key付きのリクエストのボディの最大サイズ（MB、デフォルト64MB）
+	// アカウントのインポート（50MB）等のアップロードにもキーを付けられるよう、アップロードの上限以上にします
+	idempotencyMaxBodySizeMB := 64
+	if value := os.Getenv("IDEMPOTENCY_MAX_BODY_SIZE_MB"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 1 {
+			log.Fatalf("IDEMPOTENCY_MAX_BODY_SIZE_MB must be a positive integer: %s", value)
+		}
+		idempotencyMaxBodySizeMB = size
+	}
+
+	// リマインダーのタイミング（カンマ区切りの期間、デフォルトは24時間前と1時間前）
+	reminderOffsets := []time.Duration{24 * time.Hour, time.Hour}
+	if value := os.Getenv("REMINDER_OFFSETS"); value != "" {
+		reminderOffsets = nil
+		for _, part := range strings.Split(value, ",") {
+			offset, err := time.ParseDuration(strings.TrimSpace(part))
+			if err != nil || offset < 0 {
+				log.Fatalf("REMINDER_OFFSETS must be a comma-separated list of non-negative durations (e.g. 24h,1h): %s", value)
+			}
+			reminderOffsets = append(reminderOffsets, offset)
+		}
+	}
+
+	// リマインダーの走査間隔（デフォルト1分）
+	reminderScanInterval := time.Minute
+	if value := os.Getenv("REMINDER_SCAN_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			log.Fatalf("REMINDER_SCAN_INTERVAL must be a positive duration (e.g. 1m): %s", value)
+		}
+		reminderScanInterval = interval
+	}
+
+	// SMTP設定（SMTP_HOSTが設定されている場合のみメール配信を有効化）
+	smtpHost := os.Getenv("SMTP_HOST")
+	smtpPort := os.Getenv("SMTP_PORT")
+	if smtpPort == "" {
+		smtpPort = "587"
+	}
+	smtpFrom := os.Getenv("SMTP_FROM")
+	if smtpHost != "" && smtpFrom == "" {
+		log.Fatal("SMTP_FROM environment variable is required when SMTP_HOST is set.")
+	}
+
+	// 添付ファイルの最大サイズ（MB、デフォルト10MB）
+	attachmentMaxSizeMB := 10
+	if value := os.Getenv("ATTACHMENT_MAX_SIZE_MB"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 1 {
+			log.Fatalf("ATTACHMENT_MAX_SIZE_MB must be a positive integer: %s", value)
+		}
+		attachmentMaxSizeMB = size
+	}
+
+	// 添付ファイルの保存先（デフォルトはローカルファイルシステム）
+	attachmentStorage := os.Getenv("ATTACHMENT_STORAGE")
+	if attachmentStorage == "" {
+		attachmentStorage = "local"
+	}
+	attachmentLocalDir := os.Getenv("ATTACHMENT_LOCAL_DIR")
+	if attachmentLocalDir == "" {
+		attachmentLocalDir = "./data/attachments"
+	}
+
+	// S3互換ストレージ設定（ATTACHMENT_STORAGE=s3 の場合のみ使用、S3_ENDPOINT未設定時はAWS S3）
+	s3Region := os.Getenv("S3_REGION")
+	if s3Region == "" {
+		s3Region = "us-east-1"
+	}
+	s3Endpoint := os.Getenv("S3_ENDPOINT")
+	if s3Endpoint == "" {
+		s3Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", s3Region)
+	}
+	s3UsePathStyle := false
+	if value := os.Getenv("S3_USE_PATH_STYLE"); value != "" {
+		usePathStyle, err := strconv.ParseBool(value)
+		if err != nil {
+			log.Fatalf("S3_USE_PATH_STYLE must be a boolean: %s", value)
+		}
+		s3UsePathStyle = usePathStyle
+	}
+
+	switch attachmentStorage {
+	case "local":
+	case "s3":
+		if os.Getenv("S3_BUCKET") == "" || os.Getenv("S3_ACCESS_KEY_ID") == "" || os.Getenv("S3_SECRET_ACCESS_KEY") == "" {
+			log.Fatal("S3_BUCKET, S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY environment variables are required when ATTACHMENT_STORAGE is s3.")
+		}
+	default:
+		log.Fatalf("ATTACHMENT_STORAGE must be one of: local, s3: %s", attachmentStorage)
+	}
+
+	// 外部から見たAPIサーバーのURL（デフォルトはローカル開発環境）
+	publicBaseURL := os.Getenv("PUBLIC_BASE_URL")
+	if publicBaseURL == "" {
+		publicBaseURL = "http://localhost:" + port
+	}
+
 	config := &Config{
-		Port: port,
+		Port:          port,
+		PublicBaseURL: publicBaseURL,
 
 		Database: DatabaseConfig{
 			Driver:   driver,
@@ -169,6 +414,47 @@ func Load() *Config {
 			GeminiAPIKey: geminiAPIKey,
 			GeminiModel:  geminiModel,
 		},
+
+		Task: TaskConfig{
+			TrashRetention:      time.Duration(trashRetentionDays) * 24 * time.Hour,
+			TrashPurgeInterval:  trashPurgeInterval,
+			SnoozeWakeInterval:  snoozeWakeInterval,
+			AutoArchiveInterval: autoArchiveInterval,
+		},
+
+		Idempotency: IdempotencyConfig{
+			KeyTTL:        idempotencyKeyTTL,
+			PurgeInterval: idempotencyPurgeInterval,
+			MaxBodySize:   int64(idempotencyMaxBodySizeMB) << 20,
+		},
+
+		Reminder: ReminderConfig{
+			Offsets:       reminderOffsets,
+			ScanInterval:  reminderScanInterval,
+			WebhookURL:    os.Getenv("REMINDER_WEBHOOK_URL"),
+			WebhookSecret: os.Getenv("REMINDER_WEBHOOK_SECRET"),
+			SMTP: SMTPConfig{
+				Host:     smtpHost,
+				Port:     smtpPort,
+				Username: os.Getenv("SMTP_USERNAME"),
+				Password: os.Getenv("SMTP_PASSWORD"),
+				From:     smtpFrom,
+			},
+		},
+
+		Attachment: AttachmentConfig{
+			MaxSize:  int64(attachmentMaxSizeMB) << 20,
+			Storage:  attachmentStorage,
+			LocalDir: attachmentLocalDir,
+			S3: S3Config{
+				Endpoint:        s3Endpoint,
+				Region:          s3Region,
+				Bucket:          os.Getenv("S3_BUCKET"),
+				AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+				SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+				UsePathStyle:    s3UsePathStyle,
+			},
+		},
 	}
 
 	return config
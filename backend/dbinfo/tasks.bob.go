@@ -33,6 +33,15 @@ var Tasks = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		ProjectID: column{
+			Name:      "project_id",
+			DBType:    "char(36)",
+			Default:   "",
+			Comment:   "プロジェクトID",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 		Title: column{
 			Name:      "title",
 			DBType:    "varchar(500)",
@@ -60,6 +69,15 @@ var Tasks = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		EstimateMinutes: column{
+			Name:      "estimate_minutes",
+			DBType:    "int",
+			Default:   "",
+			Comment:   "見積もり工数（分）",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 		Status: column{
 			Name:      "status",
 			DBType:    "varchar(20)",
@@ -69,6 +87,69 @@ var Tasks = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		StatusCategory: column{
+			Name:      "status_category",
+			DBType:    "varchar(10)",
+			Default:   "todo",
+			Comment:   "ステータスのカテゴリ（todo/doing/done、task_statuses.categoryの複製）",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RankKey: column{
+			Name:      "rank_key",
+			DBType:    "varchar(255)",
+			Default:   "",
+			Comment:   "列内の表示順キー（辞書順、空文字列は未配置）",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		StartedAt: column{
+			Name:      "started_at",
+			DBType:    "timestamp",
+			Default:   "",
+			Comment:   "着手日時（doingカテゴリのステータスに変更した日時、todoカテゴリに戻すとNULL）",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CompletedAt: column{
+			Name:      "completed_at",
+			DBType:    "timestamp",
+			Default:   "",
+			Comment:   "完了日時（doneカテゴリのステータスに変更した日時、未完了に戻すとNULL）",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		SnoozedUntil: column{
+			Name:      "snoozed_until",
+			DBType:    "timestamp",
+			Default:   "",
+			Comment:   "スヌーズの解除日時（この日時まで既定の一覧に表示しない、NULLはスヌーズなし）",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		SnoozeNotify: column{
+			Name:      "snooze_notify",
+			DBType:    "tinyint(1)",
+			Default:   "0",
+			Comment:   "スヌーズ解除時に通知するかどうか",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ArchivedAt: column{
+			Name:      "archived_at",
+			DBType:    "timestamp",
+			Default:   "",
+			Comment:   "アーカイブ日時（NULLは未アーカイブ、アーカイブしたタスクは既定の一覧に表示しない）",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 		Source: column{
 			Name:      "source",
 			DBType:    "varchar(20)",
@@ -87,6 +168,42 @@ var Tasks = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		RecurrenceRule: column{
+			Name:      "recurrence_rule",
+			DBType:    "varchar(500)",
+			Default:   "",
+			Comment:   "繰り返しルール（RFC 5545 RRULE）",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RecurrenceAnchorAt: column{
+			Name:      "recurrence_anchor_at",
+			DBType:    "timestamp",
+			Default:   "",
+			Comment:   "繰り返しの起点日時（DTSTART）",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		RecurrenceSeriesID: column{
+			Name:      "recurrence_series_id",
+			DBType:    "char(36)",
+			Default:   "",
+			Comment:   "繰り返しシリーズID",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Version: column{
+			Name:      "version",
+			DBType:    "int",
+			Default:   "1",
+			Comment:   "楽観的排他制御用のバージョン（更新ごとに加算、ETagとして公開）",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
 		CreatedAt: column{
 			Name:      "created_at",
 			DBType:    "timestamp",
@@ -105,6 +222,15 @@ var Tasks = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		DeletedAt: column{
+			Name:      "deleted_at",
+			DBType:    "timestamp",
+			Default:   "",
+			Comment:   "削除日時（NULLは未削除、保持期間の経過後に完全削除）",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: taskIndexes{
 		FKTasksAiInterpretation: index{
@@ -120,6 +246,19 @@ var Tasks = Table[
 			Unique:  false,
 			Comment: "",
 		},
+		FKTasksProject: index{
+			Type: "BTREE",
+			Name: "fk_tasks_project",
+			Columns: []indexColumn{
+				{
+					Name:         "project_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+		},
 		IdxTasksCreatedAt: index{
 			Type: "BTREE",
 			Name: "idx_tasks_created_at",
@@ -133,6 +272,19 @@ var Tasks = Table[
 			Unique:  false,
 			Comment: "",
 		},
+		IdxTasksDeletedAt: index{
+			Type: "BTREE",
+			Name: "idx_tasks_deleted_at",
+			Columns: []indexColumn{
+				{
+					Name:         "deleted_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+		},
 		IdxTasksDueAt: index{
 			Type: "BTREE",
 			Name: "idx_tasks_due_at",
@@ -146,6 +298,37 @@ var Tasks = Table[
 			Unique:  false,
 			Comment: "",
 		},
+		IdxTasksRecurrenceSeries: index{
+			Type: "BTREE",
+			Name: "idx_tasks_recurrence_series",
+			Columns: []indexColumn{
+				{
+					Name:         "recurrence_series_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "due_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+		},
+		IdxTasksSnoozedUntil: index{
+			Type: "BTREE",
+			Name: "idx_tasks_snoozed_until",
+			Columns: []indexColumn{
+				{
+					Name:         "snoozed_until",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+		},
 		IdxTasksStatus: index{
 			Type: "BTREE",
 			Name: "idx_tasks_status",
@@ -159,6 +342,42 @@ var Tasks = Table[
 			Unique:  false,
 			Comment: "",
 		},
+		IdxTasksUserArchived: index{
+			Type: "BTREE",
+			Name: "idx_tasks_user_archived",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "archived_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+		},
+		IdxTasksUserCompleted: index{
+			Type: "BTREE",
+			Name: "idx_tasks_user_completed",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "completed_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+		},
 		IdxTasksUserCreated: index{
 			Type: "BTREE",
 			Name: "idx_tasks_user_created",
@@ -177,6 +396,24 @@ var Tasks = Table[
 			Unique:  false,
 			Comment: "",
 		},
+		IdxTasksUserDeleted: index{
+			Type: "BTREE",
+			Name: "idx_tasks_user_deleted",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "deleted_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+		},
 		IdxTasksUserDue: index{
 			Type: "BTREE",
 			Name: "idx_tasks_user_due",
@@ -195,6 +432,42 @@ var Tasks = Table[
 			Unique:  false,
 			Comment: "",
 		},
+		IdxTasksUserProject: index{
+			Type: "BTREE",
+			Name: "idx_tasks_user_project",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "project_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+		},
+		IdxTasksUserStarted: index{
+			Type: "BTREE",
+			Name: "idx_tasks_user_started",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "started_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+		},
 		IdxTasksUserStatus: index{
 			Type: "BTREE",
 			Name: "idx_tasks_user_status",
@@ -213,6 +486,47 @@ var Tasks = Table[
 			Unique:  false,
 			Comment: "",
 		},
+		IdxTasksUserStatusCategory: index{
+			Type: "BTREE",
+			Name: "idx_tasks_user_status_category",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "status_category",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+		},
+		IdxTasksUserStatusRank: index{
+			Type: "BTREE",
+			Name: "idx_tasks_user_status_rank",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "status",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "rank_key",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:  false,
+			Comment: "",
+		},
 		PRIMARY: index{
 			Type: "BTREE",
 			Name: "PRIMARY",
@@ -242,6 +556,15 @@ var Tasks = Table[
 			ForeignTable:   "ai_interpretations",
 			ForeignColumns: []string{"id"},
 		},
+		FKTasksProject: foreignKey{
+			constraint: constraint{
+				Name:    "fk_tasks_project",
+				Columns: []string{"project_id"},
+				Comment: "",
+			},
+			ForeignTable:   "projects",
+			ForeignColumns: []string{"id"},
+		},
 		FKTasksUser: foreignKey{
 			constraint: constraint{
 				Name:    "fk_tasks_user",
@@ -259,47 +582,73 @@ var Tasks = Table[
 type taskColumns struct {
 	ID                 column
 	UserID             column
+	ProjectID          column
 	Title              column
 	Description        column
 	DueAt              column
+	EstimateMinutes    column
 	Status             column
+	StatusCategory     column
+	RankKey            column
+	StartedAt          column
+	CompletedAt        column
+	SnoozedUntil       column
+	SnoozeNotify       column
+	ArchivedAt         column
 	Source             column
 	AiInterpretationID column
+	RecurrenceRule     column
+	RecurrenceAnchorAt column
+	RecurrenceSeriesID column
+	Version            column
 	CreatedAt          column
 	UpdatedAt          column
+	DeletedAt          column
 }
 
 func (c taskColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.UserID, c.Title, c.Description, c.DueAt, c.Status, c.Source, c.AiInterpretationID, c.CreatedAt, c.UpdatedAt,
+		c.ID, c.UserID, c.ProjectID, c.Title, c.Description, c.DueAt, c.EstimateMinutes, c.Status, c.StatusCategory, c.RankKey, c.StartedAt, c.CompletedAt, c.SnoozedUntil, c.SnoozeNotify, c.ArchivedAt, c.Source, c.AiInterpretationID, c.RecurrenceRule, c.RecurrenceAnchorAt, c.RecurrenceSeriesID, c.Version, c.CreatedAt, c.UpdatedAt, c.DeletedAt,
 	}
 }
 
 type taskIndexes struct {
-	FKTasksAiInterpretation index
-	IdxTasksCreatedAt       index
-	IdxTasksDueAt           index
-	IdxTasksStatus          index
-	IdxTasksUserCreated     index
-	IdxTasksUserDue         index
-	IdxTasksUserStatus      index
-	PRIMARY                 index
+	FKTasksAiInterpretation    index
+	FKTasksProject             index
+	IdxTasksCreatedAt          index
+	IdxTasksDeletedAt          index
+	IdxTasksDueAt              index
+	IdxTasksRecurrenceSeries   index
+	IdxTasksSnoozedUntil       index
+	IdxTasksStatus             index
+	IdxTasksUserArchived       index
+	IdxTasksUserCompleted      index
+	IdxTasksUserCreated        index
+	IdxTasksUserDeleted        index
+	IdxTasksUserDue            index
+	IdxTasksUserProject        index
+	IdxTasksUserStarted        index
+	IdxTasksUserStatus         index
+	IdxTasksUserStatusCategory index
+	IdxTasksUserStatusRank     index
+	PRIMARY                    index
 }
 
 func (i taskIndexes) AsSlice() []index {
 	return []index{
-		i.FKTasksAiInterpretation, i.IdxTasksCreatedAt, i.IdxTasksDueAt, i.IdxTasksStatus, i.IdxTasksUserCreated, i.IdxTasksUserDue, i.IdxTasksUserStatus, i.PRIMARY,
+		i.FKTasksAiInterpretation, i.FKTasksProject, i.IdxTasksCreatedAt, i.IdxTasksDeletedAt, i.IdxTasksDueAt, i.IdxTasksRecurrenceSeries, i.IdxTasksSnoozedUntil, i.IdxTasksStatus, i.IdxTasksUserArchived, i.IdxTasksUserCompleted, i.IdxTasksUserCreated, i.IdxTasksUserDeleted, i.IdxTasksUserDue, i.IdxTasksUserProject, i.IdxTasksUserStarted, i.IdxTasksUserStatus, i.IdxTasksUserStatusCategory, i.IdxTasksUserStatusRank, i.PRIMARY,
 	}
 }
 
 type taskForeignKeys struct {
 	FKTasksAiInterpretation foreignKey
+	FKTasksProject          foreignKey
 	FKTasksUser             foreignKey
 }
 
 func (f taskForeignKeys) AsSlice() []foreignKey {
 	return []foreignKey{
-		f.FKTasksAiInterpretation, f.FKTasksUser,
+		f.FKTasksAiInterpretation, f.FKTasksProject, f.FKTasksUser,
 	}
 }
 
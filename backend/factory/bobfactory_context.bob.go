@@ -14,24 +14,110 @@ var (
 	aiInterpretationRelInterpretationInterpretationItemsCtx = newContextual[bool]("ai_interpretations.interpretation_items.fk_interpretation_items_interpretation")
 	aiInterpretationRelTasksCtx                             = newContextual[bool]("ai_interpretations.tasks.fk_tasks_ai_interpretation")
 
+	// Relationship Contexts for calendar_feeds
+	calendarFeedWithParentsCascadingCtx = newContextual[bool]("calendarFeedWithParentsCascading")
+	calendarFeedRelUserCtx              = newContextual[bool]("calendar_feeds.users.fk_calendar_feeds_user")
+
+	// Relationship Contexts for idempotency_keys
+	idempotencyKeyWithParentsCascadingCtx = newContextual[bool]("idempotencyKeyWithParentsCascading")
+	idempotencyKeyRelUserCtx              = newContextual[bool]("idempotency_keys.users.fk_idempotency_keys_user")
+
 	// Relationship Contexts for interpretation_items
 	interpretationItemWithParentsCascadingCtx              = newContextual[bool]("interpretationItemWithParentsCascading")
 	interpretationItemRelInterpretationAiInterpretationCtx = newContextual[bool]("ai_interpretations.interpretation_items.fk_interpretation_items_interpretation")
 
+	// Relationship Contexts for notifications
+	notificationWithParentsCascadingCtx = newContextual[bool]("notificationWithParentsCascading")
+	notificationRelTaskCtx              = newContextual[bool]("notifications.tasks.fk_notifications_task")
+	notificationRelUserCtx              = newContextual[bool]("notifications.users.fk_notifications_user")
+
+	// Relationship Contexts for projects
+	projectWithParentsCascadingCtx = newContextual[bool]("projectWithParentsCascading")
+	projectRelUserCtx              = newContextual[bool]("projects.users.fk_projects_user")
+	projectRelTaskTemplatesCtx     = newContextual[bool]("projects.task_templates.fk_task_templates_project")
+	projectRelTasksCtx             = newContextual[bool]("projects.tasks.fk_tasks_project")
+
+	// Relationship Contexts for task_attachments
+	taskAttachmentWithParentsCascadingCtx = newContextual[bool]("taskAttachmentWithParentsCascading")
+	taskAttachmentRelTaskCtx              = newContextual[bool]("task_attachments.tasks.fk_task_attachments_task")
+	taskAttachmentRelUserCtx              = newContextual[bool]("task_attachments.users.fk_task_attachments_user")
+
+	// Relationship Contexts for task_dependencies
+	taskDependencyWithParentsCascadingCtx = newContextual[bool]("taskDependencyWithParentsCascading")
+	taskDependencyRelDependsOnTaskTaskCtx = newContextual[bool]("task_dependencies.tasks.fk_task_dependencies_depends_on")
+	taskDependencyRelTaskCtx              = newContextual[bool]("task_dependencies.tasks.fk_task_dependencies_task")
+
+	// Relationship Contexts for task_events
+	taskEventWithParentsCascadingCtx = newContextual[bool]("taskEventWithParentsCascading")
+	taskEventRelActorUserCtx         = newContextual[bool]("task_events.users.fk_task_events_actor")
+	taskEventRelUserCtx              = newContextual[bool]("task_events.users.fk_task_events_user")
+
+	// Relationship Contexts for task_imports
+	taskImportWithParentsCascadingCtx = newContextual[bool]("taskImportWithParentsCascading")
+	taskImportRelTaskCtx              = newContextual[bool]("task_imports.tasks.fk_task_imports_task")
+	taskImportRelUserCtx              = newContextual[bool]("task_imports.users.fk_task_imports_user")
+
+	// Relationship Contexts for task_reminders
+	taskReminderWithParentsCascadingCtx = newContextual[bool]("taskReminderWithParentsCascading")
+	taskReminderRelTaskCtx              = newContextual[bool]("task_reminders.tasks.fk_task_reminders_task")
+
+	// Relationship Contexts for task_statuses
+	taskStatusWithParentsCascadingCtx = newContextual[bool]("taskStatusWithParentsCascading")
+	taskStatusRelUserCtx              = newContextual[bool]("task_statuses.users.fk_task_statuses_user")
+
+	// Relationship Contexts for task_templates
+	taskTemplateWithParentsCascadingCtx = newContextual[bool]("taskTemplateWithParentsCascading")
+	taskTemplateRelProjectCtx           = newContextual[bool]("projects.task_templates.fk_task_templates_project")
+	taskTemplateRelUserCtx              = newContextual[bool]("task_templates.users.fk_task_templates_user")
+
+	// Relationship Contexts for task_views
+	taskViewWithParentsCascadingCtx = newContextual[bool]("taskViewWithParentsCascading")
+	taskViewRelUserCtx              = newContextual[bool]("task_views.users.fk_task_views_user")
+
 	// Relationship Contexts for tasks
-	taskWithParentsCascadingCtx = newContextual[bool]("taskWithParentsCascading")
-	taskRelAiInterpretationCtx  = newContextual[bool]("ai_interpretations.tasks.fk_tasks_ai_interpretation")
-	taskRelUserCtx              = newContextual[bool]("tasks.users.fk_tasks_user")
+	taskWithParentsCascadingCtx             = newContextual[bool]("taskWithParentsCascading")
+	taskRelNotificationsCtx                 = newContextual[bool]("notifications.tasks.fk_notifications_task")
+	taskRelTaskAttachmentsCtx               = newContextual[bool]("task_attachments.tasks.fk_task_attachments_task")
+	taskRelDependsOnTaskTaskDependenciesCtx = newContextual[bool]("task_dependencies.tasks.fk_task_dependencies_depends_on")
+	taskRelTaskDependenciesCtx              = newContextual[bool]("task_dependencies.tasks.fk_task_dependencies_task")
+	taskRelTaskImportsCtx                   = newContextual[bool]("task_imports.tasks.fk_task_imports_task")
+	taskRelTaskRemindersCtx                 = newContextual[bool]("task_reminders.tasks.fk_task_reminders_task")
+	taskRelAiInterpretationCtx              = newContextual[bool]("ai_interpretations.tasks.fk_tasks_ai_interpretation")
+	taskRelProjectCtx                       = newContextual[bool]("projects.tasks.fk_tasks_project")
+	taskRelUserCtx                          = newContextual[bool]("tasks.users.fk_tasks_user")
+	taskRelTimeEntriesCtx                   = newContextual[bool]("tasks.time_entries.fk_time_entries_task")
+
+	// Relationship Contexts for time_entries
+	timeEntryWithParentsCascadingCtx = newContextual[bool]("timeEntryWithParentsCascading")
+	timeEntryRelTaskCtx              = newContextual[bool]("tasks.time_entries.fk_time_entries_task")
+	timeEntryRelUserCtx              = newContextual[bool]("time_entries.users.fk_time_entries_user")
 
 	// Relationship Contexts for user_auths
 	userAuthWithParentsCascadingCtx = newContextual[bool]("userAuthWithParentsCascading")
 	userAuthRelUserCtx              = newContextual[bool]("user_auths.users.fk_user_auths_user")
 
+	// Relationship Contexts for user_settings
+	userSettingWithParentsCascadingCtx = newContextual[bool]("userSettingWithParentsCascading")
+	userSettingRelUserCtx              = newContextual[bool]("user_settings.users.fk_user_settings_user")
+
 	// Relationship Contexts for users
 	userWithParentsCascadingCtx = newContextual[bool]("userWithParentsCascading")
 	userRelAiInterpretationsCtx = newContextual[bool]("ai_interpretations.users.fk_ai_interpretations_user")
+	userRelCalendarFeedCtx      = newContextual[bool]("calendar_feeds.users.fk_calendar_feeds_user")
+	userRelIdempotencyKeysCtx   = newContextual[bool]("idempotency_keys.users.fk_idempotency_keys_user")
+	userRelNotificationsCtx     = newContextual[bool]("notifications.users.fk_notifications_user")
+	userRelProjectsCtx          = newContextual[bool]("projects.users.fk_projects_user")
+	userRelTaskAttachmentsCtx   = newContextual[bool]("task_attachments.users.fk_task_attachments_user")
+	userRelActorTaskEventsCtx   = newContextual[bool]("task_events.users.fk_task_events_actor")
+	userRelTaskEventsCtx        = newContextual[bool]("task_events.users.fk_task_events_user")
+	userRelTaskImportsCtx       = newContextual[bool]("task_imports.users.fk_task_imports_user")
+	userRelTaskStatusesCtx      = newContextual[bool]("task_statuses.users.fk_task_statuses_user")
+	userRelTaskTemplatesCtx     = newContextual[bool]("task_templates.users.fk_task_templates_user")
+	userRelTaskViewsCtx         = newContextual[bool]("task_views.users.fk_task_views_user")
 	userRelTasksCtx             = newContextual[bool]("tasks.users.fk_tasks_user")
+	userRelTimeEntriesCtx       = newContextual[bool]("time_entries.users.fk_time_entries_user")
 	userRelUserAuthsCtx         = newContextual[bool]("user_auths.users.fk_user_auths_user")
+	userRelUserSettingCtx       = newContextual[bool]("user_settings.users.fk_user_settings_user")
 )
 
 // Contextual is a convienience wrapper around context.WithValue and context.Value
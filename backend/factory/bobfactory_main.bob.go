@@ -15,9 +15,23 @@ import (
 
 type Factory struct {
 	baseAiInterpretationMods   AiInterpretationModSlice
+	baseCalendarFeedMods       CalendarFeedModSlice
+	baseIdempotencyKeyMods     IdempotencyKeyModSlice
 	baseInterpretationItemMods InterpretationItemModSlice
+	baseNotificationMods       NotificationModSlice
+	baseProjectMods            ProjectModSlice
+	baseTaskAttachmentMods     TaskAttachmentModSlice
+	baseTaskDependencyMods     TaskDependencyModSlice
+	baseTaskEventMods          TaskEventModSlice
+	baseTaskImportMods         TaskImportModSlice
+	baseTaskReminderMods       TaskReminderModSlice
+	baseTaskStatusMods         TaskStatusModSlice
+	baseTaskTemplateMods       TaskTemplateModSlice
+	baseTaskViewMods           TaskViewModSlice
 	baseTaskMods               TaskModSlice
+	baseTimeEntryMods          TimeEntryModSlice
 	baseUserAuthMods           UserAuthModSlice
+	baseUserSettingMods        UserSettingModSlice
 	baseUserMods               UserModSlice
 }
 
@@ -68,6 +82,77 @@ func (f *Factory) FromExistingAiInterpretation(m *models.AiInterpretation) *AiIn
 	return o
 }
 
+func (f *Factory) NewCalendarFeed(mods ...CalendarFeedMod) *CalendarFeedTemplate {
+	return f.NewCalendarFeedWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewCalendarFeedWithContext(ctx context.Context, mods ...CalendarFeedMod) *CalendarFeedTemplate {
+	o := &CalendarFeedTemplate{f: f}
+
+	if f != nil {
+		f.baseCalendarFeedMods.Apply(ctx, o)
+	}
+
+	CalendarFeedModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingCalendarFeed(m *models.CalendarFeed) *CalendarFeedTemplate {
+	o := &CalendarFeedTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.TokenHash = func() string { return m.TokenHash }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		CalendarFeedMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewIdempotencyKey(mods ...IdempotencyKeyMod) *IdempotencyKeyTemplate {
+	return f.NewIdempotencyKeyWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewIdempotencyKeyWithContext(ctx context.Context, mods ...IdempotencyKeyMod) *IdempotencyKeyTemplate {
+	o := &IdempotencyKeyTemplate{f: f}
+
+	if f != nil {
+		f.baseIdempotencyKeyMods.Apply(ctx, o)
+	}
+
+	IdempotencyKeyModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingIdempotencyKey(m *models.IdempotencyKey) *IdempotencyKeyTemplate {
+	o := &IdempotencyKeyTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.IdempotencyKey = func() string { return m.IdempotencyKey }
+	o.RequestMethod = func() string { return m.RequestMethod }
+	o.RequestPath = func() string { return m.RequestPath }
+	o.RequestHash = func() string { return m.RequestHash }
+	o.StatusCode = func() null.Val[int32] { return m.StatusCode }
+	o.ResponseHeaders = func() null.Val[types.JSON[json.RawMessage]] { return m.ResponseHeaders }
+	o.ResponseBody = func() null.Val[[]byte] { return m.ResponseBody }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.ExpiresAt = func() time.Time { return m.ExpiresAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		IdempotencyKeyMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewInterpretationItem(mods ...InterpretationItemMod) *InterpretationItemTemplate {
 	return f.NewInterpretationItemWithContext(context.Background(), mods...)
 }
@@ -107,6 +192,386 @@ func (f *Factory) FromExistingInterpretationItem(m *models.InterpretationItem) *
 	return o
 }
 
+func (f *Factory) NewNotification(mods ...NotificationMod) *NotificationTemplate {
+	return f.NewNotificationWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewNotificationWithContext(ctx context.Context, mods ...NotificationMod) *NotificationTemplate {
+	o := &NotificationTemplate{f: f}
+
+	if f != nil {
+		f.baseNotificationMods.Apply(ctx, o)
+	}
+
+	NotificationModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingNotification(m *models.Notification) *NotificationTemplate {
+	o := &NotificationTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.Type = func() string { return m.Type }
+	o.Title = func() string { return m.Title }
+	o.Body = func() null.Val[string] { return m.Body }
+	o.TaskID = func() null.Val[string] { return m.TaskID }
+	o.ReadAt = func() null.Val[time.Time] { return m.ReadAt }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Task != nil {
+		NotificationMods.WithExistingTask(m.R.Task).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		NotificationMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewProject(mods ...ProjectMod) *ProjectTemplate {
+	return f.NewProjectWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewProjectWithContext(ctx context.Context, mods ...ProjectMod) *ProjectTemplate {
+	o := &ProjectTemplate{f: f}
+
+	if f != nil {
+		f.baseProjectMods.Apply(ctx, o)
+	}
+
+	ProjectModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingProject(m *models.Project) *ProjectTemplate {
+	o := &ProjectTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.Name = func() string { return m.Name }
+	o.Color = func() null.Val[string] { return m.Color }
+	o.Archived = func() bool { return m.Archived }
+	o.SortOrder = func() int32 { return m.SortOrder }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		ProjectMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+	if len(m.R.TaskTemplates) > 0 {
+		ProjectMods.AddExistingTaskTemplates(m.R.TaskTemplates...).Apply(ctx, o)
+	}
+	if len(m.R.Tasks) > 0 {
+		ProjectMods.AddExistingTasks(m.R.Tasks...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTaskAttachment(mods ...TaskAttachmentMod) *TaskAttachmentTemplate {
+	return f.NewTaskAttachmentWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTaskAttachmentWithContext(ctx context.Context, mods ...TaskAttachmentMod) *TaskAttachmentTemplate {
+	o := &TaskAttachmentTemplate{f: f}
+
+	if f != nil {
+		f.baseTaskAttachmentMods.Apply(ctx, o)
+	}
+
+	TaskAttachmentModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTaskAttachment(m *models.TaskAttachment) *TaskAttachmentTemplate {
+	o := &TaskAttachmentTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.TaskID = func() string { return m.TaskID }
+	o.Filename = func() string { return m.Filename }
+	o.ContentType = func() string { return m.ContentType }
+	o.SizeBytes = func() int64 { return m.SizeBytes }
+	o.ChecksumSha256 = func() string { return m.ChecksumSha256 }
+	o.StorageKey = func() string { return m.StorageKey }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Task != nil {
+		TaskAttachmentMods.WithExistingTask(m.R.Task).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		TaskAttachmentMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTaskDependency(mods ...TaskDependencyMod) *TaskDependencyTemplate {
+	return f.NewTaskDependencyWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTaskDependencyWithContext(ctx context.Context, mods ...TaskDependencyMod) *TaskDependencyTemplate {
+	o := &TaskDependencyTemplate{f: f}
+
+	if f != nil {
+		f.baseTaskDependencyMods.Apply(ctx, o)
+	}
+
+	TaskDependencyModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTaskDependency(m *models.TaskDependency) *TaskDependencyTemplate {
+	o := &TaskDependencyTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.TaskID = func() string { return m.TaskID }
+	o.DependsOnTaskID = func() string { return m.DependsOnTaskID }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.DependsOnTaskTask != nil {
+		TaskDependencyMods.WithExistingDependsOnTaskTask(m.R.DependsOnTaskTask).Apply(ctx, o)
+	}
+	if m.R.Task != nil {
+		TaskDependencyMods.WithExistingTask(m.R.Task).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTaskEvent(mods ...TaskEventMod) *TaskEventTemplate {
+	return f.NewTaskEventWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTaskEventWithContext(ctx context.Context, mods ...TaskEventMod) *TaskEventTemplate {
+	o := &TaskEventTemplate{f: f}
+
+	if f != nil {
+		f.baseTaskEventMods.Apply(ctx, o)
+	}
+
+	TaskEventModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTaskEvent(m *models.TaskEvent) *TaskEventTemplate {
+	o := &TaskEventTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.TaskID = func() string { return m.TaskID }
+	o.UserID = func() string { return m.UserID }
+	o.ActorID = func() null.Val[string] { return m.ActorID }
+	o.EventType = func() string { return m.EventType }
+	o.Source = func() string { return m.Source }
+	o.Changes = func() types.JSON[json.RawMessage] { return m.Changes }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.ActorUser != nil {
+		TaskEventMods.WithExistingActorUser(m.R.ActorUser).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		TaskEventMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTaskImport(mods ...TaskImportMod) *TaskImportTemplate {
+	return f.NewTaskImportWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTaskImportWithContext(ctx context.Context, mods ...TaskImportMod) *TaskImportTemplate {
+	o := &TaskImportTemplate{f: f}
+
+	if f != nil {
+		f.baseTaskImportMods.Apply(ctx, o)
+	}
+
+	TaskImportModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTaskImport(m *models.TaskImport) *TaskImportTemplate {
+	o := &TaskImportTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.Source = func() string { return m.Source }
+	o.ExternalID = func() string { return m.ExternalID }
+	o.TaskID = func() string { return m.TaskID }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Task != nil {
+		TaskImportMods.WithExistingTask(m.R.Task).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		TaskImportMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTaskReminder(mods ...TaskReminderMod) *TaskReminderTemplate {
+	return f.NewTaskReminderWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTaskReminderWithContext(ctx context.Context, mods ...TaskReminderMod) *TaskReminderTemplate {
+	o := &TaskReminderTemplate{f: f}
+
+	if f != nil {
+		f.baseTaskReminderMods.Apply(ctx, o)
+	}
+
+	TaskReminderModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTaskReminder(m *models.TaskReminder) *TaskReminderTemplate {
+	o := &TaskReminderTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.TaskID = func() string { return m.TaskID }
+	o.DueAt = func() time.Time { return m.DueAt }
+	o.OffsetMinutes = func() int32 { return m.OffsetMinutes }
+	o.Channel = func() string { return m.Channel }
+	o.Status = func() string { return m.Status }
+	o.Attempts = func() int32 { return m.Attempts }
+	o.LastError = func() null.Val[string] { return m.LastError }
+	o.SentAt = func() null.Val[time.Time] { return m.SentAt }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.Task != nil {
+		TaskReminderMods.WithExistingTask(m.R.Task).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTaskStatus(mods ...TaskStatusMod) *TaskStatusTemplate {
+	return f.NewTaskStatusWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTaskStatusWithContext(ctx context.Context, mods ...TaskStatusMod) *TaskStatusTemplate {
+	o := &TaskStatusTemplate{f: f}
+
+	if f != nil {
+		f.baseTaskStatusMods.Apply(ctx, o)
+	}
+
+	TaskStatusModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTaskStatus(m *models.TaskStatus) *TaskStatusTemplate {
+	o := &TaskStatusTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.Name = func() string { return m.Name }
+	o.Category = func() string { return m.Category }
+	o.SortOrder = func() int32 { return m.SortOrder }
+	o.WipLimit = func() null.Val[int32] { return m.WipLimit }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		TaskStatusMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTaskTemplate(mods ...TaskTemplateMod) *TaskTemplateTemplate {
+	return f.NewTaskTemplateWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTaskTemplateWithContext(ctx context.Context, mods ...TaskTemplateMod) *TaskTemplateTemplate {
+	o := &TaskTemplateTemplate{f: f}
+
+	if f != nil {
+		f.baseTaskTemplateMods.Apply(ctx, o)
+	}
+
+	TaskTemplateModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTaskTemplate(m *models.TaskTemplate) *TaskTemplateTemplate {
+	o := &TaskTemplateTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.Name = func() string { return m.Name }
+	o.Description = func() null.Val[string] { return m.Description }
+	o.ProjectID = func() null.Val[string] { return m.ProjectID }
+	o.Items = func() types.JSON[json.RawMessage] { return m.Items }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.Project != nil {
+		TaskTemplateMods.WithExistingProject(m.R.Project).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		TaskTemplateMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTaskView(mods ...TaskViewMod) *TaskViewTemplate {
+	return f.NewTaskViewWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTaskViewWithContext(ctx context.Context, mods ...TaskViewMod) *TaskViewTemplate {
+	o := &TaskViewTemplate{f: f}
+
+	if f != nil {
+		f.baseTaskViewMods.Apply(ctx, o)
+	}
+
+	TaskViewModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTaskView(m *models.TaskView) *TaskViewTemplate {
+	o := &TaskViewTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.Name = func() string { return m.Name }
+	o.Filter = func() types.JSON[json.RawMessage] { return m.Filter }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		TaskViewMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewTask(mods ...TaskMod) *TaskTemplate {
 	return f.NewTaskWithContext(context.Background(), mods...)
 }
@@ -128,22 +593,100 @@ func (f *Factory) FromExistingTask(m *models.Task) *TaskTemplate {
 
 	o.ID = func() string { return m.ID }
 	o.UserID = func() string { return m.UserID }
+	o.ProjectID = func() null.Val[string] { return m.ProjectID }
 	o.Title = func() string { return m.Title }
 	o.Description = func() null.Val[string] { return m.Description }
 	o.DueAt = func() null.Val[time.Time] { return m.DueAt }
+	o.EstimateMinutes = func() null.Val[int32] { return m.EstimateMinutes }
 	o.Status = func() string { return m.Status }
+	o.StatusCategory = func() string { return m.StatusCategory }
+	o.RankKey = func() string { return m.RankKey }
+	o.StartedAt = func() null.Val[time.Time] { return m.StartedAt }
+	o.CompletedAt = func() null.Val[time.Time] { return m.CompletedAt }
+	o.SnoozedUntil = func() null.Val[time.Time] { return m.SnoozedUntil }
+	o.SnoozeNotify = func() bool { return m.SnoozeNotify }
+	o.ArchivedAt = func() null.Val[time.Time] { return m.ArchivedAt }
 	o.Source = func() string { return m.Source }
 	o.AiInterpretationID = func() null.Val[string] { return m.AiInterpretationID }
+	o.RecurrenceRule = func() null.Val[string] { return m.RecurrenceRule }
+	o.RecurrenceAnchorAt = func() null.Val[time.Time] { return m.RecurrenceAnchorAt }
+	o.RecurrenceSeriesID = func() null.Val[string] { return m.RecurrenceSeriesID }
+	o.Version = func() int32 { return m.Version }
 	o.CreatedAt = func() time.Time { return m.CreatedAt }
 	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+	o.DeletedAt = func() null.Val[time.Time] { return m.DeletedAt }
 
 	ctx := context.Background()
+	if len(m.R.Notifications) > 0 {
+		TaskMods.AddExistingNotifications(m.R.Notifications...).Apply(ctx, o)
+	}
+	if len(m.R.TaskAttachments) > 0 {
+		TaskMods.AddExistingTaskAttachments(m.R.TaskAttachments...).Apply(ctx, o)
+	}
+	if len(m.R.DependsOnTaskTaskDependencies) > 0 {
+		TaskMods.AddExistingDependsOnTaskTaskDependencies(m.R.DependsOnTaskTaskDependencies...).Apply(ctx, o)
+	}
+	if len(m.R.TaskDependencies) > 0 {
+		TaskMods.AddExistingTaskDependencies(m.R.TaskDependencies...).Apply(ctx, o)
+	}
+	if len(m.R.TaskImports) > 0 {
+		TaskMods.AddExistingTaskImports(m.R.TaskImports...).Apply(ctx, o)
+	}
+	if len(m.R.TaskReminders) > 0 {
+		TaskMods.AddExistingTaskReminders(m.R.TaskReminders...).Apply(ctx, o)
+	}
 	if m.R.AiInterpretation != nil {
 		TaskMods.WithExistingAiInterpretation(m.R.AiInterpretation).Apply(ctx, o)
 	}
+	if m.R.Project != nil {
+		TaskMods.WithExistingProject(m.R.Project).Apply(ctx, o)
+	}
 	if m.R.User != nil {
 		TaskMods.WithExistingUser(m.R.User).Apply(ctx, o)
 	}
+	if len(m.R.TimeEntries) > 0 {
+		TaskMods.AddExistingTimeEntries(m.R.TimeEntries...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewTimeEntry(mods ...TimeEntryMod) *TimeEntryTemplate {
+	return f.NewTimeEntryWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTimeEntryWithContext(ctx context.Context, mods ...TimeEntryMod) *TimeEntryTemplate {
+	o := &TimeEntryTemplate{f: f}
+
+	if f != nil {
+		f.baseTimeEntryMods.Apply(ctx, o)
+	}
+
+	TimeEntryModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTimeEntry(m *models.TimeEntry) *TimeEntryTemplate {
+	o := &TimeEntryTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.TaskID = func() string { return m.TaskID }
+	o.StartedAt = func() time.Time { return m.StartedAt }
+	o.EndedAt = func() null.Val[time.Time] { return m.EndedAt }
+	o.DurationSeconds = func() int32 { return m.DurationSeconds }
+	o.Note = func() null.Val[string] { return m.Note }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.Task != nil {
+		TimeEntryMods.WithExistingTask(m.R.Task).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		TimeEntryMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
 
 	return o
 }
@@ -183,6 +726,39 @@ func (f *Factory) FromExistingUserAuth(m *models.UserAuth) *UserAuthTemplate {
 	return o
 }
 
+func (f *Factory) NewUserSetting(mods ...UserSettingMod) *UserSettingTemplate {
+	return f.NewUserSettingWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewUserSettingWithContext(ctx context.Context, mods ...UserSettingMod) *UserSettingTemplate {
+	o := &UserSettingTemplate{f: f}
+
+	if f != nil {
+		f.baseUserSettingMods.Apply(ctx, o)
+	}
+
+	UserSettingModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingUserSetting(m *models.UserSetting) *UserSettingTemplate {
+	o := &UserSettingTemplate{f: f, alreadyPersisted: true}
+
+	o.UserID = func() string { return m.UserID }
+	o.AutoArchiveDays = func() null.Val[int32] { return m.AutoArchiveDays }
+	o.Timezone = func() null.Val[string] { return m.Timezone }
+	o.CreatedAt = func() time.Time { return m.CreatedAt }
+	o.UpdatedAt = func() time.Time { return m.UpdatedAt }
+
+	ctx := context.Background()
+	if m.R.User != nil {
+		UserSettingMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewUser(mods ...UserMod) *UserTemplate {
 	return f.NewUserWithContext(context.Background(), mods...)
 }
@@ -214,12 +790,51 @@ func (f *Factory) FromExistingUser(m *models.User) *UserTemplate {
 	if len(m.R.AiInterpretations) > 0 {
 		UserMods.AddExistingAiInterpretations(m.R.AiInterpretations...).Apply(ctx, o)
 	}
+	if m.R.CalendarFeed != nil {
+		UserMods.WithExistingCalendarFeed(m.R.CalendarFeed).Apply(ctx, o)
+	}
+	if len(m.R.IdempotencyKeys) > 0 {
+		UserMods.AddExistingIdempotencyKeys(m.R.IdempotencyKeys...).Apply(ctx, o)
+	}
+	if len(m.R.Notifications) > 0 {
+		UserMods.AddExistingNotifications(m.R.Notifications...).Apply(ctx, o)
+	}
+	if len(m.R.Projects) > 0 {
+		UserMods.AddExistingProjects(m.R.Projects...).Apply(ctx, o)
+	}
+	if len(m.R.TaskAttachments) > 0 {
+		UserMods.AddExistingTaskAttachments(m.R.TaskAttachments...).Apply(ctx, o)
+	}
+	if len(m.R.ActorTaskEvents) > 0 {
+		UserMods.AddExistingActorTaskEvents(m.R.ActorTaskEvents...).Apply(ctx, o)
+	}
+	if len(m.R.TaskEvents) > 0 {
+		UserMods.AddExistingTaskEvents(m.R.TaskEvents...).Apply(ctx, o)
+	}
+	if len(m.R.TaskImports) > 0 {
+		UserMods.AddExistingTaskImports(m.R.TaskImports...).Apply(ctx, o)
+	}
+	if len(m.R.TaskStatuses) > 0 {
+		UserMods.AddExistingTaskStatuses(m.R.TaskStatuses...).Apply(ctx, o)
+	}
+	if len(m.R.TaskTemplates) > 0 {
+		UserMods.AddExistingTaskTemplates(m.R.TaskTemplates...).Apply(ctx, o)
+	}
+	if len(m.R.TaskViews) > 0 {
+		UserMods.AddExistingTaskViews(m.R.TaskViews...).Apply(ctx, o)
+	}
 	if len(m.R.Tasks) > 0 {
 		UserMods.AddExistingTasks(m.R.Tasks...).Apply(ctx, o)
 	}
+	if len(m.R.TimeEntries) > 0 {
+		UserMods.AddExistingTimeEntries(m.R.TimeEntries...).Apply(ctx, o)
+	}
 	if len(m.R.UserAuths) > 0 {
 		UserMods.AddExistingUserAuths(m.R.UserAuths...).Apply(ctx, o)
 	}
+	if m.R.UserSetting != nil {
+		UserMods.WithExistingUserSetting(m.R.UserSetting).Apply(ctx, o)
+	}
 
 	return o
 }
@@ -232,6 +847,22 @@ func (f *Factory) AddBaseAiInterpretationMod(mods ...AiInterpretationMod) {
 	f.baseAiInterpretationMods = append(f.baseAiInterpretationMods, mods...)
 }
 
+func (f *Factory) ClearBaseCalendarFeedMods() {
+	f.baseCalendarFeedMods = nil
+}
+
+func (f *Factory) AddBaseCalendarFeedMod(mods ...CalendarFeedMod) {
+	f.baseCalendarFeedMods = append(f.baseCalendarFeedMods, mods...)
+}
+
+func (f *Factory) ClearBaseIdempotencyKeyMods() {
+	f.baseIdempotencyKeyMods = nil
+}
+
+func (f *Factory) AddBaseIdempotencyKeyMod(mods ...IdempotencyKeyMod) {
+	f.baseIdempotencyKeyMods = append(f.baseIdempotencyKeyMods, mods...)
+}
+
 func (f *Factory) ClearBaseInterpretationItemMods() {
 	f.baseInterpretationItemMods = nil
 }
@@ -240,6 +871,86 @@ func (f *Factory) AddBaseInterpretationItemMod(mods ...InterpretationItemMod) {
 	f.baseInterpretationItemMods = append(f.baseInterpretationItemMods, mods...)
 }
 
+func (f *Factory) ClearBaseNotificationMods() {
+	f.baseNotificationMods = nil
+}
+
+func (f *Factory) AddBaseNotificationMod(mods ...NotificationMod) {
+	f.baseNotificationMods = append(f.baseNotificationMods, mods...)
+}
+
+func (f *Factory) ClearBaseProjectMods() {
+	f.baseProjectMods = nil
+}
+
+func (f *Factory) AddBaseProjectMod(mods ...ProjectMod) {
+	f.baseProjectMods = append(f.baseProjectMods, mods...)
+}
+
+func (f *Factory) ClearBaseTaskAttachmentMods() {
+	f.baseTaskAttachmentMods = nil
+}
+
+func (f *Factory) AddBaseTaskAttachmentMod(mods ...TaskAttachmentMod) {
+	f.baseTaskAttachmentMods = append(f.baseTaskAttachmentMods, mods...)
+}
+
+func (f *Factory) ClearBaseTaskDependencyMods() {
+	f.baseTaskDependencyMods = nil
+}
+
+func (f *Factory) AddBaseTaskDependencyMod(mods ...TaskDependencyMod) {
+	f.baseTaskDependencyMods = append(f.baseTaskDependencyMods, mods...)
+}
+
+func (f *Factory) ClearBaseTaskEventMods() {
+	f.baseTaskEventMods = nil
+}
+
+func (f *Factory) AddBaseTaskEventMod(mods ...TaskEventMod) {
+	f.baseTaskEventMods = append(f.baseTaskEventMods, mods...)
+}
+
+func (f *Factory) ClearBaseTaskImportMods() {
+	f.baseTaskImportMods = nil
+}
+
+func (f *Factory) AddBaseTaskImportMod(mods ...TaskImportMod) {
+	f.baseTaskImportMods = append(f.baseTaskImportMods, mods...)
+}
+
+func (f *Factory) ClearBaseTaskReminderMods() {
+	f.baseTaskReminderMods = nil
+}
+
+func (f *Factory) AddBaseTaskReminderMod(mods ...TaskReminderMod) {
+	f.baseTaskReminderMods = append(f.baseTaskReminderMods, mods...)
+}
+
+func (f *Factory) ClearBaseTaskStatusMods() {
+	f.baseTaskStatusMods = nil
+}
+
+func (f *Factory) AddBaseTaskStatusMod(mods ...TaskStatusMod) {
+	f.baseTaskStatusMods = append(f.baseTaskStatusMods, mods...)
+}
+
+func (f *Factory) ClearBaseTaskTemplateMods() {
+	f.baseTaskTemplateMods = nil
+}
+
+func (f *Factory) AddBaseTaskTemplateMod(mods ...TaskTemplateMod) {
+	f.baseTaskTemplateMods = append(f.baseTaskTemplateMods, mods...)
+}
+
+func (f *Factory) ClearBaseTaskViewMods() {
+	f.baseTaskViewMods = nil
+}
+
+func (f *Factory) AddBaseTaskViewMod(mods ...TaskViewMod) {
+	f.baseTaskViewMods = append(f.baseTaskViewMods, mods...)
+}
+
 func (f *Factory) ClearBaseTaskMods() {
 	f.baseTaskMods = nil
 }
@@ -248,6 +959,14 @@ func (f *Factory) AddBaseTaskMod(mods ...TaskMod) {
 	f.baseTaskMods = append(f.baseTaskMods, mods...)
 }
 
+func (f *Factory) ClearBaseTimeEntryMods() {
+	f.baseTimeEntryMods = nil
+}
+
+func (f *Factory) AddBaseTimeEntryMod(mods ...TimeEntryMod) {
+	f.baseTimeEntryMods = append(f.baseTimeEntryMods, mods...)
+}
+
 func (f *Factory) ClearBaseUserAuthMods() {
 	f.baseUserAuthMods = nil
 }
@@ -256,6 +975,14 @@ func (f *Factory) AddBaseUserAuthMod(mods ...UserAuthMod) {
 	f.baseUserAuthMods = append(f.baseUserAuthMods, mods...)
 }
 
+func (f *Factory) ClearBaseUserSettingMods() {
+	f.baseUserSettingMods = nil
+}
+
+func (f *Factory) AddBaseUserSettingMod(mods ...UserSettingMod) {
+	f.baseUserSettingMods = append(f.baseUserSettingMods, mods...)
+}
+
 func (f *Factory) ClearBaseUserMods() {
 	f.baseUserMods = nil
 }
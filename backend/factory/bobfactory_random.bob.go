@@ -17,6 +17,22 @@ import (
 
 var defaultFaker = faker.New()
 
+func random___byte(f *faker.Faker, limits ...string) []byte {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	return []byte(random_string(f, limits...))
+}
+
+func random_bool(f *faker.Faker, limits ...string) bool {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	return f.Bool()
+}
+
 func random_int32(f *faker.Faker, limits ...string) int32 {
 	if f == nil {
 		f = &defaultFaker
@@ -25,6 +41,14 @@ func random_int32(f *faker.Faker, limits ...string) int32 {
 	return f.Int32()
 }
 
+func random_int64(f *faker.Faker, limits ...string) int64 {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	return f.Int64()
+}
+
 func random_string(f *faker.Faker, limits ...string) string {
 	if f == nil {
 		f = &defaultFaker
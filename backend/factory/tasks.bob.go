@@ -39,14 +39,28 @@ func (mods TaskModSlice) Apply(ctx context.Context, n *TaskTemplate) {
 type TaskTemplate struct {
 	ID                 func() string
 	UserID             func() string
+	ProjectID          func() null.Val[string]
 	Title              func() string
 	Description        func() null.Val[string]
 	DueAt              func() null.Val[time.Time]
+	EstimateMinutes    func() null.Val[int32]
 	Status             func() string
+	StatusCategory     func() string
+	RankKey            func() string
+	StartedAt          func() null.Val[time.Time]
+	CompletedAt        func() null.Val[time.Time]
+	SnoozedUntil       func() null.Val[time.Time]
+	SnoozeNotify       func() bool
+	ArchivedAt         func() null.Val[time.Time]
 	Source             func() string
 	AiInterpretationID func() null.Val[string]
+	RecurrenceRule     func() null.Val[string]
+	RecurrenceAnchorAt func() null.Val[time.Time]
+	RecurrenceSeriesID func() null.Val[string]
+	Version            func() int32
 	CreatedAt          func() time.Time
 	UpdatedAt          func() time.Time
+	DeletedAt          func() null.Val[time.Time]
 
 	r taskR
 	f *Factory
@@ -55,16 +69,55 @@ type TaskTemplate struct {
 }
 
 type taskR struct {
-	AiInterpretation *taskRAiInterpretationR
-	User             *taskRUserR
+	Notifications                 []*taskRNotificationsR
+	TaskAttachments               []*taskRTaskAttachmentsR
+	DependsOnTaskTaskDependencies []*taskRDependsOnTaskTaskDependenciesR
+	TaskDependencies              []*taskRTaskDependenciesR
+	TaskImports                   []*taskRTaskImportsR
+	TaskReminders                 []*taskRTaskRemindersR
+	AiInterpretation              *taskRAiInterpretationR
+	Project                       *taskRProjectR
+	User                          *taskRUserR
+	TimeEntries                   []*taskRTimeEntriesR
+}
+
+type taskRNotificationsR struct {
+	number int
+	o      *NotificationTemplate
+}
+type taskRTaskAttachmentsR struct {
+	number int
+	o      *TaskAttachmentTemplate
+}
+type taskRDependsOnTaskTaskDependenciesR struct {
+	number int
+	o      *TaskDependencyTemplate
+}
+type taskRTaskDependenciesR struct {
+	number int
+	o      *TaskDependencyTemplate
+}
+type taskRTaskImportsR struct {
+	number int
+	o      *TaskImportTemplate
+}
+type taskRTaskRemindersR struct {
+	number int
+	o      *TaskReminderTemplate
 }
-
 type taskRAiInterpretationR struct {
 	o *AiInterpretationTemplate
 }
+type taskRProjectR struct {
+	o *ProjectTemplate
+}
 type taskRUserR struct {
 	o *UserTemplate
 }
+type taskRTimeEntriesR struct {
+	number int
+	o      *TimeEntryTemplate
+}
 
 // Apply mods to the TaskTemplate
 func (o *TaskTemplate) Apply(ctx context.Context, mods ...TaskMod) {
@@ -76,6 +129,84 @@ func (o *TaskTemplate) Apply(ctx context.Context, mods ...TaskMod) {
 // setModelRels creates and sets the relationships on *models.Task
 // according to the relationships in the template. Nothing is inserted into the db
 func (t TaskTemplate) setModelRels(o *models.Task) {
+	if t.r.Notifications != nil {
+		rel := models.NotificationSlice{}
+		for _, r := range t.r.Notifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TaskID = null.From(o.ID) // h2
+				rel.R.Task = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Notifications = rel
+	}
+
+	if t.r.TaskAttachments != nil {
+		rel := models.TaskAttachmentSlice{}
+		for _, r := range t.r.TaskAttachments {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TaskID = o.ID // h2
+				rel.R.Task = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TaskAttachments = rel
+	}
+
+	if t.r.DependsOnTaskTaskDependencies != nil {
+		rel := models.TaskDependencySlice{}
+		for _, r := range t.r.DependsOnTaskTaskDependencies {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.DependsOnTaskID = o.ID // h2
+				rel.R.DependsOnTaskTask = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.DependsOnTaskTaskDependencies = rel
+	}
+
+	if t.r.TaskDependencies != nil {
+		rel := models.TaskDependencySlice{}
+		for _, r := range t.r.TaskDependencies {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TaskID = o.ID // h2
+				rel.R.Task = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TaskDependencies = rel
+	}
+
+	if t.r.TaskImports != nil {
+		rel := models.TaskImportSlice{}
+		for _, r := range t.r.TaskImports {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TaskID = o.ID // h2
+				rel.R.Task = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TaskImports = rel
+	}
+
+	if t.r.TaskReminders != nil {
+		rel := models.TaskReminderSlice{}
+		for _, r := range t.r.TaskReminders {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TaskID = o.ID // h2
+				rel.R.Task = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TaskReminders = rel
+	}
+
 	if t.r.AiInterpretation != nil {
 		rel := t.r.AiInterpretation.o.Build()
 		rel.R.Tasks = append(rel.R.Tasks, o)
@@ -83,12 +214,32 @@ func (t TaskTemplate) setModelRels(o *models.Task) {
 		o.R.AiInterpretation = rel
 	}
 
+	if t.r.Project != nil {
+		rel := t.r.Project.o.Build()
+		rel.R.Tasks = append(rel.R.Tasks, o)
+		o.ProjectID = null.From(rel.ID) // h2
+		o.R.Project = rel
+	}
+
 	if t.r.User != nil {
 		rel := t.r.User.o.Build()
 		rel.R.Tasks = append(rel.R.Tasks, o)
 		o.UserID = rel.ID // h2
 		o.R.User = rel
 	}
+
+	if t.r.TimeEntries != nil {
+		rel := models.TimeEntrySlice{}
+		for _, r := range t.r.TimeEntries {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TaskID = o.ID // h2
+				rel.R.Task = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TimeEntries = rel
+	}
 }
 
 // BuildSetter returns an *models.TaskSetter
@@ -104,6 +255,10 @@ func (o TaskTemplate) BuildSetter() *models.TaskSetter {
 		val := o.UserID()
 		m.UserID = omit.From(val)
 	}
+	if o.ProjectID != nil {
+		val := o.ProjectID()
+		m.ProjectID = omitnull.FromNull(val)
+	}
 	if o.Title != nil {
 		val := o.Title()
 		m.Title = omit.From(val)
@@ -116,10 +271,42 @@ func (o TaskTemplate) BuildSetter() *models.TaskSetter {
 		val := o.DueAt()
 		m.DueAt = omitnull.FromNull(val)
 	}
+	if o.EstimateMinutes != nil {
+		val := o.EstimateMinutes()
+		m.EstimateMinutes = omitnull.FromNull(val)
+	}
 	if o.Status != nil {
 		val := o.Status()
 		m.Status = omit.From(val)
 	}
+	if o.StatusCategory != nil {
+		val := o.StatusCategory()
+		m.StatusCategory = omit.From(val)
+	}
+	if o.RankKey != nil {
+		val := o.RankKey()
+		m.RankKey = omit.From(val)
+	}
+	if o.StartedAt != nil {
+		val := o.StartedAt()
+		m.StartedAt = omitnull.FromNull(val)
+	}
+	if o.CompletedAt != nil {
+		val := o.CompletedAt()
+		m.CompletedAt = omitnull.FromNull(val)
+	}
+	if o.SnoozedUntil != nil {
+		val := o.SnoozedUntil()
+		m.SnoozedUntil = omitnull.FromNull(val)
+	}
+	if o.SnoozeNotify != nil {
+		val := o.SnoozeNotify()
+		m.SnoozeNotify = omit.From(val)
+	}
+	if o.ArchivedAt != nil {
+		val := o.ArchivedAt()
+		m.ArchivedAt = omitnull.FromNull(val)
+	}
 	if o.Source != nil {
 		val := o.Source()
 		m.Source = omit.From(val)
@@ -128,6 +315,22 @@ func (o TaskTemplate) BuildSetter() *models.TaskSetter {
 		val := o.AiInterpretationID()
 		m.AiInterpretationID = omitnull.FromNull(val)
 	}
+	if o.RecurrenceRule != nil {
+		val := o.RecurrenceRule()
+		m.RecurrenceRule = omitnull.FromNull(val)
+	}
+	if o.RecurrenceAnchorAt != nil {
+		val := o.RecurrenceAnchorAt()
+		m.RecurrenceAnchorAt = omitnull.FromNull(val)
+	}
+	if o.RecurrenceSeriesID != nil {
+		val := o.RecurrenceSeriesID()
+		m.RecurrenceSeriesID = omitnull.FromNull(val)
+	}
+	if o.Version != nil {
+		val := o.Version()
+		m.Version = omit.From(val)
+	}
 	if o.CreatedAt != nil {
 		val := o.CreatedAt()
 		m.CreatedAt = omit.From(val)
@@ -136,6 +339,10 @@ func (o TaskTemplate) BuildSetter() *models.TaskSetter {
 		val := o.UpdatedAt()
 		m.UpdatedAt = omit.From(val)
 	}
+	if o.DeletedAt != nil {
+		val := o.DeletedAt()
+		m.DeletedAt = omitnull.FromNull(val)
+	}
 
 	return m
 }
@@ -164,6 +371,9 @@ func (o TaskTemplate) Build() *models.Task {
 	if o.UserID != nil {
 		m.UserID = o.UserID()
 	}
+	if o.ProjectID != nil {
+		m.ProjectID = o.ProjectID()
+	}
 	if o.Title != nil {
 		m.Title = o.Title()
 	}
@@ -173,21 +383,60 @@ func (o TaskTemplate) Build() *models.Task {
 	if o.DueAt != nil {
 		m.DueAt = o.DueAt()
 	}
+	if o.EstimateMinutes != nil {
+		m.EstimateMinutes = o.EstimateMinutes()
+	}
 	if o.Status != nil {
 		m.Status = o.Status()
 	}
+	if o.StatusCategory != nil {
+		m.StatusCategory = o.StatusCategory()
+	}
+	if o.RankKey != nil {
+		m.RankKey = o.RankKey()
+	}
+	if o.StartedAt != nil {
+		m.StartedAt = o.StartedAt()
+	}
+	if o.CompletedAt != nil {
+		m.CompletedAt = o.CompletedAt()
+	}
+	if o.SnoozedUntil != nil {
+		m.SnoozedUntil = o.SnoozedUntil()
+	}
+	if o.SnoozeNotify != nil {
+		m.SnoozeNotify = o.SnoozeNotify()
+	}
+	if o.ArchivedAt != nil {
+		m.ArchivedAt = o.ArchivedAt()
+	}
 	if o.Source != nil {
 		m.Source = o.Source()
 	}
 	if o.AiInterpretationID != nil {
 		m.AiInterpretationID = o.AiInterpretationID()
 	}
+	if o.RecurrenceRule != nil {
+		m.RecurrenceRule = o.RecurrenceRule()
+	}
+	if o.RecurrenceAnchorAt != nil {
+		m.RecurrenceAnchorAt = o.RecurrenceAnchorAt()
+	}
+	if o.RecurrenceSeriesID != nil {
+		m.RecurrenceSeriesID = o.RecurrenceSeriesID()
+	}
+	if o.Version != nil {
+		m.Version = o.Version()
+	}
 	if o.CreatedAt != nil {
 		m.CreatedAt = o.CreatedAt()
 	}
 	if o.UpdatedAt != nil {
 		m.UpdatedAt = o.UpdatedAt()
 	}
+	if o.DeletedAt != nil {
+		m.DeletedAt = o.DeletedAt()
+	}
 
 	o.setModelRels(m)
 
@@ -220,6 +469,10 @@ func ensureCreatableTask(m *models.TaskSetter) {
 		val := random_string(nil, "500")
 		m.Title = omit.From(val)
 	}
+	if !(m.RankKey.IsValue()) {
+		val := random_string(nil, "255")
+		m.RankKey = omit.From(val)
+	}
 }
 
 // insertOptRels creates and inserts any optional the relationships on *models.Task
@@ -228,18 +481,157 @@ func ensureCreatableTask(m *models.TaskSetter) {
 func (o *TaskTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Task) error {
 	var err error
 
+	isNotificationsDone, _ := taskRelNotificationsCtx.Value(ctx)
+	if !isNotificationsDone && o.r.Notifications != nil {
+		ctx = taskRelNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Notifications {
+			if r.o.alreadyPersisted {
+				m.R.Notifications = append(m.R.Notifications, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachNotifications(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTaskAttachmentsDone, _ := taskRelTaskAttachmentsCtx.Value(ctx)
+	if !isTaskAttachmentsDone && o.r.TaskAttachments != nil {
+		ctx = taskRelTaskAttachmentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.TaskAttachments {
+			if r.o.alreadyPersisted {
+				m.R.TaskAttachments = append(m.R.TaskAttachments, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTaskAttachments(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isDependsOnTaskTaskDependenciesDone, _ := taskRelDependsOnTaskTaskDependenciesCtx.Value(ctx)
+	if !isDependsOnTaskTaskDependenciesDone && o.r.DependsOnTaskTaskDependencies != nil {
+		ctx = taskRelDependsOnTaskTaskDependenciesCtx.WithValue(ctx, true)
+		for _, r := range o.r.DependsOnTaskTaskDependencies {
+			if r.o.alreadyPersisted {
+				m.R.DependsOnTaskTaskDependencies = append(m.R.DependsOnTaskTaskDependencies, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachDependsOnTaskTaskDependencies(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTaskDependenciesDone, _ := taskRelTaskDependenciesCtx.Value(ctx)
+	if !isTaskDependenciesDone && o.r.TaskDependencies != nil {
+		ctx = taskRelTaskDependenciesCtx.WithValue(ctx, true)
+		for _, r := range o.r.TaskDependencies {
+			if r.o.alreadyPersisted {
+				m.R.TaskDependencies = append(m.R.TaskDependencies, r.o.Build())
+			} else {
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTaskDependencies(ctx, exec, rel3...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTaskImportsDone, _ := taskRelTaskImportsCtx.Value(ctx)
+	if !isTaskImportsDone && o.r.TaskImports != nil {
+		ctx = taskRelTaskImportsCtx.WithValue(ctx, true)
+		for _, r := range o.r.TaskImports {
+			if r.o.alreadyPersisted {
+				m.R.TaskImports = append(m.R.TaskImports, r.o.Build())
+			} else {
+				rel4, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTaskImports(ctx, exec, rel4...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTaskRemindersDone, _ := taskRelTaskRemindersCtx.Value(ctx)
+	if !isTaskRemindersDone && o.r.TaskReminders != nil {
+		ctx = taskRelTaskRemindersCtx.WithValue(ctx, true)
+		for _, r := range o.r.TaskReminders {
+			if r.o.alreadyPersisted {
+				m.R.TaskReminders = append(m.R.TaskReminders, r.o.Build())
+			} else {
+				rel5, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTaskReminders(ctx, exec, rel5...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	isAiInterpretationDone, _ := taskRelAiInterpretationCtx.Value(ctx)
 	if !isAiInterpretationDone && o.r.AiInterpretation != nil {
 		ctx = taskRelAiInterpretationCtx.WithValue(ctx, true)
 		if o.r.AiInterpretation.o.alreadyPersisted {
 			m.R.AiInterpretation = o.r.AiInterpretation.o.Build()
 		} else {
-			var rel0 *models.AiInterpretation
-			rel0, err = o.r.AiInterpretation.o.Create(ctx, exec)
+			var rel6 *models.AiInterpretation
+			rel6, err = o.r.AiInterpretation.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachAiInterpretation(ctx, exec, rel6)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isProjectDone, _ := taskRelProjectCtx.Value(ctx)
+	if !isProjectDone && o.r.Project != nil {
+		ctx = taskRelProjectCtx.WithValue(ctx, true)
+		if o.r.Project.o.alreadyPersisted {
+			m.R.Project = o.r.Project.o.Build()
+		} else {
+			var rel7 *models.Project
+			rel7, err = o.r.Project.o.Create(ctx, exec)
 			if err != nil {
 				return err
 			}
-			err = m.AttachAiInterpretation(ctx, exec, rel0)
+			err = m.AttachProject(ctx, exec, rel7)
 			if err != nil {
 				return err
 			}
@@ -247,6 +639,26 @@ func (o *TaskTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 
 	}
 
+	isTimeEntriesDone, _ := taskRelTimeEntriesCtx.Value(ctx)
+	if !isTimeEntriesDone && o.r.TimeEntries != nil {
+		ctx = taskRelTimeEntriesCtx.WithValue(ctx, true)
+		for _, r := range o.r.TimeEntries {
+			if r.o.alreadyPersisted {
+				m.R.TimeEntries = append(m.R.TimeEntries, r.o.Build())
+			} else {
+				rel9, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTimeEntries(ctx, exec, rel9...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	return err
 }
 
@@ -261,25 +673,25 @@ func (o *TaskTemplate) Create(ctx context.Context, exec bob.Executor) (*models.T
 		TaskMods.WithNewUser().Apply(ctx, o)
 	}
 
-	var rel1 *models.User
+	var rel8 *models.User
 
 	if o.r.User.o.alreadyPersisted {
-		rel1 = o.r.User.o.Build()
+		rel8 = o.r.User.o.Build()
 	} else {
-		rel1, err = o.r.User.o.Create(ctx, exec)
+		rel8, err = o.r.User.o.Create(ctx, exec)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	opt.UserID = omit.From(rel1.ID)
+	opt.UserID = omit.From(rel8.ID)
 
 	m, err := models.Tasks.Insert(opt).One(ctx, exec)
 	if err != nil {
 		return nil, err
 	}
 
-	m.R.User = rel1
+	m.R.User = rel8
 
 	if err := o.insertOptRels(ctx, exec, m); err != nil {
 		return nil, err
@@ -360,14 +772,28 @@ func (m taskMods) RandomizeAllColumns(f *faker.Faker) TaskMod {
 	return TaskModSlice{
 		TaskMods.RandomID(f),
 		TaskMods.RandomUserID(f),
+		TaskMods.RandomProjectID(f),
 		TaskMods.RandomTitle(f),
 		TaskMods.RandomDescription(f),
 		TaskMods.RandomDueAt(f),
+		TaskMods.RandomEstimateMinutes(f),
 		TaskMods.RandomStatus(f),
+		TaskMods.RandomStatusCategory(f),
+		TaskMods.RandomRankKey(f),
+		TaskMods.RandomStartedAt(f),
+		TaskMods.RandomCompletedAt(f),
+		TaskMods.RandomSnoozedUntil(f),
+		TaskMods.RandomSnoozeNotify(f),
+		TaskMods.RandomArchivedAt(f),
 		TaskMods.RandomSource(f),
 		TaskMods.RandomAiInterpretationID(f),
+		TaskMods.RandomRecurrenceRule(f),
+		TaskMods.RandomRecurrenceAnchorAt(f),
+		TaskMods.RandomRecurrenceSeriesID(f),
+		TaskMods.RandomVersion(f),
 		TaskMods.RandomCreatedAt(f),
 		TaskMods.RandomUpdatedAt(f),
+		TaskMods.RandomDeletedAt(f),
 	}
 }
 
@@ -433,6 +859,59 @@ func (m taskMods) RandomUserID(f *faker.Faker) TaskMod {
 	})
 }
 
+// Set the model columns to this value
+func (m taskMods) ProjectID(val null.Val[string]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.ProjectID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) ProjectIDFunc(f func() null.Val[string]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.ProjectID = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetProjectID() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.ProjectID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taskMods) RandomProjectID(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.ProjectID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taskMods) RandomProjectIDNotNull(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.ProjectID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
 // Set the model columns to this value
 func (m taskMods) Title(val string) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
@@ -570,6 +1049,59 @@ func (m taskMods) RandomDueAtNotNull(f *faker.Faker) TaskMod {
 	})
 }
 
+// Set the model columns to this value
+func (m taskMods) EstimateMinutes(val null.Val[int32]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.EstimateMinutes = func() null.Val[int32] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) EstimateMinutesFunc(f func() null.Val[int32]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.EstimateMinutes = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetEstimateMinutes() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.EstimateMinutes = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taskMods) RandomEstimateMinutes(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.EstimateMinutes = func() null.Val[int32] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int32(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taskMods) RandomEstimateMinutesNotNull(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.EstimateMinutes = func() null.Val[int32] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_int32(f)
+			return null.From(val)
+		}
+	})
+}
+
 // Set the model columns to this value
 func (m taskMods) Status(val string) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
@@ -602,68 +1134,99 @@ func (m taskMods) RandomStatus(f *faker.Faker) TaskMod {
 }
 
 // Set the model columns to this value
-func (m taskMods) Source(val string) TaskMod {
+func (m taskMods) StatusCategory(val string) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.Source = func() string { return val }
+		o.StatusCategory = func() string { return val }
 	})
 }
 
 // Set the Column from the function
-func (m taskMods) SourceFunc(f func() string) TaskMod {
+func (m taskMods) StatusCategoryFunc(f func() string) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.Source = f
+		o.StatusCategory = f
 	})
 }
 
 // Clear any values for the column
-func (m taskMods) UnsetSource() TaskMod {
+func (m taskMods) UnsetStatusCategory() TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.Source = nil
+		o.StatusCategory = nil
 	})
 }
 
 // Generates a random value for the column using the given faker
 // if faker is nil, a default faker is used
-func (m taskMods) RandomSource(f *faker.Faker) TaskMod {
+func (m taskMods) RandomStatusCategory(f *faker.Faker) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.Source = func() string {
-			return random_string(f, "20")
+		o.StatusCategory = func() string {
+			return random_string(f, "10")
 		}
 	})
 }
 
 // Set the model columns to this value
-func (m taskMods) AiInterpretationID(val null.Val[string]) TaskMod {
+func (m taskMods) RankKey(val string) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.AiInterpretationID = func() null.Val[string] { return val }
+		o.RankKey = func() string { return val }
 	})
 }
 
 // Set the Column from the function
-func (m taskMods) AiInterpretationIDFunc(f func() null.Val[string]) TaskMod {
+func (m taskMods) RankKeyFunc(f func() string) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.AiInterpretationID = f
+		o.RankKey = f
 	})
 }
 
 // Clear any values for the column
-func (m taskMods) UnsetAiInterpretationID() TaskMod {
+func (m taskMods) UnsetRankKey() TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.AiInterpretationID = nil
+		o.RankKey = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taskMods) RandomRankKey(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RankKey = func() string {
+			return random_string(f, "255")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) StartedAt(val null.Val[time.Time]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.StartedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) StartedAtFunc(f func() null.Val[time.Time]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.StartedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetStartedAt() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.StartedAt = nil
 	})
 }
 
 // Generates a random value for the column using the given faker
 // if faker is nil, a default faker is used
 // The generated value is sometimes null
-func (m taskMods) RandomAiInterpretationID(f *faker.Faker) TaskMod {
+func (m taskMods) RandomStartedAt(f *faker.Faker) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.AiInterpretationID = func() null.Val[string] {
+		o.StartedAt = func() null.Val[time.Time] {
 			if f == nil {
 				f = &defaultFaker
 			}
 
-			val := random_string(f, "36")
+			val := random_time_Time(f)
 			return null.From(val)
 		}
 	})
@@ -672,57 +1235,521 @@ func (m taskMods) RandomAiInterpretationID(f *faker.Faker) TaskMod {
 // Generates a random value for the column using the given faker
 // if faker is nil, a default faker is used
 // The generated value is never null
-func (m taskMods) RandomAiInterpretationIDNotNull(f *faker.Faker) TaskMod {
+func (m taskMods) RandomStartedAtNotNull(f *faker.Faker) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.AiInterpretationID = func() null.Val[string] {
+		o.StartedAt = func() null.Val[time.Time] {
 			if f == nil {
 				f = &defaultFaker
 			}
 
-			val := random_string(f, "36")
+			val := random_time_Time(f)
 			return null.From(val)
 		}
 	})
 }
 
 // Set the model columns to this value
-func (m taskMods) CreatedAt(val time.Time) TaskMod {
+func (m taskMods) CompletedAt(val null.Val[time.Time]) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.CreatedAt = func() time.Time { return val }
+		o.CompletedAt = func() null.Val[time.Time] { return val }
 	})
 }
 
 // Set the Column from the function
-func (m taskMods) CreatedAtFunc(f func() time.Time) TaskMod {
+func (m taskMods) CompletedAtFunc(f func() null.Val[time.Time]) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.CreatedAt = f
+		o.CompletedAt = f
 	})
 }
 
 // Clear any values for the column
-func (m taskMods) UnsetCreatedAt() TaskMod {
+func (m taskMods) UnsetCompletedAt() TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.CreatedAt = nil
+		o.CompletedAt = nil
 	})
 }
 
 // Generates a random value for the column using the given faker
 // if faker is nil, a default faker is used
-func (m taskMods) RandomCreatedAt(f *faker.Faker) TaskMod {
+// The generated value is sometimes null
+func (m taskMods) RandomCompletedAt(f *faker.Faker) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.CreatedAt = func() time.Time {
-			return random_time_Time(f)
+		o.CompletedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
 		}
 	})
 }
 
-// Set the model columns to this value
-func (m taskMods) UpdatedAt(val time.Time) TaskMod {
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taskMods) RandomCompletedAtNotNull(f *faker.Faker) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
-		o.UpdatedAt = func() time.Time { return val }
-	})
-}
-
+		o.CompletedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) SnoozedUntil(val null.Val[time.Time]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.SnoozedUntil = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) SnoozedUntilFunc(f func() null.Val[time.Time]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.SnoozedUntil = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetSnoozedUntil() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.SnoozedUntil = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taskMods) RandomSnoozedUntil(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.SnoozedUntil = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taskMods) RandomSnoozedUntilNotNull(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.SnoozedUntil = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) SnoozeNotify(val bool) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.SnoozeNotify = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) SnoozeNotifyFunc(f func() bool) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.SnoozeNotify = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetSnoozeNotify() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.SnoozeNotify = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taskMods) RandomSnoozeNotify(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.SnoozeNotify = func() bool {
+			return random_bool(f, "1")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) ArchivedAt(val null.Val[time.Time]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.ArchivedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) ArchivedAtFunc(f func() null.Val[time.Time]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.ArchivedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetArchivedAt() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.ArchivedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taskMods) RandomArchivedAt(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.ArchivedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taskMods) RandomArchivedAtNotNull(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.ArchivedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) Source(val string) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.Source = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) SourceFunc(f func() string) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.Source = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetSource() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.Source = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taskMods) RandomSource(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.Source = func() string {
+			return random_string(f, "20")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) AiInterpretationID(val null.Val[string]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.AiInterpretationID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) AiInterpretationIDFunc(f func() null.Val[string]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.AiInterpretationID = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetAiInterpretationID() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.AiInterpretationID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taskMods) RandomAiInterpretationID(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.AiInterpretationID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taskMods) RandomAiInterpretationIDNotNull(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.AiInterpretationID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) RecurrenceRule(val null.Val[string]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceRule = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) RecurrenceRuleFunc(f func() null.Val[string]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceRule = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetRecurrenceRule() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceRule = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taskMods) RandomRecurrenceRule(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceRule = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "500")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taskMods) RandomRecurrenceRuleNotNull(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceRule = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "500")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) RecurrenceAnchorAt(val null.Val[time.Time]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceAnchorAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) RecurrenceAnchorAtFunc(f func() null.Val[time.Time]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceAnchorAt = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetRecurrenceAnchorAt() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceAnchorAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taskMods) RandomRecurrenceAnchorAt(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceAnchorAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taskMods) RandomRecurrenceAnchorAtNotNull(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceAnchorAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) RecurrenceSeriesID(val null.Val[string]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceSeriesID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) RecurrenceSeriesIDFunc(f func() null.Val[string]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceSeriesID = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetRecurrenceSeriesID() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceSeriesID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taskMods) RandomRecurrenceSeriesID(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceSeriesID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taskMods) RandomRecurrenceSeriesIDNotNull(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.RecurrenceSeriesID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) Version(val int32) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.Version = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) VersionFunc(f func() int32) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.Version = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetVersion() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.Version = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taskMods) RandomVersion(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.Version = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) CreatedAt(val time.Time) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) CreatedAtFunc(f func() time.Time) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetCreatedAt() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m taskMods) RandomCreatedAt(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m taskMods) UpdatedAt(val time.Time) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.UpdatedAt = func() time.Time { return val }
+	})
+}
+
 // Set the Column from the function
 func (m taskMods) UpdatedAtFunc(f func() time.Time) TaskMod {
 	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
@@ -747,6 +1774,59 @@ func (m taskMods) RandomUpdatedAt(f *faker.Faker) TaskMod {
 	})
 }
 
+// Set the model columns to this value
+func (m taskMods) DeletedAt(val null.Val[time.Time]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.DeletedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m taskMods) DeletedAtFunc(f func() null.Val[time.Time]) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.DeletedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m taskMods) UnsetDeletedAt() TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.DeletedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m taskMods) RandomDeletedAt(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.DeletedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m taskMods) RandomDeletedAtNotNull(f *faker.Faker) TaskMod {
+	return TaskModFunc(func(_ context.Context, o *TaskTemplate) {
+		o.DeletedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
 func (m taskMods) WithParentsCascading() TaskMod {
 	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
 		if isDone, _ := taskWithParentsCascadingCtx.Value(ctx); isDone {
@@ -758,6 +1838,11 @@ func (m taskMods) WithParentsCascading() TaskMod {
 			related := o.f.NewAiInterpretationWithContext(ctx, AiInterpretationMods.WithParentsCascading())
 			m.WithAiInterpretation(related).Apply(ctx, o)
 		}
+		{
+
+			related := o.f.NewProjectWithContext(ctx, ProjectMods.WithParentsCascading())
+			m.WithProject(related).Apply(ctx, o)
+		}
 		{
 
 			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
@@ -796,6 +1881,36 @@ func (m taskMods) WithoutAiInterpretation() TaskMod {
 	})
 }
 
+func (m taskMods) WithProject(rel *ProjectTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.Project = &taskRProjectR{
+			o: rel,
+		}
+	})
+}
+
+func (m taskMods) WithNewProject(mods ...ProjectMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewProjectWithContext(ctx, mods...)
+
+		m.WithProject(related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) WithExistingProject(em *models.Project) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.Project = &taskRProjectR{
+			o: o.f.FromExistingProject(em),
+		}
+	})
+}
+
+func (m taskMods) WithoutProject() TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.Project = nil
+	})
+}
+
 func (m taskMods) WithUser(rel *UserTemplate) TaskMod {
 	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
 		o.r.User = &taskRUserR{
@@ -825,3 +1940,339 @@ func (m taskMods) WithoutUser() TaskMod {
 		o.r.User = nil
 	})
 }
+
+func (m taskMods) WithNotifications(number int, related *NotificationTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.Notifications = []*taskRNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m taskMods) WithNewNotifications(number int, mods ...NotificationMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.WithNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddNotifications(number int, related *NotificationTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.Notifications = append(o.r.Notifications, &taskRNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m taskMods) AddNewNotifications(number int, mods ...NotificationMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.AddNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddExistingNotifications(existingModels ...*models.Notification) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		for _, em := range existingModels {
+			o.r.Notifications = append(o.r.Notifications, &taskRNotificationsR{
+				o: o.f.FromExistingNotification(em),
+			})
+		}
+	})
+}
+
+func (m taskMods) WithoutNotifications() TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.Notifications = nil
+	})
+}
+
+func (m taskMods) WithTaskAttachments(number int, related *TaskAttachmentTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskAttachments = []*taskRTaskAttachmentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m taskMods) WithNewTaskAttachments(number int, mods ...TaskAttachmentMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTaskAttachmentWithContext(ctx, mods...)
+		m.WithTaskAttachments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddTaskAttachments(number int, related *TaskAttachmentTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskAttachments = append(o.r.TaskAttachments, &taskRTaskAttachmentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m taskMods) AddNewTaskAttachments(number int, mods ...TaskAttachmentMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTaskAttachmentWithContext(ctx, mods...)
+		m.AddTaskAttachments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddExistingTaskAttachments(existingModels ...*models.TaskAttachment) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		for _, em := range existingModels {
+			o.r.TaskAttachments = append(o.r.TaskAttachments, &taskRTaskAttachmentsR{
+				o: o.f.FromExistingTaskAttachment(em),
+			})
+		}
+	})
+}
+
+func (m taskMods) WithoutTaskAttachments() TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskAttachments = nil
+	})
+}
+
+func (m taskMods) WithDependsOnTaskTaskDependencies(number int, related *TaskDependencyTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.DependsOnTaskTaskDependencies = []*taskRDependsOnTaskTaskDependenciesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m taskMods) WithNewDependsOnTaskTaskDependencies(number int, mods ...TaskDependencyMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTaskDependencyWithContext(ctx, mods...)
+		m.WithDependsOnTaskTaskDependencies(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddDependsOnTaskTaskDependencies(number int, related *TaskDependencyTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.DependsOnTaskTaskDependencies = append(o.r.DependsOnTaskTaskDependencies, &taskRDependsOnTaskTaskDependenciesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m taskMods) AddNewDependsOnTaskTaskDependencies(number int, mods ...TaskDependencyMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTaskDependencyWithContext(ctx, mods...)
+		m.AddDependsOnTaskTaskDependencies(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddExistingDependsOnTaskTaskDependencies(existingModels ...*models.TaskDependency) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		for _, em := range existingModels {
+			o.r.DependsOnTaskTaskDependencies = append(o.r.DependsOnTaskTaskDependencies, &taskRDependsOnTaskTaskDependenciesR{
+				o: o.f.FromExistingTaskDependency(em),
+			})
+		}
+	})
+}
+
+func (m taskMods) WithoutDependsOnTaskTaskDependencies() TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.DependsOnTaskTaskDependencies = nil
+	})
+}
+
+func (m taskMods) WithTaskDependencies(number int, related *TaskDependencyTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskDependencies = []*taskRTaskDependenciesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m taskMods) WithNewTaskDependencies(number int, mods ...TaskDependencyMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTaskDependencyWithContext(ctx, mods...)
+		m.WithTaskDependencies(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddTaskDependencies(number int, related *TaskDependencyTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskDependencies = append(o.r.TaskDependencies, &taskRTaskDependenciesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m taskMods) AddNewTaskDependencies(number int, mods ...TaskDependencyMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTaskDependencyWithContext(ctx, mods...)
+		m.AddTaskDependencies(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddExistingTaskDependencies(existingModels ...*models.TaskDependency) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		for _, em := range existingModels {
+			o.r.TaskDependencies = append(o.r.TaskDependencies, &taskRTaskDependenciesR{
+				o: o.f.FromExistingTaskDependency(em),
+			})
+		}
+	})
+}
+
+func (m taskMods) WithoutTaskDependencies() TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskDependencies = nil
+	})
+}
+
+func (m taskMods) WithTaskImports(number int, related *TaskImportTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskImports = []*taskRTaskImportsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m taskMods) WithNewTaskImports(number int, mods ...TaskImportMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTaskImportWithContext(ctx, mods...)
+		m.WithTaskImports(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddTaskImports(number int, related *TaskImportTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskImports = append(o.r.TaskImports, &taskRTaskImportsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m taskMods) AddNewTaskImports(number int, mods ...TaskImportMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTaskImportWithContext(ctx, mods...)
+		m.AddTaskImports(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddExistingTaskImports(existingModels ...*models.TaskImport) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		for _, em := range existingModels {
+			o.r.TaskImports = append(o.r.TaskImports, &taskRTaskImportsR{
+				o: o.f.FromExistingTaskImport(em),
+			})
+		}
+	})
+}
+
+func (m taskMods) WithoutTaskImports() TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskImports = nil
+	})
+}
+
+func (m taskMods) WithTaskReminders(number int, related *TaskReminderTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskReminders = []*taskRTaskRemindersR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m taskMods) WithNewTaskReminders(number int, mods ...TaskReminderMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTaskReminderWithContext(ctx, mods...)
+		m.WithTaskReminders(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddTaskReminders(number int, related *TaskReminderTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskReminders = append(o.r.TaskReminders, &taskRTaskRemindersR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m taskMods) AddNewTaskReminders(number int, mods ...TaskReminderMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTaskReminderWithContext(ctx, mods...)
+		m.AddTaskReminders(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddExistingTaskReminders(existingModels ...*models.TaskReminder) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		for _, em := range existingModels {
+			o.r.TaskReminders = append(o.r.TaskReminders, &taskRTaskRemindersR{
+				o: o.f.FromExistingTaskReminder(em),
+			})
+		}
+	})
+}
+
+func (m taskMods) WithoutTaskReminders() TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TaskReminders = nil
+	})
+}
+
+func (m taskMods) WithTimeEntries(number int, related *TimeEntryTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TimeEntries = []*taskRTimeEntriesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m taskMods) WithNewTimeEntries(number int, mods ...TimeEntryMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTimeEntryWithContext(ctx, mods...)
+		m.WithTimeEntries(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddTimeEntries(number int, related *TimeEntryTemplate) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TimeEntries = append(o.r.TimeEntries, &taskRTimeEntriesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m taskMods) AddNewTimeEntries(number int, mods ...TimeEntryMod) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		related := o.f.NewTimeEntryWithContext(ctx, mods...)
+		m.AddTimeEntries(number, related).Apply(ctx, o)
+	})
+}
+
+func (m taskMods) AddExistingTimeEntries(existingModels ...*models.TimeEntry) TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		for _, em := range existingModels {
+			o.r.TimeEntries = append(o.r.TimeEntries, &taskRTimeEntriesR{
+				o: o.f.FromExistingTimeEntry(em),
+			})
+		}
+	})
+}
+
+func (m taskMods) WithoutTimeEntries() TaskMod {
+	return TaskModFunc(func(ctx context.Context, o *TaskTemplate) {
+		o.r.TimeEntries = nil
+	})
+}
@@ -53,22 +53,85 @@ type UserTemplate struct {
 
 type userR struct {
 	AiInterpretations []*userRAiInterpretationsR
+	CalendarFeed      *userRCalendarFeedR
+	IdempotencyKeys   []*userRIdempotencyKeysR
+	Notifications     []*userRNotificationsR
+	Projects          []*userRProjectsR
+	TaskAttachments   []*userRTaskAttachmentsR
+	ActorTaskEvents   []*userRActorTaskEventsR
+	TaskEvents        []*userRTaskEventsR
+	TaskImports       []*userRTaskImportsR
+	TaskStatuses      []*userRTaskStatusesR
+	TaskTemplates     []*userRTaskTemplatesR
+	TaskViews         []*userRTaskViewsR
 	Tasks             []*userRTasksR
+	TimeEntries       []*userRTimeEntriesR
 	UserAuths         []*userRUserAuthsR
+	UserSetting       *userRUserSettingR
 }
 
 type userRAiInterpretationsR struct {
 	number int
 	o      *AiInterpretationTemplate
 }
+type userRCalendarFeedR struct {
+	o *CalendarFeedTemplate
+}
+type userRIdempotencyKeysR struct {
+	number int
+	o      *IdempotencyKeyTemplate
+}
+type userRNotificationsR struct {
+	number int
+	o      *NotificationTemplate
+}
+type userRProjectsR struct {
+	number int
+	o      *ProjectTemplate
+}
+type userRTaskAttachmentsR struct {
+	number int
+	o      *TaskAttachmentTemplate
+}
+type userRActorTaskEventsR struct {
+	number int
+	o      *TaskEventTemplate
+}
+type userRTaskEventsR struct {
+	number int
+	o      *TaskEventTemplate
+}
+type userRTaskImportsR struct {
+	number int
+	o      *TaskImportTemplate
+}
+type userRTaskStatusesR struct {
+	number int
+	o      *TaskStatusTemplate
+}
+type userRTaskTemplatesR struct {
+	number int
+	o      *TaskTemplateTemplate
+}
+type userRTaskViewsR struct {
+	number int
+	o      *TaskViewTemplate
+}
 type userRTasksR struct {
 	number int
 	o      *TaskTemplate
 }
+type userRTimeEntriesR struct {
+	number int
+	o      *TimeEntryTemplate
+}
 type userRUserAuthsR struct {
 	number int
 	o      *UserAuthTemplate
 }
+type userRUserSettingR struct {
+	o *UserSettingTemplate
+}
 
 // Apply mods to the UserTemplate
 func (o *UserTemplate) Apply(ctx context.Context, mods ...UserMod) {
@@ -93,6 +156,143 @@ func (t UserTemplate) setModelRels(o *models.User) {
 		o.R.AiInterpretations = rel
 	}
 
+	if t.r.CalendarFeed != nil {
+		rel := t.r.CalendarFeed.o.Build()
+		rel.R.User = o
+		rel.UserID = o.ID // h2
+		o.R.CalendarFeed = rel
+	}
+
+	if t.r.IdempotencyKeys != nil {
+		rel := models.IdempotencyKeySlice{}
+		for _, r := range t.r.IdempotencyKeys {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.IdempotencyKeys = rel
+	}
+
+	if t.r.Notifications != nil {
+		rel := models.NotificationSlice{}
+		for _, r := range t.r.Notifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Notifications = rel
+	}
+
+	if t.r.Projects != nil {
+		rel := models.ProjectSlice{}
+		for _, r := range t.r.Projects {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Projects = rel
+	}
+
+	if t.r.TaskAttachments != nil {
+		rel := models.TaskAttachmentSlice{}
+		for _, r := range t.r.TaskAttachments {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TaskAttachments = rel
+	}
+
+	if t.r.ActorTaskEvents != nil {
+		rel := models.TaskEventSlice{}
+		for _, r := range t.r.ActorTaskEvents {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ActorID = null.From(o.ID) // h2
+				rel.R.ActorUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ActorTaskEvents = rel
+	}
+
+	if t.r.TaskEvents != nil {
+		rel := models.TaskEventSlice{}
+		for _, r := range t.r.TaskEvents {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TaskEvents = rel
+	}
+
+	if t.r.TaskImports != nil {
+		rel := models.TaskImportSlice{}
+		for _, r := range t.r.TaskImports {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TaskImports = rel
+	}
+
+	if t.r.TaskStatuses != nil {
+		rel := models.TaskStatusSlice{}
+		for _, r := range t.r.TaskStatuses {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TaskStatuses = rel
+	}
+
+	if t.r.TaskTemplates != nil {
+		rel := models.TaskTemplateSlice{}
+		for _, r := range t.r.TaskTemplates {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TaskTemplates = rel
+	}
+
+	if t.r.TaskViews != nil {
+		rel := models.TaskViewSlice{}
+		for _, r := range t.r.TaskViews {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TaskViews = rel
+	}
+
 	if t.r.Tasks != nil {
 		rel := models.TaskSlice{}
 		for _, r := range t.r.Tasks {
@@ -106,6 +306,19 @@ func (t UserTemplate) setModelRels(o *models.User) {
 		o.R.Tasks = rel
 	}
 
+	if t.r.TimeEntries != nil {
+		rel := models.TimeEntrySlice{}
+		for _, r := range t.r.TimeEntries {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.TimeEntries = rel
+	}
+
 	if t.r.UserAuths != nil {
 		rel := models.UserAuthSlice{}
 		for _, r := range t.r.UserAuths {
@@ -118,6 +331,13 @@ func (t UserTemplate) setModelRels(o *models.User) {
 		}
 		o.R.UserAuths = rel
 	}
+
+	if t.r.UserSetting != nil {
+		rel := t.r.UserSetting.o.Build()
+		rel.R.User = o
+		rel.UserID = o.ID // h2
+		o.R.UserSetting = rel
+	}
 }
 
 // BuildSetter returns an *models.UserSetter
@@ -260,19 +480,38 @@ func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 		}
 	}
 
-	isTasksDone, _ := userRelTasksCtx.Value(ctx)
-	if !isTasksDone && o.r.Tasks != nil {
-		ctx = userRelTasksCtx.WithValue(ctx, true)
-		for _, r := range o.r.Tasks {
+	isCalendarFeedDone, _ := userRelCalendarFeedCtx.Value(ctx)
+	if !isCalendarFeedDone && o.r.CalendarFeed != nil {
+		ctx = userRelCalendarFeedCtx.WithValue(ctx, true)
+		if o.r.CalendarFeed.o.alreadyPersisted {
+			m.R.CalendarFeed = o.r.CalendarFeed.o.Build()
+		} else {
+			var rel1 *models.CalendarFeed
+			rel1, err = o.r.CalendarFeed.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachCalendarFeed(ctx, exec, rel1)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isIdempotencyKeysDone, _ := userRelIdempotencyKeysCtx.Value(ctx)
+	if !isIdempotencyKeysDone && o.r.IdempotencyKeys != nil {
+		ctx = userRelIdempotencyKeysCtx.WithValue(ctx, true)
+		for _, r := range o.r.IdempotencyKeys {
 			if r.o.alreadyPersisted {
-				m.R.Tasks = append(m.R.Tasks, r.o.Build())
+				m.R.IdempotencyKeys = append(m.R.IdempotencyKeys, r.o.Build())
 			} else {
-				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
 				if err != nil {
 					return err
 				}
 
-				err = m.AttachTasks(ctx, exec, rel1...)
+				err = m.AttachIdempotencyKeys(ctx, exec, rel2...)
 				if err != nil {
 					return err
 				}
@@ -280,19 +519,19 @@ func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 		}
 	}
 
-	isUserAuthsDone, _ := userRelUserAuthsCtx.Value(ctx)
-	if !isUserAuthsDone && o.r.UserAuths != nil {
-		ctx = userRelUserAuthsCtx.WithValue(ctx, true)
-		for _, r := range o.r.UserAuths {
+	isNotificationsDone, _ := userRelNotificationsCtx.Value(ctx)
+	if !isNotificationsDone && o.r.Notifications != nil {
+		ctx = userRelNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Notifications {
 			if r.o.alreadyPersisted {
-				m.R.UserAuths = append(m.R.UserAuths, r.o.Build())
+				m.R.Notifications = append(m.R.Notifications, r.o.Build())
 			} else {
-				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
 				if err != nil {
 					return err
 				}
 
-				err = m.AttachUserAuths(ctx, exec, rel2...)
+				err = m.AttachNotifications(ctx, exec, rel3...)
 				if err != nil {
 					return err
 				}
@@ -300,84 +539,323 @@ func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 		}
 	}
 
-	return err
-}
-
-// Create builds a user and inserts it into the database
-// Relations objects are also inserted and placed in the .R field
-func (o *UserTemplate) Create(ctx context.Context, exec bob.Executor) (*models.User, error) {
-	var err error
-	opt := o.BuildSetter()
-	ensureCreatableUser(opt)
+	isProjectsDone, _ := userRelProjectsCtx.Value(ctx)
+	if !isProjectsDone && o.r.Projects != nil {
+		ctx = userRelProjectsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Projects {
+			if r.o.alreadyPersisted {
+				m.R.Projects = append(m.R.Projects, r.o.Build())
+			} else {
+				rel4, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
 
-	m, err := models.Users.Insert(opt).One(ctx, exec)
-	if err != nil {
-		return nil, err
+				err = m.AttachProjects(ctx, exec, rel4...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
 
-	if err := o.insertOptRels(ctx, exec, m); err != nil {
-		return nil, err
-	}
-	return m, err
-}
+	isTaskAttachmentsDone, _ := userRelTaskAttachmentsCtx.Value(ctx)
+	if !isTaskAttachmentsDone && o.r.TaskAttachments != nil {
+		ctx = userRelTaskAttachmentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.TaskAttachments {
+			if r.o.alreadyPersisted {
+				m.R.TaskAttachments = append(m.R.TaskAttachments, r.o.Build())
+			} else {
+				rel5, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
 
-// MustCreate builds a user and inserts it into the database
-// Relations objects are also inserted and placed in the .R field
-// panics if an error occurs
-func (o *UserTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.User {
-	m, err := o.Create(ctx, exec)
-	if err != nil {
-		panic(err)
+				err = m.AttachTaskAttachments(ctx, exec, rel5...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	return m
-}
 
-// CreateOrFail builds a user and inserts it into the database
-// Relations objects are also inserted and placed in the .R field
-// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
-func (o *UserTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.User {
-	tb.Helper()
-	m, err := o.Create(ctx, exec)
-	if err != nil {
-		tb.Fatal(err)
-		return nil
+	isActorTaskEventsDone, _ := userRelActorTaskEventsCtx.Value(ctx)
+	if !isActorTaskEventsDone && o.r.ActorTaskEvents != nil {
+		ctx = userRelActorTaskEventsCtx.WithValue(ctx, true)
+		for _, r := range o.r.ActorTaskEvents {
+			if r.o.alreadyPersisted {
+				m.R.ActorTaskEvents = append(m.R.ActorTaskEvents, r.o.Build())
+			} else {
+				rel6, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachActorTaskEvents(ctx, exec, rel6...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	return m
-}
 
-// CreateMany builds multiple users and inserts them into the database
-// Relations objects are also inserted and placed in the .R field
-func (o UserTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.UserSlice, error) {
-	var err error
-	m := make(models.UserSlice, number)
+	isTaskEventsDone, _ := userRelTaskEventsCtx.Value(ctx)
+	if !isTaskEventsDone && o.r.TaskEvents != nil {
+		ctx = userRelTaskEventsCtx.WithValue(ctx, true)
+		for _, r := range o.r.TaskEvents {
+			if r.o.alreadyPersisted {
+				m.R.TaskEvents = append(m.R.TaskEvents, r.o.Build())
+			} else {
+				rel7, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
 
-	for i := range m {
-		m[i], err = o.Create(ctx, exec)
-		if err != nil {
-			return nil, err
+				err = m.AttachTaskEvents(ctx, exec, rel7...)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 
-	return m, nil
-}
+	isTaskImportsDone, _ := userRelTaskImportsCtx.Value(ctx)
+	if !isTaskImportsDone && o.r.TaskImports != nil {
+		ctx = userRelTaskImportsCtx.WithValue(ctx, true)
+		for _, r := range o.r.TaskImports {
+			if r.o.alreadyPersisted {
+				m.R.TaskImports = append(m.R.TaskImports, r.o.Build())
+			} else {
+				rel8, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
 
-// MustCreateMany builds multiple users and inserts them into the database
-// Relations objects are also inserted and placed in the .R field
-// panics if an error occurs
-func (o UserTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.UserSlice {
-	m, err := o.CreateMany(ctx, exec, number)
-	if err != nil {
-		panic(err)
+				err = m.AttachTaskImports(ctx, exec, rel8...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	return m
-}
 
-// CreateManyOrFail builds multiple users and inserts them into the database
-// Relations objects are also inserted and placed in the .R field
-// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
-func (o UserTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.UserSlice {
-	tb.Helper()
-	m, err := o.CreateMany(ctx, exec, number)
+	isTaskStatusesDone, _ := userRelTaskStatusesCtx.Value(ctx)
+	if !isTaskStatusesDone && o.r.TaskStatuses != nil {
+		ctx = userRelTaskStatusesCtx.WithValue(ctx, true)
+		for _, r := range o.r.TaskStatuses {
+			if r.o.alreadyPersisted {
+				m.R.TaskStatuses = append(m.R.TaskStatuses, r.o.Build())
+			} else {
+				rel9, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTaskStatuses(ctx, exec, rel9...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTaskTemplatesDone, _ := userRelTaskTemplatesCtx.Value(ctx)
+	if !isTaskTemplatesDone && o.r.TaskTemplates != nil {
+		ctx = userRelTaskTemplatesCtx.WithValue(ctx, true)
+		for _, r := range o.r.TaskTemplates {
+			if r.o.alreadyPersisted {
+				m.R.TaskTemplates = append(m.R.TaskTemplates, r.o.Build())
+			} else {
+				rel10, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTaskTemplates(ctx, exec, rel10...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTaskViewsDone, _ := userRelTaskViewsCtx.Value(ctx)
+	if !isTaskViewsDone && o.r.TaskViews != nil {
+		ctx = userRelTaskViewsCtx.WithValue(ctx, true)
+		for _, r := range o.r.TaskViews {
+			if r.o.alreadyPersisted {
+				m.R.TaskViews = append(m.R.TaskViews, r.o.Build())
+			} else {
+				rel11, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTaskViews(ctx, exec, rel11...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTasksDone, _ := userRelTasksCtx.Value(ctx)
+	if !isTasksDone && o.r.Tasks != nil {
+		ctx = userRelTasksCtx.WithValue(ctx, true)
+		for _, r := range o.r.Tasks {
+			if r.o.alreadyPersisted {
+				m.R.Tasks = append(m.R.Tasks, r.o.Build())
+			} else {
+				rel12, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTasks(ctx, exec, rel12...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTimeEntriesDone, _ := userRelTimeEntriesCtx.Value(ctx)
+	if !isTimeEntriesDone && o.r.TimeEntries != nil {
+		ctx = userRelTimeEntriesCtx.WithValue(ctx, true)
+		for _, r := range o.r.TimeEntries {
+			if r.o.alreadyPersisted {
+				m.R.TimeEntries = append(m.R.TimeEntries, r.o.Build())
+			} else {
+				rel13, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTimeEntries(ctx, exec, rel13...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isUserAuthsDone, _ := userRelUserAuthsCtx.Value(ctx)
+	if !isUserAuthsDone && o.r.UserAuths != nil {
+		ctx = userRelUserAuthsCtx.WithValue(ctx, true)
+		for _, r := range o.r.UserAuths {
+			if r.o.alreadyPersisted {
+				m.R.UserAuths = append(m.R.UserAuths, r.o.Build())
+			} else {
+				rel14, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachUserAuths(ctx, exec, rel14...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isUserSettingDone, _ := userRelUserSettingCtx.Value(ctx)
+	if !isUserSettingDone && o.r.UserSetting != nil {
+		ctx = userRelUserSettingCtx.WithValue(ctx, true)
+		if o.r.UserSetting.o.alreadyPersisted {
+			m.R.UserSetting = o.r.UserSetting.o.Build()
+		} else {
+			var rel15 *models.UserSetting
+			rel15, err = o.r.UserSetting.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachUserSetting(ctx, exec, rel15)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return err
+}
+
+// Create builds a user and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *UserTemplate) Create(ctx context.Context, exec bob.Executor) (*models.User, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableUser(opt)
+
+	m, err := models.Users.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a user and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *UserTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.User {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a user and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *UserTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.User {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple users and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o UserTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.UserSlice, error) {
+	var err error
+	m := make(models.UserSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple users and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o UserTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.UserSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple users and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o UserTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.UserSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
 	if err != nil {
 		tb.Fatal(err)
 		return nil
@@ -647,6 +1125,76 @@ func (m userMods) WithParentsCascading() UserMod {
 			return
 		}
 		ctx = userWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewCalendarFeedWithContext(ctx, CalendarFeedMods.WithParentsCascading())
+			m.WithCalendarFeed(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserSettingWithContext(ctx, UserSettingMods.WithParentsCascading())
+			m.WithUserSetting(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m userMods) WithCalendarFeed(rel *CalendarFeedTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.CalendarFeed = &userRCalendarFeedR{
+			o: rel,
+		}
+	})
+}
+
+func (m userMods) WithNewCalendarFeed(mods ...CalendarFeedMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewCalendarFeedWithContext(ctx, mods...)
+
+		m.WithCalendarFeed(related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) WithExistingCalendarFeed(em *models.CalendarFeed) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.CalendarFeed = &userRCalendarFeedR{
+			o: o.f.FromExistingCalendarFeed(em),
+		}
+	})
+}
+
+func (m userMods) WithoutCalendarFeed() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.CalendarFeed = nil
+	})
+}
+
+func (m userMods) WithUserSetting(rel *UserSettingTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.UserSetting = &userRUserSettingR{
+			o: rel,
+		}
+	})
+}
+
+func (m userMods) WithNewUserSetting(mods ...UserSettingMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewUserSettingWithContext(ctx, mods...)
+
+		m.WithUserSetting(related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) WithExistingUserSetting(em *models.UserSetting) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.UserSetting = &userRUserSettingR{
+			o: o.f.FromExistingUserSetting(em),
+		}
+	})
+}
+
+func (m userMods) WithoutUserSetting() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.UserSetting = nil
 	})
 }
 
@@ -698,51 +1246,579 @@ func (m userMods) WithoutAiInterpretations() UserMod {
 	})
 }
 
-func (m userMods) WithTasks(number int, related *TaskTemplate) UserMod {
+func (m userMods) WithIdempotencyKeys(number int, related *IdempotencyKeyTemplate) UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.Tasks = []*userRTasksR{{
+		o.r.IdempotencyKeys = []*userRIdempotencyKeysR{{
 			number: number,
 			o:      related,
 		}}
 	})
 }
 
-func (m userMods) WithNewTasks(number int, mods ...TaskMod) UserMod {
+func (m userMods) WithNewIdempotencyKeys(number int, mods ...IdempotencyKeyMod) UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		related := o.f.NewTaskWithContext(ctx, mods...)
-		m.WithTasks(number, related).Apply(ctx, o)
+		related := o.f.NewIdempotencyKeyWithContext(ctx, mods...)
+		m.WithIdempotencyKeys(number, related).Apply(ctx, o)
 	})
 }
 
-func (m userMods) AddTasks(number int, related *TaskTemplate) UserMod {
+func (m userMods) AddIdempotencyKeys(number int, related *IdempotencyKeyTemplate) UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.Tasks = append(o.r.Tasks, &userRTasksR{
+		o.r.IdempotencyKeys = append(o.r.IdempotencyKeys, &userRIdempotencyKeysR{
 			number: number,
 			o:      related,
 		})
 	})
 }
 
-func (m userMods) AddNewTasks(number int, mods ...TaskMod) UserMod {
+func (m userMods) AddNewIdempotencyKeys(number int, mods ...IdempotencyKeyMod) UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		related := o.f.NewTaskWithContext(ctx, mods...)
-		m.AddTasks(number, related).Apply(ctx, o)
+		related := o.f.NewIdempotencyKeyWithContext(ctx, mods...)
+		m.AddIdempotencyKeys(number, related).Apply(ctx, o)
 	})
 }
 
-func (m userMods) AddExistingTasks(existingModels ...*models.Task) UserMod {
+func (m userMods) AddExistingIdempotencyKeys(existingModels ...*models.IdempotencyKey) UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
 		for _, em := range existingModels {
-			o.r.Tasks = append(o.r.Tasks, &userRTasksR{
-				o: o.f.FromExistingTask(em),
+			o.r.IdempotencyKeys = append(o.r.IdempotencyKeys, &userRIdempotencyKeysR{
+				o: o.f.FromExistingIdempotencyKey(em),
 			})
 		}
 	})
 }
 
-func (m userMods) WithoutTasks() UserMod {
+func (m userMods) WithoutIdempotencyKeys() UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
-		o.r.Tasks = nil
+		o.r.IdempotencyKeys = nil
+	})
+}
+
+func (m userMods) WithNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = []*userRNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.WithNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = append(o.r.Notifications, &userRNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.AddNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingNotifications(existingModels ...*models.Notification) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Notifications = append(o.r.Notifications, &userRNotificationsR{
+				o: o.f.FromExistingNotification(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutNotifications() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = nil
+	})
+}
+
+func (m userMods) WithProjects(number int, related *ProjectTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Projects = []*userRProjectsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewProjects(number int, mods ...ProjectMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewProjectWithContext(ctx, mods...)
+		m.WithProjects(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddProjects(number int, related *ProjectTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Projects = append(o.r.Projects, &userRProjectsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewProjects(number int, mods ...ProjectMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewProjectWithContext(ctx, mods...)
+		m.AddProjects(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingProjects(existingModels ...*models.Project) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Projects = append(o.r.Projects, &userRProjectsR{
+				o: o.f.FromExistingProject(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutProjects() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Projects = nil
+	})
+}
+
+func (m userMods) WithTaskAttachments(number int, related *TaskAttachmentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskAttachments = []*userRTaskAttachmentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTaskAttachments(number int, mods ...TaskAttachmentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskAttachmentWithContext(ctx, mods...)
+		m.WithTaskAttachments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTaskAttachments(number int, related *TaskAttachmentTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskAttachments = append(o.r.TaskAttachments, &userRTaskAttachmentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTaskAttachments(number int, mods ...TaskAttachmentMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskAttachmentWithContext(ctx, mods...)
+		m.AddTaskAttachments(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTaskAttachments(existingModels ...*models.TaskAttachment) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.TaskAttachments = append(o.r.TaskAttachments, &userRTaskAttachmentsR{
+				o: o.f.FromExistingTaskAttachment(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTaskAttachments() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskAttachments = nil
+	})
+}
+
+func (m userMods) WithActorTaskEvents(number int, related *TaskEventTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorTaskEvents = []*userRActorTaskEventsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewActorTaskEvents(number int, mods ...TaskEventMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskEventWithContext(ctx, mods...)
+		m.WithActorTaskEvents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddActorTaskEvents(number int, related *TaskEventTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorTaskEvents = append(o.r.ActorTaskEvents, &userRActorTaskEventsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewActorTaskEvents(number int, mods ...TaskEventMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskEventWithContext(ctx, mods...)
+		m.AddActorTaskEvents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingActorTaskEvents(existingModels ...*models.TaskEvent) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.ActorTaskEvents = append(o.r.ActorTaskEvents, &userRActorTaskEventsR{
+				o: o.f.FromExistingTaskEvent(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutActorTaskEvents() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorTaskEvents = nil
+	})
+}
+
+func (m userMods) WithTaskEvents(number int, related *TaskEventTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskEvents = []*userRTaskEventsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTaskEvents(number int, mods ...TaskEventMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskEventWithContext(ctx, mods...)
+		m.WithTaskEvents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTaskEvents(number int, related *TaskEventTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskEvents = append(o.r.TaskEvents, &userRTaskEventsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTaskEvents(number int, mods ...TaskEventMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskEventWithContext(ctx, mods...)
+		m.AddTaskEvents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTaskEvents(existingModels ...*models.TaskEvent) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.TaskEvents = append(o.r.TaskEvents, &userRTaskEventsR{
+				o: o.f.FromExistingTaskEvent(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTaskEvents() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskEvents = nil
+	})
+}
+
+func (m userMods) WithTaskImports(number int, related *TaskImportTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskImports = []*userRTaskImportsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTaskImports(number int, mods ...TaskImportMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskImportWithContext(ctx, mods...)
+		m.WithTaskImports(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTaskImports(number int, related *TaskImportTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskImports = append(o.r.TaskImports, &userRTaskImportsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTaskImports(number int, mods ...TaskImportMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskImportWithContext(ctx, mods...)
+		m.AddTaskImports(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTaskImports(existingModels ...*models.TaskImport) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.TaskImports = append(o.r.TaskImports, &userRTaskImportsR{
+				o: o.f.FromExistingTaskImport(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTaskImports() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskImports = nil
+	})
+}
+
+func (m userMods) WithTaskStatuses(number int, related *TaskStatusTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskStatuses = []*userRTaskStatusesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTaskStatuses(number int, mods ...TaskStatusMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskStatusWithContext(ctx, mods...)
+		m.WithTaskStatuses(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTaskStatuses(number int, related *TaskStatusTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskStatuses = append(o.r.TaskStatuses, &userRTaskStatusesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTaskStatuses(number int, mods ...TaskStatusMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskStatusWithContext(ctx, mods...)
+		m.AddTaskStatuses(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTaskStatuses(existingModels ...*models.TaskStatus) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.TaskStatuses = append(o.r.TaskStatuses, &userRTaskStatusesR{
+				o: o.f.FromExistingTaskStatus(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTaskStatuses() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskStatuses = nil
+	})
+}
+
+func (m userMods) WithTaskTemplates(number int, related *TaskTemplateTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskTemplates = []*userRTaskTemplatesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTaskTemplates(number int, mods ...TaskTemplateMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskTemplateWithContext(ctx, mods...)
+		m.WithTaskTemplates(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTaskTemplates(number int, related *TaskTemplateTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskTemplates = append(o.r.TaskTemplates, &userRTaskTemplatesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTaskTemplates(number int, mods ...TaskTemplateMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskTemplateWithContext(ctx, mods...)
+		m.AddTaskTemplates(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTaskTemplates(existingModels ...*models.TaskTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.TaskTemplates = append(o.r.TaskTemplates, &userRTaskTemplatesR{
+				o: o.f.FromExistingTaskTemplate(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTaskTemplates() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskTemplates = nil
+	})
+}
+
+func (m userMods) WithTaskViews(number int, related *TaskViewTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskViews = []*userRTaskViewsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTaskViews(number int, mods ...TaskViewMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskViewWithContext(ctx, mods...)
+		m.WithTaskViews(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTaskViews(number int, related *TaskViewTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskViews = append(o.r.TaskViews, &userRTaskViewsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTaskViews(number int, mods ...TaskViewMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskViewWithContext(ctx, mods...)
+		m.AddTaskViews(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTaskViews(existingModels ...*models.TaskView) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.TaskViews = append(o.r.TaskViews, &userRTaskViewsR{
+				o: o.f.FromExistingTaskView(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTaskViews() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TaskViews = nil
+	})
+}
+
+func (m userMods) WithTasks(number int, related *TaskTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Tasks = []*userRTasksR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTasks(number int, mods ...TaskMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskWithContext(ctx, mods...)
+		m.WithTasks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTasks(number int, related *TaskTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Tasks = append(o.r.Tasks, &userRTasksR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTasks(number int, mods ...TaskMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTaskWithContext(ctx, mods...)
+		m.AddTasks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTasks(existingModels ...*models.Task) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Tasks = append(o.r.Tasks, &userRTasksR{
+				o: o.f.FromExistingTask(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTasks() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Tasks = nil
+	})
+}
+
+func (m userMods) WithTimeEntries(number int, related *TimeEntryTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TimeEntries = []*userRTimeEntriesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewTimeEntries(number int, mods ...TimeEntryMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTimeEntryWithContext(ctx, mods...)
+		m.WithTimeEntries(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddTimeEntries(number int, related *TimeEntryTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TimeEntries = append(o.r.TimeEntries, &userRTimeEntriesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewTimeEntries(number int, mods ...TimeEntryMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewTimeEntryWithContext(ctx, mods...)
+		m.AddTimeEntries(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingTimeEntries(existingModels ...*models.TimeEntry) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.TimeEntries = append(o.r.TimeEntries, &userRTimeEntriesR{
+				o: o.f.FromExistingTimeEntry(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutTimeEntries() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.TimeEntries = nil
 	})
 }
 
@@ -35,6 +35,22 @@ const (
 	AIInterpretationStructuredResultTypeTodo AIInterpretationStructuredResultType = "todo"
 )
 
+// Defines values for BatchTaskOperationOp.
+const (
+	Delete      BatchTaskOperationOp = "delete"
+	MoveProject BatchTaskOperationOp = "move_project"
+	SetStatus   BatchTaskOperationOp = "set_status"
+	Update      BatchTaskOperationOp = "update"
+)
+
+// Defines values for BatchTaskResultStatus.
+const (
+	BatchTaskResultStatusFailed     BatchTaskResultStatus = "failed"
+	BatchTaskResultStatusRolledBack BatchTaskResultStatus = "rolled_back"
+	BatchTaskResultStatusSkipped    BatchTaskResultStatus = "skipped"
+	BatchTaskResultStatusSucceeded  BatchTaskResultStatus = "succeeded"
+)
+
 // Defines values for CreateTaskRequestPriority.
 const (
 	CreateTaskRequestPriorityHigh   CreateTaskRequestPriority = "high"
@@ -42,18 +58,27 @@ const (
 	CreateTaskRequestPriorityMedium CreateTaskRequestPriority = "medium"
 )
 
-// Defines values for CreateTaskRequestStatus.
+// Defines values for ImportResultItemPriority.
+const (
+	ImportResultItemPriorityHigh   ImportResultItemPriority = "high"
+	ImportResultItemPriorityLow    ImportResultItemPriority = "low"
+	ImportResultItemPriorityMedium ImportResultItemPriority = "medium"
+)
+
+// Defines values for ImportResultItemResult.
 const (
-	CreateTaskRequestStatusDone       CreateTaskRequestStatus = "done"
-	CreateTaskRequestStatusInProgress CreateTaskRequestStatus = "in_progress"
-	CreateTaskRequestStatusTodo       CreateTaskRequestStatus = "todo"
+	ImportResultItemResultCreated     ImportResultItemResult = "created"
+	ImportResultItemResultDuplicate   ImportResultItemResult = "duplicate"
+	ImportResultItemResultPending     ImportResultItemResult = "pending"
+	ImportResultItemResultSkipped     ImportResultItemResult = "skipped"
+	ImportResultItemResultWouldCreate ImportResultItemResult = "would_create"
 )
 
-// Defines values for EditTaskRequestStatus.
+// Defines values for ImportResultItemStatus.
 const (
-	EditTaskRequestStatusDone       EditTaskRequestStatus = "done"
-	EditTaskRequestStatusInProgress EditTaskRequestStatus = "in_progress"
-	EditTaskRequestStatusTodo       EditTaskRequestStatus = "todo"
+	ImportResultItemStatusDone       ImportResultItemStatus = "done"
+	ImportResultItemStatusInProgress ImportResultItemStatus = "in_progress"
+	ImportResultItemStatusTodo       ImportResultItemStatus = "todo"
 )
 
 // Defines values for InterpretationItemResourceType.
@@ -65,8 +90,8 @@ const (
 
 // Defines values for InterpretationItemStatus.
 const (
-	Created InterpretationItemStatus = "created"
-	Pending InterpretationItemStatus = "pending"
+	InterpretationItemStatusCreated InterpretationItemStatus = "created"
+	InterpretationItemStatusPending InterpretationItemStatus = "pending"
 )
 
 // Defines values for InterpretationResponseType.
@@ -88,39 +113,109 @@ const (
 
 // Defines values for TaskSource.
 const (
-	Ai     TaskSource = "ai"
-	Manual TaskSource = "manual"
+	TaskSourceAi     TaskSource = "ai"
+	TaskSourceManual TaskSource = "manual"
+)
+
+// Defines values for TaskEventEventType.
+const (
+	TaskEventEventTypeCreated  TaskEventEventType = "created"
+	TaskEventEventTypeDeleted  TaskEventEventType = "deleted"
+	TaskEventEventTypeRestored TaskEventEventType = "restored"
+	TaskEventEventTypeUpdated  TaskEventEventType = "updated"
+)
+
+// Defines values for TaskEventSource.
+const (
+	TaskEventSourceAi     TaskEventSource = "ai"
+	TaskEventSourceManual TaskEventSource = "manual"
+)
+
+// Defines values for TaskFilterSort.
+const (
+	CreatedAt TaskFilterSort = "created_at"
+	DueAt     TaskFilterSort = "due_at"
+	Rank      TaskFilterSort = "rank"
+)
+
+// Defines values for TaskFilterSources.
+const (
+	TaskFilterSourcesAi     TaskFilterSources = "ai"
+	TaskFilterSourcesManual TaskFilterSources = "manual"
+)
+
+// Defines values for TaskFlowReportGroupBy.
+const (
+	TaskFlowReportGroupByDay  TaskFlowReportGroupBy = "day"
+	TaskFlowReportGroupByWeek TaskFlowReportGroupBy = "week"
+)
+
+// Defines values for TaskStatsGroupBy.
+const (
+	TaskStatsGroupByDay  TaskStatsGroupBy = "day"
+	TaskStatsGroupByWeek TaskStatsGroupBy = "week"
+)
+
+// Defines values for TaskStatsSourceSource.
+const (
+	TaskStatsSourceSourceAi     TaskStatsSourceSource = "ai"
+	TaskStatsSourceSourceManual TaskStatsSourceSource = "manual"
+)
+
+// Defines values for TaskStatusCategory.
+const (
+	TaskStatusCategoryDoing TaskStatusCategory = "doing"
+	TaskStatusCategoryDone  TaskStatusCategory = "done"
+	TaskStatusCategoryTodo  TaskStatusCategory = "todo"
 )
 
-// Defines values for TaskStatus.
+// Defines values for TimeReportGroupBy.
 const (
-	TaskStatusDone       TaskStatus = "done"
-	TaskStatusInProgress TaskStatus = "in_progress"
-	TaskStatusTodo       TaskStatus = "todo"
+	TimeReportGroupByDay     TimeReportGroupBy = "day"
+	TimeReportGroupByProject TimeReportGroupBy = "project"
+	TimeReportGroupByTag     TimeReportGroupBy = "tag"
 )
 
 // Defines values for UpdateTaskRequestPriority.
 const (
-	UpdateTaskRequestPriorityHigh   UpdateTaskRequestPriority = "high"
-	UpdateTaskRequestPriorityLow    UpdateTaskRequestPriority = "low"
-	UpdateTaskRequestPriorityMedium UpdateTaskRequestPriority = "medium"
+	High   UpdateTaskRequestPriority = "high"
+	Low    UpdateTaskRequestPriority = "low"
+	Medium UpdateTaskRequestPriority = "medium"
 )
 
-// Defines values for UpdateTaskRequestStatus.
+// Defines values for GetCalendarFeedICSParamsType.
 const (
-	UpdateTaskRequestStatusDone       UpdateTaskRequestStatus = "done"
-	UpdateTaskRequestStatusInProgress UpdateTaskRequestStatus = "in_progress"
-	UpdateTaskRequestStatusTodo       UpdateTaskRequestStatus = "todo"
+	GetCalendarFeedICSParamsTypeEvent GetCalendarFeedICSParamsType = "event"
+	GetCalendarFeedICSParamsTypeTodo  GetCalendarFeedICSParamsType = "todo"
 )
 
 // Defines values for ListInterpretationsParamsType.
 const (
-	ListInterpretationsParamsTypeEvent    ListInterpretationsParamsType = "event"
-	ListInterpretationsParamsTypeExpense  ListInterpretationsParamsType = "expense"
-	ListInterpretationsParamsTypeNote     ListInterpretationsParamsType = "note"
-	ListInterpretationsParamsTypeReminder ListInterpretationsParamsType = "reminder"
-	ListInterpretationsParamsTypeTodo     ListInterpretationsParamsType = "todo"
-	ListInterpretationsParamsTypeUnknown  ListInterpretationsParamsType = "unknown"
+	Event    ListInterpretationsParamsType = "event"
+	Expense  ListInterpretationsParamsType = "expense"
+	Note     ListInterpretationsParamsType = "note"
+	Reminder ListInterpretationsParamsType = "reminder"
+	Todo     ListInterpretationsParamsType = "todo"
+	Unknown  ListInterpretationsParamsType = "unknown"
+)
+
+// Defines values for GetTaskStatsParamsGroupBy.
+const (
+	GetTaskStatsParamsGroupByDay  GetTaskStatsParamsGroupBy = "day"
+	GetTaskStatsParamsGroupByWeek GetTaskStatsParamsGroupBy = "week"
+)
+
+// Defines values for GetTaskFlowReportParamsGroupBy.
+const (
+	GetTaskFlowReportParamsGroupByDay  GetTaskFlowReportParamsGroupBy = "day"
+	GetTaskFlowReportParamsGroupByWeek GetTaskFlowReportParamsGroupBy = "week"
+)
+
+// Defines values for GetTimeReportParamsGroupBy.
+const (
+	GetTimeReportParamsGroupByDay     GetTimeReportParamsGroupBy = "day"
+	GetTimeReportParamsGroupByProject GetTimeReportParamsGroupBy = "project"
+	GetTimeReportParamsGroupByTag     GetTimeReportParamsGroupBy = "tag"
 )
 
 // AIInterpretation defines model for AIInterpretation.
@@ -153,9 +248,18 @@ type AIInterpretation struct {
 			// Deadline 期限
 			Deadline *time.Time `json:"deadline,omitempty"`
 
+			// EstimateMinutes 見積もり工数（分）
+			EstimateMinutes *int32 `json:"estimate_minutes,omitempty"`
+
 			// Priority 優先度
 			Priority *AIInterpretationStructuredResultMetadataPriority `json:"priority,omitempty"`
 
+			// Project 割り当て先のプロジェクト名（ユーザーの既存プロジェクトから選択）
+			Project *string `json:"project,omitempty"`
+
+			// Recurrence 繰り返しルール（RFC 5545 RRULE）
+			Recurrence *string `json:"recurrence,omitempty"`
+
 			// Tags タグ
 			Tags *[]string `json:"tags,omitempty"`
 		} `json:"metadata,omitempty"`
@@ -177,6 +281,30 @@ type AIInterpretationStructuredResultMetadataPriority string
 // AIInterpretationStructuredResultType アイテムタイプ（現在はtodoのみサポート）
 type AIInterpretationStructuredResultType string
 
+// AccountImportResult defines model for AccountImportResult.
+type AccountImportResult struct {
+	// InterpretationItems 作成したAI解釈アイテム数
+	InterpretationItems int `json:"interpretation_items"`
+
+	// Interpretations 作成したAI解釈数
+	Interpretations int `json:"interpretations"`
+
+	// ProjectsCreated 新しく作成したプロジェクト数
+	ProjectsCreated int `json:"projects_created"`
+
+	// ProjectsMerged 同名のプロジェクトが既にあったため、それを使ったプロジェクト数
+	ProjectsMerged int `json:"projects_merged"`
+
+	// Tasks 作成したタスク数
+	Tasks int `json:"tasks"`
+}
+
+// AddTaskDependencyRequest defines model for AddTaskDependencyRequest.
+type AddTaskDependencyRequest struct {
+	// DependsOnTaskId 先行タスクID（このタスクが完了するまで対象タスクはブロックされる）
+	DependsOnTaskId openapi_types.UUID `json:"depends_on_task_id"`
+}
+
 // ApproveItemResponse defines model for ApproveItemResponse.
 type ApproveItemResponse struct {
 	// ResourceId 作成されたリソースID
@@ -202,12 +330,119 @@ type AuthResponse struct {
 	User     User   `json:"user"`
 }
 
+// BatchTaskOperation defines model for BatchTaskOperation.
+type BatchTaskOperation struct {
+	// Description タスクの説明（updateのみ）
+	Description *string `json:"description"`
+
+	// DueAt タスクの期限（updateのみ）
+	DueAt *time.Time `json:"due_at"`
+
+	// Force ブロック中のタスクでもdoingカテゴリのステータスへの変更を強制する（WIP制限は強制できない）
+	Force *bool `json:"force,omitempty"`
+
+	// Op 操作の種類（update:フィールドの部分更新、set_status:ステータス変更、delete:ゴミ箱に移動、move_project:プロジェクト変更）
+	Op BatchTaskOperationOp `json:"op"`
+
+	// ProjectId 所属プロジェクトID（update、move_projectで使用。move_projectで未指定またはnullの場合はプロジェクトから除外）
+	ProjectId *openapi_types.UUID `json:"project_id"`
+
+	// Status ステータス名（update、set_statusで使用。set_statusでは必須）
+	Status *string `json:"status,omitempty"`
+
+	// TaskId 対象のタスクID
+	TaskId openapi_types.UUID `json:"task_id"`
+
+	// Title タスクのタイトル（updateのみ）
+	Title *string `json:"title,omitempty"`
+
+	// Version 取得時のタスクのバージョン（指定した場合は現在のバージョンと一致しなければ失敗）
+	Version *int `json:"version,omitempty"`
+}
+
+// BatchTaskOperationOp 操作の種類（update:フィールドの部分更新、set_status:ステータス変更、delete:ゴミ箱に移動、move_project:プロジェクト変更）
+type BatchTaskOperationOp string
+
+// BatchTaskRequest defines model for BatchTaskRequest.
+type BatchTaskRequest struct {
+	// AllOrNothing trueの場合は1件でも失敗すると全ての操作を取り消す。falseの場合は失敗した操作のみを取り消す
+	AllOrNothing *bool `json:"all_or_nothing,omitempty"`
+
+	// Operations 先頭から順に実行する操作一覧
+	Operations []BatchTaskOperation `json:"operations"`
+}
+
+// BatchTaskResponse defines model for BatchTaskResponse.
+type BatchTaskResponse struct {
+	// Failed 失敗した操作の件数
+	Failed int `json:"failed"`
+
+	// Results 操作ごとの実行結果（リクエストと同じ順）
+	Results []BatchTaskResult `json:"results"`
+
+	// Succeeded 反映された操作の件数
+	Succeeded int `json:"succeeded"`
+}
+
+// BatchTaskResult defines model for BatchTaskResult.
+type BatchTaskResult struct {
+	Error *ErrorResponse `json:"error,omitempty"`
+
+	// Index リクエストのoperations内の位置（0始まり）
+	Index int `json:"index"`
+
+	// Op 操作の種類
+	Op string `json:"op"`
+
+	// Status 実行結果（rolled_back:成功したが全件ロールバックで取り消し、skipped:全件ロールバックにより未実行）
+	Status BatchTaskResultStatus `json:"status"`
+
+	// TaskId 対象のタスクID
+	TaskId string `json:"task_id"`
+}
+
+// BatchTaskResultStatus 実行結果（rolled_back:成功したが全件ロールバックで取り消し、skipped:全件ロールバックにより未実行）
+type BatchTaskResultStatus string
+
+// CalendarFeedStatus defines model for CalendarFeedStatus.
+type CalendarFeedStatus struct {
+	// CreatedAt 現在のトークンの発行日時（発行されていない場合はnull）
+	CreatedAt *time.Time `json:"created_at"`
+
+	// Enabled 購読フィードが発行されているか
+	Enabled bool `json:"enabled"`
+}
+
+// CalendarFeedToken defines model for CalendarFeedToken.
+type CalendarFeedToken struct {
+	// CreatedAt 発行日時
+	CreatedAt time.Time `json:"created_at"`
+
+	// Token フィードのトークン（保存されないため、再表示するには再発行が必要）
+	Token string `json:"token"`
+
+	// Url カレンダーアプリに登録する購読URL
+	Url string `json:"url"`
+}
+
 // CreateInterpretationRequest defines model for CreateInterpretationRequest.
 type CreateInterpretationRequest struct {
 	// InputText 自然言語テキスト
 	InputText string `json:"input_text"`
 }
 
+// CreateProjectRequest defines model for CreateProjectRequest.
+type CreateProjectRequest struct {
+	// Color 表示色（#RRGGBB）
+	Color *string `json:"color"`
+
+	// Name プロジェクト名
+	Name string `json:"name"`
+
+	// SortOrder 表示順（昇順）
+	SortOrder *int `json:"sort_order,omitempty"`
+}
+
 // CreateTaskRequest defines model for CreateTaskRequest.
 type CreateTaskRequest struct {
 	// Description タスクの説明
@@ -216,14 +451,26 @@ type CreateTaskRequest struct {
 	// DueAt タスクの期限
 	DueAt *time.Time `json:"due_at"`
 
+	// EstimateMinutes 見積もり工数（分）。0を指定すると見積もりなし
+	EstimateMinutes *int32 `json:"estimate_minutes"`
+
 	// InterpretationId このタスクを作成したAI解釈のID
 	InterpretationId *openapi_types.UUID `json:"interpretation_id"`
 
 	// Priority タスクの優先度
 	Priority *CreateTaskRequestPriority `json:"priority"`
 
-	// Status タスクの状態
-	Status *CreateTaskRequestStatus `json:"status,omitempty"`
+	// ProjectId 所属プロジェクトID
+	ProjectId *openapi_types.UUID `json:"project_id"`
+
+	// RecurrenceAnchorAt 繰り返しの起点日時（DTSTART）。省略時は期限または作成日時
+	RecurrenceAnchorAt *time.Time `json:"recurrence_anchor_at"`
+
+	// RecurrenceRule 繰り返しルール（RFC 5545 RRULE、例 FREQ=WEEKLY;BYDAY=FR）
+	RecurrenceRule *string `json:"recurrence_rule"`
+
+	// Status ステータス名（省略時はtodoカテゴリの最初のステータス）
+	Status *string `json:"status,omitempty"`
 
 	// Title タスクのタイトル
 	Title string `json:"title"`
@@ -232,8 +479,71 @@ type CreateTaskRequest struct {
 // CreateTaskRequestPriority タスクの優先度
 type CreateTaskRequestPriority string
 
-// CreateTaskRequestStatus タスクの状態
-type CreateTaskRequestStatus string
+// CreateTaskStatusRequest defines model for CreateTaskStatusRequest.
+type CreateTaskStatusRequest struct {
+	// Category ステータスのカテゴリ（todo は未着手、doing は作業中、done は完了。着手・完了日時の記録や集計に使用）
+	Category TaskStatusCategory `json:"category"`
+
+	// Name ステータス名（作成後は変更できない）
+	Name string `json:"name"`
+
+	// SortOrder 表示順（昇順、省略時は末尾）
+	SortOrder *int32 `json:"sort_order,omitempty"`
+
+	// WipLimit 同時に置けるタスクの上限（0は無制限）
+	WipLimit *int32 `json:"wip_limit,omitempty"`
+}
+
+// CreateTaskTemplateRequest defines model for CreateTaskTemplateRequest.
+type CreateTaskTemplateRequest struct {
+	// Description テンプレートの説明
+	Description *string `json:"description"`
+
+	// Items タスク定義の一覧（サブタスクを含めて最大100件）
+	Items []TaskTemplateItem `json:"items"`
+
+	// Name テンプレート名
+	Name string `json:"name"`
+
+	// ProjectId 作成したタスクを割り当てる既定のプロジェクトID
+	ProjectId *openapi_types.UUID `json:"project_id"`
+}
+
+// CreateTaskViewRequest defines model for CreateTaskViewRequest.
+type CreateTaskViewRequest struct {
+	// Filter ビューの絞り込み条件（指定した条件はすべて満たし、一覧で指定した値はいずれかに一致するタスク）
+	Filter TaskFilter `json:"filter"`
+
+	// Name ビュー名
+	Name string `json:"name"`
+}
+
+// CreateTimeEntryRequest defines model for CreateTimeEntryRequest.
+type CreateTimeEntryRequest struct {
+	// EndedAt 終了日時（開始日時より後）
+	EndedAt time.Time `json:"ended_at"`
+
+	// Note メモ
+	Note *string `json:"note,omitempty"`
+
+	// StartedAt 開始日時
+	StartedAt time.Time `json:"started_at"`
+}
+
+// EditProjectRequest defines model for EditProjectRequest.
+type EditProjectRequest struct {
+	// Archived アーカイブ済みかどうか
+	Archived *bool `json:"archived,omitempty"`
+
+	// Color 表示色（#RRGGBB）
+	Color *string `json:"color"`
+
+	// Name プロジェクト名
+	Name *string `json:"name,omitempty"`
+
+	// SortOrder 表示順（昇順）
+	SortOrder *int `json:"sort_order,omitempty"`
+}
 
 // EditTaskRequest defines model for EditTaskRequest.
 type EditTaskRequest struct {
@@ -243,15 +553,84 @@ type EditTaskRequest struct {
 	// DueAt タスクの期限
 	DueAt *time.Time `json:"due_at"`
 
-	// Status タスクの状態
-	Status *EditTaskRequestStatus `json:"status,omitempty"`
+	// EstimateMinutes 見積もり工数（分）。0を指定すると見積もりを解除
+	EstimateMinutes *int32 `json:"estimate_minutes"`
+
+	// Force ブロック中のタスクでもdoingカテゴリのステータスへの変更を強制する（WIP制限は強制できない）
+	Force *bool `json:"force,omitempty"`
+
+	// ProjectId 所属プロジェクトID
+	ProjectId *openapi_types.UUID `json:"project_id"`
+
+	// RecurrenceAnchorAt 繰り返しの起点日時（DTSTART）
+	RecurrenceAnchorAt *time.Time `json:"recurrence_anchor_at"`
+
+	// RecurrenceRule 繰り返しルール（RFC 5545 RRULE）。空文字列を指定すると繰り返しを解除
+	RecurrenceRule *string `json:"recurrence_rule"`
+
+	// Status ステータス名（ユーザーが定義したステータスのいずれか）
+	Status *string `json:"status,omitempty"`
 
 	// Title タスクのタイトル
 	Title *string `json:"title,omitempty"`
 }
 
-// EditTaskRequestStatus タスクの状態
-type EditTaskRequestStatus string
+// EditTaskStatusRequest defines model for EditTaskStatusRequest.
+type EditTaskStatusRequest struct {
+	// Category ステータスのカテゴリ（todo は未着手、doing は作業中、done は完了。着手・完了日時の記録や集計に使用）
+	Category *TaskStatusCategory `json:"category,omitempty"`
+
+	// SortOrder 表示順（昇順）
+	SortOrder *int32 `json:"sort_order,omitempty"`
+
+	// WipLimit 同時に置けるタスクの上限（0は無制限。既に上限を超えているタスクはそのまま残る）
+	WipLimit *int32 `json:"wip_limit,omitempty"`
+}
+
+// EditTaskTemplateRequest defines model for EditTaskTemplateRequest.
+type EditTaskTemplateRequest struct {
+	// Description テンプレートの説明。空文字列を指定すると説明を削除
+	Description *string `json:"description"`
+
+	// Items タスク定義の一覧（指定した場合はすべて置き換える）
+	Items *[]TaskTemplateItem `json:"items,omitempty"`
+
+	// Name テンプレート名
+	Name *string `json:"name,omitempty"`
+
+	// ProjectId 作成したタスクを割り当てる既定のプロジェクトID
+	ProjectId *openapi_types.UUID `json:"project_id"`
+}
+
+// EditTaskViewRequest defines model for EditTaskViewRequest.
+type EditTaskViewRequest struct {
+	// Filter ビューの絞り込み条件（指定した条件はすべて満たし、一覧で指定した値はいずれかに一致するタスク）
+	Filter *TaskFilter `json:"filter,omitempty"`
+
+	// Name ビュー名
+	Name *string `json:"name,omitempty"`
+}
+
+// EditTimeEntryRequest 指定したフィールドのみ更新する。計測中の記録にended_atを指定するとタイマーを停止する
+type EditTimeEntryRequest struct {
+	// EndedAt 終了日時（開始日時より後）
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+
+	// Note メモ
+	Note *string `json:"note,omitempty"`
+
+	// StartedAt 開始日時
+	StartedAt *time.Time `json:"started_at,omitempty"`
+}
+
+// EditUserSettingsRequest defines model for EditUserSettingsRequest.
+type EditUserSettingsRequest struct {
+	// AutoArchiveDays 完了したタスクを自動でアーカイブするまでの日数（0は自動アーカイブしない）
+	AutoArchiveDays *int32 `json:"auto_archive_days,omitempty"`
+
+	// Timezone タイムゾーン（IANA名、例 Asia/Tokyo。空文字はUTCに戻す）
+	Timezone *string `json:"timezone,omitempty"`
+}
 
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
@@ -262,11 +641,131 @@ type ErrorResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// EstimateReport defines model for EstimateReport.
+type EstimateReport struct {
+	// ActualToEstimateRatio 実績÷見積もりの比率（1より大きいと見積もりより時間がかかっている、対象がない場合はnull）
+	ActualToEstimateRatio *float64 `json:"actual_to_estimate_ratio"`
+
+	// TaskCount 集計対象の完了タスク数（時間記録のないタスクは含まない）
+	TaskCount int `json:"task_count"`
+
+	// Tasks 集計対象のタスク（直近に更新された順）
+	Tasks []EstimateReportTask `json:"tasks"`
+
+	// TotalActualSeconds 実績時間の合計（秒）
+	TotalActualSeconds int64 `json:"total_actual_seconds"`
+
+	// TotalEstimateSeconds 見積もり工数の合計（秒）
+	TotalEstimateSeconds int64 `json:"total_estimate_seconds"`
+}
+
+// EstimateReportTask defines model for EstimateReportTask.
+type EstimateReportTask struct {
+	// ActualSeconds 実績時間（終了済みの時間記録の合計、秒）
+	ActualSeconds int64 `json:"actual_seconds"`
+
+	// EstimateMinutes 見積もり工数（分）
+	EstimateMinutes int32 `json:"estimate_minutes"`
+
+	// TaskId タスクID
+	TaskId openapi_types.UUID `json:"task_id"`
+
+	// Title タスクのタイトル
+	Title string `json:"title"`
+}
+
 // HealthResponse defines model for HealthResponse.
 type HealthResponse struct {
 	Status string `json:"status"`
 }
 
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	// Created 作成した（ドライランの場合は作成される）タスク数
+	Created int `json:"created"`
+
+	// DryRun ドライラン（プレビューのみでタスクは作成していない）か
+	DryRun bool `json:"dry_run"`
+
+	// Duplicates 取り込み済みのため作成しなかった件数
+	Duplicates int `json:"duplicates"`
+
+	// InterpretationId レビュー待ちのアイテムをまとめたAI解釈のID（/interpretations/{id}/items でレビューする）
+	InterpretationId *openapi_types.UUID `json:"interpretation_id"`
+
+	// Items 取り込み元の順に並んだ各エントリの処理結果
+	Items []ImportResultItem `json:"items"`
+
+	// Pending レビュー待ちのAI解釈アイテムとして登録した件数（承認するとタスクを作成する）
+	Pending int `json:"pending"`
+
+	// Skipped タスクに変換できず作成しなかった件数
+	Skipped int `json:"skipped"`
+}
+
+// ImportResultItem defines model for ImportResultItem.
+type ImportResultItem struct {
+	// Description タスク詳細
+	Description *string `json:"description"`
+
+	// DueAt 期限日時
+	DueAt *time.Time `json:"due_at"`
+
+	// EstimateMinutes 見積もり工数（分）
+	EstimateMinutes *int32 `json:"estimate_minutes"`
+
+	// ExternalId 取り込み元での識別子（iCalendarのUID等、再取り込み時の重複判定に使用）
+	ExternalId string `json:"external_id"`
+
+	// InterpretationItemId レビュー待ちとして登録したAI解釈アイテムのID
+	InterpretationItemId *openapi_types.UUID `json:"interpretation_item_id"`
+
+	// Labels 取り込み元のラベル・タグ
+	Labels []string `json:"labels"`
+
+	// Priority 取り込み元の優先度
+	Priority *ImportResultItemPriority `json:"priority"`
+
+	// Reason duplicate・skippedの理由
+	Reason *string `json:"reason"`
+
+	// RecurrenceRule 繰り返しルール（RFC 5545 RRULE）
+	RecurrenceRule *string `json:"recurrence_rule"`
+
+	// Result 処理結果（created: 作成した、would_create: ドライランのため未作成、pending: レビュー待ちのアイテムとして登録した、duplicate: 取り込み済み、skipped: 変換できない）
+	Result ImportResultItemResult `json:"result"`
+
+	// Status ステータス
+	Status ImportResultItemStatus `json:"status"`
+
+	// TaskId 作成したタスク、または取り込み済みのタスクのID
+	TaskId *openapi_types.UUID `json:"task_id"`
+
+	// Title タスクタイトル
+	Title string `json:"title"`
+
+	// Warnings 取り込めなかった一部の情報（無視した繰り返しルール等）
+	Warnings []string `json:"warnings"`
+}
+
+// ImportResultItemPriority 取り込み元の優先度
+type ImportResultItemPriority string
+
+// ImportResultItemResult 処理結果（created: 作成した、would_create: ドライランのため未作成、pending: レビュー待ちのアイテムとして登録した、duplicate: 取り込み済み、skipped: 変換できない）
+type ImportResultItemResult string
+
+// ImportResultItemStatus ステータス
+type ImportResultItemStatus string
+
+// InstantiateTaskTemplateRequest defines model for InstantiateTaskTemplateRequest.
+type InstantiateTaskTemplateRequest struct {
+	// AnchorAt 相対期限の基準日時（未指定の場合は現在時刻）
+	AnchorAt *time.Time `json:"anchor_at"`
+
+	// ProjectId 作成したタスクを割り当てるプロジェクトID（未指定の場合はテンプレートの既定のプロジェクト）
+	ProjectId *openapi_types.UUID `json:"project_id"`
+}
+
 // InterpretationItem defines model for InterpretationItem.
 type InterpretationItem struct {
 	// CreatedAt 作成日時
@@ -329,34 +828,73 @@ type InterpretationResponse struct {
 // InterpretationResponseType 解析結果のタイプ
 type InterpretationResponseType string
 
-// Task defines model for Task.
-type Task struct {
+// MoveTaskRequest defines model for MoveTaskRequest.
+type MoveTaskRequest struct {
+	// Force ブロック中のタスクでもdoingカテゴリのステータスへの移動を強制する（WIP制限は強制できない）
+	Force *bool `json:"force,omitempty"`
+
+	// Position 移動先の列内での位置（0始まり、移動するタスク自身を除いた並びに対する挿入位置。列の件数以上は末尾）
+	Position int `json:"position"`
+
+	// Status 移動先のステータス列（ステータス名）
+	Status string `json:"status"`
+}
+
+// Notification defines model for Notification.
+type Notification struct {
+	// Body 通知の本文
+	Body *string `json:"body"`
+
 	// CreatedAt 作成日時
 	CreatedAt time.Time `json:"created_at"`
 
-	// Description タスクの説明
-	Description *string `json:"description"`
+	// Id 通知ID
+	Id openapi_types.UUID `json:"id"`
 
-	// DueAt タスクの期限
-	DueAt *time.Time `json:"due_at"`
+	// Read 既読か
+	Read bool `json:"read"`
 
-	// Id タスクID
-	Id openapi_types.UUID `json:"id"`
+	// ReadAt 既読日時（未読の場合はnull）
+	ReadAt *time.Time `json:"read_at"`
 
-	// InterpretationId このタスクを作成したAI解釈のID
-	InterpretationId *openapi_types.UUID `json:"interpretation_id"`
+	// TaskId 関連するタスクID
+	TaskId *openapi_types.UUID `json:"task_id"`
 
-	// Priority タスクの優先度
-	Priority *TaskPriority `json:"priority"`
+	// Title 通知のタイトル
+	Title string `json:"title"`
 
-	// Source 作成元
-	Source TaskSource `json:"source"`
+	// Type 通知の種類（task_reminder等）
+	Type string `json:"type"`
+}
 
-	// Status タスクの状態
-	Status TaskStatus `json:"status"`
+// NotificationListResponse defines model for NotificationListResponse.
+type NotificationListResponse struct {
+	// NextCursor 次のページを取得するためのカーソル（最後のページの場合はnull）
+	NextCursor *string `json:"next_cursor"`
 
-	// Title タスクのタイトル
-	Title string `json:"title"`
+	// Notifications 通知（作成日時の新しい順）
+	Notifications []Notification `json:"notifications"`
+}
+
+// Project defines model for Project.
+type Project struct {
+	// Archived アーカイブ済みかどうか
+	Archived bool `json:"archived"`
+
+	// Color 表示色（#RRGGBB）
+	Color *string `json:"color"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id プロジェクトID
+	Id openapi_types.UUID `json:"id"`
+
+	// Name プロジェクト名
+	Name string `json:"name"`
+
+	// SortOrder 表示順（昇順）
+	SortOrder int `json:"sort_order"`
 
 	// UpdatedAt 更新日時
 	UpdatedAt time.Time `json:"updated_at"`
@@ -365,2548 +903,14708 @@ type Task struct {
 	UserId openapi_types.UUID `json:"user_id"`
 }
 
-// TaskPriority タスクの優先度
-type TaskPriority string
-
-// TaskSource 作成元
-type TaskSource string
-
-// TaskStatus タスクの状態
-type TaskStatus string
+// ReadAllNotificationsResponse defines model for ReadAllNotificationsResponse.
+type ReadAllNotificationsResponse struct {
+	// Updated 既読にした通知数
+	Updated int64 `json:"updated"`
+}
 
-// UpdateItemRequest defines model for UpdateItemRequest.
-type UpdateItemRequest struct {
-	// Data 更新後のアイテム内容（JSON）
-	Data map[string]interface{} `json:"data"`
+// RunningTimerResponse defines model for RunningTimerResponse.
+type RunningTimerResponse struct {
+	// Entry 計測中の時間記録（計測中のタイマーがない場合はnull）
+	Entry *TimeEntry `json:"entry"`
 }
 
-// UpdateTaskRequest defines model for UpdateTaskRequest.
-type UpdateTaskRequest struct {
-	// Description タスクの説明
+// SaveTaskAsTemplateRequest defines model for SaveTaskAsTemplateRequest.
+type SaveTaskAsTemplateRequest struct {
+	// Description テンプレートの説明
 	Description *string `json:"description"`
 
-	// DueAt タスクの期限
-	DueAt *time.Time `json:"due_at"`
+	// Name テンプレート名
+	Name string `json:"name"`
+}
 
-	// Priority タスクの優先度
-	Priority *UpdateTaskRequestPriority `json:"priority"`
+// SnoozeTaskRequest defines model for SnoozeTaskRequest.
+type SnoozeTaskRequest struct {
+	// Duration 現在からのスヌーズ期間（例: "3 days"、"2 hours"、"1 week 2 days"、"3d"、"3日"。最大365日。untilとどちらか一方を指定）
+	Duration *string `json:"duration,omitempty"`
 
-	// Status タスクの状態
-	Status UpdateTaskRequestStatus `json:"status"`
+	// Notify スヌーズの解除時に通知する
+	Notify *bool `json:"notify,omitempty"`
 
-	// Title タスクのタイトル
-	Title string `json:"title"`
+	// Until スヌーズの解除日時（現在より後の日時。durationとどちらか一方を指定）
+	Until *time.Time `json:"until,omitempty"`
 }
 
-// UpdateTaskRequestPriority タスクの優先度
-type UpdateTaskRequestPriority string
+// StartTimerRequest defines model for StartTimerRequest.
+type StartTimerRequest struct {
+	// Note メモ
+	Note *string `json:"note,omitempty"`
+}
 
-// UpdateTaskRequestStatus タスクの状態
-type UpdateTaskRequestStatus string
+// Task defines model for Task.
+type Task struct {
+	// ArchivedAt アーカイブ日時（アーカイブしたタスクは既定の一覧に表示しない。完了カテゴリ以外のステータスに戻すと解除される。nullは未アーカイブ）
+	ArchivedAt *time.Time `json:"archived_at"`
 
-// User defines model for User.
-type User struct {
-	// Avatar アバター画像URL
-	Avatar *string `json:"avatar"`
+	// Blocked 未完了の先行タスクが存在するか（依存関係から算出）
+	Blocked bool `json:"blocked"`
+
+	// CompletedAt 完了日時（doneカテゴリのステータスに変更した日時。未完了に戻すとnull）
+	CompletedAt *time.Time `json:"completed_at"`
 
 	// CreatedAt 作成日時
 	CreatedAt time.Time `json:"created_at"`
 
-	// Id ユーザーID
+	// DeletedAt ゴミ箱に移動した日時（ゴミ箱の一覧でのみ設定）
+	DeletedAt *time.Time `json:"deleted_at"`
+
+	// Description タスクの説明
+	Description *string `json:"description"`
+
+	// DueAt タスクの期限
+	DueAt *time.Time `json:"due_at"`
+
+	// EstimateMinutes 見積もり工数（分）
+	EstimateMinutes *int32 `json:"estimate_minutes"`
+
+	// Id タスクID
 	Id openapi_types.UUID `json:"id"`
 
-	// Nickname ニックネーム/表示名
-	Nickname string `json:"nickname"`
-}
+	// InterpretationId このタスクを作成したAI解釈のID
+	InterpretationId *openapi_types.UUID `json:"interpretation_id"`
 
-// GoogleCallbackJSONBody defines parameters for GoogleCallback.
-type GoogleCallbackJSONBody struct {
-	// Code Google OAuthから返されたauthorization code
-	Code string `json:"code"`
-}
+	// Priority タスクの優先度
+	Priority *TaskPriority `json:"priority"`
 
-// ListInterpretationsParams defines parameters for ListInterpretations.
-type ListInterpretationsParams struct {
-	// Type AI解析のtype絞り込み
-	Type *ListInterpretationsParamsType `form:"type,omitempty" json:"type,omitempty"`
+	// ProjectId 所属プロジェクトID
+	ProjectId *openapi_types.UUID `json:"project_id"`
 
-	// Limit 取得件数（デフォルト: 20）
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// Rank 同じステータス列（プロジェクトがある場合はプロジェクト内）での表示順キー。辞書順に昇順で並べる。空文字列は未配置（作成日時の降順で先頭に表示）
+	Rank string `json:"rank"`
 
-	// Offset オフセット（ページネーション）
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
-}
+	// RecurrenceAnchorAt 繰り返しの起点日時（DTSTART）
+	RecurrenceAnchorAt *time.Time `json:"recurrence_anchor_at"`
 
-// ListInterpretationsParamsType defines parameters for ListInterpretations.
-type ListInterpretationsParamsType string
+	// RecurrenceRule 繰り返しルール（RFC 5545 RRULE、例 FREQ=WEEKLY;BYDAY=FR）
+	RecurrenceRule *string `json:"recurrence_rule"`
 
-// GoogleCallbackJSONRequestBody defines body for GoogleCallback for application/json ContentType.
-type GoogleCallbackJSONRequestBody GoogleCallbackJSONBody
+	// RecurrenceSeriesId 繰り返しシリーズID（同じルールから生成されたタスクで共通）
+	RecurrenceSeriesId *openapi_types.UUID `json:"recurrence_series_id"`
 
-// UpdateInterpretationItemJSONRequestBody defines body for UpdateInterpretationItem for application/json ContentType.
-type UpdateInterpretationItemJSONRequestBody = UpdateItemRequest
+	// SnoozedUntil スヌーズの解除日時（この日時まで既定の一覧に表示しない。nullはスヌーズなし）
+	SnoozedUntil *time.Time `json:"snoozed_until"`
 
-// CreateInterpretationJSONRequestBody defines body for CreateInterpretation for application/json ContentType.
-type CreateInterpretationJSONRequestBody = CreateInterpretationRequest
+	// Source 作成元
+	Source TaskSource `json:"source"`
 
-// ApproveMultipleInterpretationItemsJSONRequestBody defines body for ApproveMultipleInterpretationItems for application/json ContentType.
-type ApproveMultipleInterpretationItemsJSONRequestBody = ApproveMultipleItemsRequest
+	// StartedAt 着手日時（doingカテゴリのステータスに変更した日時。todoカテゴリに戻すとnull）
+	StartedAt *time.Time `json:"started_at"`
 
-// CreateTaskJSONRequestBody defines body for CreateTask for application/json ContentType.
-type CreateTaskJSONRequestBody = CreateTaskRequest
+	// Status ステータス名（ユーザーが定義したステータスのいずれか）
+	Status string `json:"status"`
 
-// EditTaskJSONRequestBody defines body for EditTask for application/json ContentType.
-type EditTaskJSONRequestBody = EditTaskRequest
+	// StatusCategory ステータスのカテゴリ（todo は未着手、doing は作業中、done は完了。着手・完了日時の記録や集計に使用）
+	StatusCategory TaskStatusCategory `json:"status_category"`
 
-// UpdateTaskJSONRequestBody defines body for UpdateTask for application/json ContentType.
-type UpdateTaskJSONRequestBody = UpdateTaskRequest
+	// TimerRunning タイマーが計測中か
+	TimerRunning bool `json:"timer_running"`
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+	// Title タスクのタイトル
+	Title string `json:"title"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
-}
+	// TrackedSeconds 記録された作業時間の合計（秒、計測中のタイマーは現在までの経過時間を含む）
+	TrackedSeconds int64 `json:"tracked_seconds"`
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	// UserId ユーザーID
+	UserId openapi_types.UUID `json:"user_id"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// Version 楽観的排他制御用のバージョン（更新ごとに加算。ETagヘッダーと同じ値）
+	Version int `json:"version"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// TaskPriority タスクの優先度
+type TaskPriority string
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+// TaskSource 作成元
+type TaskSource string
+
+// TaskAttachment defines model for TaskAttachment.
+type TaskAttachment struct {
+	// ChecksumSha256 ファイルの内容のSHA-256（16進数）
+	ChecksumSha256 string `json:"checksum_sha256"`
+
+	// ContentType ファイルの内容から判定したMIMEタイプ
+	ContentType string `json:"content_type"`
+
+	// CreatedAt アップロード日時
+	CreatedAt time.Time `json:"created_at"`
+
+	// Filename アップロード時のファイル名
+	Filename string `json:"filename"`
+
+	// Id 添付ファイルID
+	Id openapi_types.UUID `json:"id"`
+
+	// SizeBytes ファイルサイズ（バイト）
+	SizeBytes int64 `json:"size_bytes"`
+
+	// TaskId タスクID
+	TaskId openapi_types.UUID `json:"task_id"`
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
+// TaskAttachmentListResponse defines model for TaskAttachmentListResponse.
+type TaskAttachmentListResponse struct {
+	// Attachments 添付ファイル（アップロード日時の古い順）
+	Attachments []TaskAttachment `json:"attachments"`
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+// TaskDependencies defines model for TaskDependencies.
+type TaskDependencies struct {
+	// BlockedBy このタスクが依存している先行タスク一覧
+	BlockedBy []Task `json:"blocked_by"`
+
+	// Blocks このタスクに依存している後続タスク一覧
+	Blocks []Task `json:"blocks"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// GoogleCallbackWithBody request with any body
-	GoogleCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskEvent defines model for TaskEvent.
+type TaskEvent struct {
+	// ActorId 変更したユーザーID（ユーザー削除後はnull）
+	ActorId *openapi_types.UUID `json:"actor_id"`
 
-	GoogleCallback(ctx context.Context, body GoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Changes 変更されたフィールドごとの変更前後の値
+	Changes map[string]TaskFieldChange `json:"changes"`
 
-	// GetHealth request
-	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// CreatedAt 記録日時
+	CreatedAt time.Time `json:"created_at"`
 
-	// GetInterpretationItem request
-	GetInterpretationItem(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// EventType 変更の種類
+	EventType TaskEventEventType `json:"event_type"`
 
-	// UpdateInterpretationItemWithBody request with any body
-	UpdateInterpretationItemWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Id 変更履歴ID
+	Id openapi_types.UUID `json:"id"`
 
-	UpdateInterpretationItem(ctx context.Context, id openapi_types.UUID, body UpdateInterpretationItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Source 変更元（手動操作・AI解釈の承認）
+	Source TaskEventSource `json:"source"`
 
-	// ApproveInterpretationItem request
-	ApproveInterpretationItem(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// TaskId タスクID
+	TaskId openapi_types.UUID `json:"task_id"`
+}
 
-	// ListInterpretations request
-	ListInterpretations(ctx context.Context, params *ListInterpretationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskEventEventType 変更の種類
+type TaskEventEventType string
 
-	// CreateInterpretationWithBody request with any body
-	CreateInterpretationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskEventSource 変更元（手動操作・AI解釈の承認）
+type TaskEventSource string
 
-	CreateInterpretation(ctx context.Context, body CreateInterpretationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskFieldChange defines model for TaskFieldChange.
+type TaskFieldChange struct {
+	// After 変更後の値（削除時または未設定の場合はnull）
+	After interface{} `json:"after"`
 
-	// GetInterpretation request
-	GetInterpretation(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Before 変更前の値（作成時または未設定の場合はnull）
+	Before interface{} `json:"before"`
+}
 
-	// ApproveMultipleInterpretationItemsWithBody request with any body
-	ApproveMultipleInterpretationItemsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskFilter ビューの絞り込み条件（指定した条件はすべて満たし、一覧で指定した値はいずれかに一致するタスク）
+type TaskFilter struct {
+	// DueFrom 期限の範囲の開始（含む）。当日0時からの相対期限（例 +0d、+1w、-3d）または現在時刻を表す now
+	DueFrom *string `json:"due_from,omitempty"`
 
-	ApproveMultipleInterpretationItems(ctx context.Context, id openapi_types.UUID, body ApproveMultipleInterpretationItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// DueTo 期限の範囲の終了（含まない）。当日0時からの相対期限（例 +1d、+8d）または現在時刻を表す now
+	DueTo *string `json:"due_to,omitempty"`
 
-	// GetInterpretationItems request
-	GetInterpretationItems(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// HasDueDate 期限の有無（省略時は問わない）
+	HasDueDate *bool `json:"has_due_date,omitempty"`
 
-	// GetTaskList request
-	GetTaskList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// IncludeArchived アーカイブしたタスクを含めるかどうか（省略時は含めない）
+	IncludeArchived *bool `json:"include_archived,omitempty"`
 
-	// CreateTaskWithBody request with any body
-	CreateTaskWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// IncludeSnoozed スヌーズ中のタスクを含めるかどうか（省略時は含めない）
+	IncludeSnoozed *bool `json:"include_snoozed,omitempty"`
 
-	CreateTask(ctx context.Context, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ProjectIds プロジェクトID
+	ProjectIds *[]openapi_types.UUID `json:"project_ids,omitempty"`
 
-	// DeleteTask request
-	DeleteTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Sort 並び順（rank は一覧と同じ表示順、due_at は期限の早い順、created_at は作成日時の新しい順）
+	Sort *TaskFilterSort `json:"sort,omitempty"`
 
-	// GetTask request
-	GetTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Sources タスクの作成元
+	Sources *[]TaskFilterSources `json:"sources,omitempty"`
 
-	// EditTaskWithBody request with any body
-	EditTaskWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// StatusCategories ステータスのカテゴリ
+	StatusCategories *[]TaskStatusCategory `json:"status_categories,omitempty"`
 
-	EditTask(ctx context.Context, id openapi_types.UUID, body EditTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Statuses ステータス名
+	Statuses *[]string `json:"statuses,omitempty"`
 
-	// UpdateTaskWithBody request with any body
-	UpdateTaskWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// TitleContains タイトルに含まれる文字列（大文字・小文字は区別しない）
+	TitleContains *string `json:"title_contains,omitempty"`
+}
+
+// TaskFilterSort 並び順（rank は一覧と同じ表示順、due_at は期限の早い順、created_at は作成日時の新しい順）
+type TaskFilterSort string
+
+// TaskFilterSources defines model for TaskFilter.Sources.
+type TaskFilterSources string
+
+// TaskFlowPeriod defines model for TaskFlowPeriod.
+type TaskFlowPeriod struct {
+	// AverageCycleTimeSeconds 完了したタスクの着手から完了までの平均時間（秒、対象がない場合はnull）
+	AverageCycleTimeSeconds *float64 `json:"average_cycle_time_seconds"`
+
+	// CompletedCount 完了したタスクの件数（スループット）
+	CompletedCount int64 `json:"completed_count"`
 
-	UpdateTask(ctx context.Context, id openapi_types.UUID, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// CumulativeCompleted 期間末時点で完了済みのタスクの累計件数（cumulative_created との差が残りのタスク数）
+	CumulativeCompleted int64 `json:"cumulative_completed"`
+
+	// CumulativeCreated 期間末時点で作成済みのタスクの累計件数
+	CumulativeCreated int64 `json:"cumulative_created"`
+
+	// CumulativeStarted 期間末時点で着手済みのタスクの累計件数
+	CumulativeStarted int64 `json:"cumulative_started"`
+
+	// Date 期間の開始日（weekの場合は月曜日）
+	Date openapi_types.Date `json:"date"`
+
+	// StartedCount 着手したタスクの件数（着手せずに完了したタスクは完了日に着手したものとして数える）
+	StartedCount int64 `json:"started_count"`
 }
 
-func (c *Client) GoogleCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGoogleCallbackRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TaskFlowReport defines model for TaskFlowReport.
+type TaskFlowReport struct {
+	// AverageCycleTimeSeconds 期間内に完了したタスクの着手から完了までの平均時間（秒、着手日時のないタスクは除く。対象がない場合はnull）
+	AverageCycleTimeSeconds *float64 `json:"average_cycle_time_seconds"`
+
+	// From 集計期間の開始日時
+	From time.Time `json:"from"`
+
+	// GroupBy 期間ごとの集計単位
+	GroupBy TaskFlowReportGroupBy `json:"group_by"`
+
+	// Periods 期間ごとの集計（開始日順、件数0の期間も含む）
+	Periods []TaskFlowPeriod `json:"periods"`
+
+	// Throughput 期間内に完了したタスクの件数
+	Throughput int64 `json:"throughput"`
+
+	// To 集計期間の終了日時
+	To time.Time `json:"to"`
 }
 
-func (c *Client) GoogleCallback(ctx context.Context, body GoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGoogleCallbackRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TaskFlowReportGroupBy 期間ごとの集計単位
+type TaskFlowReportGroupBy string
+
+// TaskHistoryResponse defines model for TaskHistoryResponse.
+type TaskHistoryResponse struct {
+	// Events 変更履歴一覧（記録日時の昇順）
+	Events []TaskEvent `json:"events"`
 }
 
-func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetHealthRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TaskOccurrence defines model for TaskOccurrence.
+type TaskOccurrence struct {
+	// OccursAt 発生日時
+	OccursAt time.Time `json:"occurs_at"`
+
+	// SeriesId 繰り返しシリーズID
+	SeriesId openapi_types.UUID `json:"series_id"`
+
+	// TaskId 展開元のタスクID
+	TaskId openapi_types.UUID `json:"task_id"`
+
+	// Title タスクのタイトル
+	Title string `json:"title"`
 }
 
-func (c *Client) GetInterpretationItem(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetInterpretationItemRequest(c.Server, id)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TaskOccurrencesResponse defines model for TaskOccurrencesResponse.
+type TaskOccurrencesResponse struct {
+	// Occurrences 発生予定一覧（発生日時の昇順）
+	Occurrences []TaskOccurrence `json:"occurrences"`
 }
 
-func (c *Client) UpdateInterpretationItemWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateInterpretationItemRequestWithBody(c.Server, id, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TaskStats defines model for TaskStats.
+type TaskStats struct {
+	// AverageLeadTimeSeconds 期間内に完了したタスクの作成から完了までの平均時間（秒、完了がない場合はnull）
+	AverageLeadTimeSeconds *float64 `json:"average_lead_time_seconds"`
+
+	// CompletedCount 期間内に完了したタスクの件数
+	CompletedCount int64 `json:"completed_count"`
+
+	// CompletionRate 期間内に作成したタスクのうち現在完了している割合（0〜1、作成がない場合はnull）
+	CompletionRate *float64 `json:"completion_rate"`
+
+	// CreatedCount 期間内に作成したタスクの件数
+	CreatedCount int64 `json:"created_count"`
+
+	// From 集計期間の開始日時
+	From time.Time `json:"from"`
+
+	// GroupBy 期間ごとの集計単位
+	GroupBy TaskStatsGroupBy `json:"group_by"`
+
+	// OverdueCount 現在期限を過ぎている未完了タスクの件数（集計期間によらない）
+	OverdueCount int64 `json:"overdue_count"`
+
+	// Periods 期間ごとの作成・完了件数（開始日順、件数0の期間も含む）
+	Periods []TaskStatsPeriod `json:"periods"`
+
+	// Sources 作成元ごとの作成・完了件数
+	Sources []TaskStatsSource `json:"sources"`
+
+	// To 集計期間の終了日時
+	To time.Time `json:"to"`
 }
 
-func (c *Client) UpdateInterpretationItem(ctx context.Context, id openapi_types.UUID, body UpdateInterpretationItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateInterpretationItemRequest(c.Server, id, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TaskStatsGroupBy 期間ごとの集計単位
+type TaskStatsGroupBy string
+
+// TaskStatsPeriod defines model for TaskStatsPeriod.
+type TaskStatsPeriod struct {
+	// CompletedCount 完了したタスクの件数
+	CompletedCount int64 `json:"completed_count"`
+
+	// CreatedCount 作成したタスクの件数
+	CreatedCount int64 `json:"created_count"`
+
+	// Date 期間の開始日（weekの場合は月曜日）
+	Date openapi_types.Date `json:"date"`
 }
 
-func (c *Client) ApproveInterpretationItem(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// TaskStatsSource defines model for TaskStatsSource.
+type TaskStatsSource struct {
+	// CompletedCount 期間内に完了したタスクの件数
+	CompletedCount int64 `json:"completed_count"`
+
+	// CreatedCount 期間内に作成したタスクの件数
+	CreatedCount int64 `json:"created_count"`
+
+	// Source タスクの作成元
+	Source TaskStatsSourceSource `json:"source"`
+}
+
+// TaskStatsSourceSource タスクの作成元
+type TaskStatsSourceSource string
+
+// TaskStatus defines model for TaskStatus.
+type TaskStatus struct {
+	// Category ステータスのカテゴリ（todo は未着手、doing は作業中、done は完了。着手・完了日時の記録や集計に使用）
+	Category TaskStatusCategory `json:"category"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id ステータスID
+	Id openapi_types.UUID `json:"id"`
+
+	// Name ステータス名（タスクのstatusに指定する値。作成後は変更できない）
+	Name string `json:"name"`
+
+	// SortOrder 表示順（昇順）
+	SortOrder int32 `json:"sort_order"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// WipLimit このステータスに同時に置けるタスクの上限（nullは無制限）
+	WipLimit *int32 `json:"wip_limit"`
+}
+
+// TaskStatusCategory ステータスのカテゴリ（todo は未着手、doing は作業中、done は完了。着手・完了日時の記録や集計に使用）
+type TaskStatusCategory string
+
+// TaskTemplate defines model for TaskTemplate.
+type TaskTemplate struct {
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
+
+	// Description テンプレートの説明
+	Description *string `json:"description"`
+
+	// Id テンプレートID
+	Id openapi_types.UUID `json:"id"`
+
+	// Items タスク定義の一覧
+	Items []TaskTemplateItem `json:"items"`
+
+	// Name テンプレート名
+	Name string `json:"name"`
+
+	// ProjectId 作成したタスクを割り当てる既定のプロジェクトID
+	ProjectId *openapi_types.UUID `json:"project_id"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// UserId ユーザーID
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+// TaskTemplateItem テンプレートに含まれるタスクの定義
+type TaskTemplateItem struct {
+	// Description タスクの説明
+	Description *string `json:"description"`
+
+	// DueOffset 展開時の基準日時からの相対期限（w=週・d=日・h=時間・m=分、例 "+3d"、"-1d12h"）。未指定の場合は期限なし
+	DueOffset *string `json:"due_offset"`
+
+	// EstimateMinutes 見積もり工数（分）
+	EstimateMinutes *int32 `json:"estimate_minutes"`
+
+	// Subtasks サブタスクの定義（展開時は親タスクがサブタスクに依存する関係として作成。最大5階層）
+	Subtasks *[]TaskTemplateItem `json:"subtasks,omitempty"`
+
+	// Title タスクのタイトル
+	Title string `json:"title"`
+}
+
+// TaskView defines model for TaskView.
+type TaskView struct {
+	// CreatedAt 作成日時（システムビューでは省略）
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Filter ビューの絞り込み条件（指定した条件はすべて満たし、一覧で指定した値はいずれかに一致するタスク）
+	Filter TaskFilter `json:"filter"`
+
+	// Id ビューID（保存したビューはUUID、システムビューは today・overdue・upcoming・ai-created）
+	Id string `json:"id"`
+
+	// Name ビュー名
+	Name string `json:"name"`
+
+	// System システムビュー（編集・削除できない）かどうか
+	System bool `json:"system"`
+
+	// UpdatedAt 更新日時（システムビューでは省略）
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// UserId ユーザーID（システムビューでは省略）
+	UserId *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// TimeEntry defines model for TimeEntry.
+type TimeEntry struct {
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
+
+	// DurationSeconds 記録時間（秒、計測中は現在までの経過時間）
+	DurationSeconds int64 `json:"duration_seconds"`
+
+	// EndedAt 終了日時（計測中はnull）
+	EndedAt *time.Time `json:"ended_at"`
+
+	// Id 時間記録ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Note メモ
+	Note *string `json:"note"`
+
+	// Running タイマーが計測中か
+	Running bool `json:"running"`
+
+	// StartedAt 開始日時
+	StartedAt time.Time `json:"started_at"`
+
+	// TaskId タスクID
+	TaskId openapi_types.UUID `json:"task_id"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TimeEntryListResponse defines model for TimeEntryListResponse.
+type TimeEntryListResponse struct {
+	// Entries 時間記録（開始日時の新しい順）
+	Entries []TimeEntry `json:"entries"`
+}
+
+// TimeReport defines model for TimeReport.
+type TimeReport struct {
+	// From 集計期間の開始日時
+	From time.Time `json:"from"`
+
+	// GroupBy 集計単位
+	GroupBy TimeReportGroupBy `json:"group_by"`
+
+	// Groups 集計単位ごとの合計（dayは日付順、project・tagは作業時間の多い順）
+	Groups []TimeReportGroup `json:"groups"`
+
+	// To 集計期間の終了日時
+	To time.Time `json:"to"`
+
+	// TotalSeconds 期間内の作業時間の合計（秒。tagでは複数のタグを持つタスクを重複して数えない）
+	TotalSeconds int64 `json:"total_seconds"`
+}
+
+// TimeReportGroupBy 集計単位
+type TimeReportGroupBy string
+
+// TimeReportGroup defines model for TimeReportGroup.
+type TimeReportGroup struct {
+	// EntryCount 集計した時間記録の件数
+	EntryCount int `json:"entry_count"`
+
+	// Key 集計キー（dayの場合は日付YYYY-MM-DD、projectの場合はプロジェクトID、tagの場合はタグ。プロジェクトなし・タグなしは"none"）
+	Key string `json:"key"`
+
+	// Label プロジェクト名またはタグ（project・tagの場合のみ。プロジェクトなし・タグなしはnull）
+	Label *string `json:"label"`
+
+	// TotalSeconds 作業時間の合計（秒）
+	TotalSeconds int64 `json:"total_seconds"`
+}
+
+// UnreadNotificationCountResponse defines model for UnreadNotificationCountResponse.
+type UnreadNotificationCountResponse struct {
+	// Count 未読の通知数
+	Count int64 `json:"count"`
+}
+
+// UpdateItemRequest defines model for UpdateItemRequest.
+type UpdateItemRequest struct {
+	// Data 更新後のアイテム内容（JSON）
+	Data map[string]interface{} `json:"data"`
+}
+
+// UpdateTaskRequest defines model for UpdateTaskRequest.
+type UpdateTaskRequest struct {
+	// Description タスクの説明
+	Description *string `json:"description"`
+
+	// DueAt タスクの期限
+	DueAt *time.Time `json:"due_at"`
+
+	// EstimateMinutes 見積もり工数（分）。0を指定すると見積もりなし
+	EstimateMinutes *int32 `json:"estimate_minutes"`
+
+	// Force ブロック中のタスクでもdoingカテゴリのステータスへの変更を強制する（WIP制限は強制できない）
+	Force *bool `json:"force,omitempty"`
+
+	// Priority タスクの優先度
+	Priority *UpdateTaskRequestPriority `json:"priority"`
+
+	// ProjectId 所属プロジェクトID
+	ProjectId *openapi_types.UUID `json:"project_id"`
+
+	// RecurrenceAnchorAt 繰り返しの起点日時（DTSTART）。省略時は期限または作成日時
+	RecurrenceAnchorAt *time.Time `json:"recurrence_anchor_at"`
+
+	// RecurrenceRule 繰り返しルール（RFC 5545 RRULE、例 FREQ=WEEKLY;BYDAY=FR）
+	RecurrenceRule *string `json:"recurrence_rule"`
+
+	// Status ステータス名（ユーザーが定義したステータスのいずれか）
+	Status string `json:"status"`
+
+	// Title タスクのタイトル
+	Title string `json:"title"`
+}
+
+// UpdateTaskRequestPriority タスクの優先度
+type UpdateTaskRequestPriority string
+
+// User defines model for User.
+type User struct {
+	// Avatar アバター画像URL
+	Avatar *string `json:"avatar"`
+
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id ユーザーID
+	Id openapi_types.UUID `json:"id"`
+
+	// Nickname ニックネーム/表示名
+	Nickname string `json:"nickname"`
+}
+
+// UserSettings defines model for UserSettings.
+type UserSettings struct {
+	// AutoArchiveDays 完了したタスクを自動でアーカイブするまでの日数（完了・最終更新からの経過日数。nullは自動アーカイブしない）
+	AutoArchiveDays *int32 `json:"auto_archive_days"`
+
+	// Timezone タイムゾーン（IANA名、例 Asia/Tokyo。繰り返しタスクの曜日・日付をこのタイムゾーンで判定する。nullはUTC）
+	Timezone *string `json:"timezone"`
+}
+
+// GoogleCallbackJSONBody defines parameters for GoogleCallback.
+type GoogleCallbackJSONBody struct {
+	// Code Google OAuthから返されたauthorization code
+	Code string `json:"code"`
+}
+
+// GetCalendarFeedICSParams defines parameters for GetCalendarFeedICS.
+type GetCalendarFeedICSParams struct {
+	// Type タスクの出力形式（event: VEVENT、todo: VTODO、デフォルト: event）
+	Type *GetCalendarFeedICSParamsType `form:"type,omitempty" json:"type,omitempty"`
+}
+
+// GetCalendarFeedICSParamsType defines parameters for GetCalendarFeedICS.
+type GetCalendarFeedICSParamsType string
+
+// ImportICalMultipartBody defines parameters for ImportICal.
+type ImportICalMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportICalParams defines parameters for ImportICal.
+type ImportICalParams struct {
+	// DryRun trueの場合はタスクを作成せずにプレビューを返す
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// ProjectId 取り込んだタスクを割り当てるプロジェクトID
+	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// Timezone タイムゾーン指定のない日時を解釈するIANAタイムゾーン名（省略時はカレンダーのX-WR-TIMEZONE、なければUTC）
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// ImportMarkdownMultipartBody defines parameters for ImportMarkdown.
+type ImportMarkdownMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportMarkdownParams defines parameters for ImportMarkdown.
+type ImportMarkdownParams struct {
+	// DryRun trueの場合はアイテムを登録せずにプレビューを返す
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// ProjectId 承認時にタスクを割り当てるプロジェクトID
+	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// Timezone タイムゾーン指定のない日時を解釈するIANAタイムゾーン名（省略時はUTC）
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// ImportTodoistMultipartBody defines parameters for ImportTodoist.
+type ImportTodoistMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportTodoistParams defines parameters for ImportTodoist.
+type ImportTodoistParams struct {
+	// DryRun trueの場合はアイテムを登録せずにプレビューを返す
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// ProjectId 承認時にタスクを割り当てるプロジェクトID
+	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// Timezone タイムゾーン指定のない日時を解釈するIANAタイムゾーン名（省略時はUTC）
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// ImportTrelloJSONBody defines parameters for ImportTrello.
+type ImportTrelloJSONBody = map[string]interface{}
+
+// ImportTrelloMultipartBody defines parameters for ImportTrello.
+type ImportTrelloMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportTrelloParams defines parameters for ImportTrello.
+type ImportTrelloParams struct {
+	// DryRun trueの場合はアイテムを登録せずにプレビューを返す
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// ProjectId 承認時にタスクを割り当てるプロジェクトID
+	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+}
+
+// ListInterpretationsParams defines parameters for ListInterpretations.
+type ListInterpretationsParams struct {
+	// Type AI解析のtype絞り込み
+	Type *ListInterpretationsParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Limit 取得件数（デフォルト: 20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset オフセット（ページネーション）
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListInterpretationsParamsType defines parameters for ListInterpretations.
+type ListInterpretationsParamsType string
+
+// CreateInterpretationParams defines parameters for CreateInterpretation.
+type CreateInterpretationParams struct {
+	// IdempotencyKey 再送による重複作成を防ぐための一意なキー（255文字以内）。同じキーの再送には最初のレスポンスを返し、Idempotent-Replayedヘッダーを付与する
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// ImportAccountMultipartBody defines parameters for ImportAccount.
+type ImportAccountMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ListNotificationsParams defines parameters for ListNotifications.
+type ListNotificationsParams struct {
+	// Unread trueの場合は未読の通知のみ取得
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+
+	// Limit 取得件数（デフォルト: 20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスのnext_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetProjectListParams defines parameters for GetProjectList.
+type GetProjectListParams struct {
+	// IncludeArchived アーカイブ済みのプロジェクトも含める
+	IncludeArchived *bool `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+}
+
+// GetTaskStatsParams defines parameters for GetTaskStats.
+type GetTaskStatsParams struct {
+	// From 集計期間の開始日時
+	From time.Time `form:"from" json:"from"`
+
+	// To 集計期間の終了日時（最大366日間）
+	To time.Time `form:"to" json:"to"`
+
+	// GroupBy 期間ごとの集計単位（デフォルトday）
+	GroupBy *GetTaskStatsParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// Timezone 日・週を区切るタイムゾーン（IANA名、デフォルトUTC）
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// GetTaskStatsParamsGroupBy defines parameters for GetTaskStats.
+type GetTaskStatsParamsGroupBy string
+
+// GetTaskFlowReportParams defines parameters for GetTaskFlowReport.
+type GetTaskFlowReportParams struct {
+	// From 集計期間の開始日時
+	From time.Time `form:"from" json:"from"`
+
+	// To 集計期間の終了日時（最大366日間）
+	To time.Time `form:"to" json:"to"`
+
+	// GroupBy 期間ごとの集計単位（デフォルトday）
+	GroupBy *GetTaskFlowReportParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// Timezone 日・週を区切るタイムゾーン（IANA名、デフォルトUTC）
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// GetTaskFlowReportParamsGroupBy defines parameters for GetTaskFlowReport.
+type GetTaskFlowReportParamsGroupBy string
+
+// GetTaskListParams defines parameters for GetTaskList.
+type GetTaskListParams struct {
+	// ProjectId 指定したプロジェクトのタスクのみ取得
+	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// IncludeSnoozed スヌーズ中のタスクも含める
+	IncludeSnoozed *bool `form:"include_snoozed,omitempty" json:"include_snoozed,omitempty"`
+}
+
+// CreateTaskParams defines parameters for CreateTask.
+type CreateTaskParams struct {
+	// IdempotencyKey 再送による重複作成を防ぐための一意なキー（255文字以内）。同じキーの再送には最初のレスポンスを返し、Idempotent-Replayedヘッダーを付与する
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetTaskOccurrencesParams defines parameters for GetTaskOccurrences.
+type GetTaskOccurrencesParams struct {
+	// From 期間の開始日時
+	From time.Time `form:"from" json:"from"`
+
+	// To 期間の終了日時
+	To time.Time `form:"to" json:"to"`
+}
+
+// DeleteTaskParams defines parameters for DeleteTask.
+type DeleteTaskParams struct {
+	// IfMatch 取得時のETag。現在のバージョンと一致しない場合は412を返す（"*"または省略時は確認しない）
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// EditTaskParams defines parameters for EditTask.
+type EditTaskParams struct {
+	// IfMatch 取得時のETag。現在のバージョンと一致しない場合は412を返す（"*"または省略時は確認しない）
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// UpdateTaskParams defines parameters for UpdateTask.
+type UpdateTaskParams struct {
+	// IfMatch 取得時のETag。現在のバージョンと一致しない場合は412を返す（"*"または省略時は確認しない）
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// UploadTaskAttachmentMultipartBody defines parameters for UploadTaskAttachment.
+type UploadTaskAttachmentMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// GetTaskOccurrencesByIDParams defines parameters for GetTaskOccurrencesByID.
+type GetTaskOccurrencesByIDParams struct {
+	// Limit 取得件数（最大100）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetTimeReportParams defines parameters for GetTimeReport.
+type GetTimeReportParams struct {
+	// From 集計期間の開始日時
+	From time.Time `form:"from" json:"from"`
+
+	// To 集計期間の終了日時（最大366日間）
+	To time.Time `form:"to" json:"to"`
+
+	// GroupBy 集計単位
+	GroupBy GetTimeReportParamsGroupBy `form:"group_by" json:"group_by"`
+
+	// Timezone 日ごとの集計で日付を区切るタイムゾーン（IANA名、デフォルトUTC）
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// GetTimeReportParamsGroupBy defines parameters for GetTimeReport.
+type GetTimeReportParamsGroupBy string
+
+// GetTaskViewTasksParams defines parameters for GetTaskViewTasks.
+type GetTaskViewTasksParams struct {
+	// Timezone 相対期限の基準とするIANAタイムゾーン名（省略時はUTC）
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// GoogleCallbackJSONRequestBody defines body for GoogleCallback for application/json ContentType.
+type GoogleCallbackJSONRequestBody GoogleCallbackJSONBody
+
+// ImportICalMultipartRequestBody defines body for ImportICal for multipart/form-data ContentType.
+type ImportICalMultipartRequestBody ImportICalMultipartBody
+
+// ImportMarkdownMultipartRequestBody defines body for ImportMarkdown for multipart/form-data ContentType.
+type ImportMarkdownMultipartRequestBody ImportMarkdownMultipartBody
+
+// ImportTodoistMultipartRequestBody defines body for ImportTodoist for multipart/form-data ContentType.
+type ImportTodoistMultipartRequestBody ImportTodoistMultipartBody
+
+// ImportTrelloJSONRequestBody defines body for ImportTrello for application/json ContentType.
+type ImportTrelloJSONRequestBody = ImportTrelloJSONBody
+
+// ImportTrelloMultipartRequestBody defines body for ImportTrello for multipart/form-data ContentType.
+type ImportTrelloMultipartRequestBody ImportTrelloMultipartBody
+
+// UpdateInterpretationItemJSONRequestBody defines body for UpdateInterpretationItem for application/json ContentType.
+type UpdateInterpretationItemJSONRequestBody = UpdateItemRequest
+
+// CreateInterpretationJSONRequestBody defines body for CreateInterpretation for application/json ContentType.
+type CreateInterpretationJSONRequestBody = CreateInterpretationRequest
+
+// ApproveMultipleInterpretationItemsJSONRequestBody defines body for ApproveMultipleInterpretationItems for application/json ContentType.
+type ApproveMultipleInterpretationItemsJSONRequestBody = ApproveMultipleItemsRequest
+
+// ImportAccountMultipartRequestBody defines body for ImportAccount for multipart/form-data ContentType.
+type ImportAccountMultipartRequestBody ImportAccountMultipartBody
+
+// EditUserSettingsJSONRequestBody defines body for EditUserSettings for application/json ContentType.
+type EditUserSettingsJSONRequestBody = EditUserSettingsRequest
+
+// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
+type CreateProjectJSONRequestBody = CreateProjectRequest
+
+// EditProjectJSONRequestBody defines body for EditProject for application/json ContentType.
+type EditProjectJSONRequestBody = EditProjectRequest
+
+// CreateTaskStatusJSONRequestBody defines body for CreateTaskStatus for application/json ContentType.
+type CreateTaskStatusJSONRequestBody = CreateTaskStatusRequest
+
+// EditTaskStatusJSONRequestBody defines body for EditTaskStatus for application/json ContentType.
+type EditTaskStatusJSONRequestBody = EditTaskStatusRequest
+
+// CreateTaskJSONRequestBody defines body for CreateTask for application/json ContentType.
+type CreateTaskJSONRequestBody = CreateTaskRequest
+
+// BatchTasksJSONRequestBody defines body for BatchTasks for application/json ContentType.
+type BatchTasksJSONRequestBody = BatchTaskRequest
+
+// EditTaskJSONRequestBody defines body for EditTask for application/json ContentType.
+type EditTaskJSONRequestBody = EditTaskRequest
+
+// UpdateTaskJSONRequestBody defines body for UpdateTask for application/json ContentType.
+type UpdateTaskJSONRequestBody = UpdateTaskRequest
+
+// UploadTaskAttachmentMultipartRequestBody defines body for UploadTaskAttachment for multipart/form-data ContentType.
+type UploadTaskAttachmentMultipartRequestBody UploadTaskAttachmentMultipartBody
+
+// AddTaskDependencyJSONRequestBody defines body for AddTaskDependency for application/json ContentType.
+type AddTaskDependencyJSONRequestBody = AddTaskDependencyRequest
+
+// MoveTaskJSONRequestBody defines body for MoveTask for application/json ContentType.
+type MoveTaskJSONRequestBody = MoveTaskRequest
+
+// SaveTaskAsTemplateJSONRequestBody defines body for SaveTaskAsTemplate for application/json ContentType.
+type SaveTaskAsTemplateJSONRequestBody = SaveTaskAsTemplateRequest
+
+// SnoozeTaskJSONRequestBody defines body for SnoozeTask for application/json ContentType.
+type SnoozeTaskJSONRequestBody = SnoozeTaskRequest
+
+// CreateTaskTimeEntryJSONRequestBody defines body for CreateTaskTimeEntry for application/json ContentType.
+type CreateTaskTimeEntryJSONRequestBody = CreateTimeEntryRequest
+
+// StartTaskTimerJSONRequestBody defines body for StartTaskTimer for application/json ContentType.
+type StartTaskTimerJSONRequestBody = StartTimerRequest
+
+// CreateTaskTemplateJSONRequestBody defines body for CreateTaskTemplate for application/json ContentType.
+type CreateTaskTemplateJSONRequestBody = CreateTaskTemplateRequest
+
+// EditTaskTemplateJSONRequestBody defines body for EditTaskTemplate for application/json ContentType.
+type EditTaskTemplateJSONRequestBody = EditTaskTemplateRequest
+
+// InstantiateTaskTemplateJSONRequestBody defines body for InstantiateTaskTemplate for application/json ContentType.
+type InstantiateTaskTemplateJSONRequestBody = InstantiateTaskTemplateRequest
+
+// EditTimeEntryJSONRequestBody defines body for EditTimeEntry for application/json ContentType.
+type EditTimeEntryJSONRequestBody = EditTimeEntryRequest
+
+// CreateTaskViewJSONRequestBody defines body for CreateTaskView for application/json ContentType.
+type CreateTaskViewJSONRequestBody = CreateTaskViewRequest
+
+// EditTaskViewJSONRequestBody defines body for EditTaskView for application/json ContentType.
+type EditTaskViewJSONRequestBody = EditTaskViewRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GoogleCallbackWithBody request with any body
+	GoogleCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GoogleCallback(ctx context.Context, body GoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeCalendarFeed request
+	RevokeCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendarFeed request
+	GetCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateCalendarFeedToken request
+	RotateCalendarFeedToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHealth request
+	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendarFeedICS request
+	GetCalendarFeedICS(ctx context.Context, token string, params *GetCalendarFeedICSParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportICalWithBody request with any body
+	ImportICalWithBody(ctx context.Context, params *ImportICalParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportMarkdownWithBody request with any body
+	ImportMarkdownWithBody(ctx context.Context, params *ImportMarkdownParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportTodoistWithBody request with any body
+	ImportTodoistWithBody(ctx context.Context, params *ImportTodoistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportTrelloWithBody request with any body
+	ImportTrelloWithBody(ctx context.Context, params *ImportTrelloParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportTrello(ctx context.Context, params *ImportTrelloParams, body ImportTrelloJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInterpretationItem request
+	GetInterpretationItem(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateInterpretationItemWithBody request with any body
+	UpdateInterpretationItemWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateInterpretationItem(ctx context.Context, id openapi_types.UUID, body UpdateInterpretationItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApproveInterpretationItem request
+	ApproveInterpretationItem(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInterpretations request
+	ListInterpretations(ctx context.Context, params *ListInterpretationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInterpretationWithBody request with any body
+	CreateInterpretationWithBody(ctx context.Context, params *CreateInterpretationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInterpretation(ctx context.Context, params *CreateInterpretationParams, body CreateInterpretationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInterpretation request
+	GetInterpretation(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApproveMultipleInterpretationItemsWithBody request with any body
+	ApproveMultipleInterpretationItemsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApproveMultipleInterpretationItems(ctx context.Context, id openapi_types.UUID, body ApproveMultipleInterpretationItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInterpretationItems request
+	GetInterpretationItems(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportAccount request
+	ExportAccount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportAccountWithBody request with any body
+	ImportAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserSettings request
+	GetUserSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditUserSettingsWithBody request with any body
+	EditUserSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditUserSettings(ctx context.Context, body EditUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListNotifications request
+	ListNotifications(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MarkAllNotificationsRead request
+	MarkAllNotificationsRead(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUnreadNotificationCount request
+	GetUnreadNotificationCount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteNotification request
+	DeleteNotification(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MarkNotificationRead request
+	MarkNotificationRead(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectList request
+	GetProjectList(ctx context.Context, params *GetProjectListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectWithBody request with any body
+	CreateProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProject(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProject request
+	DeleteProject(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProject request
+	GetProject(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditProjectWithBody request with any body
+	EditProjectWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditProject(ctx context.Context, id openapi_types.UUID, body EditProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskStats request
+	GetTaskStats(ctx context.Context, params *GetTaskStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskFlowReport request
+	GetTaskFlowReport(ctx context.Context, params *GetTaskFlowReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskStatusList request
+	GetTaskStatusList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTaskStatusWithBody request with any body
+	CreateTaskStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTaskStatus(ctx context.Context, body CreateTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTaskStatus request
+	DeleteTaskStatus(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditTaskStatusWithBody request with any body
+	EditTaskStatusWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditTaskStatus(ctx context.Context, id openapi_types.UUID, body EditTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskList request
+	GetTaskList(ctx context.Context, params *GetTaskListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTaskWithBody request with any body
+	CreateTaskWithBody(ctx context.Context, params *CreateTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTask(ctx context.Context, params *CreateTaskParams, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetArchivedTasks request
+	GetArchivedTasks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BatchTasksWithBody request with any body
+	BatchTasksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BatchTasks(ctx context.Context, body BatchTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskOccurrences request
+	GetTaskOccurrences(ctx context.Context, params *GetTaskOccurrencesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskTrash request
+	GetTaskTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTask request
+	DeleteTask(ctx context.Context, id openapi_types.UUID, params *DeleteTaskParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTask request
+	GetTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditTaskWithBody request with any body
+	EditTaskWithBody(ctx context.Context, id openapi_types.UUID, params *EditTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditTask(ctx context.Context, id openapi_types.UUID, params *EditTaskParams, body EditTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTaskWithBody request with any body
+	UpdateTaskWithBody(ctx context.Context, id openapi_types.UUID, params *UpdateTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTask(ctx context.Context, id openapi_types.UUID, params *UpdateTaskParams, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskAttachments request
+	GetTaskAttachments(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadTaskAttachmentWithBody request with any body
+	UploadTaskAttachmentWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTaskAttachment request
+	DeleteTaskAttachment(ctx context.Context, id openapi_types.UUID, attachmentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadTaskAttachment request
+	DownloadTaskAttachment(ctx context.Context, id openapi_types.UUID, attachmentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskDependencies request
+	GetTaskDependencies(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddTaskDependencyWithBody request with any body
+	AddTaskDependencyWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddTaskDependency(ctx context.Context, id openapi_types.UUID, body AddTaskDependencyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveTaskDependency request
+	RemoveTaskDependency(ctx context.Context, id openapi_types.UUID, dependsOnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskHistoryByID request
+	GetTaskHistoryByID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MoveTaskWithBody request with any body
+	MoveTaskWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MoveTask(ctx context.Context, id openapi_types.UUID, body MoveTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskOccurrencesByID request
+	GetTaskOccurrencesByID(ctx context.Context, id openapi_types.UUID, params *GetTaskOccurrencesByIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreTask request
+	RestoreTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SaveTaskAsTemplateWithBody request with any body
+	SaveTaskAsTemplateWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SaveTaskAsTemplate(ctx context.Context, id openapi_types.UUID, body SaveTaskAsTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnsnoozeTask request
+	UnsnoozeTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SnoozeTaskWithBody request with any body
+	SnoozeTaskWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SnoozeTask(ctx context.Context, id openapi_types.UUID, body SnoozeTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskTimeEntries request
+	GetTaskTimeEntries(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTaskTimeEntryWithBody request with any body
+	CreateTaskTimeEntryWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTaskTimeEntry(ctx context.Context, id openapi_types.UUID, body CreateTaskTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartTaskTimerWithBody request with any body
+	StartTaskTimerWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StartTaskTimer(ctx context.Context, id openapi_types.UUID, body StartTaskTimerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StopTaskTimer request
+	StopTaskTimer(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnarchiveTask request
+	UnarchiveTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskTemplateList request
+	GetTaskTemplateList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTaskTemplateWithBody request with any body
+	CreateTaskTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTaskTemplate(ctx context.Context, body CreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTaskTemplate request
+	DeleteTaskTemplate(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskTemplate request
+	GetTaskTemplate(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditTaskTemplateWithBody request with any body
+	EditTaskTemplateWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditTaskTemplate(ctx context.Context, id openapi_types.UUID, body EditTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InstantiateTaskTemplateWithBody request with any body
+	InstantiateTaskTemplateWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InstantiateTaskTemplate(ctx context.Context, id openapi_types.UUID, body InstantiateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEstimateReport request
+	GetEstimateReport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTimeReport request
+	GetTimeReport(ctx context.Context, params *GetTimeReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRunningTimer request
+	GetRunningTimer(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTimeEntry request
+	DeleteTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditTimeEntryWithBody request with any body
+	EditTimeEntryWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditTimeEntry(ctx context.Context, id openapi_types.UUID, body EditTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskViewList request
+	GetTaskViewList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTaskViewWithBody request with any body
+	CreateTaskViewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTaskView(ctx context.Context, body CreateTaskViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTaskView request
+	DeleteTaskView(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskView request
+	GetTaskView(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditTaskViewWithBody request with any body
+	EditTaskViewWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditTaskView(ctx context.Context, id string, body EditTaskViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTaskViewTasks request
+	GetTaskViewTasks(ctx context.Context, id string, params *GetTaskViewTasksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GoogleCallbackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGoogleCallbackRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GoogleCallback(ctx context.Context, body GoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGoogleCallbackRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeCalendarFeedRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCalendarFeed(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarFeedRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RotateCalendarFeedToken(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateCalendarFeedTokenRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCalendarFeedICS(ctx context.Context, token string, params *GetCalendarFeedICSParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarFeedICSRequest(c.Server, token, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportICalWithBody(ctx context.Context, params *ImportICalParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportICalRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportMarkdownWithBody(ctx context.Context, params *ImportMarkdownParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportMarkdownRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportTodoistWithBody(ctx context.Context, params *ImportTodoistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportTodoistRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportTrelloWithBody(ctx context.Context, params *ImportTrelloParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportTrelloRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportTrello(ctx context.Context, params *ImportTrelloParams, body ImportTrelloJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportTrelloRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInterpretationItem(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInterpretationItemRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateInterpretationItemWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInterpretationItemRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateInterpretationItem(ctx context.Context, id openapi_types.UUID, body UpdateInterpretationItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInterpretationItemRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveInterpretationItem(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewApproveInterpretationItemRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInterpretations(ctx context.Context, params *ListInterpretationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInterpretationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInterpretationWithBody(ctx context.Context, params *CreateInterpretationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInterpretationRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInterpretation(ctx context.Context, params *CreateInterpretationParams, body CreateInterpretationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInterpretationRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInterpretation(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInterpretationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveMultipleInterpretationItemsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveMultipleInterpretationItemsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApproveMultipleInterpretationItems(ctx context.Context, id openapi_types.UUID, body ApproveMultipleInterpretationItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveMultipleInterpretationItemsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInterpretationItems(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInterpretationItemsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportAccount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportAccountRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportAccountRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditUserSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditUserSettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditUserSettings(ctx context.Context, body EditUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditUserSettingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListNotifications(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNotificationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MarkAllNotificationsRead(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMarkAllNotificationsReadRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUnreadNotificationCount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUnreadNotificationCountRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteNotification(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteNotificationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MarkNotificationRead(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMarkNotificationReadRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProjectList(ctx context.Context, params *GetProjectListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProject(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteProject(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProject(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditProjectWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditProjectRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditProject(ctx context.Context, id openapi_types.UUID, body EditProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditProjectRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskStats(ctx context.Context, params *GetTaskStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskFlowReport(ctx context.Context, params *GetTaskFlowReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskFlowReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskStatusList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskStatusListRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTaskStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskStatusRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTaskStatus(ctx context.Context, body CreateTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskStatusRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTaskStatus(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTaskStatusRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTaskStatusWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTaskStatusRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTaskStatus(ctx context.Context, id openapi_types.UUID, body EditTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTaskStatusRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskList(ctx context.Context, params *GetTaskListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTaskWithBody(ctx context.Context, params *CreateTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTask(ctx context.Context, params *CreateTaskParams, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetArchivedTasks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetArchivedTasksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BatchTasksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchTasksRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BatchTasks(ctx context.Context, body BatchTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchTasksRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskOccurrences(ctx context.Context, params *GetTaskOccurrencesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskOccurrencesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskTrashRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTask(ctx context.Context, id openapi_types.UUID, params *DeleteTaskParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTaskRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTaskWithBody(ctx context.Context, id openapi_types.UUID, params *EditTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTaskRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTask(ctx context.Context, id openapi_types.UUID, params *EditTaskParams, body EditTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTaskRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTaskWithBody(ctx context.Context, id openapi_types.UUID, params *UpdateTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTaskRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTask(ctx context.Context, id openapi_types.UUID, params *UpdateTaskParams, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTaskRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskAttachments(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskAttachmentsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UploadTaskAttachmentWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadTaskAttachmentRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTaskAttachment(ctx context.Context, id openapi_types.UUID, attachmentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTaskAttachmentRequest(c.Server, id, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DownloadTaskAttachment(ctx context.Context, id openapi_types.UUID, attachmentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadTaskAttachmentRequest(c.Server, id, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskDependencies(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskDependenciesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddTaskDependencyWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTaskDependencyRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddTaskDependency(ctx context.Context, id openapi_types.UUID, body AddTaskDependencyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTaskDependencyRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveTaskDependency(ctx context.Context, id openapi_types.UUID, dependsOnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTaskDependencyRequest(c.Server, id, dependsOnId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskHistoryByID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskHistoryByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveTaskWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveTaskRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveTask(ctx context.Context, id openapi_types.UUID, body MoveTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveTaskRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskOccurrencesByID(ctx context.Context, id openapi_types.UUID, params *GetTaskOccurrencesByIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskOccurrencesByIDRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreTaskRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SaveTaskAsTemplateWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveTaskAsTemplateRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SaveTaskAsTemplate(ctx context.Context, id openapi_types.UUID, body SaveTaskAsTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveTaskAsTemplateRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnsnoozeTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnsnoozeTaskRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SnoozeTaskWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnoozeTaskRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SnoozeTask(ctx context.Context, id openapi_types.UUID, body SnoozeTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnoozeTaskRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskTimeEntries(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskTimeEntriesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTaskTimeEntryWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskTimeEntryRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTaskTimeEntry(ctx context.Context, id openapi_types.UUID, body CreateTaskTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskTimeEntryRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartTaskTimerWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartTaskTimerRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartTaskTimer(ctx context.Context, id openapi_types.UUID, body StartTaskTimerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartTaskTimerRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StopTaskTimer(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStopTaskTimerRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnarchiveTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnarchiveTaskRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskTemplateList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskTemplateListRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTaskTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskTemplateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTaskTemplate(ctx context.Context, body CreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskTemplateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTaskTemplate(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTaskTemplateRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskTemplate(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskTemplateRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTaskTemplateWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTaskTemplateRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTaskTemplate(ctx context.Context, id openapi_types.UUID, body EditTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTaskTemplateRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InstantiateTaskTemplateWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInstantiateTaskTemplateRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InstantiateTaskTemplate(ctx context.Context, id openapi_types.UUID, body InstantiateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInstantiateTaskTemplateRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEstimateReport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEstimateReportRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTimeReport(ctx context.Context, params *GetTimeReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTimeReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRunningTimer(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRunningTimerRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTimeEntryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTimeEntryWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTimeEntryRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTimeEntry(ctx context.Context, id openapi_types.UUID, body EditTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTimeEntryRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskViewList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskViewListRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTaskViewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskViewRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTaskView(ctx context.Context, body CreateTaskViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTaskViewRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTaskView(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTaskViewRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskView(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskViewRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTaskViewWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTaskViewRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTaskView(ctx context.Context, id string, body EditTaskViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTaskViewRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTaskViewTasks(ctx context.Context, id string, params *GetTaskViewTasksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskViewTasksRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGoogleCallbackRequest calls the generic GoogleCallback builder with application/json body
+func NewGoogleCallbackRequest(server string, body GoogleCallbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGoogleCallbackRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGoogleCallbackRequestWithBody generates requests for GoogleCallback with any type of body
+func NewGoogleCallbackRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/google/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeCalendarFeedRequest generates requests for RevokeCalendarFeed
+func NewRevokeCalendarFeedRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendar-feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarFeedRequest generates requests for GetCalendarFeed
+func NewGetCalendarFeedRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendar-feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRotateCalendarFeedTokenRequest generates requests for RotateCalendarFeedToken
+func NewRotateCalendarFeedTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendar-feed/rotate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarFeedICSRequest generates requests for GetCalendarFeedICS
+func NewGetCalendarFeedICSRequest(server string, token string, params *GetCalendarFeedICSParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ical/%s.ics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportICalRequestWithBody generates requests for ImportICal with any type of body
+func NewImportICalRequestWithBody(server string, params *ImportICalParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/import/ical")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Timezone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportMarkdownRequestWithBody generates requests for ImportMarkdown with any type of body
+func NewImportMarkdownRequestWithBody(server string, params *ImportMarkdownParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/import/markdown")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Timezone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportTodoistRequestWithBody generates requests for ImportTodoist with any type of body
+func NewImportTodoistRequestWithBody(server string, params *ImportTodoistParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/import/todoist")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Timezone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportTrelloRequest calls the generic ImportTrello builder with application/json body
+func NewImportTrelloRequest(server string, params *ImportTrelloParams, body ImportTrelloJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportTrelloRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewImportTrelloRequestWithBody generates requests for ImportTrello with any type of body
+func NewImportTrelloRequestWithBody(server string, params *ImportTrelloParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/import/trello")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInterpretationItemRequest generates requests for GetInterpretationItem
+func NewGetInterpretationItemRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interpretation-items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateInterpretationItemRequest calls the generic UpdateInterpretationItem builder with application/json body
+func NewUpdateInterpretationItemRequest(server string, id openapi_types.UUID, body UpdateInterpretationItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateInterpretationItemRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateInterpretationItemRequestWithBody generates requests for UpdateInterpretationItem with any type of body
+func NewUpdateInterpretationItemRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interpretation-items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApproveInterpretationItemRequest generates requests for ApproveInterpretationItem
+func NewApproveInterpretationItemRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interpretation-items/%s/approve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInterpretationsRequest generates requests for ListInterpretations
+func NewListInterpretationsRequest(server string, params *ListInterpretationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interpretations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInterpretationRequest calls the generic CreateInterpretation builder with application/json body
+func NewCreateInterpretationRequest(server string, params *CreateInterpretationParams, body CreateInterpretationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInterpretationRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateInterpretationRequestWithBody generates requests for CreateInterpretation with any type of body
+func NewCreateInterpretationRequestWithBody(server string, params *CreateInterpretationParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interpretations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetInterpretationRequest generates requests for GetInterpretation
+func NewGetInterpretationRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interpretations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApproveMultipleInterpretationItemsRequest calls the generic ApproveMultipleInterpretationItems builder with application/json body
+func NewApproveMultipleInterpretationItemsRequest(server string, id openapi_types.UUID, body ApproveMultipleInterpretationItemsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApproveMultipleInterpretationItemsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewApproveMultipleInterpretationItemsRequestWithBody generates requests for ApproveMultipleInterpretationItems with any type of body
+func NewApproveMultipleInterpretationItemsRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interpretations/%s/approve-items", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInterpretationItemsRequest generates requests for GetInterpretationItems
+func NewGetInterpretationItemsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/interpretations/%s/items", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportAccountRequest generates requests for ExportAccount
+func NewExportAccountRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportAccountRequestWithBody generates requests for ImportAccount with any type of body
+func NewImportAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserSettingsRequest generates requests for GetUserSettings
+func NewGetUserSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEditUserSettingsRequest calls the generic EditUserSettings builder with application/json body
+func NewEditUserSettingsRequest(server string, body EditUserSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditUserSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewEditUserSettingsRequestWithBody generates requests for EditUserSettings with any type of body
+func NewEditUserSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListNotificationsRequest generates requests for ListNotifications
+func NewListNotificationsRequest(server string, params *ListNotificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Unread != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unread", runtime.ParamLocationQuery, *params.Unread); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMarkAllNotificationsReadRequest generates requests for MarkAllNotificationsRead
+func NewMarkAllNotificationsReadRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/read-all")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUnreadNotificationCountRequest generates requests for GetUnreadNotificationCount
+func NewGetUnreadNotificationCountRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/unread-count")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteNotificationRequest generates requests for DeleteNotification
+func NewDeleteNotificationRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMarkNotificationReadRequest generates requests for MarkNotificationRead
+func NewMarkNotificationReadRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectListRequest generates requests for GetProjectList
+func NewGetProjectListRequest(server string, params *GetProjectListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeArchived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_archived", runtime.ParamLocationQuery, *params.IncludeArchived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
+func NewCreateProjectRequest(server string, body CreateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateProjectRequestWithBody generates requests for CreateProject with any type of body
+func NewCreateProjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectRequest generates requests for DeleteProject
+func NewDeleteProjectRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectRequest generates requests for GetProject
+func NewGetProjectRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEditProjectRequest calls the generic EditProject builder with application/json body
+func NewEditProjectRequest(server string, id openapi_types.UUID, body EditProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditProjectRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewEditProjectRequestWithBody generates requests for EditProject with any type of body
+func NewEditProjectRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTaskStatsRequest generates requests for GetTaskStats
+func NewGetTaskStatsRequest(server string, params *GetTaskStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_by", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Timezone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTaskFlowReportRequest generates requests for GetTaskFlowReport
+func NewGetTaskFlowReportRequest(server string, params *GetTaskFlowReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/flow")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_by", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Timezone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTaskStatusListRequest generates requests for GetTaskStatusList
+func NewGetTaskStatusListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTaskStatusRequest calls the generic CreateTaskStatus builder with application/json body
+func NewCreateTaskStatusRequest(server string, body CreateTaskStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTaskStatusRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTaskStatusRequestWithBody generates requests for CreateTaskStatus with any type of body
+func NewCreateTaskStatusRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTaskStatusRequest generates requests for DeleteTaskStatus
+func NewDeleteTaskStatusRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEditTaskStatusRequest calls the generic EditTaskStatus builder with application/json body
+func NewEditTaskStatusRequest(server string, id openapi_types.UUID, body EditTaskStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditTaskStatusRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewEditTaskStatusRequestWithBody generates requests for EditTaskStatus with any type of body
+func NewEditTaskStatusRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statuses/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTaskListRequest generates requests for GetTaskList
+func NewGetTaskListRequest(server string, params *GetTaskListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeSnoozed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_snoozed", runtime.ParamLocationQuery, *params.IncludeSnoozed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTaskRequest calls the generic CreateTask builder with application/json body
+func NewCreateTaskRequest(server string, params *CreateTaskParams, body CreateTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTaskRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateTaskRequestWithBody generates requests for CreateTask with any type of body
+func NewCreateTaskRequestWithBody(server string, params *CreateTaskParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetArchivedTasksRequest generates requests for GetArchivedTasks
+func NewGetArchivedTasksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/archived")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBatchTasksRequest calls the generic BatchTasks builder with application/json body
+func NewBatchTasksRequest(server string, body BatchTasksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBatchTasksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewBatchTasksRequestWithBody generates requests for BatchTasks with any type of body
+func NewBatchTasksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTaskOccurrencesRequest generates requests for GetTaskOccurrences
+func NewGetTaskOccurrencesRequest(server string, params *GetTaskOccurrencesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/occurrences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTaskTrashRequest generates requests for GetTaskTrash
+func NewGetTaskTrashRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/trash")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteTaskRequest generates requests for DeleteTask
+func NewDeleteTaskRequest(server string, id openapi_types.UUID, params *DeleteTaskParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTaskRequest generates requests for GetTask
+func NewGetTaskRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEditTaskRequest calls the generic EditTask builder with application/json body
+func NewEditTaskRequest(server string, id openapi_types.UUID, params *EditTaskParams, body EditTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditTaskRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewEditTaskRequestWithBody generates requests for EditTask with any type of body
+func NewEditTaskRequestWithBody(server string, id openapi_types.UUID, params *EditTaskParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateTaskRequest calls the generic UpdateTask builder with application/json body
+func NewUpdateTaskRequest(server string, id openapi_types.UUID, params *UpdateTaskParams, body UpdateTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTaskRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateTaskRequestWithBody generates requests for UpdateTask with any type of body
+func NewUpdateTaskRequestWithBody(server string, id openapi_types.UUID, params *UpdateTaskParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTaskAttachmentsRequest generates requests for GetTaskAttachments
+func NewGetTaskAttachmentsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/attachments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadTaskAttachmentRequestWithBody generates requests for UploadTaskAttachment with any type of body
+func NewUploadTaskAttachmentRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/attachments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTaskAttachmentRequest generates requests for DeleteTaskAttachment
+func NewDeleteTaskAttachmentRequest(server string, id openapi_types.UUID, attachmentId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "attachment_id", runtime.ParamLocationPath, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/attachments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDownloadTaskAttachmentRequest generates requests for DownloadTaskAttachment
+func NewDownloadTaskAttachmentRequest(server string, id openapi_types.UUID, attachmentId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "attachment_id", runtime.ParamLocationPath, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/attachments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTaskDependenciesRequest generates requests for GetTaskDependencies
+func NewGetTaskDependenciesRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/dependencies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddTaskDependencyRequest calls the generic AddTaskDependency builder with application/json body
+func NewAddTaskDependencyRequest(server string, id openapi_types.UUID, body AddTaskDependencyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTaskDependencyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddTaskDependencyRequestWithBody generates requests for AddTaskDependency with any type of body
+func NewAddTaskDependencyRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/dependencies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveTaskDependencyRequest generates requests for RemoveTaskDependency
+func NewRemoveTaskDependencyRequest(server string, id openapi_types.UUID, dependsOnId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depends_on_id", runtime.ParamLocationPath, dependsOnId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/dependencies/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTaskHistoryByIDRequest generates requests for GetTaskHistoryByID
+func NewGetTaskHistoryByIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMoveTaskRequest calls the generic MoveTask builder with application/json body
+func NewMoveTaskRequest(server string, id openapi_types.UUID, body MoveTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveTaskRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewMoveTaskRequestWithBody generates requests for MoveTask with any type of body
+func NewMoveTaskRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/move", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTaskOccurrencesByIDRequest generates requests for GetTaskOccurrencesByID
+func NewGetTaskOccurrencesByIDRequest(server string, id openapi_types.UUID, params *GetTaskOccurrencesByIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/occurrences", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreTaskRequest generates requests for RestoreTask
+func NewRestoreTaskRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSaveTaskAsTemplateRequest calls the generic SaveTaskAsTemplate builder with application/json body
+func NewSaveTaskAsTemplateRequest(server string, id openapi_types.UUID, body SaveTaskAsTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSaveTaskAsTemplateRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSaveTaskAsTemplateRequestWithBody generates requests for SaveTaskAsTemplate with any type of body
+func NewSaveTaskAsTemplateRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/save-as-template", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUnsnoozeTaskRequest generates requests for UnsnoozeTask
+func NewUnsnoozeTaskRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/snooze", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSnoozeTaskRequest calls the generic SnoozeTask builder with application/json body
+func NewSnoozeTaskRequest(server string, id openapi_types.UUID, body SnoozeTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSnoozeTaskRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSnoozeTaskRequestWithBody generates requests for SnoozeTask with any type of body
+func NewSnoozeTaskRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/snooze", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTaskTimeEntriesRequest generates requests for GetTaskTimeEntries
+func NewGetTaskTimeEntriesRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/time-entries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTaskTimeEntryRequest calls the generic CreateTaskTimeEntry builder with application/json body
+func NewCreateTaskTimeEntryRequest(server string, id openapi_types.UUID, body CreateTaskTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTaskTimeEntryRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateTaskTimeEntryRequestWithBody generates requests for CreateTaskTimeEntry with any type of body
+func NewCreateTaskTimeEntryRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/time-entries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStartTaskTimerRequest calls the generic StartTaskTimer builder with application/json body
+func NewStartTaskTimerRequest(server string, id openapi_types.UUID, body StartTaskTimerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStartTaskTimerRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewStartTaskTimerRequestWithBody generates requests for StartTaskTimer with any type of body
+func NewStartTaskTimerRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/timer/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStopTaskTimerRequest generates requests for StopTaskTimer
+func NewStopTaskTimerRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/timer/stop", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnarchiveTaskRequest generates requests for UnarchiveTask
+func NewUnarchiveTaskRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/%s/unarchive", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTaskTemplateListRequest generates requests for GetTaskTemplateList
+func NewGetTaskTemplateListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTaskTemplateRequest calls the generic CreateTaskTemplate builder with application/json body
+func NewCreateTaskTemplateRequest(server string, body CreateTaskTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTaskTemplateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTaskTemplateRequestWithBody generates requests for CreateTaskTemplate with any type of body
+func NewCreateTaskTemplateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTaskTemplateRequest generates requests for DeleteTaskTemplate
+func NewDeleteTaskTemplateRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTaskTemplateRequest generates requests for GetTaskTemplate
+func NewGetTaskTemplateRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEditTaskTemplateRequest calls the generic EditTaskTemplate builder with application/json body
+func NewEditTaskTemplateRequest(server string, id openapi_types.UUID, body EditTaskTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditTaskTemplateRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewEditTaskTemplateRequestWithBody generates requests for EditTaskTemplate with any type of body
+func NewEditTaskTemplateRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewInstantiateTaskTemplateRequest calls the generic InstantiateTaskTemplate builder with application/json body
+func NewInstantiateTaskTemplateRequest(server string, id openapi_types.UUID, body InstantiateTaskTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInstantiateTaskTemplateRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewInstantiateTaskTemplateRequestWithBody generates requests for InstantiateTaskTemplate with any type of body
+func NewInstantiateTaskTemplateRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates/%s/instantiate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetEstimateReportRequest generates requests for GetEstimateReport
+func NewGetEstimateReportRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/time-entries/estimate-report")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTimeReportRequest generates requests for GetTimeReport
+func NewGetTimeReportRequest(server string, params *GetTimeReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/time-entries/report")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_by", runtime.ParamLocationQuery, params.GroupBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Timezone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRunningTimerRequest generates requests for GetRunningTimer
+func NewGetRunningTimerRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/time-entries/running")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteTimeEntryRequest generates requests for DeleteTimeEntry
+func NewDeleteTimeEntryRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/time-entries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEditTimeEntryRequest calls the generic EditTimeEntry builder with application/json body
+func NewEditTimeEntryRequest(server string, id openapi_types.UUID, body EditTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditTimeEntryRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewEditTimeEntryRequestWithBody generates requests for EditTimeEntry with any type of body
+func NewEditTimeEntryRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/time-entries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTaskViewListRequest generates requests for GetTaskViewList
+func NewGetTaskViewListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/views")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTaskViewRequest calls the generic CreateTaskView builder with application/json body
+func NewCreateTaskViewRequest(server string, body CreateTaskViewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTaskViewRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTaskViewRequestWithBody generates requests for CreateTaskView with any type of body
+func NewCreateTaskViewRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/views")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTaskViewRequest generates requests for DeleteTaskView
+func NewDeleteTaskViewRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/views/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTaskViewRequest generates requests for GetTaskView
+func NewGetTaskViewRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/views/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEditTaskViewRequest calls the generic EditTaskView builder with application/json body
+func NewEditTaskViewRequest(server string, id string, body EditTaskViewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditTaskViewRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewEditTaskViewRequestWithBody generates requests for EditTaskView with any type of body
+func NewEditTaskViewRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/views/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTaskViewTasksRequest generates requests for GetTaskViewTasks
+func NewGetTaskViewTasksRequest(server string, id string, params *GetTaskViewTasksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/views/%s/tasks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Timezone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GoogleCallbackWithBodyWithResponse request with any body
+	GoogleCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GoogleCallbackResponse, error)
+
+	GoogleCallbackWithResponse(ctx context.Context, body GoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*GoogleCallbackResponse, error)
+
+	// RevokeCalendarFeedWithResponse request
+	RevokeCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RevokeCalendarFeedResponse, error)
+
+	// GetCalendarFeedWithResponse request
+	GetCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCalendarFeedResponse, error)
+
+	// RotateCalendarFeedTokenWithResponse request
+	RotateCalendarFeedTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RotateCalendarFeedTokenResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// GetCalendarFeedICSWithResponse request
+	GetCalendarFeedICSWithResponse(ctx context.Context, token string, params *GetCalendarFeedICSParams, reqEditors ...RequestEditorFn) (*GetCalendarFeedICSResponse, error)
+
+	// ImportICalWithBodyWithResponse request with any body
+	ImportICalWithBodyWithResponse(ctx context.Context, params *ImportICalParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportICalResponse, error)
+
+	// ImportMarkdownWithBodyWithResponse request with any body
+	ImportMarkdownWithBodyWithResponse(ctx context.Context, params *ImportMarkdownParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportMarkdownResponse, error)
+
+	// ImportTodoistWithBodyWithResponse request with any body
+	ImportTodoistWithBodyWithResponse(ctx context.Context, params *ImportTodoistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTodoistResponse, error)
+
+	// ImportTrelloWithBodyWithResponse request with any body
+	ImportTrelloWithBodyWithResponse(ctx context.Context, params *ImportTrelloParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTrelloResponse, error)
+
+	ImportTrelloWithResponse(ctx context.Context, params *ImportTrelloParams, body ImportTrelloJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportTrelloResponse, error)
+
+	// GetInterpretationItemWithResponse request
+	GetInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationItemResponse, error)
+
+	// UpdateInterpretationItemWithBodyWithResponse request with any body
+	UpdateInterpretationItemWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInterpretationItemResponse, error)
+
+	UpdateInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateInterpretationItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInterpretationItemResponse, error)
+
+	// ApproveInterpretationItemWithResponse request
+	ApproveInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApproveInterpretationItemResponse, error)
+
+	// ListInterpretationsWithResponse request
+	ListInterpretationsWithResponse(ctx context.Context, params *ListInterpretationsParams, reqEditors ...RequestEditorFn) (*ListInterpretationsResponse, error)
+
+	// CreateInterpretationWithBodyWithResponse request with any body
+	CreateInterpretationWithBodyWithResponse(ctx context.Context, params *CreateInterpretationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInterpretationResponse, error)
+
+	CreateInterpretationWithResponse(ctx context.Context, params *CreateInterpretationParams, body CreateInterpretationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInterpretationResponse, error)
+
+	// GetInterpretationWithResponse request
+	GetInterpretationWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationResponse, error)
+
+	// ApproveMultipleInterpretationItemsWithBodyWithResponse request with any body
+	ApproveMultipleInterpretationItemsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveMultipleInterpretationItemsResponse, error)
+
+	ApproveMultipleInterpretationItemsWithResponse(ctx context.Context, id openapi_types.UUID, body ApproveMultipleInterpretationItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveMultipleInterpretationItemsResponse, error)
+
+	// GetInterpretationItemsWithResponse request
+	GetInterpretationItemsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationItemsResponse, error)
+
+	// ExportAccountWithResponse request
+	ExportAccountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportAccountResponse, error)
+
+	// ImportAccountWithBodyWithResponse request with any body
+	ImportAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportAccountResponse, error)
+
+	// GetUserSettingsWithResponse request
+	GetUserSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserSettingsResponse, error)
+
+	// EditUserSettingsWithBodyWithResponse request with any body
+	EditUserSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditUserSettingsResponse, error)
+
+	EditUserSettingsWithResponse(ctx context.Context, body EditUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*EditUserSettingsResponse, error)
+
+	// ListNotificationsWithResponse request
+	ListNotificationsWithResponse(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*ListNotificationsResponse, error)
+
+	// MarkAllNotificationsReadWithResponse request
+	MarkAllNotificationsReadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MarkAllNotificationsReadResponse, error)
+
+	// GetUnreadNotificationCountWithResponse request
+	GetUnreadNotificationCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUnreadNotificationCountResponse, error)
+
+	// DeleteNotificationWithResponse request
+	DeleteNotificationWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteNotificationResponse, error)
+
+	// MarkNotificationReadWithResponse request
+	MarkNotificationReadWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*MarkNotificationReadResponse, error)
+
+	// GetProjectListWithResponse request
+	GetProjectListWithResponse(ctx context.Context, params *GetProjectListParams, reqEditors ...RequestEditorFn) (*GetProjectListResponse, error)
+
+	// CreateProjectWithBodyWithResponse request with any body
+	CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	// DeleteProjectWithResponse request
+	DeleteProjectWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error)
+
+	// GetProjectWithResponse request
+	GetProjectWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+
+	// EditProjectWithBodyWithResponse request with any body
+	EditProjectWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditProjectResponse, error)
+
+	EditProjectWithResponse(ctx context.Context, id openapi_types.UUID, body EditProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*EditProjectResponse, error)
+
+	// GetTaskStatsWithResponse request
+	GetTaskStatsWithResponse(ctx context.Context, params *GetTaskStatsParams, reqEditors ...RequestEditorFn) (*GetTaskStatsResponse, error)
+
+	// GetTaskFlowReportWithResponse request
+	GetTaskFlowReportWithResponse(ctx context.Context, params *GetTaskFlowReportParams, reqEditors ...RequestEditorFn) (*GetTaskFlowReportResponse, error)
+
+	// GetTaskStatusListWithResponse request
+	GetTaskStatusListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaskStatusListResponse, error)
+
+	// CreateTaskStatusWithBodyWithResponse request with any body
+	CreateTaskStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskStatusResponse, error)
+
+	CreateTaskStatusWithResponse(ctx context.Context, body CreateTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskStatusResponse, error)
+
+	// DeleteTaskStatusWithResponse request
+	DeleteTaskStatusWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTaskStatusResponse, error)
+
+	// EditTaskStatusWithBodyWithResponse request with any body
+	EditTaskStatusWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTaskStatusResponse, error)
+
+	EditTaskStatusWithResponse(ctx context.Context, id openapi_types.UUID, body EditTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTaskStatusResponse, error)
+
+	// GetTaskListWithResponse request
+	GetTaskListWithResponse(ctx context.Context, params *GetTaskListParams, reqEditors ...RequestEditorFn) (*GetTaskListResponse, error)
+
+	// CreateTaskWithBodyWithResponse request with any body
+	CreateTaskWithBodyWithResponse(ctx context.Context, params *CreateTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error)
+
+	CreateTaskWithResponse(ctx context.Context, params *CreateTaskParams, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error)
+
+	// GetArchivedTasksWithResponse request
+	GetArchivedTasksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetArchivedTasksResponse, error)
+
+	// BatchTasksWithBodyWithResponse request with any body
+	BatchTasksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchTasksResponse, error)
+
+	BatchTasksWithResponse(ctx context.Context, body BatchTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchTasksResponse, error)
+
+	// GetTaskOccurrencesWithResponse request
+	GetTaskOccurrencesWithResponse(ctx context.Context, params *GetTaskOccurrencesParams, reqEditors ...RequestEditorFn) (*GetTaskOccurrencesResponse, error)
+
+	// GetTaskTrashWithResponse request
+	GetTaskTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaskTrashResponse, error)
+
+	// DeleteTaskWithResponse request
+	DeleteTaskWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteTaskParams, reqEditors ...RequestEditorFn) (*DeleteTaskResponse, error)
+
+	// GetTaskWithResponse request
+	GetTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskResponse, error)
+
+	// EditTaskWithBodyWithResponse request with any body
+	EditTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *EditTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTaskResponse, error)
+
+	EditTaskWithResponse(ctx context.Context, id openapi_types.UUID, params *EditTaskParams, body EditTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTaskResponse, error)
+
+	// UpdateTaskWithBodyWithResponse request with any body
+	UpdateTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *UpdateTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error)
+
+	UpdateTaskWithResponse(ctx context.Context, id openapi_types.UUID, params *UpdateTaskParams, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error)
+
+	// GetTaskAttachmentsWithResponse request
+	GetTaskAttachmentsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskAttachmentsResponse, error)
+
+	// UploadTaskAttachmentWithBodyWithResponse request with any body
+	UploadTaskAttachmentWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadTaskAttachmentResponse, error)
+
+	// DeleteTaskAttachmentWithResponse request
+	DeleteTaskAttachmentWithResponse(ctx context.Context, id openapi_types.UUID, attachmentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTaskAttachmentResponse, error)
+
+	// DownloadTaskAttachmentWithResponse request
+	DownloadTaskAttachmentWithResponse(ctx context.Context, id openapi_types.UUID, attachmentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DownloadTaskAttachmentResponse, error)
+
+	// GetTaskDependenciesWithResponse request
+	GetTaskDependenciesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskDependenciesResponse, error)
+
+	// AddTaskDependencyWithBodyWithResponse request with any body
+	AddTaskDependencyWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTaskDependencyResponse, error)
+
+	AddTaskDependencyWithResponse(ctx context.Context, id openapi_types.UUID, body AddTaskDependencyJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTaskDependencyResponse, error)
+
+	// RemoveTaskDependencyWithResponse request
+	RemoveTaskDependencyWithResponse(ctx context.Context, id openapi_types.UUID, dependsOnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RemoveTaskDependencyResponse, error)
+
+	// GetTaskHistoryByIDWithResponse request
+	GetTaskHistoryByIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskHistoryByIDResponse, error)
+
+	// MoveTaskWithBodyWithResponse request with any body
+	MoveTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveTaskResponse, error)
+
+	MoveTaskWithResponse(ctx context.Context, id openapi_types.UUID, body MoveTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveTaskResponse, error)
+
+	// GetTaskOccurrencesByIDWithResponse request
+	GetTaskOccurrencesByIDWithResponse(ctx context.Context, id openapi_types.UUID, params *GetTaskOccurrencesByIDParams, reqEditors ...RequestEditorFn) (*GetTaskOccurrencesByIDResponse, error)
+
+	// RestoreTaskWithResponse request
+	RestoreTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreTaskResponse, error)
+
+	// SaveTaskAsTemplateWithBodyWithResponse request with any body
+	SaveTaskAsTemplateWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveTaskAsTemplateResponse, error)
+
+	SaveTaskAsTemplateWithResponse(ctx context.Context, id openapi_types.UUID, body SaveTaskAsTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveTaskAsTemplateResponse, error)
+
+	// UnsnoozeTaskWithResponse request
+	UnsnoozeTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*UnsnoozeTaskResponse, error)
+
+	// SnoozeTaskWithBodyWithResponse request with any body
+	SnoozeTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnoozeTaskResponse, error)
+
+	SnoozeTaskWithResponse(ctx context.Context, id openapi_types.UUID, body SnoozeTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*SnoozeTaskResponse, error)
+
+	// GetTaskTimeEntriesWithResponse request
+	GetTaskTimeEntriesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskTimeEntriesResponse, error)
+
+	// CreateTaskTimeEntryWithBodyWithResponse request with any body
+	CreateTaskTimeEntryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskTimeEntryResponse, error)
+
+	CreateTaskTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, body CreateTaskTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskTimeEntryResponse, error)
+
+	// StartTaskTimerWithBodyWithResponse request with any body
+	StartTaskTimerWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartTaskTimerResponse, error)
+
+	StartTaskTimerWithResponse(ctx context.Context, id openapi_types.UUID, body StartTaskTimerJSONRequestBody, reqEditors ...RequestEditorFn) (*StartTaskTimerResponse, error)
+
+	// StopTaskTimerWithResponse request
+	StopTaskTimerWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*StopTaskTimerResponse, error)
+
+	// UnarchiveTaskWithResponse request
+	UnarchiveTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*UnarchiveTaskResponse, error)
+
+	// GetTaskTemplateListWithResponse request
+	GetTaskTemplateListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaskTemplateListResponse, error)
+
+	// CreateTaskTemplateWithBodyWithResponse request with any body
+	CreateTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskTemplateResponse, error)
+
+	CreateTaskTemplateWithResponse(ctx context.Context, body CreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskTemplateResponse, error)
+
+	// DeleteTaskTemplateWithResponse request
+	DeleteTaskTemplateWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTaskTemplateResponse, error)
+
+	// GetTaskTemplateWithResponse request
+	GetTaskTemplateWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskTemplateResponse, error)
+
+	// EditTaskTemplateWithBodyWithResponse request with any body
+	EditTaskTemplateWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTaskTemplateResponse, error)
+
+	EditTaskTemplateWithResponse(ctx context.Context, id openapi_types.UUID, body EditTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTaskTemplateResponse, error)
+
+	// InstantiateTaskTemplateWithBodyWithResponse request with any body
+	InstantiateTaskTemplateWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InstantiateTaskTemplateResponse, error)
+
+	InstantiateTaskTemplateWithResponse(ctx context.Context, id openapi_types.UUID, body InstantiateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*InstantiateTaskTemplateResponse, error)
+
+	// GetEstimateReportWithResponse request
+	GetEstimateReportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEstimateReportResponse, error)
+
+	// GetTimeReportWithResponse request
+	GetTimeReportWithResponse(ctx context.Context, params *GetTimeReportParams, reqEditors ...RequestEditorFn) (*GetTimeReportResponse, error)
+
+	// GetRunningTimerWithResponse request
+	GetRunningTimerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRunningTimerResponse, error)
+
+	// DeleteTimeEntryWithResponse request
+	DeleteTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTimeEntryResponse, error)
+
+	// EditTimeEntryWithBodyWithResponse request with any body
+	EditTimeEntryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTimeEntryResponse, error)
+
+	EditTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, body EditTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTimeEntryResponse, error)
+
+	// GetTaskViewListWithResponse request
+	GetTaskViewListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaskViewListResponse, error)
+
+	// CreateTaskViewWithBodyWithResponse request with any body
+	CreateTaskViewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskViewResponse, error)
+
+	CreateTaskViewWithResponse(ctx context.Context, body CreateTaskViewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskViewResponse, error)
+
+	// DeleteTaskViewWithResponse request
+	DeleteTaskViewWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteTaskViewResponse, error)
+
+	// GetTaskViewWithResponse request
+	GetTaskViewWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetTaskViewResponse, error)
+
+	// EditTaskViewWithBodyWithResponse request with any body
+	EditTaskViewWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTaskViewResponse, error)
+
+	EditTaskViewWithResponse(ctx context.Context, id string, body EditTaskViewJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTaskViewResponse, error)
+
+	// GetTaskViewTasksWithResponse request
+	GetTaskViewTasksWithResponse(ctx context.Context, id string, params *GetTaskViewTasksParams, reqEditors ...RequestEditorFn) (*GetTaskViewTasksResponse, error)
+}
+
+type GoogleCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GoogleCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GoogleCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeCalendarFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeCalendarFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeCalendarFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarFeedStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RotateCalendarFeedTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarFeedToken
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateCalendarFeedTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateCalendarFeedTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarFeedICSResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarFeedICSResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarFeedICSResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportICalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportResult
+	JSON400      *ErrorResponse
+	JSON413      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportICalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportICalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportMarkdownResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportResult
+	JSON400      *ErrorResponse
+	JSON413      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportMarkdownResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportMarkdownResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportTodoistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportResult
+	JSON400      *ErrorResponse
+	JSON413      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportTodoistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportTodoistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportTrelloResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportResult
+	JSON400      *ErrorResponse
+	JSON413      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportTrelloResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportTrelloResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInterpretationItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InterpretationItem
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInterpretationItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInterpretationItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateInterpretationItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InterpretationItem
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateInterpretationItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateInterpretationItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveInterpretationItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApproveItemResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveInterpretationItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveInterpretationItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInterpretationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Interpretations []AIInterpretation `json:"interpretations"`
+		Limit           *int               `json:"limit,omitempty"`
+		Offset          *int               `json:"offset,omitempty"`
+		Total           int                `json:"total"`
+	}
+	JSON401 *ErrorResponse
+	JSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInterpretationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInterpretationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInterpretationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InterpretationResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON422      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInterpretationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInterpretationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInterpretationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AIInterpretation
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInterpretationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInterpretationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveMultipleInterpretationItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApproveMultipleItemsResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveMultipleInterpretationItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveMultipleInterpretationItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInterpretationItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InterpretationItemsResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInterpretationItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInterpretationItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccountImportResult
+	JSON400      *ErrorResponse
+	JSON413      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditUserSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSettings
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EditUserSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditUserSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationListResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MarkAllNotificationsReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReadAllNotificationsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MarkAllNotificationsReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MarkAllNotificationsReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUnreadNotificationCountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UnreadNotificationCountResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUnreadNotificationCountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUnreadNotificationCountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteNotificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteNotificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteNotificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MarkNotificationReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Notification
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MarkNotificationReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MarkNotificationReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Project
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Project
+	JSON400      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EditProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskStats
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskFlowReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskFlowReport
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskFlowReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskFlowReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskStatusListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TaskStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskStatusListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskStatusListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTaskStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TaskStatus
+	JSON400      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTaskStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTaskStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTaskStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTaskStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTaskStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditTaskStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskStatus
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EditTaskStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditTaskStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Task
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Task
+	JSON400      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON422      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetArchivedTasksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Task
+}
+
+// Status returns HTTPResponse.Status
+func (r GetArchivedTasksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetArchivedTasksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchTasksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchTaskResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchTasksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchTasksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskOccurrencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskOccurrencesResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskOccurrencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskOccurrencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskTrashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Task
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskTrashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskTrashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON412      *Task
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON412      *Task
+}
+
+// Status returns HTTPResponse.Status
+func (r EditTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON412      *Task
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskAttachmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskAttachmentListResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskAttachmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskAttachmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadTaskAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TaskAttachment
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON413      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadTaskAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadTaskAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTaskAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTaskAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTaskAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadTaskAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadTaskAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadTaskAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskDependenciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskDependencies
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskDependenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskDependenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddTaskDependencyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TaskDependencies
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTaskDependencyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTaskDependencyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveTaskDependencyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveTaskDependencyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveTaskDependencyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskHistoryByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskHistoryResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskHistoryByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskHistoryByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MoveTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskOccurrencesByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskOccurrencesResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskOccurrencesByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskOccurrencesByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SaveTaskAsTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TaskTemplate
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SaveTaskAsTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SaveTaskAsTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnsnoozeTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnsnoozeTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnsnoozeTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnoozeTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SnoozeTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnoozeTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskTimeEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntryListResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskTimeEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskTimeEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTaskTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TimeEntry
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTaskTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTaskTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartTaskTimerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TimeEntry
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StartTaskTimerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartTaskTimerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StopTaskTimerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StopTaskTimerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StopTaskTimerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnarchiveTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnarchiveTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnarchiveTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskTemplateListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TaskTemplate
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskTemplateListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskTemplateListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTaskTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TaskTemplate
+	JSON400      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTaskTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTaskTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTaskTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTaskTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTaskTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskTemplate
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditTaskTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskTemplate
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EditTaskTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditTaskTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InstantiateTaskTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *[]Task
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r InstantiateTaskTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InstantiateTaskTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEstimateReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EstimateReport
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEstimateReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEstimateReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTimeReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeReport
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTimeReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTimeReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRunningTimerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunningTimerResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRunningTimerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRunningTimerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditTimeEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EditTimeEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditTimeEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskViewListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TaskView
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskViewListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskViewListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTaskViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TaskView
+	JSON400      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTaskViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTaskViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTaskViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTaskViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTaskViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskView
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditTaskViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskView
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EditTaskViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditTaskViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTaskViewTasksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Task
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTaskViewTasksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTaskViewTasksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GoogleCallbackWithBodyWithResponse request with arbitrary body returning *GoogleCallbackResponse
+func (c *ClientWithResponses) GoogleCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GoogleCallbackResponse, error) {
+	rsp, err := c.GoogleCallbackWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGoogleCallbackResponse(rsp)
+}
+
+func (c *ClientWithResponses) GoogleCallbackWithResponse(ctx context.Context, body GoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*GoogleCallbackResponse, error) {
+	rsp, err := c.GoogleCallback(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGoogleCallbackResponse(rsp)
+}
+
+// RevokeCalendarFeedWithResponse request returning *RevokeCalendarFeedResponse
+func (c *ClientWithResponses) RevokeCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RevokeCalendarFeedResponse, error) {
+	rsp, err := c.RevokeCalendarFeed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeCalendarFeedResponse(rsp)
+}
+
+// GetCalendarFeedWithResponse request returning *GetCalendarFeedResponse
+func (c *ClientWithResponses) GetCalendarFeedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCalendarFeedResponse, error) {
+	rsp, err := c.GetCalendarFeed(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarFeedResponse(rsp)
+}
+
+// RotateCalendarFeedTokenWithResponse request returning *RotateCalendarFeedTokenResponse
+func (c *ClientWithResponses) RotateCalendarFeedTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RotateCalendarFeedTokenResponse, error) {
+	rsp, err := c.RotateCalendarFeedToken(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateCalendarFeedTokenResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// GetCalendarFeedICSWithResponse request returning *GetCalendarFeedICSResponse
+func (c *ClientWithResponses) GetCalendarFeedICSWithResponse(ctx context.Context, token string, params *GetCalendarFeedICSParams, reqEditors ...RequestEditorFn) (*GetCalendarFeedICSResponse, error) {
+	rsp, err := c.GetCalendarFeedICS(ctx, token, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarFeedICSResponse(rsp)
+}
+
+// ImportICalWithBodyWithResponse request with arbitrary body returning *ImportICalResponse
+func (c *ClientWithResponses) ImportICalWithBodyWithResponse(ctx context.Context, params *ImportICalParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportICalResponse, error) {
+	rsp, err := c.ImportICalWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportICalResponse(rsp)
+}
+
+// ImportMarkdownWithBodyWithResponse request with arbitrary body returning *ImportMarkdownResponse
+func (c *ClientWithResponses) ImportMarkdownWithBodyWithResponse(ctx context.Context, params *ImportMarkdownParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportMarkdownResponse, error) {
+	rsp, err := c.ImportMarkdownWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportMarkdownResponse(rsp)
+}
+
+// ImportTodoistWithBodyWithResponse request with arbitrary body returning *ImportTodoistResponse
+func (c *ClientWithResponses) ImportTodoistWithBodyWithResponse(ctx context.Context, params *ImportTodoistParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTodoistResponse, error) {
+	rsp, err := c.ImportTodoistWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportTodoistResponse(rsp)
+}
+
+// ImportTrelloWithBodyWithResponse request with arbitrary body returning *ImportTrelloResponse
+func (c *ClientWithResponses) ImportTrelloWithBodyWithResponse(ctx context.Context, params *ImportTrelloParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTrelloResponse, error) {
+	rsp, err := c.ImportTrelloWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportTrelloResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportTrelloWithResponse(ctx context.Context, params *ImportTrelloParams, body ImportTrelloJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportTrelloResponse, error) {
+	rsp, err := c.ImportTrello(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportTrelloResponse(rsp)
+}
+
+// GetInterpretationItemWithResponse request returning *GetInterpretationItemResponse
+func (c *ClientWithResponses) GetInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationItemResponse, error) {
+	rsp, err := c.GetInterpretationItem(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInterpretationItemResponse(rsp)
+}
+
+// UpdateInterpretationItemWithBodyWithResponse request with arbitrary body returning *UpdateInterpretationItemResponse
+func (c *ClientWithResponses) UpdateInterpretationItemWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInterpretationItemResponse, error) {
+	rsp, err := c.UpdateInterpretationItemWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInterpretationItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateInterpretationItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInterpretationItemResponse, error) {
+	rsp, err := c.UpdateInterpretationItem(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInterpretationItemResponse(rsp)
+}
+
+// ApproveInterpretationItemWithResponse request returning *ApproveInterpretationItemResponse
+func (c *ClientWithResponses) ApproveInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApproveInterpretationItemResponse, error) {
+	rsp, err := c.ApproveInterpretationItem(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveInterpretationItemResponse(rsp)
+}
+
+// ListInterpretationsWithResponse request returning *ListInterpretationsResponse
+func (c *ClientWithResponses) ListInterpretationsWithResponse(ctx context.Context, params *ListInterpretationsParams, reqEditors ...RequestEditorFn) (*ListInterpretationsResponse, error) {
+	rsp, err := c.ListInterpretations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInterpretationsResponse(rsp)
+}
+
+// CreateInterpretationWithBodyWithResponse request with arbitrary body returning *CreateInterpretationResponse
+func (c *ClientWithResponses) CreateInterpretationWithBodyWithResponse(ctx context.Context, params *CreateInterpretationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInterpretationResponse, error) {
+	rsp, err := c.CreateInterpretationWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInterpretationResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInterpretationWithResponse(ctx context.Context, params *CreateInterpretationParams, body CreateInterpretationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInterpretationResponse, error) {
+	rsp, err := c.CreateInterpretation(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInterpretationResponse(rsp)
+}
+
+// GetInterpretationWithResponse request returning *GetInterpretationResponse
+func (c *ClientWithResponses) GetInterpretationWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationResponse, error) {
+	rsp, err := c.GetInterpretation(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInterpretationResponse(rsp)
+}
+
+// ApproveMultipleInterpretationItemsWithBodyWithResponse request with arbitrary body returning *ApproveMultipleInterpretationItemsResponse
+func (c *ClientWithResponses) ApproveMultipleInterpretationItemsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveMultipleInterpretationItemsResponse, error) {
+	rsp, err := c.ApproveMultipleInterpretationItemsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveMultipleInterpretationItemsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApproveMultipleInterpretationItemsWithResponse(ctx context.Context, id openapi_types.UUID, body ApproveMultipleInterpretationItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveMultipleInterpretationItemsResponse, error) {
+	rsp, err := c.ApproveMultipleInterpretationItems(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveMultipleInterpretationItemsResponse(rsp)
+}
+
+// GetInterpretationItemsWithResponse request returning *GetInterpretationItemsResponse
+func (c *ClientWithResponses) GetInterpretationItemsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationItemsResponse, error) {
+	rsp, err := c.GetInterpretationItems(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInterpretationItemsResponse(rsp)
+}
+
+// ExportAccountWithResponse request returning *ExportAccountResponse
+func (c *ClientWithResponses) ExportAccountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportAccountResponse, error) {
+	rsp, err := c.ExportAccount(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportAccountResponse(rsp)
+}
+
+// ImportAccountWithBodyWithResponse request with arbitrary body returning *ImportAccountResponse
+func (c *ClientWithResponses) ImportAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportAccountResponse, error) {
+	rsp, err := c.ImportAccountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportAccountResponse(rsp)
+}
+
+// GetUserSettingsWithResponse request returning *GetUserSettingsResponse
+func (c *ClientWithResponses) GetUserSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserSettingsResponse, error) {
+	rsp, err := c.GetUserSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserSettingsResponse(rsp)
+}
+
+// EditUserSettingsWithBodyWithResponse request with arbitrary body returning *EditUserSettingsResponse
+func (c *ClientWithResponses) EditUserSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditUserSettingsResponse, error) {
+	rsp, err := c.EditUserSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditUserSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditUserSettingsWithResponse(ctx context.Context, body EditUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*EditUserSettingsResponse, error) {
+	rsp, err := c.EditUserSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditUserSettingsResponse(rsp)
+}
+
+// ListNotificationsWithResponse request returning *ListNotificationsResponse
+func (c *ClientWithResponses) ListNotificationsWithResponse(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*ListNotificationsResponse, error) {
+	rsp, err := c.ListNotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNotificationsResponse(rsp)
+}
+
+// MarkAllNotificationsReadWithResponse request returning *MarkAllNotificationsReadResponse
+func (c *ClientWithResponses) MarkAllNotificationsReadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MarkAllNotificationsReadResponse, error) {
+	rsp, err := c.MarkAllNotificationsRead(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMarkAllNotificationsReadResponse(rsp)
+}
+
+// GetUnreadNotificationCountWithResponse request returning *GetUnreadNotificationCountResponse
+func (c *ClientWithResponses) GetUnreadNotificationCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUnreadNotificationCountResponse, error) {
+	rsp, err := c.GetUnreadNotificationCount(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUnreadNotificationCountResponse(rsp)
+}
+
+// DeleteNotificationWithResponse request returning *DeleteNotificationResponse
+func (c *ClientWithResponses) DeleteNotificationWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteNotificationResponse, error) {
+	rsp, err := c.DeleteNotification(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteNotificationResponse(rsp)
+}
+
+// MarkNotificationReadWithResponse request returning *MarkNotificationReadResponse
+func (c *ClientWithResponses) MarkNotificationReadWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*MarkNotificationReadResponse, error) {
+	rsp, err := c.MarkNotificationRead(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMarkNotificationReadResponse(rsp)
+}
+
+// GetProjectListWithResponse request returning *GetProjectListResponse
+func (c *ClientWithResponses) GetProjectListWithResponse(ctx context.Context, params *GetProjectListParams, reqEditors ...RequestEditorFn) (*GetProjectListResponse, error) {
+	rsp, err := c.GetProjectList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectListResponse(rsp)
+}
+
+// CreateProjectWithBodyWithResponse request with arbitrary body returning *CreateProjectResponse
+func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProjectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProject(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+// DeleteProjectWithResponse request returning *DeleteProjectResponse
+func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error) {
+	rsp, err := c.DeleteProject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectResponse(rsp)
+}
+
+// GetProjectWithResponse request returning *GetProjectResponse
+func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
+	rsp, err := c.GetProject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectResponse(rsp)
+}
+
+// EditProjectWithBodyWithResponse request with arbitrary body returning *EditProjectResponse
+func (c *ClientWithResponses) EditProjectWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditProjectResponse, error) {
+	rsp, err := c.EditProjectWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditProjectWithResponse(ctx context.Context, id openapi_types.UUID, body EditProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*EditProjectResponse, error) {
+	rsp, err := c.EditProject(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditProjectResponse(rsp)
+}
+
+// GetTaskStatsWithResponse request returning *GetTaskStatsResponse
+func (c *ClientWithResponses) GetTaskStatsWithResponse(ctx context.Context, params *GetTaskStatsParams, reqEditors ...RequestEditorFn) (*GetTaskStatsResponse, error) {
+	rsp, err := c.GetTaskStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskStatsResponse(rsp)
+}
+
+// GetTaskFlowReportWithResponse request returning *GetTaskFlowReportResponse
+func (c *ClientWithResponses) GetTaskFlowReportWithResponse(ctx context.Context, params *GetTaskFlowReportParams, reqEditors ...RequestEditorFn) (*GetTaskFlowReportResponse, error) {
+	rsp, err := c.GetTaskFlowReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskFlowReportResponse(rsp)
+}
+
+// GetTaskStatusListWithResponse request returning *GetTaskStatusListResponse
+func (c *ClientWithResponses) GetTaskStatusListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaskStatusListResponse, error) {
+	rsp, err := c.GetTaskStatusList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskStatusListResponse(rsp)
+}
+
+// CreateTaskStatusWithBodyWithResponse request with arbitrary body returning *CreateTaskStatusResponse
+func (c *ClientWithResponses) CreateTaskStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskStatusResponse, error) {
+	rsp, err := c.CreateTaskStatusWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTaskStatusWithResponse(ctx context.Context, body CreateTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskStatusResponse, error) {
+	rsp, err := c.CreateTaskStatus(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskStatusResponse(rsp)
+}
+
+// DeleteTaskStatusWithResponse request returning *DeleteTaskStatusResponse
+func (c *ClientWithResponses) DeleteTaskStatusWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTaskStatusResponse, error) {
+	rsp, err := c.DeleteTaskStatus(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTaskStatusResponse(rsp)
+}
+
+// EditTaskStatusWithBodyWithResponse request with arbitrary body returning *EditTaskStatusResponse
+func (c *ClientWithResponses) EditTaskStatusWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTaskStatusResponse, error) {
+	rsp, err := c.EditTaskStatusWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTaskStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditTaskStatusWithResponse(ctx context.Context, id openapi_types.UUID, body EditTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTaskStatusResponse, error) {
+	rsp, err := c.EditTaskStatus(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTaskStatusResponse(rsp)
+}
+
+// GetTaskListWithResponse request returning *GetTaskListResponse
+func (c *ClientWithResponses) GetTaskListWithResponse(ctx context.Context, params *GetTaskListParams, reqEditors ...RequestEditorFn) (*GetTaskListResponse, error) {
+	rsp, err := c.GetTaskList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskListResponse(rsp)
+}
+
+// CreateTaskWithBodyWithResponse request with arbitrary body returning *CreateTaskResponse
+func (c *ClientWithResponses) CreateTaskWithBodyWithResponse(ctx context.Context, params *CreateTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error) {
+	rsp, err := c.CreateTaskWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTaskWithResponse(ctx context.Context, params *CreateTaskParams, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error) {
+	rsp, err := c.CreateTask(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskResponse(rsp)
+}
+
+// GetArchivedTasksWithResponse request returning *GetArchivedTasksResponse
+func (c *ClientWithResponses) GetArchivedTasksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetArchivedTasksResponse, error) {
+	rsp, err := c.GetArchivedTasks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetArchivedTasksResponse(rsp)
+}
+
+// BatchTasksWithBodyWithResponse request with arbitrary body returning *BatchTasksResponse
+func (c *ClientWithResponses) BatchTasksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchTasksResponse, error) {
+	rsp, err := c.BatchTasksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchTasksResponse(rsp)
+}
+
+func (c *ClientWithResponses) BatchTasksWithResponse(ctx context.Context, body BatchTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchTasksResponse, error) {
+	rsp, err := c.BatchTasks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchTasksResponse(rsp)
+}
+
+// GetTaskOccurrencesWithResponse request returning *GetTaskOccurrencesResponse
+func (c *ClientWithResponses) GetTaskOccurrencesWithResponse(ctx context.Context, params *GetTaskOccurrencesParams, reqEditors ...RequestEditorFn) (*GetTaskOccurrencesResponse, error) {
+	rsp, err := c.GetTaskOccurrences(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskOccurrencesResponse(rsp)
+}
+
+// GetTaskTrashWithResponse request returning *GetTaskTrashResponse
+func (c *ClientWithResponses) GetTaskTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaskTrashResponse, error) {
+	rsp, err := c.GetTaskTrash(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskTrashResponse(rsp)
+}
+
+// DeleteTaskWithResponse request returning *DeleteTaskResponse
+func (c *ClientWithResponses) DeleteTaskWithResponse(ctx context.Context, id openapi_types.UUID, params *DeleteTaskParams, reqEditors ...RequestEditorFn) (*DeleteTaskResponse, error) {
+	rsp, err := c.DeleteTask(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTaskResponse(rsp)
+}
+
+// GetTaskWithResponse request returning *GetTaskResponse
+func (c *ClientWithResponses) GetTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskResponse, error) {
+	rsp, err := c.GetTask(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskResponse(rsp)
+}
+
+// EditTaskWithBodyWithResponse request with arbitrary body returning *EditTaskResponse
+func (c *ClientWithResponses) EditTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *EditTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTaskResponse, error) {
+	rsp, err := c.EditTaskWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditTaskWithResponse(ctx context.Context, id openapi_types.UUID, params *EditTaskParams, body EditTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTaskResponse, error) {
+	rsp, err := c.EditTask(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTaskResponse(rsp)
+}
+
+// UpdateTaskWithBodyWithResponse request with arbitrary body returning *UpdateTaskResponse
+func (c *ClientWithResponses) UpdateTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, params *UpdateTaskParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error) {
+	rsp, err := c.UpdateTaskWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTaskWithResponse(ctx context.Context, id openapi_types.UUID, params *UpdateTaskParams, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error) {
+	rsp, err := c.UpdateTask(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTaskResponse(rsp)
+}
+
+// GetTaskAttachmentsWithResponse request returning *GetTaskAttachmentsResponse
+func (c *ClientWithResponses) GetTaskAttachmentsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskAttachmentsResponse, error) {
+	rsp, err := c.GetTaskAttachments(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskAttachmentsResponse(rsp)
+}
+
+// UploadTaskAttachmentWithBodyWithResponse request with arbitrary body returning *UploadTaskAttachmentResponse
+func (c *ClientWithResponses) UploadTaskAttachmentWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadTaskAttachmentResponse, error) {
+	rsp, err := c.UploadTaskAttachmentWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadTaskAttachmentResponse(rsp)
+}
+
+// DeleteTaskAttachmentWithResponse request returning *DeleteTaskAttachmentResponse
+func (c *ClientWithResponses) DeleteTaskAttachmentWithResponse(ctx context.Context, id openapi_types.UUID, attachmentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTaskAttachmentResponse, error) {
+	rsp, err := c.DeleteTaskAttachment(ctx, id, attachmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTaskAttachmentResponse(rsp)
+}
+
+// DownloadTaskAttachmentWithResponse request returning *DownloadTaskAttachmentResponse
+func (c *ClientWithResponses) DownloadTaskAttachmentWithResponse(ctx context.Context, id openapi_types.UUID, attachmentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DownloadTaskAttachmentResponse, error) {
+	rsp, err := c.DownloadTaskAttachment(ctx, id, attachmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadTaskAttachmentResponse(rsp)
+}
+
+// GetTaskDependenciesWithResponse request returning *GetTaskDependenciesResponse
+func (c *ClientWithResponses) GetTaskDependenciesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskDependenciesResponse, error) {
+	rsp, err := c.GetTaskDependencies(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskDependenciesResponse(rsp)
+}
+
+// AddTaskDependencyWithBodyWithResponse request with arbitrary body returning *AddTaskDependencyResponse
+func (c *ClientWithResponses) AddTaskDependencyWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTaskDependencyResponse, error) {
+	rsp, err := c.AddTaskDependencyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTaskDependencyResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddTaskDependencyWithResponse(ctx context.Context, id openapi_types.UUID, body AddTaskDependencyJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTaskDependencyResponse, error) {
+	rsp, err := c.AddTaskDependency(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTaskDependencyResponse(rsp)
+}
+
+// RemoveTaskDependencyWithResponse request returning *RemoveTaskDependencyResponse
+func (c *ClientWithResponses) RemoveTaskDependencyWithResponse(ctx context.Context, id openapi_types.UUID, dependsOnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RemoveTaskDependencyResponse, error) {
+	rsp, err := c.RemoveTaskDependency(ctx, id, dependsOnId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTaskDependencyResponse(rsp)
+}
+
+// GetTaskHistoryByIDWithResponse request returning *GetTaskHistoryByIDResponse
+func (c *ClientWithResponses) GetTaskHistoryByIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskHistoryByIDResponse, error) {
+	rsp, err := c.GetTaskHistoryByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskHistoryByIDResponse(rsp)
+}
+
+// MoveTaskWithBodyWithResponse request with arbitrary body returning *MoveTaskResponse
+func (c *ClientWithResponses) MoveTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveTaskResponse, error) {
+	rsp, err := c.MoveTaskWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) MoveTaskWithResponse(ctx context.Context, id openapi_types.UUID, body MoveTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveTaskResponse, error) {
+	rsp, err := c.MoveTask(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveTaskResponse(rsp)
+}
+
+// GetTaskOccurrencesByIDWithResponse request returning *GetTaskOccurrencesByIDResponse
+func (c *ClientWithResponses) GetTaskOccurrencesByIDWithResponse(ctx context.Context, id openapi_types.UUID, params *GetTaskOccurrencesByIDParams, reqEditors ...RequestEditorFn) (*GetTaskOccurrencesByIDResponse, error) {
+	rsp, err := c.GetTaskOccurrencesByID(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskOccurrencesByIDResponse(rsp)
+}
+
+// RestoreTaskWithResponse request returning *RestoreTaskResponse
+func (c *ClientWithResponses) RestoreTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreTaskResponse, error) {
+	rsp, err := c.RestoreTask(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreTaskResponse(rsp)
+}
+
+// SaveTaskAsTemplateWithBodyWithResponse request with arbitrary body returning *SaveTaskAsTemplateResponse
+func (c *ClientWithResponses) SaveTaskAsTemplateWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveTaskAsTemplateResponse, error) {
+	rsp, err := c.SaveTaskAsTemplateWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveTaskAsTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) SaveTaskAsTemplateWithResponse(ctx context.Context, id openapi_types.UUID, body SaveTaskAsTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveTaskAsTemplateResponse, error) {
+	rsp, err := c.SaveTaskAsTemplate(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveTaskAsTemplateResponse(rsp)
+}
+
+// UnsnoozeTaskWithResponse request returning *UnsnoozeTaskResponse
+func (c *ClientWithResponses) UnsnoozeTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*UnsnoozeTaskResponse, error) {
+	rsp, err := c.UnsnoozeTask(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnsnoozeTaskResponse(rsp)
+}
+
+// SnoozeTaskWithBodyWithResponse request with arbitrary body returning *SnoozeTaskResponse
+func (c *ClientWithResponses) SnoozeTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnoozeTaskResponse, error) {
+	rsp, err := c.SnoozeTaskWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnoozeTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) SnoozeTaskWithResponse(ctx context.Context, id openapi_types.UUID, body SnoozeTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*SnoozeTaskResponse, error) {
+	rsp, err := c.SnoozeTask(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnoozeTaskResponse(rsp)
+}
+
+// GetTaskTimeEntriesWithResponse request returning *GetTaskTimeEntriesResponse
+func (c *ClientWithResponses) GetTaskTimeEntriesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskTimeEntriesResponse, error) {
+	rsp, err := c.GetTaskTimeEntries(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskTimeEntriesResponse(rsp)
+}
+
+// CreateTaskTimeEntryWithBodyWithResponse request with arbitrary body returning *CreateTaskTimeEntryResponse
+func (c *ClientWithResponses) CreateTaskTimeEntryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskTimeEntryResponse, error) {
+	rsp, err := c.CreateTaskTimeEntryWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskTimeEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTaskTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, body CreateTaskTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskTimeEntryResponse, error) {
+	rsp, err := c.CreateTaskTimeEntry(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskTimeEntryResponse(rsp)
+}
+
+// StartTaskTimerWithBodyWithResponse request with arbitrary body returning *StartTaskTimerResponse
+func (c *ClientWithResponses) StartTaskTimerWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartTaskTimerResponse, error) {
+	rsp, err := c.StartTaskTimerWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartTaskTimerResponse(rsp)
+}
+
+func (c *ClientWithResponses) StartTaskTimerWithResponse(ctx context.Context, id openapi_types.UUID, body StartTaskTimerJSONRequestBody, reqEditors ...RequestEditorFn) (*StartTaskTimerResponse, error) {
+	rsp, err := c.StartTaskTimer(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartTaskTimerResponse(rsp)
+}
+
+// StopTaskTimerWithResponse request returning *StopTaskTimerResponse
+func (c *ClientWithResponses) StopTaskTimerWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*StopTaskTimerResponse, error) {
+	rsp, err := c.StopTaskTimer(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopTaskTimerResponse(rsp)
+}
+
+// UnarchiveTaskWithResponse request returning *UnarchiveTaskResponse
+func (c *ClientWithResponses) UnarchiveTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*UnarchiveTaskResponse, error) {
+	rsp, err := c.UnarchiveTask(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnarchiveTaskResponse(rsp)
+}
+
+// GetTaskTemplateListWithResponse request returning *GetTaskTemplateListResponse
+func (c *ClientWithResponses) GetTaskTemplateListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaskTemplateListResponse, error) {
+	rsp, err := c.GetTaskTemplateList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskTemplateListResponse(rsp)
+}
+
+// CreateTaskTemplateWithBodyWithResponse request with arbitrary body returning *CreateTaskTemplateResponse
+func (c *ClientWithResponses) CreateTaskTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskTemplateResponse, error) {
+	rsp, err := c.CreateTaskTemplateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTaskTemplateWithResponse(ctx context.Context, body CreateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskTemplateResponse, error) {
+	rsp, err := c.CreateTaskTemplate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskTemplateResponse(rsp)
+}
+
+// DeleteTaskTemplateWithResponse request returning *DeleteTaskTemplateResponse
+func (c *ClientWithResponses) DeleteTaskTemplateWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTaskTemplateResponse, error) {
+	rsp, err := c.DeleteTaskTemplate(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTaskTemplateResponse(rsp)
+}
+
+// GetTaskTemplateWithResponse request returning *GetTaskTemplateResponse
+func (c *ClientWithResponses) GetTaskTemplateWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskTemplateResponse, error) {
+	rsp, err := c.GetTaskTemplate(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskTemplateResponse(rsp)
+}
+
+// EditTaskTemplateWithBodyWithResponse request with arbitrary body returning *EditTaskTemplateResponse
+func (c *ClientWithResponses) EditTaskTemplateWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTaskTemplateResponse, error) {
+	rsp, err := c.EditTaskTemplateWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTaskTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditTaskTemplateWithResponse(ctx context.Context, id openapi_types.UUID, body EditTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTaskTemplateResponse, error) {
+	rsp, err := c.EditTaskTemplate(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTaskTemplateResponse(rsp)
+}
+
+// InstantiateTaskTemplateWithBodyWithResponse request with arbitrary body returning *InstantiateTaskTemplateResponse
+func (c *ClientWithResponses) InstantiateTaskTemplateWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InstantiateTaskTemplateResponse, error) {
+	rsp, err := c.InstantiateTaskTemplateWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInstantiateTaskTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) InstantiateTaskTemplateWithResponse(ctx context.Context, id openapi_types.UUID, body InstantiateTaskTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*InstantiateTaskTemplateResponse, error) {
+	rsp, err := c.InstantiateTaskTemplate(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInstantiateTaskTemplateResponse(rsp)
+}
+
+// GetEstimateReportWithResponse request returning *GetEstimateReportResponse
+func (c *ClientWithResponses) GetEstimateReportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEstimateReportResponse, error) {
+	rsp, err := c.GetEstimateReport(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEstimateReportResponse(rsp)
+}
+
+// GetTimeReportWithResponse request returning *GetTimeReportResponse
+func (c *ClientWithResponses) GetTimeReportWithResponse(ctx context.Context, params *GetTimeReportParams, reqEditors ...RequestEditorFn) (*GetTimeReportResponse, error) {
+	rsp, err := c.GetTimeReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTimeReportResponse(rsp)
+}
+
+// GetRunningTimerWithResponse request returning *GetRunningTimerResponse
+func (c *ClientWithResponses) GetRunningTimerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRunningTimerResponse, error) {
+	rsp, err := c.GetRunningTimer(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRunningTimerResponse(rsp)
+}
+
+// DeleteTimeEntryWithResponse request returning *DeleteTimeEntryResponse
+func (c *ClientWithResponses) DeleteTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTimeEntryResponse, error) {
+	rsp, err := c.DeleteTimeEntry(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTimeEntryResponse(rsp)
+}
+
+// EditTimeEntryWithBodyWithResponse request with arbitrary body returning *EditTimeEntryResponse
+func (c *ClientWithResponses) EditTimeEntryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTimeEntryResponse, error) {
+	rsp, err := c.EditTimeEntryWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTimeEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, body EditTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTimeEntryResponse, error) {
+	rsp, err := c.EditTimeEntry(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTimeEntryResponse(rsp)
+}
+
+// GetTaskViewListWithResponse request returning *GetTaskViewListResponse
+func (c *ClientWithResponses) GetTaskViewListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaskViewListResponse, error) {
+	rsp, err := c.GetTaskViewList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskViewListResponse(rsp)
+}
+
+// CreateTaskViewWithBodyWithResponse request with arbitrary body returning *CreateTaskViewResponse
+func (c *ClientWithResponses) CreateTaskViewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskViewResponse, error) {
+	rsp, err := c.CreateTaskViewWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskViewResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTaskViewWithResponse(ctx context.Context, body CreateTaskViewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskViewResponse, error) {
+	rsp, err := c.CreateTaskView(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTaskViewResponse(rsp)
+}
+
+// DeleteTaskViewWithResponse request returning *DeleteTaskViewResponse
+func (c *ClientWithResponses) DeleteTaskViewWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteTaskViewResponse, error) {
+	rsp, err := c.DeleteTaskView(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTaskViewResponse(rsp)
+}
+
+// GetTaskViewWithResponse request returning *GetTaskViewResponse
+func (c *ClientWithResponses) GetTaskViewWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetTaskViewResponse, error) {
+	rsp, err := c.GetTaskView(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskViewResponse(rsp)
+}
+
+// EditTaskViewWithBodyWithResponse request with arbitrary body returning *EditTaskViewResponse
+func (c *ClientWithResponses) EditTaskViewWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTaskViewResponse, error) {
+	rsp, err := c.EditTaskViewWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTaskViewResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditTaskViewWithResponse(ctx context.Context, id string, body EditTaskViewJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTaskViewResponse, error) {
+	rsp, err := c.EditTaskView(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTaskViewResponse(rsp)
+}
+
+// GetTaskViewTasksWithResponse request returning *GetTaskViewTasksResponse
+func (c *ClientWithResponses) GetTaskViewTasksWithResponse(ctx context.Context, id string, params *GetTaskViewTasksParams, reqEditors ...RequestEditorFn) (*GetTaskViewTasksResponse, error) {
+	rsp, err := c.GetTaskViewTasks(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTaskViewTasksResponse(rsp)
+}
+
+// ParseGoogleCallbackResponse parses an HTTP response from a GoogleCallbackWithResponse call
+func ParseGoogleCallbackResponse(rsp *http.Response) (*GoogleCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GoogleCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeCalendarFeedResponse parses an HTTP response from a RevokeCalendarFeedWithResponse call
+func ParseRevokeCalendarFeedResponse(rsp *http.Response) (*RevokeCalendarFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeCalendarFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarFeedResponse parses an HTTP response from a GetCalendarFeedWithResponse call
+func ParseGetCalendarFeedResponse(rsp *http.Response) (*GetCalendarFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarFeedStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRotateCalendarFeedTokenResponse parses an HTTP response from a RotateCalendarFeedTokenWithResponse call
+func ParseRotateCalendarFeedTokenResponse(rsp *http.Response) (*RotateCalendarFeedTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RotateCalendarFeedTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarFeedToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarFeedICSResponse parses an HTTP response from a GetCalendarFeedICSWithResponse call
+func ParseGetCalendarFeedICSResponse(rsp *http.Response) (*GetCalendarFeedICSResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarFeedICSResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportICalResponse parses an HTTP response from a ImportICalWithResponse call
+func ParseImportICalResponse(rsp *http.Response) (*ImportICalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportICalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportMarkdownResponse parses an HTTP response from a ImportMarkdownWithResponse call
+func ParseImportMarkdownResponse(rsp *http.Response) (*ImportMarkdownResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportMarkdownResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportTodoistResponse parses an HTTP response from a ImportTodoistWithResponse call
+func ParseImportTodoistResponse(rsp *http.Response) (*ImportTodoistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportTodoistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportTrelloResponse parses an HTTP response from a ImportTrelloWithResponse call
+func ParseImportTrelloResponse(rsp *http.Response) (*ImportTrelloResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportTrelloResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInterpretationItemResponse parses an HTTP response from a GetInterpretationItemWithResponse call
+func ParseGetInterpretationItemResponse(rsp *http.Response) (*GetInterpretationItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInterpretationItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InterpretationItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateInterpretationItemResponse parses an HTTP response from a UpdateInterpretationItemWithResponse call
+func ParseUpdateInterpretationItemResponse(rsp *http.Response) (*UpdateInterpretationItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateInterpretationItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InterpretationItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApproveInterpretationItemResponse parses an HTTP response from a ApproveInterpretationItemWithResponse call
+func ParseApproveInterpretationItemResponse(rsp *http.Response) (*ApproveInterpretationItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApproveInterpretationItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApproveItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInterpretationsResponse parses an HTTP response from a ListInterpretationsWithResponse call
+func ParseListInterpretationsResponse(rsp *http.Response) (*ListInterpretationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInterpretationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Interpretations []AIInterpretation `json:"interpretations"`
+			Limit           *int               `json:"limit,omitempty"`
+			Offset          *int               `json:"offset,omitempty"`
+			Total           int                `json:"total"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInterpretationResponse parses an HTTP response from a CreateInterpretationWithResponse call
+func ParseCreateInterpretationResponse(rsp *http.Response) (*CreateInterpretationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInterpretationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InterpretationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInterpretationResponse parses an HTTP response from a GetInterpretationWithResponse call
+func ParseGetInterpretationResponse(rsp *http.Response) (*GetInterpretationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInterpretationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AIInterpretation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApproveMultipleInterpretationItemsResponse parses an HTTP response from a ApproveMultipleInterpretationItemsWithResponse call
+func ParseApproveMultipleInterpretationItemsResponse(rsp *http.Response) (*ApproveMultipleInterpretationItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApproveMultipleInterpretationItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApproveMultipleItemsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInterpretationItemsResponse parses an HTTP response from a GetInterpretationItemsWithResponse call
+func ParseGetInterpretationItemsResponse(rsp *http.Response) (*GetInterpretationItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInterpretationItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InterpretationItemsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportAccountResponse parses an HTTP response from a ExportAccountWithResponse call
+func ParseExportAccountResponse(rsp *http.Response) (*ExportAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportAccountResponse parses an HTTP response from a ImportAccountWithResponse call
+func ParseImportAccountResponse(rsp *http.Response) (*ImportAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserSettingsResponse parses an HTTP response from a GetUserSettingsWithResponse call
+func ParseGetUserSettingsResponse(rsp *http.Response) (*GetUserSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEditUserSettingsResponse parses an HTTP response from a EditUserSettingsWithResponse call
+func ParseEditUserSettingsResponse(rsp *http.Response) (*EditUserSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EditUserSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNotificationsResponse parses an HTTP response from a ListNotificationsWithResponse call
+func ParseListNotificationsResponse(rsp *http.Response) (*ListNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMarkAllNotificationsReadResponse parses an HTTP response from a MarkAllNotificationsReadWithResponse call
+func ParseMarkAllNotificationsReadResponse(rsp *http.Response) (*MarkAllNotificationsReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MarkAllNotificationsReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReadAllNotificationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUnreadNotificationCountResponse parses an HTTP response from a GetUnreadNotificationCountWithResponse call
+func ParseGetUnreadNotificationCountResponse(rsp *http.Response) (*GetUnreadNotificationCountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUnreadNotificationCountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UnreadNotificationCountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteNotificationResponse parses an HTTP response from a DeleteNotificationWithResponse call
+func ParseDeleteNotificationResponse(rsp *http.Response) (*DeleteNotificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteNotificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMarkNotificationReadResponse parses an HTTP response from a MarkNotificationReadWithResponse call
+func ParseMarkNotificationReadResponse(rsp *http.Response) (*MarkNotificationReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MarkNotificationReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Notification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectListResponse parses an HTTP response from a GetProjectListWithResponse call
+func ParseGetProjectListResponse(rsp *http.Response) (*GetProjectListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProjectResponse parses an HTTP response from a CreateProjectWithResponse call
+func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProjectResponse parses an HTTP response from a DeleteProjectWithResponse call
+func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
+func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEditProjectResponse parses an HTTP response from a EditProjectWithResponse call
+func ParseEditProjectResponse(rsp *http.Response) (*EditProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EditProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskStatsResponse parses an HTTP response from a GetTaskStatsWithResponse call
+func ParseGetTaskStatsResponse(rsp *http.Response) (*GetTaskStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskFlowReportResponse parses an HTTP response from a GetTaskFlowReportWithResponse call
+func ParseGetTaskFlowReportResponse(rsp *http.Response) (*GetTaskFlowReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskFlowReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskFlowReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskStatusListResponse parses an HTTP response from a GetTaskStatusListWithResponse call
+func ParseGetTaskStatusListResponse(rsp *http.Response) (*GetTaskStatusListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskStatusListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TaskStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTaskStatusResponse parses an HTTP response from a CreateTaskStatusWithResponse call
+func ParseCreateTaskStatusResponse(rsp *http.Response) (*CreateTaskStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTaskStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TaskStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTaskStatusResponse parses an HTTP response from a DeleteTaskStatusWithResponse call
+func ParseDeleteTaskStatusResponse(rsp *http.Response) (*DeleteTaskStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTaskStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEditTaskStatusResponse parses an HTTP response from a EditTaskStatusWithResponse call
+func ParseEditTaskStatusResponse(rsp *http.Response) (*EditTaskStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EditTaskStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskListResponse parses an HTTP response from a GetTaskListWithResponse call
+func ParseGetTaskListResponse(rsp *http.Response) (*GetTaskListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTaskResponse parses an HTTP response from a CreateTaskWithResponse call
+func ParseCreateTaskResponse(rsp *http.Response) (*CreateTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetArchivedTasksResponse parses an HTTP response from a GetArchivedTasksWithResponse call
+func ParseGetArchivedTasksResponse(rsp *http.Response) (*GetArchivedTasksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetArchivedTasksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBatchTasksResponse parses an HTTP response from a BatchTasksWithResponse call
+func ParseBatchTasksResponse(rsp *http.Response) (*BatchTasksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BatchTasksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchTaskResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskOccurrencesResponse parses an HTTP response from a GetTaskOccurrencesWithResponse call
+func ParseGetTaskOccurrencesResponse(rsp *http.Response) (*GetTaskOccurrencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskOccurrencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskOccurrencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskTrashResponse parses an HTTP response from a GetTaskTrashWithResponse call
+func ParseGetTaskTrashResponse(rsp *http.Response) (*GetTaskTrashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskTrashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTaskResponse parses an HTTP response from a DeleteTaskWithResponse call
+func ParseDeleteTaskResponse(rsp *http.Response) (*DeleteTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskResponse parses an HTTP response from a GetTaskWithResponse call
+func ParseGetTaskResponse(rsp *http.Response) (*GetTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEditTaskResponse parses an HTTP response from a EditTaskWithResponse call
+func ParseEditTaskResponse(rsp *http.Response) (*EditTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EditTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTaskResponse parses an HTTP response from a UpdateTaskWithResponse call
+func ParseUpdateTaskResponse(rsp *http.Response) (*UpdateTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskAttachmentsResponse parses an HTTP response from a GetTaskAttachmentsWithResponse call
+func ParseGetTaskAttachmentsResponse(rsp *http.Response) (*GetTaskAttachmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskAttachmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskAttachmentListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadTaskAttachmentResponse parses an HTTP response from a UploadTaskAttachmentWithResponse call
+func ParseUploadTaskAttachmentResponse(rsp *http.Response) (*UploadTaskAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadTaskAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TaskAttachment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTaskAttachmentResponse parses an HTTP response from a DeleteTaskAttachmentWithResponse call
+func ParseDeleteTaskAttachmentResponse(rsp *http.Response) (*DeleteTaskAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTaskAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDownloadTaskAttachmentResponse parses an HTTP response from a DownloadTaskAttachmentWithResponse call
+func ParseDownloadTaskAttachmentResponse(rsp *http.Response) (*DownloadTaskAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadTaskAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskDependenciesResponse parses an HTTP response from a GetTaskDependenciesWithResponse call
+func ParseGetTaskDependenciesResponse(rsp *http.Response) (*GetTaskDependenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskDependenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskDependencies
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddTaskDependencyResponse parses an HTTP response from a AddTaskDependencyWithResponse call
+func ParseAddTaskDependencyResponse(rsp *http.Response) (*AddTaskDependencyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddTaskDependencyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TaskDependencies
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveTaskDependencyResponse parses an HTTP response from a RemoveTaskDependencyWithResponse call
+func ParseRemoveTaskDependencyResponse(rsp *http.Response) (*RemoveTaskDependencyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveTaskDependencyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskHistoryByIDResponse parses an HTTP response from a GetTaskHistoryByIDWithResponse call
+func ParseGetTaskHistoryByIDResponse(rsp *http.Response) (*GetTaskHistoryByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskHistoryByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskHistoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMoveTaskResponse parses an HTTP response from a MoveTaskWithResponse call
+func ParseMoveTaskResponse(rsp *http.Response) (*MoveTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MoveTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskOccurrencesByIDResponse parses an HTTP response from a GetTaskOccurrencesByIDWithResponse call
+func ParseGetTaskOccurrencesByIDResponse(rsp *http.Response) (*GetTaskOccurrencesByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskOccurrencesByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskOccurrencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreTaskResponse parses an HTTP response from a RestoreTaskWithResponse call
+func ParseRestoreTaskResponse(rsp *http.Response) (*RestoreTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSaveTaskAsTemplateResponse parses an HTTP response from a SaveTaskAsTemplateWithResponse call
+func ParseSaveTaskAsTemplateResponse(rsp *http.Response) (*SaveTaskAsTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SaveTaskAsTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TaskTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnsnoozeTaskResponse parses an HTTP response from a UnsnoozeTaskWithResponse call
+func ParseUnsnoozeTaskResponse(rsp *http.Response) (*UnsnoozeTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnsnoozeTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSnoozeTaskResponse parses an HTTP response from a SnoozeTaskWithResponse call
+func ParseSnoozeTaskResponse(rsp *http.Response) (*SnoozeTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SnoozeTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTaskTimeEntriesResponse parses an HTTP response from a GetTaskTimeEntriesWithResponse call
+func ParseGetTaskTimeEntriesResponse(rsp *http.Response) (*GetTaskTimeEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTaskTimeEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntryListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTaskTimeEntryResponse parses an HTTP response from a CreateTaskTimeEntryWithResponse call
+func ParseCreateTaskTimeEntryResponse(rsp *http.Response) (*CreateTaskTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTaskTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartTaskTimerResponse parses an HTTP response from a StartTaskTimerWithResponse call
+func ParseStartTaskTimerResponse(rsp *http.Response) (*StartTaskTimerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartTaskTimerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStopTaskTimerResponse parses an HTTP response from a StopTaskTimerWithResponse call
+func ParseStopTaskTimerResponse(rsp *http.Response) (*StopTaskTimerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StopTaskTimerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) ListInterpretations(ctx context.Context, params *ListInterpretationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListInterpretationsRequest(c.Server, params)
+// ParseUnarchiveTaskResponse parses an HTTP response from a UnarchiveTaskWithResponse call
+func ParseUnarchiveTaskResponse(rsp *http.Response) (*UnarchiveTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &UnarchiveTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) CreateInterpretationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateInterpretationRequestWithBody(c.Server, contentType, body)
+// ParseGetTaskTemplateListResponse parses an HTTP response from a GetTaskTemplateListWithResponse call
+func ParseGetTaskTemplateListResponse(rsp *http.Response) (*GetTaskTemplateListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &GetTaskTemplateListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TaskTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) CreateInterpretation(ctx context.Context, body CreateInterpretationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateInterpretationRequest(c.Server, body)
+// ParseCreateTaskTemplateResponse parses an HTTP response from a CreateTaskTemplateWithResponse call
+func ParseCreateTaskTemplateResponse(rsp *http.Response) (*CreateTaskTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &CreateTaskTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TaskTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) GetInterpretation(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetInterpretationRequest(c.Server, id)
+// ParseDeleteTaskTemplateResponse parses an HTTP response from a DeleteTaskTemplateWithResponse call
+func ParseDeleteTaskTemplateResponse(rsp *http.Response) (*DeleteTaskTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &DeleteTaskTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) ApproveMultipleInterpretationItemsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApproveMultipleInterpretationItemsRequestWithBody(c.Server, id, contentType, body)
+// ParseGetTaskTemplateResponse parses an HTTP response from a GetTaskTemplateWithResponse call
+func ParseGetTaskTemplateResponse(rsp *http.Response) (*GetTaskTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &GetTaskTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) ApproveMultipleInterpretationItems(ctx context.Context, id openapi_types.UUID, body ApproveMultipleInterpretationItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApproveMultipleInterpretationItemsRequest(c.Server, id, body)
+// ParseEditTaskTemplateResponse parses an HTTP response from a EditTaskTemplateWithResponse call
+func ParseEditTaskTemplateResponse(rsp *http.Response) (*EditTaskTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &EditTaskTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) GetInterpretationItems(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetInterpretationItemsRequest(c.Server, id)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) GetTaskList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTaskListRequest(c.Server)
+// ParseInstantiateTaskTemplateResponse parses an HTTP response from a InstantiateTaskTemplateWithResponse call
+func ParseInstantiateTaskTemplateResponse(rsp *http.Response) (*InstantiateTaskTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) CreateTaskWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTaskRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
+	response := &InstantiateTaskTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest []Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) CreateTask(ctx context.Context, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTaskRequest(c.Server, body)
+// ParseGetEstimateReportResponse parses an HTTP response from a GetEstimateReportWithResponse call
+func ParseGetEstimateReportResponse(rsp *http.Response) (*GetEstimateReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) DeleteTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTaskRequest(c.Server, id)
-	if err != nil {
-		return nil, err
+	response := &GetEstimateReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EstimateReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) GetTask(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTaskRequest(c.Server, id)
+// ParseGetTimeReportResponse parses an HTTP response from a GetTimeReportWithResponse call
+func ParseGetTimeReportResponse(rsp *http.Response) (*GetTimeReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) EditTaskWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEditTaskRequestWithBody(c.Server, id, contentType, body)
-	if err != nil {
-		return nil, err
+	response := &GetTimeReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) EditTask(ctx context.Context, id openapi_types.UUID, body EditTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEditTaskRequest(c.Server, id, body)
+// ParseGetRunningTimerResponse parses an HTTP response from a GetRunningTimerWithResponse call
+func ParseGetRunningTimerResponse(rsp *http.Response) (*GetRunningTimerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) UpdateTaskWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTaskRequestWithBody(c.Server, id, contentType, body)
-	if err != nil {
-		return nil, err
+	response := &GetRunningTimerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunningTimerResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) UpdateTask(ctx context.Context, id openapi_types.UUID, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTaskRequest(c.Server, id, body)
+// ParseDeleteTimeEntryResponse parses an HTTP response from a DeleteTimeEntryWithResponse call
+func ParseDeleteTimeEntryResponse(rsp *http.Response) (*DeleteTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-// NewGoogleCallbackRequest calls the generic GoogleCallback builder with application/json body
-func NewGoogleCallbackRequest(server string, body GoogleCallbackJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+	response := &DeleteTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewGoogleCallbackRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewGoogleCallbackRequestWithBody generates requests for GoogleCallback with any type of body
-func NewGoogleCallbackRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	operationPath := fmt.Sprintf("/auth/google/callback")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseEditTimeEntryResponse parses an HTTP response from a EditTimeEntryWithResponse call
+func ParseEditTimeEntryResponse(rsp *http.Response) (*EditTimeEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+	response := &EditTimeEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	return req, nil
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// NewGetHealthRequest generates requests for GetHealth
-func NewGetHealthRequest(server string) (*http.Request, error) {
-	var err error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return response, nil
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// ParseGetTaskViewListResponse parses an HTTP response from a GetTaskViewListWithResponse call
+func ParseGetTaskViewListResponse(rsp *http.Response) (*GetTaskViewListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	response := &GetTaskViewListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	return req, nil
-}
-
-// NewGetInterpretationItemRequest generates requests for GetInterpretationItem
-func NewGetInterpretationItemRequest(server string, id openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TaskView
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	return response, nil
+}
+
+// ParseCreateTaskViewResponse parses an HTTP response from a CreateTaskViewWithResponse call
+func ParseCreateTaskViewResponse(rsp *http.Response) (*CreateTaskViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/interpretation-items/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &CreateTaskViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TaskView
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
 	}
 
-	return req, nil
+	return response, nil
 }
 
-// NewUpdateInterpretationItemRequest calls the generic UpdateInterpretationItem builder with application/json body
-func NewUpdateInterpretationItemRequest(server string, id openapi_types.UUID, body UpdateInterpretationItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// ParseDeleteTaskViewResponse parses an HTTP response from a DeleteTaskViewWithResponse call
+func ParseDeleteTaskViewResponse(rsp *http.Response) (*DeleteTaskViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateInterpretationItemRequestWithBody(server, id, "application/json", bodyReader)
-}
 
-// NewUpdateInterpretationItemRequestWithBody generates requests for UpdateInterpretationItem with any type of body
-func NewUpdateInterpretationItemRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	response := &DeleteTaskViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	var pathParam0 string
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/interpretation-items/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return response, nil
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// ParseGetTaskViewResponse parses an HTTP response from a GetTaskViewWithResponse call
+func ParseGetTaskViewResponse(rsp *http.Response) (*GetTaskViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+	response := &GetTaskViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskView
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// NewApproveInterpretationItemRequest generates requests for ApproveInterpretationItem
-func NewApproveInterpretationItemRequest(server string, id openapi_types.UUID) (*http.Request, error) {
-	var err error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	var pathParam0 string
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	return response, nil
+}
+
+// ParseEditTaskViewResponse parses an HTTP response from a EditTaskViewWithResponse call
+func ParseEditTaskViewResponse(rsp *http.Response) (*EditTaskViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/interpretation-items/%s/approve", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &EditTaskViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskView
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	return req, nil
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// NewListInterpretationsRequest generates requests for ListInterpretations
-func NewListInterpretationsRequest(server string, params *ListInterpretationsParams) (*http.Request, error) {
-	var err error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/interpretations")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return response, nil
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// ParseGetTaskViewTasksResponse parses an HTTP response from a GetTaskViewTasksWithResponse call
+func ParseGetTaskViewTasksResponse(rsp *http.Response) (*GetTaskViewTasksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	response := &GetTaskViewTasksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		if params.Type != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON404 = &dest
 
-		if params.Limit != nil {
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// GoogleCallback
+	// (POST /auth/google/callback)
+	GoogleCallback(c *gin.Context)
+	// RevokeCalendarFeed
+	// (DELETE /calendar-feed)
+	RevokeCalendarFeed(c *gin.Context)
+	// GetCalendarFeed
+	// (GET /calendar-feed)
+	GetCalendarFeed(c *gin.Context)
+	// RotateCalendarFeedToken
+	// (POST /calendar-feed/rotate)
+	RotateCalendarFeedToken(c *gin.Context)
+	// GetHealth
+	// (GET /health)
+	GetHealth(c *gin.Context)
+	// GetCalendarFeedICS
+	// (GET /ical/{token}.ics)
+	GetCalendarFeedICS(c *gin.Context, token string, params GetCalendarFeedICSParams)
+	// ImportICal
+	// (POST /import/ical)
+	ImportICal(c *gin.Context, params ImportICalParams)
+	// ImportMarkdown
+	// (POST /import/markdown)
+	ImportMarkdown(c *gin.Context, params ImportMarkdownParams)
+	// ImportTodoist
+	// (POST /import/todoist)
+	ImportTodoist(c *gin.Context, params ImportTodoistParams)
+	// ImportTrello
+	// (POST /import/trello)
+	ImportTrello(c *gin.Context, params ImportTrelloParams)
+	// GetInterpretationItem
+	// (GET /interpretation-items/{id})
+	GetInterpretationItem(c *gin.Context, id openapi_types.UUID)
+	// UpdateInterpretationItem
+	// (PATCH /interpretation-items/{id})
+	UpdateInterpretationItem(c *gin.Context, id openapi_types.UUID)
+	// ApproveInterpretationItem
+	// (POST /interpretation-items/{id}/approve)
+	ApproveInterpretationItem(c *gin.Context, id openapi_types.UUID)
+	// ListInterpretations
+	// (GET /interpretations)
+	ListInterpretations(c *gin.Context, params ListInterpretationsParams)
+	// CreateInterpretation
+	// (POST /interpretations)
+	CreateInterpretation(c *gin.Context, params CreateInterpretationParams)
+	// GetInterpretation
+	// (GET /interpretations/{id})
+	GetInterpretation(c *gin.Context, id openapi_types.UUID)
+	// ApproveMultipleInterpretationItems
+	// (POST /interpretations/{id}/approve-items)
+	ApproveMultipleInterpretationItems(c *gin.Context, id openapi_types.UUID)
+	// GetInterpretationItems
+	// (GET /interpretations/{id}/items)
+	GetInterpretationItems(c *gin.Context, id openapi_types.UUID)
+	// ExportAccount
+	// (GET /me/export)
+	ExportAccount(c *gin.Context)
+	// ImportAccount
+	// (POST /me/import)
+	ImportAccount(c *gin.Context)
+	// GetUserSettings
+	// (GET /me/settings)
+	GetUserSettings(c *gin.Context)
+	// EditUserSettings
+	// (PATCH /me/settings)
+	EditUserSettings(c *gin.Context)
+	// ListNotifications
+	// (GET /notifications)
+	ListNotifications(c *gin.Context, params ListNotificationsParams)
+	// MarkAllNotificationsRead
+	// (POST /notifications/read-all)
+	MarkAllNotificationsRead(c *gin.Context)
+	// GetUnreadNotificationCount
+	// (GET /notifications/unread-count)
+	GetUnreadNotificationCount(c *gin.Context)
+	// DeleteNotification
+	// (DELETE /notifications/{id})
+	DeleteNotification(c *gin.Context, id openapi_types.UUID)
+	// MarkNotificationRead
+	// (POST /notifications/{id}/read)
+	MarkNotificationRead(c *gin.Context, id openapi_types.UUID)
+	// GetProjectList
+	// (GET /projects)
+	GetProjectList(c *gin.Context, params GetProjectListParams)
+	// CreateProject
+	// (POST /projects)
+	CreateProject(c *gin.Context)
+	// DeleteProject
+	// (DELETE /projects/{id})
+	DeleteProject(c *gin.Context, id openapi_types.UUID)
+	// GetProject
+	// (GET /projects/{id})
+	GetProject(c *gin.Context, id openapi_types.UUID)
+	// EditProject
+	// (PATCH /projects/{id})
+	EditProject(c *gin.Context, id openapi_types.UUID)
+	// GetTaskStats
+	// (GET /stats)
+	GetTaskStats(c *gin.Context, params GetTaskStatsParams)
+	// GetTaskFlowReport
+	// (GET /stats/flow)
+	GetTaskFlowReport(c *gin.Context, params GetTaskFlowReportParams)
+	// GetTaskStatusList
+	// (GET /statuses)
+	GetTaskStatusList(c *gin.Context)
+	// CreateTaskStatus
+	// (POST /statuses)
+	CreateTaskStatus(c *gin.Context)
+	// DeleteTaskStatus
+	// (DELETE /statuses/{id})
+	DeleteTaskStatus(c *gin.Context, id openapi_types.UUID)
+	// EditTaskStatus
+	// (PATCH /statuses/{id})
+	EditTaskStatus(c *gin.Context, id openapi_types.UUID)
+	// GetTaskList
+	// (GET /tasks)
+	GetTaskList(c *gin.Context, params GetTaskListParams)
+	// CreateTask
+	// (POST /tasks)
+	CreateTask(c *gin.Context, params CreateTaskParams)
+	// GetArchivedTasks
+	// (GET /tasks/archived)
+	GetArchivedTasks(c *gin.Context)
+	// BatchTasks
+	// (POST /tasks/batch)
+	BatchTasks(c *gin.Context)
+	// GetTaskOccurrences
+	// (GET /tasks/occurrences)
+	GetTaskOccurrences(c *gin.Context, params GetTaskOccurrencesParams)
+	// GetTaskTrash
+	// (GET /tasks/trash)
+	GetTaskTrash(c *gin.Context)
+	// DeleteTask
+	// (DELETE /tasks/{id})
+	DeleteTask(c *gin.Context, id openapi_types.UUID, params DeleteTaskParams)
+	// GetTask
+	// (GET /tasks/{id})
+	GetTask(c *gin.Context, id openapi_types.UUID)
+	// EditTask
+	// (PATCH /tasks/{id})
+	EditTask(c *gin.Context, id openapi_types.UUID, params EditTaskParams)
+	// UpdateTask
+	// (PUT /tasks/{id})
+	UpdateTask(c *gin.Context, id openapi_types.UUID, params UpdateTaskParams)
+	// GetTaskAttachments
+	// (GET /tasks/{id}/attachments)
+	GetTaskAttachments(c *gin.Context, id openapi_types.UUID)
+	// UploadTaskAttachment
+	// (POST /tasks/{id}/attachments)
+	UploadTaskAttachment(c *gin.Context, id openapi_types.UUID)
+	// DeleteTaskAttachment
+	// (DELETE /tasks/{id}/attachments/{attachment_id})
+	DeleteTaskAttachment(c *gin.Context, id openapi_types.UUID, attachmentId openapi_types.UUID)
+	// DownloadTaskAttachment
+	// (GET /tasks/{id}/attachments/{attachment_id})
+	DownloadTaskAttachment(c *gin.Context, id openapi_types.UUID, attachmentId openapi_types.UUID)
+	// GetTaskDependencies
+	// (GET /tasks/{id}/dependencies)
+	GetTaskDependencies(c *gin.Context, id openapi_types.UUID)
+	// AddTaskDependency
+	// (POST /tasks/{id}/dependencies)
+	AddTaskDependency(c *gin.Context, id openapi_types.UUID)
+	// RemoveTaskDependency
+	// (DELETE /tasks/{id}/dependencies/{depends_on_id})
+	RemoveTaskDependency(c *gin.Context, id openapi_types.UUID, dependsOnId openapi_types.UUID)
+	// GetTaskHistoryByID
+	// (GET /tasks/{id}/history)
+	GetTaskHistoryByID(c *gin.Context, id openapi_types.UUID)
+	// MoveTask
+	// (POST /tasks/{id}/move)
+	MoveTask(c *gin.Context, id openapi_types.UUID)
+	// GetTaskOccurrencesByID
+	// (GET /tasks/{id}/occurrences)
+	GetTaskOccurrencesByID(c *gin.Context, id openapi_types.UUID, params GetTaskOccurrencesByIDParams)
+	// RestoreTask
+	// (POST /tasks/{id}/restore)
+	RestoreTask(c *gin.Context, id openapi_types.UUID)
+	// SaveTaskAsTemplate
+	// (POST /tasks/{id}/save-as-template)
+	SaveTaskAsTemplate(c *gin.Context, id openapi_types.UUID)
+	// UnsnoozeTask
+	// (DELETE /tasks/{id}/snooze)
+	UnsnoozeTask(c *gin.Context, id openapi_types.UUID)
+	// SnoozeTask
+	// (POST /tasks/{id}/snooze)
+	SnoozeTask(c *gin.Context, id openapi_types.UUID)
+	// GetTaskTimeEntries
+	// (GET /tasks/{id}/time-entries)
+	GetTaskTimeEntries(c *gin.Context, id openapi_types.UUID)
+	// CreateTaskTimeEntry
+	// (POST /tasks/{id}/time-entries)
+	CreateTaskTimeEntry(c *gin.Context, id openapi_types.UUID)
+	// StartTaskTimer
+	// (POST /tasks/{id}/timer/start)
+	StartTaskTimer(c *gin.Context, id openapi_types.UUID)
+	// StopTaskTimer
+	// (POST /tasks/{id}/timer/stop)
+	StopTaskTimer(c *gin.Context, id openapi_types.UUID)
+	// UnarchiveTask
+	// (POST /tasks/{id}/unarchive)
+	UnarchiveTask(c *gin.Context, id openapi_types.UUID)
+	// GetTaskTemplateList
+	// (GET /templates)
+	GetTaskTemplateList(c *gin.Context)
+	// CreateTaskTemplate
+	// (POST /templates)
+	CreateTaskTemplate(c *gin.Context)
+	// DeleteTaskTemplate
+	// (DELETE /templates/{id})
+	DeleteTaskTemplate(c *gin.Context, id openapi_types.UUID)
+	// GetTaskTemplate
+	// (GET /templates/{id})
+	GetTaskTemplate(c *gin.Context, id openapi_types.UUID)
+	// EditTaskTemplate
+	// (PATCH /templates/{id})
+	EditTaskTemplate(c *gin.Context, id openapi_types.UUID)
+	// InstantiateTaskTemplate
+	// (POST /templates/{id}/instantiate)
+	InstantiateTaskTemplate(c *gin.Context, id openapi_types.UUID)
+	// GetEstimateReport
+	// (GET /time-entries/estimate-report)
+	GetEstimateReport(c *gin.Context)
+	// GetTimeReport
+	// (GET /time-entries/report)
+	GetTimeReport(c *gin.Context, params GetTimeReportParams)
+	// GetRunningTimer
+	// (GET /time-entries/running)
+	GetRunningTimer(c *gin.Context)
+	// DeleteTimeEntry
+	// (DELETE /time-entries/{id})
+	DeleteTimeEntry(c *gin.Context, id openapi_types.UUID)
+	// EditTimeEntry
+	// (PATCH /time-entries/{id})
+	EditTimeEntry(c *gin.Context, id openapi_types.UUID)
+	// GetTaskViewList
+	// (GET /views)
+	GetTaskViewList(c *gin.Context)
+	// CreateTaskView
+	// (POST /views)
+	CreateTaskView(c *gin.Context)
+	// DeleteTaskView
+	// (DELETE /views/{id})
+	DeleteTaskView(c *gin.Context, id string)
+	// GetTaskView
+	// (GET /views/{id})
+	GetTaskView(c *gin.Context, id string)
+	// EditTaskView
+	// (PATCH /views/{id})
+	EditTaskView(c *gin.Context, id string)
+	// GetTaskViewTasks
+	// (GET /views/{id}/tasks)
+	GetTaskViewTasks(c *gin.Context, id string, params GetTaskViewTasksParams)
+}
 
-		}
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
 
-		if params.Offset != nil {
+type MiddlewareFunc func(c *gin.Context)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// GoogleCallback operation middleware
+func (siw *ServerInterfaceWrapper) GoogleCallback(c *gin.Context) {
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewCreateInterpretationRequest calls the generic CreateInterpretation builder with application/json body
-func NewCreateInterpretationRequest(server string, body CreateInterpretationJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateInterpretationRequestWithBody(server, "application/json", bodyReader)
+	siw.Handler.GoogleCallback(c)
 }
 
-// NewCreateInterpretationRequestWithBody generates requests for CreateInterpretation with any type of body
-func NewCreateInterpretationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// RevokeCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) RevokeCalendarFeed(c *gin.Context) {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	operationPath := fmt.Sprintf("/interpretations")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	siw.Handler.RevokeCalendarFeed(c)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// GetCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarFeed(c *gin.Context) {
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	siw.Handler.GetCalendarFeed(c)
 }
 
-// NewGetInterpretationRequest generates requests for GetInterpretation
-func NewGetInterpretationRequest(server string, id openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
+// RotateCalendarFeedToken operation middleware
+func (siw *ServerInterfaceWrapper) RotateCalendarFeedToken(c *gin.Context) {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	operationPath := fmt.Sprintf("/interpretations/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	siw.Handler.RotateCalendarFeedToken(c)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return req, nil
+	siw.Handler.GetHealth(c)
 }
 
-// NewApproveMultipleInterpretationItemsRequest calls the generic ApproveMultipleInterpretationItems builder with application/json body
-func NewApproveMultipleInterpretationItemsRequest(server string, id openapi_types.UUID, body ApproveMultipleInterpretationItemsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewApproveMultipleInterpretationItemsRequestWithBody(server, id, "application/json", bodyReader)
-}
+// GetCalendarFeedICS operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarFeedICS(c *gin.Context) {
 
-// NewApproveMultipleInterpretationItemsRequestWithBody generates requests for ApproveMultipleInterpretationItems with any type of body
-func NewApproveMultipleInterpretationItemsRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	// ------------- Path parameter "token" -------------
+	var token string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	err = runtime.BindStyledParameterWithOptions("simple", "token", c.Param("token"), &token, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarFeedICSParams
 
-	operationPath := fmt.Sprintf("/interpretations/%s/approve-items", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// ------------- Optional query parameter "type" -------------
 
-	queryURL, err := serverURL.Parse(operationPath)
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	siw.Handler.GetCalendarFeedICS(c, token, params)
 }
 
-// NewGetInterpretationItemsRequest generates requests for GetInterpretationItems
-func NewGetInterpretationItemsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+// ImportICal operation middleware
+func (siw *ServerInterfaceWrapper) ImportICal(c *gin.Context) {
+
 	var err error
 
-	var pathParam0 string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportICalParams
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	serverURL, err := url.Parse(server)
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", c.Request.URL.Query(), &params.ProjectId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter project_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	operationPath := fmt.Sprintf("/interpretations/%s/items", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// ------------- Optional query parameter "timezone" -------------
 
-	queryURL, err := serverURL.Parse(operationPath)
+	err = runtime.BindQueryParameter("form", true, false, "timezone", c.Request.URL.Query(), &params.Timezone)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timezone: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return req, nil
+	siw.Handler.ImportICal(c, params)
 }
 
-// NewGetTaskListRequest generates requests for GetTaskList
-func NewGetTaskListRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/tasks")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// ImportMarkdown operation middleware
+func (siw *ServerInterfaceWrapper) ImportMarkdown(c *gin.Context) {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var err error
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportMarkdownParams
 
-	return req, nil
-}
+	// ------------- Optional query parameter "dry_run" -------------
 
-// NewCreateTaskRequest calls the generic CreateTask builder with application/json body
-func NewCreateTaskRequest(server string, body CreateTaskJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTaskRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewCreateTaskRequestWithBody generates requests for CreateTask with any type of body
-func NewCreateTaskRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	// ------------- Optional query parameter "project_id" -------------
 
-	serverURL, err := url.Parse(server)
+	err = runtime.BindQueryParameter("form", true, false, "project_id", c.Request.URL.Query(), &params.ProjectId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter project_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	operationPath := fmt.Sprintf("/tasks")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// ------------- Optional query parameter "timezone" -------------
 
-	queryURL, err := serverURL.Parse(operationPath)
+	err = runtime.BindQueryParameter("form", true, false, "timezone", c.Request.URL.Query(), &params.Timezone)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timezone: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	siw.Handler.ImportMarkdown(c, params)
 }
 
-// NewDeleteTaskRequest generates requests for DeleteTask
-func NewDeleteTaskRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+// ImportTodoist operation middleware
+func (siw *ServerInterfaceWrapper) ImportTodoist(c *gin.Context) {
+
 	var err error
 
-	var pathParam0 string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTodoistParams
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	serverURL, err := url.Parse(server)
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", c.Request.URL.Query(), &params.ProjectId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter project_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	operationPath := fmt.Sprintf("/tasks/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// ------------- Optional query parameter "timezone" -------------
 
-	queryURL, err := serverURL.Parse(operationPath)
+	err = runtime.BindQueryParameter("form", true, false, "timezone", c.Request.URL.Query(), &params.Timezone)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timezone: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return req, nil
+	siw.Handler.ImportTodoist(c, params)
 }
 
-// NewGetTaskRequest generates requests for GetTask
-func NewGetTaskRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+// ImportTrello operation middleware
+func (siw *ServerInterfaceWrapper) ImportTrello(c *gin.Context) {
+
 	var err error
 
-	var pathParam0 string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTrelloParams
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
+	// ------------- Optional query parameter "dry_run" -------------
 
-	serverURL, err := url.Parse(server)
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	operationPath := fmt.Sprintf("/tasks/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// ------------- Optional query parameter "project_id" -------------
 
-	queryURL, err := serverURL.Parse(operationPath)
+	err = runtime.BindQueryParameter("form", true, false, "project_id", c.Request.URL.Query(), &params.ProjectId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter project_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return req, nil
+	siw.Handler.ImportTrello(c, params)
 }
 
-// NewEditTaskRequest calls the generic EditTask builder with application/json body
-func NewEditTaskRequest(server string, id openapi_types.UUID, body EditTaskJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewEditTaskRequestWithBody(server, id, "application/json", bodyReader)
-}
+// GetInterpretationItem operation middleware
+func (siw *ServerInterfaceWrapper) GetInterpretationItem(c *gin.Context) {
 
-// NewEditTaskRequestWithBody generates requests for EditTask with any type of body
-func NewEditTaskRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	operationPath := fmt.Sprintf("/tasks/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	siw.Handler.GetInterpretationItem(c, id)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// UpdateInterpretationItem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateInterpretationItem(c *gin.Context) {
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return req, nil
+	siw.Handler.UpdateInterpretationItem(c, id)
 }
 
-// NewUpdateTaskRequest calls the generic UpdateTask builder with application/json body
-func NewUpdateTaskRequest(server string, id openapi_types.UUID, body UpdateTaskJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTaskRequestWithBody(server, id, "application/json", bodyReader)
-}
+// ApproveInterpretationItem operation middleware
+func (siw *ServerInterfaceWrapper) ApproveInterpretationItem(c *gin.Context) {
 
-// NewUpdateTaskRequestWithBody generates requests for UpdateTask with any type of body
-func NewUpdateTaskRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	operationPath := fmt.Sprintf("/tasks/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	siw.Handler.ApproveInterpretationItem(c, id)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// ListInterpretations operation middleware
+func (siw *ServerInterfaceWrapper) ListInterpretations(c *gin.Context) {
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	var err error
 
-	req.Header.Add("Content-Type", contentType)
+	c.Set(BearerAuthScopes, []string{})
 
-	return req, nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListInterpretationsParams
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	// ------------- Optional query parameter "offset" -------------
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		c.Server = newBaseURL.String()
-		return nil
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GoogleCallbackWithBodyWithResponse request with any body
-	GoogleCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GoogleCallbackResponse, error)
-
-	GoogleCallbackWithResponse(ctx context.Context, body GoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*GoogleCallbackResponse, error)
 
-	// GetHealthWithResponse request
-	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
-
-	// GetInterpretationItemWithResponse request
-	GetInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationItemResponse, error)
-
-	// UpdateInterpretationItemWithBodyWithResponse request with any body
-	UpdateInterpretationItemWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInterpretationItemResponse, error)
+	siw.Handler.ListInterpretations(c, params)
+}
 
-	UpdateInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateInterpretationItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInterpretationItemResponse, error)
+// CreateInterpretation operation middleware
+func (siw *ServerInterfaceWrapper) CreateInterpretation(c *gin.Context) {
 
-	// ApproveInterpretationItemWithResponse request
-	ApproveInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApproveInterpretationItemResponse, error)
+	var err error
 
-	// ListInterpretationsWithResponse request
-	ListInterpretationsWithResponse(ctx context.Context, params *ListInterpretationsParams, reqEditors ...RequestEditorFn) (*ListInterpretationsResponse, error)
+	c.Set(BearerAuthScopes, []string{})
 
-	// CreateInterpretationWithBodyWithResponse request with any body
-	CreateInterpretationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInterpretationResponse, error)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateInterpretationParams
 
-	CreateInterpretationWithResponse(ctx context.Context, body CreateInterpretationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInterpretationResponse, error)
+	headers := c.Request.Header
 
-	// GetInterpretationWithResponse request
-	GetInterpretationWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationResponse, error)
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-	// ApproveMultipleInterpretationItemsWithBodyWithResponse request with any body
-	ApproveMultipleInterpretationItemsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveMultipleInterpretationItemsResponse, error)
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
 
-	ApproveMultipleInterpretationItemsWithResponse(ctx context.Context, id openapi_types.UUID, body ApproveMultipleInterpretationItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveMultipleInterpretationItemsResponse, error)
+		params.IdempotencyKey = &IdempotencyKey
 
-	// GetInterpretationItemsWithResponse request
-	GetInterpretationItemsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationItemsResponse, error)
+	}
 
-	// GetTaskListWithResponse request
-	GetTaskListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaskListResponse, error)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// CreateTaskWithBodyWithResponse request with any body
-	CreateTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error)
+	siw.Handler.CreateInterpretation(c, params)
+}
 
-	CreateTaskWithResponse(ctx context.Context, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error)
+// GetInterpretation operation middleware
+func (siw *ServerInterfaceWrapper) GetInterpretation(c *gin.Context) {
 
-	// DeleteTaskWithResponse request
-	DeleteTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTaskResponse, error)
+	var err error
 
-	// GetTaskWithResponse request
-	GetTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskResponse, error)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	// EditTaskWithBodyWithResponse request with any body
-	EditTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTaskResponse, error)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	EditTaskWithResponse(ctx context.Context, id openapi_types.UUID, body EditTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTaskResponse, error)
+	c.Set(BearerAuthScopes, []string{})
 
-	// UpdateTaskWithBodyWithResponse request with any body
-	UpdateTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	UpdateTaskWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error)
+	siw.Handler.GetInterpretation(c, id)
 }
 
-type GoogleCallbackResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+// ApproveMultipleInterpretationItems operation middleware
+func (siw *ServerInterfaceWrapper) ApproveMultipleInterpretationItems(c *gin.Context) {
 
-// Status returns HTTPResponse.Status
-func (r GoogleCallbackResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GoogleCallbackResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type GetHealthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HealthResponse
+	siw.Handler.ApproveMultipleInterpretationItems(c, id)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetHealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// GetInterpretationItems operation middleware
+func (siw *ServerInterfaceWrapper) GetInterpretationItems(c *gin.Context) {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	var err error
 
-type GetInterpretationItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *InterpretationItem
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// Status returns HTTPResponse.Status
-func (r GetInterpretationItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetInterpretationItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type UpdateInterpretationItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *InterpretationItem
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	siw.Handler.GetInterpretationItems(c, id)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateInterpretationItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// ExportAccount operation middleware
+func (siw *ServerInterfaceWrapper) ExportAccount(c *gin.Context) {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateInterpretationItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type ApproveInterpretationItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ApproveItemResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	siw.Handler.ExportAccount(c)
 }
 
-// Status returns HTTPResponse.Status
-func (r ApproveInterpretationItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// ImportAccount operation middleware
+func (siw *ServerInterfaceWrapper) ImportAccount(c *gin.Context) {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApproveInterpretationItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type ListInterpretationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Interpretations []AIInterpretation `json:"interpretations"`
-		Limit           *int               `json:"limit,omitempty"`
-		Offset          *int               `json:"offset,omitempty"`
-		Total           int                `json:"total"`
-	}
-	JSON401 *ErrorResponse
-	JSON500 *ErrorResponse
+	siw.Handler.ImportAccount(c)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListInterpretationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetUserSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetUserSettings(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return http.StatusText(0)
+
+	siw.Handler.GetUserSettings(c)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListInterpretationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EditUserSettings operation middleware
+func (siw *ServerInterfaceWrapper) EditUserSettings(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type CreateInterpretationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *InterpretationResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON422      *ErrorResponse
-	JSON500      *ErrorResponse
+	siw.Handler.EditUserSettings(c)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateInterpretationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ListNotifications operation middleware
+func (siw *ServerInterfaceWrapper) ListNotifications(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNotificationsParams
+
+	// ------------- Optional query parameter "unread" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unread", c.Request.URL.Query(), &params.Unread)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter unread: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateInterpretationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
-	return 0
-}
 
-type GetInterpretationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AIInterpretation
-	JSON401      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-// Status returns HTTPResponse.Status
-func (r GetInterpretationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetInterpretationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type ApproveMultipleInterpretationItemsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ApproveMultipleItemsResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	siw.Handler.ListNotifications(c, params)
 }
 
-// Status returns HTTPResponse.Status
-func (r ApproveMultipleInterpretationItemsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// MarkAllNotificationsRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkAllNotificationsRead(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return http.StatusText(0)
+
+	siw.Handler.MarkAllNotificationsRead(c)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApproveMultipleInterpretationItemsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetUnreadNotificationCount operation middleware
+func (siw *ServerInterfaceWrapper) GetUnreadNotificationCount(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type GetInterpretationItemsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *InterpretationItemsResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	siw.Handler.GetUnreadNotificationCount(c)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetInterpretationItemsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteNotification operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNotification(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetInterpretationItemsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type GetTaskListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Task
-	JSON400      *ErrorResponse
+	siw.Handler.DeleteNotification(c, id)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTaskListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// MarkNotificationRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkNotificationRead(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTaskListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type CreateTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Task
-	JSON400      *ErrorResponse
+	siw.Handler.MarkNotificationRead(c, id)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetProjectList operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectList(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectListParams
+
+	// ------------- Optional query parameter "include_archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", c.Request.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_archived: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type DeleteTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	siw.Handler.GetProjectList(c, params)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// CreateProject operation middleware
+func (siw *ServerInterfaceWrapper) CreateProject(c *gin.Context) {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type GetTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Task
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	siw.Handler.CreateProject(c)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteProject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type EditTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Task
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	siw.Handler.DeleteProject(c, id)
 }
 
-// Status returns HTTPResponse.Status
-func (r EditTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetProject operation middleware
+func (siw *ServerInterfaceWrapper) GetProject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r EditTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type UpdateTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Task
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	siw.Handler.GetProject(c, id)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EditProject operation middleware
+func (siw *ServerInterfaceWrapper) EditProject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
+
+	siw.Handler.EditProject(c, id)
 }
 
-// GoogleCallbackWithBodyWithResponse request with arbitrary body returning *GoogleCallbackResponse
-func (c *ClientWithResponses) GoogleCallbackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GoogleCallbackResponse, error) {
-	rsp, err := c.GoogleCallbackWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// GetTaskStats operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskStats(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskStatsParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
 	}
-	return ParseGoogleCallbackResponse(rsp)
-}
 
-func (c *ClientWithResponses) GoogleCallbackWithResponse(ctx context.Context, body GoogleCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*GoogleCallbackResponse, error) {
-	rsp, err := c.GoogleCallback(ctx, body, reqEditors...)
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseGoogleCallbackResponse(rsp)
-}
 
-// GetHealthWithResponse request returning *GetHealthResponse
-func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
-	rsp, err := c.GetHealth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
 	}
-	return ParseGetHealthResponse(rsp)
-}
 
-// GetInterpretationItemWithResponse request returning *GetInterpretationItemResponse
-func (c *ClientWithResponses) GetInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationItemResponse, error) {
-	rsp, err := c.GetInterpretationItem(ctx, id, reqEditors...)
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseGetInterpretationItemResponse(rsp)
-}
 
-// UpdateInterpretationItemWithBodyWithResponse request with arbitrary body returning *UpdateInterpretationItemResponse
-func (c *ClientWithResponses) UpdateInterpretationItemWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInterpretationItemResponse, error) {
-	rsp, err := c.UpdateInterpretationItemWithBody(ctx, id, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", c.Request.URL.Query(), &params.GroupBy)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter group_by: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseUpdateInterpretationItemResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateInterpretationItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInterpretationItemResponse, error) {
-	rsp, err := c.UpdateInterpretationItem(ctx, id, body, reqEditors...)
+	// ------------- Optional query parameter "timezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", c.Request.URL.Query(), &params.Timezone)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timezone: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseUpdateInterpretationItemResponse(rsp)
-}
 
-// ApproveInterpretationItemWithResponse request returning *ApproveInterpretationItemResponse
-func (c *ClientWithResponses) ApproveInterpretationItemWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApproveInterpretationItemResponse, error) {
-	rsp, err := c.ApproveInterpretationItem(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParseApproveInterpretationItemResponse(rsp)
+
+	siw.Handler.GetTaskStats(c, params)
 }
 
-// ListInterpretationsWithResponse request returning *ListInterpretationsResponse
-func (c *ClientWithResponses) ListInterpretationsWithResponse(ctx context.Context, params *ListInterpretationsParams, reqEditors ...RequestEditorFn) (*ListInterpretationsResponse, error) {
-	rsp, err := c.ListInterpretations(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// GetTaskFlowReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskFlowReport(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskFlowReportParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
 	}
-	return ParseListInterpretationsResponse(rsp)
-}
 
-// CreateInterpretationWithBodyWithResponse request with arbitrary body returning *CreateInterpretationResponse
-func (c *ClientWithResponses) CreateInterpretationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInterpretationResponse, error) {
-	rsp, err := c.CreateInterpretationWithBody(ctx, contentType, body, reqEditors...)
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseCreateInterpretationResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateInterpretationWithResponse(ctx context.Context, body CreateInterpretationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInterpretationResponse, error) {
-	rsp, err := c.CreateInterpretation(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
 	}
-	return ParseCreateInterpretationResponse(rsp)
-}
 
-// GetInterpretationWithResponse request returning *GetInterpretationResponse
-func (c *ClientWithResponses) GetInterpretationWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationResponse, error) {
-	rsp, err := c.GetInterpretation(ctx, id, reqEditors...)
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseGetInterpretationResponse(rsp)
-}
 
-// ApproveMultipleInterpretationItemsWithBodyWithResponse request with arbitrary body returning *ApproveMultipleInterpretationItemsResponse
-func (c *ClientWithResponses) ApproveMultipleInterpretationItemsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApproveMultipleInterpretationItemsResponse, error) {
-	rsp, err := c.ApproveMultipleInterpretationItemsWithBody(ctx, id, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", c.Request.URL.Query(), &params.GroupBy)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter group_by: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseApproveMultipleInterpretationItemsResponse(rsp)
-}
 
-func (c *ClientWithResponses) ApproveMultipleInterpretationItemsWithResponse(ctx context.Context, id openapi_types.UUID, body ApproveMultipleInterpretationItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*ApproveMultipleInterpretationItemsResponse, error) {
-	rsp, err := c.ApproveMultipleInterpretationItems(ctx, id, body, reqEditors...)
+	// ------------- Optional query parameter "timezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", c.Request.URL.Query(), &params.Timezone)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timezone: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseApproveMultipleInterpretationItemsResponse(rsp)
-}
 
-// GetInterpretationItemsWithResponse request returning *GetInterpretationItemsResponse
-func (c *ClientWithResponses) GetInterpretationItemsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInterpretationItemsResponse, error) {
-	rsp, err := c.GetInterpretationItems(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParseGetInterpretationItemsResponse(rsp)
+
+	siw.Handler.GetTaskFlowReport(c, params)
 }
 
-// GetTaskListWithResponse request returning *GetTaskListResponse
-func (c *ClientWithResponses) GetTaskListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTaskListResponse, error) {
-	rsp, err := c.GetTaskList(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// GetTaskStatusList operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskStatusList(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParseGetTaskListResponse(rsp)
+
+	siw.Handler.GetTaskStatusList(c)
 }
 
-// CreateTaskWithBodyWithResponse request with arbitrary body returning *CreateTaskResponse
-func (c *ClientWithResponses) CreateTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error) {
-	rsp, err := c.CreateTaskWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// CreateTaskStatus operation middleware
+func (siw *ServerInterfaceWrapper) CreateTaskStatus(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParseCreateTaskResponse(rsp)
+
+	siw.Handler.CreateTaskStatus(c)
 }
 
-func (c *ClientWithResponses) CreateTaskWithResponse(ctx context.Context, body CreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTaskResponse, error) {
-	rsp, err := c.CreateTask(ctx, body, reqEditors...)
+// DeleteTaskStatus operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTaskStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseCreateTaskResponse(rsp)
-}
 
-// DeleteTaskWithResponse request returning *DeleteTaskResponse
-func (c *ClientWithResponses) DeleteTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTaskResponse, error) {
-	rsp, err := c.DeleteTask(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParseDeleteTaskResponse(rsp)
+
+	siw.Handler.DeleteTaskStatus(c, id)
 }
 
-// GetTaskWithResponse request returning *GetTaskResponse
-func (c *ClientWithResponses) GetTaskWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTaskResponse, error) {
-	rsp, err := c.GetTask(ctx, id, reqEditors...)
+// EditTaskStatus operation middleware
+func (siw *ServerInterfaceWrapper) EditTaskStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseGetTaskResponse(rsp)
-}
 
-// EditTaskWithBodyWithResponse request with arbitrary body returning *EditTaskResponse
-func (c *ClientWithResponses) EditTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTaskResponse, error) {
-	rsp, err := c.EditTaskWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParseEditTaskResponse(rsp)
+
+	siw.Handler.EditTaskStatus(c, id)
 }
 
-func (c *ClientWithResponses) EditTaskWithResponse(ctx context.Context, id openapi_types.UUID, body EditTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTaskResponse, error) {
-	rsp, err := c.EditTask(ctx, id, body, reqEditors...)
+// GetTaskList operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskList(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskListParams
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", c.Request.URL.Query(), &params.ProjectId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter project_id: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseEditTaskResponse(rsp)
-}
 
-// UpdateTaskWithBodyWithResponse request with arbitrary body returning *UpdateTaskResponse
-func (c *ClientWithResponses) UpdateTaskWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error) {
-	rsp, err := c.UpdateTaskWithBody(ctx, id, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "include_snoozed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_snoozed", c.Request.URL.Query(), &params.IncludeSnoozed)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_snoozed: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseUpdateTaskResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTaskWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTaskResponse, error) {
-	rsp, err := c.UpdateTask(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParseUpdateTaskResponse(rsp)
+
+	siw.Handler.GetTaskList(c, params)
 }
 
-// ParseGoogleCallbackResponse parses an HTTP response from a GoogleCallbackWithResponse call
-func ParseGoogleCallbackResponse(rsp *http.Response) (*GoogleCallbackResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// CreateTask operation middleware
+func (siw *ServerInterfaceWrapper) CreateTask(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateTaskParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
 	}
 
-	response := &GoogleCallbackResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	siw.Handler.CreateTask(c, params)
+}
+
+// GetArchivedTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetArchivedTasks(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON200 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	siw.Handler.GetArchivedTasks(c)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// BatchTasks operation middleware
+func (siw *ServerInterfaceWrapper) BatchTasks(c *gin.Context) {
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.BatchTasks(c)
 }
 
-// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
-func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// GetTaskOccurrences operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskOccurrences(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskOccurrencesParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetHealthResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Required query parameter "to" -------------
 
-	}
+	if paramValue := c.Query("to"); paramValue != "" {
 
-	return response, nil
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-// ParseGetInterpretationItemResponse parses an HTTP response from a GetInterpretationItemWithResponse call
-func ParseGetInterpretationItemResponse(rsp *http.Response) (*GetInterpretationItemResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetInterpretationItemResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InterpretationItem
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	siw.Handler.GetTaskOccurrences(c, params)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// GetTaskTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskTrash(c *gin.Context) {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON404 = &dest
-
 	}
 
-	return response, nil
+	siw.Handler.GetTaskTrash(c)
 }
 
-// ParseUpdateInterpretationItemResponse parses an HTTP response from a UpdateInterpretationItemWithResponse call
-func ParseUpdateInterpretationItemResponse(rsp *http.Response) (*UpdateInterpretationItemResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteTask operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &UpdateInterpretationItemResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTaskParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InterpretationItem
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	headers := c.Request.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
 		}
-		response.JSON404 = &dest
+
+		params.IfMatch = &IfMatch
 
 	}
 
-	return response, nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTask(c, id, params)
 }
 
-// ParseApproveInterpretationItemResponse parses an HTTP response from a ApproveInterpretationItemWithResponse call
-func ParseApproveInterpretationItemResponse(rsp *http.Response) (*ApproveInterpretationItemResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetTask operation middleware
+func (siw *ServerInterfaceWrapper) GetTask(c *gin.Context) {
 
-	response := &ApproveInterpretationItemResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApproveItemResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON404 = &dest
-
 	}
 
-	return response, nil
+	siw.Handler.GetTask(c, id)
 }
 
-// ParseListInterpretationsResponse parses an HTTP response from a ListInterpretationsWithResponse call
-func ParseListInterpretationsResponse(rsp *http.Response) (*ListInterpretationsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// EditTask operation middleware
+func (siw *ServerInterfaceWrapper) EditTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &ListInterpretationsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditTaskParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Interpretations []AIInterpretation `json:"interpretations"`
-			Limit           *int               `json:"limit,omitempty"`
-			Offset          *int               `json:"offset,omitempty"`
-			Total           int                `json:"total"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	headers := c.Request.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
 		}
-		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
 		}
-		response.JSON500 = &dest
+
+		params.IfMatch = &IfMatch
 
 	}
 
-	return response, nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.EditTask(c, id, params)
 }
 
-// ParseCreateInterpretationResponse parses an HTTP response from a CreateInterpretationWithResponse call
-func ParseCreateInterpretationResponse(rsp *http.Response) (*CreateInterpretationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// UpdateTask operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &CreateInterpretationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTaskParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InterpretationResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	headers := c.Request.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
 		}
-		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
+		params.IfMatch = &IfMatch
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.UpdateTask(c, id, params)
 }
 
-// ParseGetInterpretationResponse parses an HTTP response from a GetInterpretationWithResponse call
-func ParseGetInterpretationResponse(rsp *http.Response) (*GetInterpretationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetTaskAttachments operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskAttachments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetInterpretationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AIInterpretation
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	siw.Handler.GetTaskAttachments(c, id)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// UploadTaskAttachment operation middleware
+func (siw *ServerInterfaceWrapper) UploadTaskAttachment(c *gin.Context) {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	return response, nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UploadTaskAttachment(c, id)
 }
 
-// ParseApproveMultipleInterpretationItemsResponse parses an HTTP response from a ApproveMultipleInterpretationItemsWithResponse call
-func ParseApproveMultipleInterpretationItemsResponse(rsp *http.Response) (*ApproveMultipleInterpretationItemsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteTaskAttachment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTaskAttachment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &ApproveMultipleInterpretationItemsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", c.Param("attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter attachment_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApproveMultipleItemsResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON200 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	siw.Handler.DeleteTaskAttachment(c, id, attachmentId)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// DownloadTaskAttachment operation middleware
+func (siw *ServerInterfaceWrapper) DownloadTaskAttachment(c *gin.Context) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// ParseGetInterpretationItemsResponse parses an HTTP response from a GetInterpretationItemsWithResponse call
-func ParseGetInterpretationItemsResponse(rsp *http.Response) (*GetInterpretationItemsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetInterpretationItemsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", c.Param("attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter attachment_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InterpretationItemsResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON200 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	siw.Handler.DownloadTaskAttachment(c, id, attachmentId)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// GetTaskDependencies operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskDependencies(c *gin.Context) {
+
+	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	return response, nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTaskDependencies(c, id)
 }
 
-// ParseGetTaskListResponse parses an HTTP response from a GetTaskListWithResponse call
-func ParseGetTaskListResponse(rsp *http.Response) (*GetTaskListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// AddTaskDependency operation middleware
+func (siw *ServerInterfaceWrapper) AddTaskDependency(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetTaskListResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Task
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	siw.Handler.AddTaskDependency(c, id)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// RemoveTaskDependency operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTaskDependency(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "depends_on_id" -------------
+	var dependsOnId openapi_types.UUID
 
-// ParseCreateTaskResponse parses an HTTP response from a CreateTaskWithResponse call
-func ParseCreateTaskResponse(rsp *http.Response) (*CreateTaskResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "depends_on_id", c.Param("depends_on_id"), &dependsOnId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter depends_on_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &CreateTaskResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Task
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	siw.Handler.RemoveTaskDependency(c, id, dependsOnId)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// GetTaskHistoryByID operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskHistoryByID(c *gin.Context) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// ParseDeleteTaskResponse parses an HTTP response from a DeleteTaskWithResponse call
-func ParseDeleteTaskResponse(rsp *http.Response) (*DeleteTaskResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &DeleteTaskResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	siw.Handler.GetTaskHistoryByID(c, id)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// MoveTask operation middleware
+func (siw *ServerInterfaceWrapper) MoveTask(c *gin.Context) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// ParseGetTaskResponse parses an HTTP response from a GetTaskWithResponse call
-func ParseGetTaskResponse(rsp *http.Response) (*GetTaskResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
-	}
-
-	response := &GetTaskResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Task
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON200 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	siw.Handler.MoveTask(c, id)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// GetTaskOccurrencesByID operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskOccurrencesByID(c *gin.Context) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// ParseEditTaskResponse parses an HTTP response from a EditTaskWithResponse call
-func ParseEditTaskResponse(rsp *http.Response) (*EditTaskResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &EditTaskResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskOccurrencesByIDParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Task
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "limit" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON404 = &dest
-
 	}
 
-	return response, nil
+	siw.Handler.GetTaskOccurrencesByID(c, id, params)
 }
 
-// ParseUpdateTaskResponse parses an HTTP response from a UpdateTaskWithResponse call
-func ParseUpdateTaskResponse(rsp *http.Response) (*UpdateTaskResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// RestoreTask operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTask(c *gin.Context) {
 
-	response := &UpdateTaskResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Task
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON404 = &dest
-
 	}
 
-	return response, nil
+	siw.Handler.RestoreTask(c, id)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// GoogleCallback
-	// (POST /auth/google/callback)
-	GoogleCallback(c *gin.Context)
-	// GetHealth
-	// (GET /health)
-	GetHealth(c *gin.Context)
-	// GetInterpretationItem
-	// (GET /interpretation-items/{id})
-	GetInterpretationItem(c *gin.Context, id openapi_types.UUID)
-	// UpdateInterpretationItem
-	// (PATCH /interpretation-items/{id})
-	UpdateInterpretationItem(c *gin.Context, id openapi_types.UUID)
-	// ApproveInterpretationItem
-	// (POST /interpretation-items/{id}/approve)
-	ApproveInterpretationItem(c *gin.Context, id openapi_types.UUID)
-	// ListInterpretations
-	// (GET /interpretations)
-	ListInterpretations(c *gin.Context, params ListInterpretationsParams)
-	// CreateInterpretation
-	// (POST /interpretations)
-	CreateInterpretation(c *gin.Context)
-	// GetInterpretation
-	// (GET /interpretations/{id})
-	GetInterpretation(c *gin.Context, id openapi_types.UUID)
-	// ApproveMultipleInterpretationItems
-	// (POST /interpretations/{id}/approve-items)
-	ApproveMultipleInterpretationItems(c *gin.Context, id openapi_types.UUID)
-	// GetInterpretationItems
-	// (GET /interpretations/{id}/items)
-	GetInterpretationItems(c *gin.Context, id openapi_types.UUID)
-	// GetTaskList
-	// (GET /tasks)
-	GetTaskList(c *gin.Context)
-	// CreateTask
-	// (POST /tasks)
-	CreateTask(c *gin.Context)
-	// DeleteTask
-	// (DELETE /tasks/{id})
-	DeleteTask(c *gin.Context, id openapi_types.UUID)
-	// GetTask
-	// (GET /tasks/{id})
-	GetTask(c *gin.Context, id openapi_types.UUID)
-	// EditTask
-	// (PATCH /tasks/{id})
-	EditTask(c *gin.Context, id openapi_types.UUID)
-	// UpdateTask
-	// (PUT /tasks/{id})
-	UpdateTask(c *gin.Context, id openapi_types.UUID)
-}
+// SaveTaskAsTemplate operation middleware
+func (siw *ServerInterfaceWrapper) SaveTaskAsTemplate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SaveTaskAsTemplate(c, id)
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// UnsnoozeTask operation middleware
+func (siw *ServerInterfaceWrapper) UnsnoozeTask(c *gin.Context) {
 
-// GoogleCallback operation middleware
-func (siw *ServerInterfaceWrapper) GoogleCallback(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2915,11 +15613,22 @@ func (siw *ServerInterfaceWrapper) GoogleCallback(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GoogleCallback(c)
+	siw.Handler.UnsnoozeTask(c, id)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
+// SnoozeTask operation middleware
+func (siw *ServerInterfaceWrapper) SnoozeTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2928,11 +15637,11 @@ func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetHealth(c)
+	siw.Handler.SnoozeTask(c, id)
 }
 
-// GetInterpretationItem operation middleware
-func (siw *ServerInterfaceWrapper) GetInterpretationItem(c *gin.Context) {
+// GetTaskTimeEntries operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskTimeEntries(c *gin.Context) {
 
 	var err error
 
@@ -2952,11 +15661,11 @@ func (siw *ServerInterfaceWrapper) GetInterpretationItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetInterpretationItem(c, id)
+	siw.Handler.GetTaskTimeEntries(c, id)
 }
 
-// UpdateInterpretationItem operation middleware
-func (siw *ServerInterfaceWrapper) UpdateInterpretationItem(c *gin.Context) {
+// CreateTaskTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) CreateTaskTimeEntry(c *gin.Context) {
 
 	var err error
 
@@ -2976,11 +15685,11 @@ func (siw *ServerInterfaceWrapper) UpdateInterpretationItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateInterpretationItem(c, id)
+	siw.Handler.CreateTaskTimeEntry(c, id)
 }
 
-// ApproveInterpretationItem operation middleware
-func (siw *ServerInterfaceWrapper) ApproveInterpretationItem(c *gin.Context) {
+// StartTaskTimer operation middleware
+func (siw *ServerInterfaceWrapper) StartTaskTimer(c *gin.Context) {
 
 	var err error
 
@@ -3000,40 +15709,118 @@ func (siw *ServerInterfaceWrapper) ApproveInterpretationItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ApproveInterpretationItem(c, id)
+	siw.Handler.StartTaskTimer(c, id)
 }
 
-// ListInterpretations operation middleware
-func (siw *ServerInterfaceWrapper) ListInterpretations(c *gin.Context) {
+// StopTaskTimer operation middleware
+func (siw *ServerInterfaceWrapper) StopTaskTimer(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListInterpretationsParams
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// ------------- Optional query parameter "type" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	siw.Handler.StopTaskTimer(c, id)
+}
+
+// UnarchiveTask operation middleware
+func (siw *ServerInterfaceWrapper) UnarchiveTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	siw.Handler.UnarchiveTask(c, id)
+}
+
+// GetTaskTemplateList operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskTemplateList(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTaskTemplateList(c)
+}
+
+// CreateTaskTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateTaskTemplate(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTaskTemplate(c)
+}
+
+// DeleteTaskTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTaskTemplate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	siw.Handler.DeleteTaskTemplate(c, id)
+}
+
+// GetTaskTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskTemplate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -3044,13 +15831,22 @@ func (siw *ServerInterfaceWrapper) ListInterpretations(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListInterpretations(c, params)
+	siw.Handler.GetTaskTemplate(c, id)
 }
 
-// CreateInterpretation operation middleware
-func (siw *ServerInterfaceWrapper) CreateInterpretation(c *gin.Context) {
+// EditTaskTemplate operation middleware
+func (siw *ServerInterfaceWrapper) EditTaskTemplate(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -3059,11 +15855,11 @@ func (siw *ServerInterfaceWrapper) CreateInterpretation(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateInterpretation(c)
+	siw.Handler.EditTaskTemplate(c, id)
 }
 
-// GetInterpretation operation middleware
-func (siw *ServerInterfaceWrapper) GetInterpretation(c *gin.Context) {
+// InstantiateTaskTemplate operation middleware
+func (siw *ServerInterfaceWrapper) InstantiateTaskTemplate(c *gin.Context) {
 
 	var err error
 
@@ -3076,7 +15872,18 @@ func (siw *ServerInterfaceWrapper) GetInterpretation(c *gin.Context) {
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.InstantiateTaskTemplate(c, id)
+}
+
+// GetEstimateReport operation middleware
+func (siw *ServerInterfaceWrapper) GetEstimateReport(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -3085,11 +15892,95 @@ func (siw *ServerInterfaceWrapper) GetInterpretation(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetInterpretation(c, id)
+	siw.Handler.GetEstimateReport(c)
 }
 
-// ApproveMultipleInterpretationItems operation middleware
-func (siw *ServerInterfaceWrapper) ApproveMultipleInterpretationItems(c *gin.Context) {
+// GetTimeReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTimeReport(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimeReportParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "group_by" -------------
+
+	if paramValue := c.Query("group_by"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument group_by is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "group_by", c.Request.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter group_by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "timezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", c.Request.URL.Query(), &params.Timezone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timezone: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTimeReport(c, params)
+}
+
+// GetRunningTimer operation middleware
+func (siw *ServerInterfaceWrapper) GetRunningTimer(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRunningTimer(c)
+}
+
+// DeleteTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTimeEntry(c *gin.Context) {
 
 	var err error
 
@@ -3109,11 +16000,11 @@ func (siw *ServerInterfaceWrapper) ApproveMultipleInterpretationItems(c *gin.Con
 		}
 	}
 
-	siw.Handler.ApproveMultipleInterpretationItems(c, id)
+	siw.Handler.DeleteTimeEntry(c, id)
 }
 
-// GetInterpretationItems operation middleware
-func (siw *ServerInterfaceWrapper) GetInterpretationItems(c *gin.Context) {
+// EditTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) EditTimeEntry(c *gin.Context) {
 
 	var err error
 
@@ -3133,11 +16024,11 @@ func (siw *ServerInterfaceWrapper) GetInterpretationItems(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetInterpretationItems(c, id)
+	siw.Handler.EditTimeEntry(c, id)
 }
 
-// GetTaskList operation middleware
-func (siw *ServerInterfaceWrapper) GetTaskList(c *gin.Context) {
+// GetTaskViewList operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskViewList(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -3146,11 +16037,11 @@ func (siw *ServerInterfaceWrapper) GetTaskList(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTaskList(c)
+	siw.Handler.GetTaskViewList(c)
 }
 
-// CreateTask operation middleware
-func (siw *ServerInterfaceWrapper) CreateTask(c *gin.Context) {
+// CreateTaskView operation middleware
+func (siw *ServerInterfaceWrapper) CreateTaskView(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -3159,16 +16050,16 @@ func (siw *ServerInterfaceWrapper) CreateTask(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateTask(c)
+	siw.Handler.CreateTaskView(c)
 }
 
-// DeleteTask operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTask(c *gin.Context) {
+// DeleteTaskView operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTaskView(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -3183,16 +16074,16 @@ func (siw *ServerInterfaceWrapper) DeleteTask(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteTask(c, id)
+	siw.Handler.DeleteTaskView(c, id)
 }
 
-// GetTask operation middleware
-func (siw *ServerInterfaceWrapper) GetTask(c *gin.Context) {
+// GetTaskView operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskView(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -3207,16 +16098,16 @@ func (siw *ServerInterfaceWrapper) GetTask(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTask(c, id)
+	siw.Handler.GetTaskView(c, id)
 }
 
-// EditTask operation middleware
-func (siw *ServerInterfaceWrapper) EditTask(c *gin.Context) {
+// EditTaskView operation middleware
+func (siw *ServerInterfaceWrapper) EditTaskView(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -3231,16 +16122,16 @@ func (siw *ServerInterfaceWrapper) EditTask(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.EditTask(c, id)
+	siw.Handler.EditTaskView(c, id)
 }
 
-// UpdateTask operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTask(c *gin.Context) {
+// GetTaskViewTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskViewTasks(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -3248,6 +16139,17 @@ func (siw *ServerInterfaceWrapper) UpdateTask(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskViewTasksParams
+
+	// ------------- Optional query parameter "timezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", c.Request.URL.Query(), &params.Timezone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timezone: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -3255,7 +16157,7 @@ func (siw *ServerInterfaceWrapper) UpdateTask(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateTask(c, id)
+	siw.Handler.GetTaskViewTasks(c, id, params)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -3286,7 +16188,15 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	}
 
 	router.POST(options.BaseURL+"/auth/google/callback", wrapper.GoogleCallback)
+	router.DELETE(options.BaseURL+"/calendar-feed", wrapper.RevokeCalendarFeed)
+	router.GET(options.BaseURL+"/calendar-feed", wrapper.GetCalendarFeed)
+	router.POST(options.BaseURL+"/calendar-feed/rotate", wrapper.RotateCalendarFeedToken)
 	router.GET(options.BaseURL+"/health", wrapper.GetHealth)
+	router.GET(options.BaseURL+"/ical/:token.ics", wrapper.GetCalendarFeedICS)
+	router.POST(options.BaseURL+"/import/ical", wrapper.ImportICal)
+	router.POST(options.BaseURL+"/import/markdown", wrapper.ImportMarkdown)
+	router.POST(options.BaseURL+"/import/todoist", wrapper.ImportTodoist)
+	router.POST(options.BaseURL+"/import/trello", wrapper.ImportTrello)
 	router.GET(options.BaseURL+"/interpretation-items/:id", wrapper.GetInterpretationItem)
 	router.PATCH(options.BaseURL+"/interpretation-items/:id", wrapper.UpdateInterpretationItem)
 	router.POST(options.BaseURL+"/interpretation-items/:id/approve", wrapper.ApproveInterpretationItem)
@@ -3295,10 +16205,70 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/interpretations/:id", wrapper.GetInterpretation)
 	router.POST(options.BaseURL+"/interpretations/:id/approve-items", wrapper.ApproveMultipleInterpretationItems)
 	router.GET(options.BaseURL+"/interpretations/:id/items", wrapper.GetInterpretationItems)
+	router.GET(options.BaseURL+"/me/export", wrapper.ExportAccount)
+	router.POST(options.BaseURL+"/me/import", wrapper.ImportAccount)
+	router.GET(options.BaseURL+"/me/settings", wrapper.GetUserSettings)
+	router.PATCH(options.BaseURL+"/me/settings", wrapper.EditUserSettings)
+	router.GET(options.BaseURL+"/notifications", wrapper.ListNotifications)
+	router.POST(options.BaseURL+"/notifications/read-all", wrapper.MarkAllNotificationsRead)
+	router.GET(options.BaseURL+"/notifications/unread-count", wrapper.GetUnreadNotificationCount)
+	router.DELETE(options.BaseURL+"/notifications/:id", wrapper.DeleteNotification)
+	router.POST(options.BaseURL+"/notifications/:id/read", wrapper.MarkNotificationRead)
+	router.GET(options.BaseURL+"/projects", wrapper.GetProjectList)
+	router.POST(options.BaseURL+"/projects", wrapper.CreateProject)
+	router.DELETE(options.BaseURL+"/projects/:id", wrapper.DeleteProject)
+	router.GET(options.BaseURL+"/projects/:id", wrapper.GetProject)
+	router.PATCH(options.BaseURL+"/projects/:id", wrapper.EditProject)
+	router.GET(options.BaseURL+"/stats", wrapper.GetTaskStats)
+	router.GET(options.BaseURL+"/stats/flow", wrapper.GetTaskFlowReport)
+	router.GET(options.BaseURL+"/statuses", wrapper.GetTaskStatusList)
+	router.POST(options.BaseURL+"/statuses", wrapper.CreateTaskStatus)
+	router.DELETE(options.BaseURL+"/statuses/:id", wrapper.DeleteTaskStatus)
+	router.PATCH(options.BaseURL+"/statuses/:id", wrapper.EditTaskStatus)
 	router.GET(options.BaseURL+"/tasks", wrapper.GetTaskList)
 	router.POST(options.BaseURL+"/tasks", wrapper.CreateTask)
+	router.GET(options.BaseURL+"/tasks/archived", wrapper.GetArchivedTasks)
+	router.POST(options.BaseURL+"/tasks/batch", wrapper.BatchTasks)
+	router.GET(options.BaseURL+"/tasks/occurrences", wrapper.GetTaskOccurrences)
+	router.GET(options.BaseURL+"/tasks/trash", wrapper.GetTaskTrash)
 	router.DELETE(options.BaseURL+"/tasks/:id", wrapper.DeleteTask)
 	router.GET(options.BaseURL+"/tasks/:id", wrapper.GetTask)
 	router.PATCH(options.BaseURL+"/tasks/:id", wrapper.EditTask)
 	router.PUT(options.BaseURL+"/tasks/:id", wrapper.UpdateTask)
+	router.GET(options.BaseURL+"/tasks/:id/attachments", wrapper.GetTaskAttachments)
+	router.POST(options.BaseURL+"/tasks/:id/attachments", wrapper.UploadTaskAttachment)
+	router.DELETE(options.BaseURL+"/tasks/:id/attachments/:attachment_id", wrapper.DeleteTaskAttachment)
+	router.GET(options.BaseURL+"/tasks/:id/attachments/:attachment_id", wrapper.DownloadTaskAttachment)
+	router.GET(options.BaseURL+"/tasks/:id/dependencies", wrapper.GetTaskDependencies)
+	router.POST(options.BaseURL+"/tasks/:id/dependencies", wrapper.AddTaskDependency)
+	router.DELETE(options.BaseURL+"/tasks/:id/dependencies/:depends_on_id", wrapper.RemoveTaskDependency)
+	router.GET(options.BaseURL+"/tasks/:id/history", wrapper.GetTaskHistoryByID)
+	router.POST(options.BaseURL+"/tasks/:id/move", wrapper.MoveTask)
+	router.GET(options.BaseURL+"/tasks/:id/occurrences", wrapper.GetTaskOccurrencesByID)
+	router.POST(options.BaseURL+"/tasks/:id/restore", wrapper.RestoreTask)
+	router.POST(options.BaseURL+"/tasks/:id/save-as-template", wrapper.SaveTaskAsTemplate)
+	router.DELETE(options.BaseURL+"/tasks/:id/snooze", wrapper.UnsnoozeTask)
+	router.POST(options.BaseURL+"/tasks/:id/snooze", wrapper.SnoozeTask)
+	router.GET(options.BaseURL+"/tasks/:id/time-entries", wrapper.GetTaskTimeEntries)
+	router.POST(options.BaseURL+"/tasks/:id/time-entries", wrapper.CreateTaskTimeEntry)
+	router.POST(options.BaseURL+"/tasks/:id/timer/start", wrapper.StartTaskTimer)
+	router.POST(options.BaseURL+"/tasks/:id/timer/stop", wrapper.StopTaskTimer)
+	router.POST(options.BaseURL+"/tasks/:id/unarchive", wrapper.UnarchiveTask)
+	router.GET(options.BaseURL+"/templates", wrapper.GetTaskTemplateList)
+	router.POST(options.BaseURL+"/templates", wrapper.CreateTaskTemplate)
+	router.DELETE(options.BaseURL+"/templates/:id", wrapper.DeleteTaskTemplate)
+	router.GET(options.BaseURL+"/templates/:id", wrapper.GetTaskTemplate)
+	router.PATCH(options.BaseURL+"/templates/:id", wrapper.EditTaskTemplate)
+	router.POST(options.BaseURL+"/templates/:id/instantiate", wrapper.InstantiateTaskTemplate)
+	router.GET(options.BaseURL+"/time-entries/estimate-report", wrapper.GetEstimateReport)
+	router.GET(options.BaseURL+"/time-entries/report", wrapper.GetTimeReport)
+	router.GET(options.BaseURL+"/time-entries/running", wrapper.GetRunningTimer)
+	router.DELETE(options.BaseURL+"/time-entries/:id", wrapper.DeleteTimeEntry)
+	router.PATCH(options.BaseURL+"/time-entries/:id", wrapper.EditTimeEntry)
+	router.GET(options.BaseURL+"/views", wrapper.GetTaskViewList)
+	router.POST(options.BaseURL+"/views", wrapper.CreateTaskView)
+	router.DELETE(options.BaseURL+"/views/:id", wrapper.DeleteTaskView)
+	router.GET(options.BaseURL+"/views/:id", wrapper.GetTaskView)
+	router.PATCH(options.BaseURL+"/views/:id", wrapper.EditTaskView)
+	router.GET(options.BaseURL+"/views/:id/tasks", wrapper.GetTaskViewTasks)
 }
@@ -33,9 +33,23 @@ func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
 
 type joins[Q dialect.Joinable] struct {
 	AiInterpretations   joinSet[aiInterpretationJoins[Q]]
+	CalendarFeeds       joinSet[calendarFeedJoins[Q]]
+	IdempotencyKeys     joinSet[idempotencyKeyJoins[Q]]
 	InterpretationItems joinSet[interpretationItemJoins[Q]]
+	Notifications       joinSet[notificationJoins[Q]]
+	Projects            joinSet[projectJoins[Q]]
+	TaskAttachments     joinSet[taskAttachmentJoins[Q]]
+	TaskDependencies    joinSet[taskDependencyJoins[Q]]
+	TaskEvents          joinSet[taskEventJoins[Q]]
+	TaskImports         joinSet[taskImportJoins[Q]]
+	TaskReminders       joinSet[taskReminderJoins[Q]]
+	TaskStatuses        joinSet[taskStatusJoins[Q]]
+	TaskTemplates       joinSet[taskTemplateJoins[Q]]
+	TaskViews           joinSet[taskViewJoins[Q]]
 	Tasks               joinSet[taskJoins[Q]]
+	TimeEntries         joinSet[timeEntryJoins[Q]]
 	UserAuths           joinSet[userAuthJoins[Q]]
+	UserSettings        joinSet[userSettingJoins[Q]]
 	Users               joinSet[userJoins[Q]]
 }
 
@@ -50,9 +64,23 @@ func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q
 func getJoins[Q dialect.Joinable]() joins[Q] {
 	return joins[Q]{
 		AiInterpretations:   buildJoinSet[aiInterpretationJoins[Q]](AiInterpretations.Columns, buildAiInterpretationJoins),
+		CalendarFeeds:       buildJoinSet[calendarFeedJoins[Q]](CalendarFeeds.Columns, buildCalendarFeedJoins),
+		IdempotencyKeys:     buildJoinSet[idempotencyKeyJoins[Q]](IdempotencyKeys.Columns, buildIdempotencyKeyJoins),
 		InterpretationItems: buildJoinSet[interpretationItemJoins[Q]](InterpretationItems.Columns, buildInterpretationItemJoins),
+		Notifications:       buildJoinSet[notificationJoins[Q]](Notifications.Columns, buildNotificationJoins),
+		Projects:            buildJoinSet[projectJoins[Q]](Projects.Columns, buildProjectJoins),
+		TaskAttachments:     buildJoinSet[taskAttachmentJoins[Q]](TaskAttachments.Columns, buildTaskAttachmentJoins),
+		TaskDependencies:    buildJoinSet[taskDependencyJoins[Q]](TaskDependencies.Columns, buildTaskDependencyJoins),
+		TaskEvents:          buildJoinSet[taskEventJoins[Q]](TaskEvents.Columns, buildTaskEventJoins),
+		TaskImports:         buildJoinSet[taskImportJoins[Q]](TaskImports.Columns, buildTaskImportJoins),
+		TaskReminders:       buildJoinSet[taskReminderJoins[Q]](TaskReminders.Columns, buildTaskReminderJoins),
+		TaskStatuses:        buildJoinSet[taskStatusJoins[Q]](TaskStatuses.Columns, buildTaskStatusJoins),
+		TaskTemplates:       buildJoinSet[taskTemplateJoins[Q]](TaskTemplates.Columns, buildTaskTemplateJoins),
+		TaskViews:           buildJoinSet[taskViewJoins[Q]](TaskViews.Columns, buildTaskViewJoins),
 		Tasks:               buildJoinSet[taskJoins[Q]](Tasks.Columns, buildTaskJoins),
+		TimeEntries:         buildJoinSet[timeEntryJoins[Q]](TimeEntries.Columns, buildTimeEntryJoins),
 		UserAuths:           buildJoinSet[userAuthJoins[Q]](UserAuths.Columns, buildUserAuthJoins),
+		UserSettings:        buildJoinSet[userSettingJoins[Q]](UserSettings.Columns, buildUserSettingJoins),
 		Users:               buildJoinSet[userJoins[Q]](Users.Columns, buildUserJoins),
 	}
 }
@@ -18,18 +18,46 @@ var Preload = getPreloaders()
 
 type preloaders struct {
 	AiInterpretation   aiInterpretationPreloader
+	CalendarFeed       calendarFeedPreloader
+	IdempotencyKey     idempotencyKeyPreloader
 	InterpretationItem interpretationItemPreloader
+	Notification       notificationPreloader
+	Project            projectPreloader
+	TaskAttachment     taskAttachmentPreloader
+	TaskDependency     taskDependencyPreloader
+	TaskEvent          taskEventPreloader
+	TaskImport         taskImportPreloader
+	TaskReminder       taskReminderPreloader
+	TaskStatus         taskStatusPreloader
+	TaskTemplate       taskTemplatePreloader
+	TaskView           taskViewPreloader
 	Task               taskPreloader
+	TimeEntry          timeEntryPreloader
 	UserAuth           userAuthPreloader
+	UserSetting        userSettingPreloader
 	User               userPreloader
 }
 
 func getPreloaders() preloaders {
 	return preloaders{
 		AiInterpretation:   buildAiInterpretationPreloader(),
+		CalendarFeed:       buildCalendarFeedPreloader(),
+		IdempotencyKey:     buildIdempotencyKeyPreloader(),
 		InterpretationItem: buildInterpretationItemPreloader(),
+		Notification:       buildNotificationPreloader(),
+		Project:            buildProjectPreloader(),
+		TaskAttachment:     buildTaskAttachmentPreloader(),
+		TaskDependency:     buildTaskDependencyPreloader(),
+		TaskEvent:          buildTaskEventPreloader(),
+		TaskImport:         buildTaskImportPreloader(),
+		TaskReminder:       buildTaskReminderPreloader(),
+		TaskStatus:         buildTaskStatusPreloader(),
+		TaskTemplate:       buildTaskTemplatePreloader(),
+		TaskView:           buildTaskViewPreloader(),
 		Task:               buildTaskPreloader(),
+		TimeEntry:          buildTimeEntryPreloader(),
 		UserAuth:           buildUserAuthPreloader(),
+		UserSetting:        buildUserSettingPreloader(),
 		User:               buildUserPreloader(),
 	}
 }
@@ -38,18 +66,46 @@ var SelectThenLoad = getThenLoaders[*dialect.SelectQuery]()
 
 type thenLoaders[Q orm.Loadable] struct {
 	AiInterpretation   aiInterpretationThenLoader[Q]
+	CalendarFeed       calendarFeedThenLoader[Q]
+	IdempotencyKey     idempotencyKeyThenLoader[Q]
 	InterpretationItem interpretationItemThenLoader[Q]
+	Notification       notificationThenLoader[Q]
+	Project            projectThenLoader[Q]
+	TaskAttachment     taskAttachmentThenLoader[Q]
+	TaskDependency     taskDependencyThenLoader[Q]
+	TaskEvent          taskEventThenLoader[Q]
+	TaskImport         taskImportThenLoader[Q]
+	TaskReminder       taskReminderThenLoader[Q]
+	TaskStatus         taskStatusThenLoader[Q]
+	TaskTemplate       taskTemplateThenLoader[Q]
+	TaskView           taskViewThenLoader[Q]
 	Task               taskThenLoader[Q]
+	TimeEntry          timeEntryThenLoader[Q]
 	UserAuth           userAuthThenLoader[Q]
+	UserSetting        userSettingThenLoader[Q]
 	User               userThenLoader[Q]
 }
 
 func getThenLoaders[Q orm.Loadable]() thenLoaders[Q] {
 	return thenLoaders[Q]{
 		AiInterpretation:   buildAiInterpretationThenLoader[Q](),
+		CalendarFeed:       buildCalendarFeedThenLoader[Q](),
+		IdempotencyKey:     buildIdempotencyKeyThenLoader[Q](),
 		InterpretationItem: buildInterpretationItemThenLoader[Q](),
+		Notification:       buildNotificationThenLoader[Q](),
+		Project:            buildProjectThenLoader[Q](),
+		TaskAttachment:     buildTaskAttachmentThenLoader[Q](),
+		TaskDependency:     buildTaskDependencyThenLoader[Q](),
+		TaskEvent:          buildTaskEventThenLoader[Q](),
+		TaskImport:         buildTaskImportThenLoader[Q](),
+		TaskReminder:       buildTaskReminderThenLoader[Q](),
+		TaskStatus:         buildTaskStatusThenLoader[Q](),
+		TaskTemplate:       buildTaskTemplateThenLoader[Q](),
+		TaskView:           buildTaskViewThenLoader[Q](),
 		Task:               buildTaskThenLoader[Q](),
+		TimeEntry:          buildTimeEntryThenLoader[Q](),
 		UserAuth:           buildUserAuthThenLoader[Q](),
+		UserSetting:        buildUserSettingThenLoader[Q](),
 		User:               buildUserThenLoader[Q](),
 	}
 }
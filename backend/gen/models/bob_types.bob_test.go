@@ -18,15 +18,57 @@ var testDB bob.Transactor[bob.Tx]
 // Make sure the type AiInterpretation runs hooks after queries
 var _ bob.HookableType = &AiInterpretation{}
 
+// Make sure the type CalendarFeed runs hooks after queries
+var _ bob.HookableType = &CalendarFeed{}
+
+// Make sure the type IdempotencyKey runs hooks after queries
+var _ bob.HookableType = &IdempotencyKey{}
+
 // Make sure the type InterpretationItem runs hooks after queries
 var _ bob.HookableType = &InterpretationItem{}
 
+// Make sure the type Notification runs hooks after queries
+var _ bob.HookableType = &Notification{}
+
+// Make sure the type Project runs hooks after queries
+var _ bob.HookableType = &Project{}
+
+// Make sure the type TaskAttachment runs hooks after queries
+var _ bob.HookableType = &TaskAttachment{}
+
+// Make sure the type TaskDependency runs hooks after queries
+var _ bob.HookableType = &TaskDependency{}
+
+// Make sure the type TaskEvent runs hooks after queries
+var _ bob.HookableType = &TaskEvent{}
+
+// Make sure the type TaskImport runs hooks after queries
+var _ bob.HookableType = &TaskImport{}
+
+// Make sure the type TaskReminder runs hooks after queries
+var _ bob.HookableType = &TaskReminder{}
+
+// Make sure the type TaskStatus runs hooks after queries
+var _ bob.HookableType = &TaskStatus{}
+
+// Make sure the type TaskTemplate runs hooks after queries
+var _ bob.HookableType = &TaskTemplate{}
+
+// Make sure the type TaskView runs hooks after queries
+var _ bob.HookableType = &TaskView{}
+
 // Make sure the type Task runs hooks after queries
 var _ bob.HookableType = &Task{}
 
+// Make sure the type TimeEntry runs hooks after queries
+var _ bob.HookableType = &TimeEntry{}
+
 // Make sure the type UserAuth runs hooks after queries
 var _ bob.HookableType = &UserAuth{}
 
+// Make sure the type UserSetting runs hooks after queries
+var _ bob.HookableType = &UserSetting{}
+
 // Make sure the type User runs hooks after queries
 var _ bob.HookableType = &User{}
 
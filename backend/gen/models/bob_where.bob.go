@@ -18,22 +18,64 @@ var (
 
 func Where[Q mysql.Filterable]() struct {
 	AiInterpretations   aiInterpretationWhere[Q]
+	CalendarFeeds       calendarFeedWhere[Q]
+	IdempotencyKeys     idempotencyKeyWhere[Q]
 	InterpretationItems interpretationItemWhere[Q]
+	Notifications       notificationWhere[Q]
+	Projects            projectWhere[Q]
+	TaskAttachments     taskAttachmentWhere[Q]
+	TaskDependencies    taskDependencyWhere[Q]
+	TaskEvents          taskEventWhere[Q]
+	TaskImports         taskImportWhere[Q]
+	TaskReminders       taskReminderWhere[Q]
+	TaskStatuses        taskStatusWhere[Q]
+	TaskTemplates       taskTemplateWhere[Q]
+	TaskViews           taskViewWhere[Q]
 	Tasks               taskWhere[Q]
+	TimeEntries         timeEntryWhere[Q]
 	UserAuths           userAuthWhere[Q]
+	UserSettings        userSettingWhere[Q]
 	Users               userWhere[Q]
 } {
 	return struct {
 		AiInterpretations   aiInterpretationWhere[Q]
+		CalendarFeeds       calendarFeedWhere[Q]
+		IdempotencyKeys     idempotencyKeyWhere[Q]
 		InterpretationItems interpretationItemWhere[Q]
+		Notifications       notificationWhere[Q]
+		Projects            projectWhere[Q]
+		TaskAttachments     taskAttachmentWhere[Q]
+		TaskDependencies    taskDependencyWhere[Q]
+		TaskEvents          taskEventWhere[Q]
+		TaskImports         taskImportWhere[Q]
+		TaskReminders       taskReminderWhere[Q]
+		TaskStatuses        taskStatusWhere[Q]
+		TaskTemplates       taskTemplateWhere[Q]
+		TaskViews           taskViewWhere[Q]
 		Tasks               taskWhere[Q]
+		TimeEntries         timeEntryWhere[Q]
 		UserAuths           userAuthWhere[Q]
+		UserSettings        userSettingWhere[Q]
 		Users               userWhere[Q]
 	}{
 		AiInterpretations:   buildAiInterpretationWhere[Q](AiInterpretations.Columns),
+		CalendarFeeds:       buildCalendarFeedWhere[Q](CalendarFeeds.Columns),
+		IdempotencyKeys:     buildIdempotencyKeyWhere[Q](IdempotencyKeys.Columns),
 		InterpretationItems: buildInterpretationItemWhere[Q](InterpretationItems.Columns),
+		Notifications:       buildNotificationWhere[Q](Notifications.Columns),
+		Projects:            buildProjectWhere[Q](Projects.Columns),
+		TaskAttachments:     buildTaskAttachmentWhere[Q](TaskAttachments.Columns),
+		TaskDependencies:    buildTaskDependencyWhere[Q](TaskDependencies.Columns),
+		TaskEvents:          buildTaskEventWhere[Q](TaskEvents.Columns),
+		TaskImports:         buildTaskImportWhere[Q](TaskImports.Columns),
+		TaskReminders:       buildTaskReminderWhere[Q](TaskReminders.Columns),
+		TaskStatuses:        buildTaskStatusWhere[Q](TaskStatuses.Columns),
+		TaskTemplates:       buildTaskTemplateWhere[Q](TaskTemplates.Columns),
+		TaskViews:           buildTaskViewWhere[Q](TaskViews.Columns),
 		Tasks:               buildTaskWhere[Q](Tasks.Columns),
+		TimeEntries:         buildTimeEntryWhere[Q](TimeEntries.Columns),
 		UserAuths:           buildUserAuthWhere[Q](UserAuths.Columns),
+		UserSettings:        buildUserSettingWhere[Q](UserSettings.Columns),
 		Users:               buildUserWhere[Q](Users.Columns),
 	}
 }
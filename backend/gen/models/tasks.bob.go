@@ -29,22 +29,50 @@ type Task struct {
 	ID string `db:"id,pk" `
 	// ãƒ¦ãƒ¼ã‚¶ãƒ¼ID
 	UserID string `db:"user_id" `
+	// プロジェクトID
+	ProjectID null.Val[string] `db:"project_id" `
 	// タスクタイトル
 	Title string `db:"title" `
 	// タスク詳細
 	Description null.Val[string] `db:"description" `
 	// 期限日時
 	DueAt null.Val[time.Time] `db:"due_at" `
+	// 見積もり工数（分）
+	EstimateMinutes null.Val[int32] `db:"estimate_minutes" `
 	// ステータス（pending/in_progress/completed）
 	Status string `db:"status" `
+	// ステータスのカテゴリ（todo/doing/done、task_statuses.categoryの複製）
+	StatusCategory string `db:"status_category" `
+	// 列内の表示順キー（辞書順、空文字列は未配置）
+	RankKey string `db:"rank_key" `
+	// 着手日時（doingカテゴリのステータスに変更した日時、todoカテゴリに戻すとNULL）
+	StartedAt null.Val[time.Time] `db:"started_at" `
+	// 完了日時（doneカテゴリのステータスに変更した日時、未完了に戻すとNULL）
+	CompletedAt null.Val[time.Time] `db:"completed_at" `
+	// スヌーズの解除日時（この日時まで既定の一覧に表示しない、NULLはスヌーズなし）
+	SnoozedUntil null.Val[time.Time] `db:"snoozed_until" `
+	// スヌーズ解除時に通知するかどうか
+	SnoozeNotify bool `db:"snooze_notify" `
+	// アーカイブ日時（NULLは未アーカイブ、アーカイブしたタスクは既定の一覧に表示しない）
+	ArchivedAt null.Val[time.Time] `db:"archived_at" `
 	// ä½œæˆå…ƒ
 	Source string `db:"source" `
 	// å…ƒã®AIè§£é‡ˆID
 	AiInterpretationID null.Val[string] `db:"ai_interpretation_id" `
+	// 繰り返しルール（RFC 5545 RRULE）
+	RecurrenceRule null.Val[string] `db:"recurrence_rule" `
+	// 繰り返しの起点日時（DTSTART）
+	RecurrenceAnchorAt null.Val[time.Time] `db:"recurrence_anchor_at" `
+	// 繰り返しシリーズID
+	RecurrenceSeriesID null.Val[string] `db:"recurrence_series_id" `
+	// 楽観的排他制御用のバージョン（更新ごとに加算、ETagとして公開）
+	Version int32 `db:"version" `
 	// 作成日時
 	CreatedAt time.Time `db:"created_at" `
 	// 更新日時
 	UpdatedAt time.Time `db:"updated_at" `
+	// 削除日時（NULLは未削除、保持期間の経過後に完全削除）
+	DeletedAt null.Val[time.Time] `db:"deleted_at" `
 
 	R taskR `db:"-" `
 }
@@ -61,26 +89,48 @@ type TasksQuery = *mysql.ViewQuery[*Task, TaskSlice]
 
 // taskR is where relationships are stored.
 type taskR struct {
-	AiInterpretation *AiInterpretation // fk_tasks_ai_interpretation
-	User             *User             // fk_tasks_user
+	Notifications                 NotificationSlice   // fk_notifications_task
+	TaskAttachments               TaskAttachmentSlice // fk_task_attachments_task
+	DependsOnTaskTaskDependencies TaskDependencySlice // fk_task_dependencies_depends_on
+	TaskDependencies              TaskDependencySlice // fk_task_dependencies_task
+	TaskImports                   TaskImportSlice     // fk_task_imports_task
+	TaskReminders                 TaskReminderSlice   // fk_task_reminders_task
+	AiInterpretation              *AiInterpretation   // fk_tasks_ai_interpretation
+	Project                       *Project            // fk_tasks_project
+	User                          *User               // fk_tasks_user
+	TimeEntries                   TimeEntrySlice      // fk_time_entries_task
 }
 
 func buildTaskColumns(alias string) taskColumns {
 	return taskColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "user_id", "title", "description", "due_at", "status", "source", "ai_interpretation_id", "created_at", "updated_at",
+			"id", "user_id", "project_id", "title", "description", "due_at", "estimate_minutes", "status", "status_category", "rank_key", "started_at", "completed_at", "snoozed_until", "snooze_notify", "archived_at", "source", "ai_interpretation_id", "recurrence_rule", "recurrence_anchor_at", "recurrence_series_id", "version", "created_at", "updated_at", "deleted_at",
 		).WithParent("tasks"),
 		tableAlias:         alias,
 		ID:                 mysql.Quote(alias, "id"),
 		UserID:             mysql.Quote(alias, "user_id"),
+		ProjectID:          mysql.Quote(alias, "project_id"),
 		Title:              mysql.Quote(alias, "title"),
 		Description:        mysql.Quote(alias, "description"),
 		DueAt:              mysql.Quote(alias, "due_at"),
+		EstimateMinutes:    mysql.Quote(alias, "estimate_minutes"),
 		Status:             mysql.Quote(alias, "status"),
+		StatusCategory:     mysql.Quote(alias, "status_category"),
+		RankKey:            mysql.Quote(alias, "rank_key"),
+		StartedAt:          mysql.Quote(alias, "started_at"),
+		CompletedAt:        mysql.Quote(alias, "completed_at"),
+		SnoozedUntil:       mysql.Quote(alias, "snoozed_until"),
+		SnoozeNotify:       mysql.Quote(alias, "snooze_notify"),
+		ArchivedAt:         mysql.Quote(alias, "archived_at"),
 		Source:             mysql.Quote(alias, "source"),
 		AiInterpretationID: mysql.Quote(alias, "ai_interpretation_id"),
+		RecurrenceRule:     mysql.Quote(alias, "recurrence_rule"),
+		RecurrenceAnchorAt: mysql.Quote(alias, "recurrence_anchor_at"),
+		RecurrenceSeriesID: mysql.Quote(alias, "recurrence_series_id"),
+		Version:            mysql.Quote(alias, "version"),
 		CreatedAt:          mysql.Quote(alias, "created_at"),
 		UpdatedAt:          mysql.Quote(alias, "updated_at"),
+		DeletedAt:          mysql.Quote(alias, "deleted_at"),
 	}
 }
 
@@ -89,14 +139,28 @@ type taskColumns struct {
 	tableAlias         string
 	ID                 mysql.Expression
 	UserID             mysql.Expression
+	ProjectID          mysql.Expression
 	Title              mysql.Expression
 	Description        mysql.Expression
 	DueAt              mysql.Expression
+	EstimateMinutes    mysql.Expression
 	Status             mysql.Expression
+	StatusCategory     mysql.Expression
+	RankKey            mysql.Expression
+	StartedAt          mysql.Expression
+	CompletedAt        mysql.Expression
+	SnoozedUntil       mysql.Expression
+	SnoozeNotify       mysql.Expression
+	ArchivedAt         mysql.Expression
 	Source             mysql.Expression
 	AiInterpretationID mysql.Expression
+	RecurrenceRule     mysql.Expression
+	RecurrenceAnchorAt mysql.Expression
+	RecurrenceSeriesID mysql.Expression
+	Version            mysql.Expression
 	CreatedAt          mysql.Expression
 	UpdatedAt          mysql.Expression
+	DeletedAt          mysql.Expression
 }
 
 func (c taskColumns) Alias() string {
@@ -113,24 +177,41 @@ func (taskColumns) AliasedAs(alias string) taskColumns {
 type TaskSetter struct {
 	ID                 omit.Val[string]        `db:"id,pk" `
 	UserID             omit.Val[string]        `db:"user_id" `
+	ProjectID          omitnull.Val[string]    `db:"project_id" `
 	Title              omit.Val[string]        `db:"title" `
 	Description        omitnull.Val[string]    `db:"description" `
 	DueAt              omitnull.Val[time.Time] `db:"due_at" `
+	EstimateMinutes    omitnull.Val[int32]     `db:"estimate_minutes" `
 	Status             omit.Val[string]        `db:"status" `
+	StatusCategory     omit.Val[string]        `db:"status_category" `
+	RankKey            omit.Val[string]        `db:"rank_key" `
+	StartedAt          omitnull.Val[time.Time] `db:"started_at" `
+	CompletedAt        omitnull.Val[time.Time] `db:"completed_at" `
+	SnoozedUntil       omitnull.Val[time.Time] `db:"snoozed_until" `
+	SnoozeNotify       omit.Val[bool]          `db:"snooze_notify" `
+	ArchivedAt         omitnull.Val[time.Time] `db:"archived_at" `
 	Source             omit.Val[string]        `db:"source" `
 	AiInterpretationID omitnull.Val[string]    `db:"ai_interpretation_id" `
+	RecurrenceRule     omitnull.Val[string]    `db:"recurrence_rule" `
+	RecurrenceAnchorAt omitnull.Val[time.Time] `db:"recurrence_anchor_at" `
+	RecurrenceSeriesID omitnull.Val[string]    `db:"recurrence_series_id" `
+	Version            omit.Val[int32]         `db:"version" `
 	CreatedAt          omit.Val[time.Time]     `db:"created_at" `
 	UpdatedAt          omit.Val[time.Time]     `db:"updated_at" `
+	DeletedAt          omitnull.Val[time.Time] `db:"deleted_at" `
 }
 
 func (s TaskSetter) SetColumns() []string {
-	vals := make([]string, 0, 10)
+	vals := make([]string, 0, 24)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
 	if s.UserID.IsValue() {
 		vals = append(vals, "user_id")
 	}
+	if !s.ProjectID.IsUnset() {
+		vals = append(vals, "project_id")
+	}
 	if s.Title.IsValue() {
 		vals = append(vals, "title")
 	}
@@ -140,21 +221,60 @@ func (s TaskSetter) SetColumns() []string {
 	if !s.DueAt.IsUnset() {
 		vals = append(vals, "due_at")
 	}
+	if !s.EstimateMinutes.IsUnset() {
+		vals = append(vals, "estimate_minutes")
+	}
 	if s.Status.IsValue() {
 		vals = append(vals, "status")
 	}
+	if s.StatusCategory.IsValue() {
+		vals = append(vals, "status_category")
+	}
+	if s.RankKey.IsValue() {
+		vals = append(vals, "rank_key")
+	}
+	if !s.StartedAt.IsUnset() {
+		vals = append(vals, "started_at")
+	}
+	if !s.CompletedAt.IsUnset() {
+		vals = append(vals, "completed_at")
+	}
+	if !s.SnoozedUntil.IsUnset() {
+		vals = append(vals, "snoozed_until")
+	}
+	if s.SnoozeNotify.IsValue() {
+		vals = append(vals, "snooze_notify")
+	}
+	if !s.ArchivedAt.IsUnset() {
+		vals = append(vals, "archived_at")
+	}
 	if s.Source.IsValue() {
 		vals = append(vals, "source")
 	}
 	if !s.AiInterpretationID.IsUnset() {
 		vals = append(vals, "ai_interpretation_id")
 	}
+	if !s.RecurrenceRule.IsUnset() {
+		vals = append(vals, "recurrence_rule")
+	}
+	if !s.RecurrenceAnchorAt.IsUnset() {
+		vals = append(vals, "recurrence_anchor_at")
+	}
+	if !s.RecurrenceSeriesID.IsUnset() {
+		vals = append(vals, "recurrence_series_id")
+	}
+	if s.Version.IsValue() {
+		vals = append(vals, "version")
+	}
 	if s.CreatedAt.IsValue() {
 		vals = append(vals, "created_at")
 	}
 	if s.UpdatedAt.IsValue() {
 		vals = append(vals, "updated_at")
 	}
+	if !s.DeletedAt.IsUnset() {
+		vals = append(vals, "deleted_at")
+	}
 	return vals
 }
 
@@ -165,6 +285,9 @@ func (s TaskSetter) Overwrite(t *Task) {
 	if s.UserID.IsValue() {
 		t.UserID = s.UserID.MustGet()
 	}
+	if !s.ProjectID.IsUnset() {
+		t.ProjectID = s.ProjectID.MustGetNull()
+	}
 	if s.Title.IsValue() {
 		t.Title = s.Title.MustGet()
 	}
@@ -174,21 +297,60 @@ func (s TaskSetter) Overwrite(t *Task) {
 	if !s.DueAt.IsUnset() {
 		t.DueAt = s.DueAt.MustGetNull()
 	}
+	if !s.EstimateMinutes.IsUnset() {
+		t.EstimateMinutes = s.EstimateMinutes.MustGetNull()
+	}
 	if s.Status.IsValue() {
 		t.Status = s.Status.MustGet()
 	}
+	if s.StatusCategory.IsValue() {
+		t.StatusCategory = s.StatusCategory.MustGet()
+	}
+	if s.RankKey.IsValue() {
+		t.RankKey = s.RankKey.MustGet()
+	}
+	if !s.StartedAt.IsUnset() {
+		t.StartedAt = s.StartedAt.MustGetNull()
+	}
+	if !s.CompletedAt.IsUnset() {
+		t.CompletedAt = s.CompletedAt.MustGetNull()
+	}
+	if !s.SnoozedUntil.IsUnset() {
+		t.SnoozedUntil = s.SnoozedUntil.MustGetNull()
+	}
+	if s.SnoozeNotify.IsValue() {
+		t.SnoozeNotify = s.SnoozeNotify.MustGet()
+	}
+	if !s.ArchivedAt.IsUnset() {
+		t.ArchivedAt = s.ArchivedAt.MustGetNull()
+	}
 	if s.Source.IsValue() {
 		t.Source = s.Source.MustGet()
 	}
 	if !s.AiInterpretationID.IsUnset() {
 		t.AiInterpretationID = s.AiInterpretationID.MustGetNull()
 	}
+	if !s.RecurrenceRule.IsUnset() {
+		t.RecurrenceRule = s.RecurrenceRule.MustGetNull()
+	}
+	if !s.RecurrenceAnchorAt.IsUnset() {
+		t.RecurrenceAnchorAt = s.RecurrenceAnchorAt.MustGetNull()
+	}
+	if !s.RecurrenceSeriesID.IsUnset() {
+		t.RecurrenceSeriesID = s.RecurrenceSeriesID.MustGetNull()
+	}
+	if s.Version.IsValue() {
+		t.Version = s.Version.MustGet()
+	}
 	if s.CreatedAt.IsValue() {
 		t.CreatedAt = s.CreatedAt.MustGet()
 	}
 	if s.UpdatedAt.IsValue() {
 		t.UpdatedAt = s.UpdatedAt.MustGet()
 	}
+	if !s.DeletedAt.IsUnset() {
+		t.DeletedAt = s.DeletedAt.MustGetNull()
+	}
 }
 
 func (s *TaskSetter) Apply(q *dialect.InsertQuery) {
@@ -207,6 +369,11 @@ func (s *TaskSetter) Apply(q *dialect.InsertQuery) {
 				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
 			}
 			return mysql.Arg(s.UserID.MustGet()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(!s.ProjectID.IsUnset()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.ProjectID.MustGetNull()).WriteSQL(ctx, w, d, start)
 		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
 			if !(s.Title.IsValue()) {
 				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
@@ -222,11 +389,51 @@ func (s *TaskSetter) Apply(q *dialect.InsertQuery) {
 				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
 			}
 			return mysql.Arg(s.DueAt.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(!s.EstimateMinutes.IsUnset()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.EstimateMinutes.MustGetNull()).WriteSQL(ctx, w, d, start)
 		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
 			if !(s.Status.IsValue()) {
 				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
 			}
 			return mysql.Arg(s.Status.MustGet()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(s.StatusCategory.IsValue()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.StatusCategory.MustGet()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(s.RankKey.IsValue()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.RankKey.MustGet()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(!s.StartedAt.IsUnset()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.StartedAt.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(!s.CompletedAt.IsUnset()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.CompletedAt.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(!s.SnoozedUntil.IsUnset()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.SnoozedUntil.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(s.SnoozeNotify.IsValue()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.SnoozeNotify.MustGet()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(!s.ArchivedAt.IsUnset()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.ArchivedAt.MustGetNull()).WriteSQL(ctx, w, d, start)
 		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
 			if !(s.Source.IsValue()) {
 				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
@@ -237,6 +444,26 @@ func (s *TaskSetter) Apply(q *dialect.InsertQuery) {
 				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
 			}
 			return mysql.Arg(s.AiInterpretationID.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(!s.RecurrenceRule.IsUnset()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.RecurrenceRule.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(!s.RecurrenceAnchorAt.IsUnset()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.RecurrenceAnchorAt.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(!s.RecurrenceSeriesID.IsUnset()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.RecurrenceSeriesID.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(s.Version.IsValue()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.Version.MustGet()).WriteSQL(ctx, w, d, start)
 		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
 			if !(s.CreatedAt.IsValue()) {
 				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
@@ -247,6 +474,11 @@ func (s *TaskSetter) Apply(q *dialect.InsertQuery) {
 				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
 			}
 			return mysql.Arg(s.UpdatedAt.MustGet()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+			if !(!s.DeletedAt.IsUnset()) {
+				return mysql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return mysql.Arg(s.DeletedAt.MustGetNull()).WriteSQL(ctx, w, d, start)
 		}))
 }
 
@@ -255,7 +487,7 @@ func (s TaskSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s TaskSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 10)
+	exprs := make([]bob.Expression, 0, 24)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -271,6 +503,13 @@ func (s TaskSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.ProjectID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "project_id")...),
+			mysql.Arg(s.ProjectID),
+		}})
+	}
+
 	if s.Title.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
 			mysql.Quote(append(prefix, "title")...),
@@ -292,6 +531,13 @@ func (s TaskSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.EstimateMinutes.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "estimate_minutes")...),
+			mysql.Arg(s.EstimateMinutes),
+		}})
+	}
+
 	if s.Status.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
 			mysql.Quote(append(prefix, "status")...),
@@ -299,6 +545,55 @@ func (s TaskSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.StatusCategory.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "status_category")...),
+			mysql.Arg(s.StatusCategory),
+		}})
+	}
+
+	if s.RankKey.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "rank_key")...),
+			mysql.Arg(s.RankKey),
+		}})
+	}
+
+	if !s.StartedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "started_at")...),
+			mysql.Arg(s.StartedAt),
+		}})
+	}
+
+	if !s.CompletedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "completed_at")...),
+			mysql.Arg(s.CompletedAt),
+		}})
+	}
+
+	if !s.SnoozedUntil.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "snoozed_until")...),
+			mysql.Arg(s.SnoozedUntil),
+		}})
+	}
+
+	if s.SnoozeNotify.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "snooze_notify")...),
+			mysql.Arg(s.SnoozeNotify),
+		}})
+	}
+
+	if !s.ArchivedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "archived_at")...),
+			mysql.Arg(s.ArchivedAt),
+		}})
+	}
+
 	if s.Source.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
 			mysql.Quote(append(prefix, "source")...),
@@ -313,6 +608,34 @@ func (s TaskSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.RecurrenceRule.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "recurrence_rule")...),
+			mysql.Arg(s.RecurrenceRule),
+		}})
+	}
+
+	if !s.RecurrenceAnchorAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "recurrence_anchor_at")...),
+			mysql.Arg(s.RecurrenceAnchorAt),
+		}})
+	}
+
+	if !s.RecurrenceSeriesID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "recurrence_series_id")...),
+			mysql.Arg(s.RecurrenceSeriesID),
+		}})
+	}
+
+	if s.Version.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "version")...),
+			mysql.Arg(s.Version),
+		}})
+	}
+
 	if s.CreatedAt.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
 			mysql.Quote(append(prefix, "created_at")...),
@@ -327,6 +650,13 @@ func (s TaskSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.DeletedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			mysql.Quote(append(prefix, "deleted_at")...),
+			mysql.Arg(s.DeletedAt),
+		}})
+	}
+
 	return exprs
 }
 
@@ -553,6 +883,120 @@ func (o TaskSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
+// Notifications starts a query for related objects on notifications
+func (o *Task) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(Notifications.Columns.TaskID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os TaskSlice) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return Notifications.Query(append(mods,
+		sm.Where(mysql.Group(Notifications.Columns.TaskID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TaskAttachments starts a query for related objects on task_attachments
+func (o *Task) TaskAttachments(mods ...bob.Mod[*dialect.SelectQuery]) TaskAttachmentsQuery {
+	return TaskAttachments.Query(append(mods,
+		sm.Where(TaskAttachments.Columns.TaskID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os TaskSlice) TaskAttachments(mods ...bob.Mod[*dialect.SelectQuery]) TaskAttachmentsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskAttachments.Query(append(mods,
+		sm.Where(mysql.Group(TaskAttachments.Columns.TaskID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// DependsOnTaskTaskDependencies starts a query for related objects on task_dependencies
+func (o *Task) DependsOnTaskTaskDependencies(mods ...bob.Mod[*dialect.SelectQuery]) TaskDependenciesQuery {
+	return TaskDependencies.Query(append(mods,
+		sm.Where(TaskDependencies.Columns.DependsOnTaskID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os TaskSlice) DependsOnTaskTaskDependencies(mods ...bob.Mod[*dialect.SelectQuery]) TaskDependenciesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskDependencies.Query(append(mods,
+		sm.Where(mysql.Group(TaskDependencies.Columns.DependsOnTaskID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TaskDependencies starts a query for related objects on task_dependencies
+func (o *Task) TaskDependencies(mods ...bob.Mod[*dialect.SelectQuery]) TaskDependenciesQuery {
+	return TaskDependencies.Query(append(mods,
+		sm.Where(TaskDependencies.Columns.TaskID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os TaskSlice) TaskDependencies(mods ...bob.Mod[*dialect.SelectQuery]) TaskDependenciesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskDependencies.Query(append(mods,
+		sm.Where(mysql.Group(TaskDependencies.Columns.TaskID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TaskImports starts a query for related objects on task_imports
+func (o *Task) TaskImports(mods ...bob.Mod[*dialect.SelectQuery]) TaskImportsQuery {
+	return TaskImports.Query(append(mods,
+		sm.Where(TaskImports.Columns.TaskID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os TaskSlice) TaskImports(mods ...bob.Mod[*dialect.SelectQuery]) TaskImportsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskImports.Query(append(mods,
+		sm.Where(mysql.Group(TaskImports.Columns.TaskID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TaskReminders starts a query for related objects on task_reminders
+func (o *Task) TaskReminders(mods ...bob.Mod[*dialect.SelectQuery]) TaskRemindersQuery {
+	return TaskReminders.Query(append(mods,
+		sm.Where(TaskReminders.Columns.TaskID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os TaskSlice) TaskReminders(mods ...bob.Mod[*dialect.SelectQuery]) TaskRemindersQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskReminders.Query(append(mods,
+		sm.Where(mysql.Group(TaskReminders.Columns.TaskID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 // AiInterpretation starts a query for related objects on ai_interpretations
 func (o *Task) AiInterpretation(mods ...bob.Mod[*dialect.SelectQuery]) AiInterpretationsQuery {
 	return AiInterpretations.Query(append(mods,
@@ -572,6 +1016,25 @@ func (os TaskSlice) AiInterpretation(mods ...bob.Mod[*dialect.SelectQuery]) AiIn
 	)...)
 }
 
+// Project starts a query for related objects on projects
+func (o *Task) Project(mods ...bob.Mod[*dialect.SelectQuery]) ProjectsQuery {
+	return Projects.Query(append(mods,
+		sm.Where(Projects.Columns.ID.EQ(mysql.Arg(o.ProjectID))),
+	)...)
+}
+
+func (os TaskSlice) Project(mods ...bob.Mod[*dialect.SelectQuery]) ProjectsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ProjectID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return Projects.Query(append(mods,
+		sm.Where(mysql.Group(Projects.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 // User starts a query for related objects on users
 func (o *Task) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
 	return Users.Query(append(mods,
@@ -591,232 +1054,1376 @@ func (os TaskSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
 	)...)
 }
 
-func attachTaskAiInterpretation0(ctx context.Context, exec bob.Executor, count int, task0 *Task, aiInterpretation1 *AiInterpretation) (*Task, error) {
-	setter := &TaskSetter{
-		AiInterpretationID: omitnull.From(aiInterpretation1.ID),
+// TimeEntries starts a query for related objects on time_entries
+func (o *Task) TimeEntries(mods ...bob.Mod[*dialect.SelectQuery]) TimeEntriesQuery {
+	return TimeEntries.Query(append(mods,
+		sm.Where(TimeEntries.Columns.TaskID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os TaskSlice) TimeEntries(mods ...bob.Mod[*dialect.SelectQuery]) TimeEntriesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
 	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
 
-	err := task0.Update(ctx, exec, setter)
+	return TimeEntries.Query(append(mods,
+		sm.Where(mysql.Group(TimeEntries.Columns.TaskID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertTaskNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, task0 *Task) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].TaskID = omitnull.From(task0.ID)
+	}
+
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
 	if err != nil {
-		return nil, fmt.Errorf("attachTaskAiInterpretation0: %w", err)
+		return ret, fmt.Errorf("insertTaskNotifications0: %w", err)
 	}
 
-	return task0, nil
+	return ret, nil
 }
 
-func (task0 *Task) InsertAiInterpretation(ctx context.Context, exec bob.Executor, related *AiInterpretationSetter) error {
-	var err error
+func attachTaskNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, task0 *Task) (NotificationSlice, error) {
+	setter := &NotificationSetter{
+		TaskID: omitnull.From(task0.ID),
+	}
 
-	aiInterpretation1, err := AiInterpretations.Insert(related).One(ctx, exec)
+	err := notifications1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return fmt.Errorf("inserting related objects: %w", err)
+		return nil, fmt.Errorf("attachTaskNotifications0: %w", err)
 	}
 
-	_, err = attachTaskAiInterpretation0(ctx, exec, 1, task0, aiInterpretation1)
+	return notifications1, nil
+}
+
+func (task0 *Task) InsertNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notifications1, err := insertTaskNotifications0(ctx, exec, related, task0)
 	if err != nil {
 		return err
 	}
 
-	task0.R.AiInterpretation = aiInterpretation1
-
-	aiInterpretation1.R.Tasks = append(aiInterpretation1.R.Tasks, task0)
+	task0.R.Notifications = append(task0.R.Notifications, notifications1...)
 
+	for _, rel := range notifications1 {
+		rel.R.Task = task0
+	}
 	return nil
 }
 
-func (task0 *Task) AttachAiInterpretation(ctx context.Context, exec bob.Executor, aiInterpretation1 *AiInterpretation) error {
+func (task0 *Task) AttachNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
 	var err error
+	notifications1 := NotificationSlice(related)
 
-	_, err = attachTaskAiInterpretation0(ctx, exec, 1, task0, aiInterpretation1)
+	_, err = attachTaskNotifications0(ctx, exec, len(related), notifications1, task0)
 	if err != nil {
 		return err
 	}
 
-	task0.R.AiInterpretation = aiInterpretation1
+	task0.R.Notifications = append(task0.R.Notifications, notifications1...)
 
-	aiInterpretation1.R.Tasks = append(aiInterpretation1.R.Tasks, task0)
+	for _, rel := range related {
+		rel.R.Task = task0
+	}
 
 	return nil
 }
 
-func attachTaskUser0(ctx context.Context, exec bob.Executor, count int, task0 *Task, user1 *User) (*Task, error) {
-	setter := &TaskSetter{
-		UserID: omit.From(user1.ID),
+func insertTaskTaskAttachments0(ctx context.Context, exec bob.Executor, taskAttachments1 []*TaskAttachmentSetter, task0 *Task) (TaskAttachmentSlice, error) {
+	for i := range taskAttachments1 {
+		taskAttachments1[i].TaskID = omit.From(task0.ID)
 	}
 
-	err := task0.Update(ctx, exec, setter)
+	ret, err := TaskAttachments.Insert(bob.ToMods(taskAttachments1...)).All(ctx, exec)
 	if err != nil {
-		return nil, fmt.Errorf("attachTaskUser0: %w", err)
+		return ret, fmt.Errorf("insertTaskTaskAttachments0: %w", err)
 	}
 
-	return task0, nil
+	return ret, nil
 }
 
-func (task0 *Task) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
-	var err error
+func attachTaskTaskAttachments0(ctx context.Context, exec bob.Executor, count int, taskAttachments1 TaskAttachmentSlice, task0 *Task) (TaskAttachmentSlice, error) {
+	setter := &TaskAttachmentSetter{
+		TaskID: omit.From(task0.ID),
+	}
 
-	user1, err := Users.Insert(related).One(ctx, exec)
+	err := taskAttachments1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return fmt.Errorf("inserting related objects: %w", err)
+		return nil, fmt.Errorf("attachTaskTaskAttachments0: %w", err)
 	}
 
+	return taskAttachments1, nil
+}
+
+func (task0 *Task) InsertTaskAttachments(ctx context.Context, exec bob.Executor, related ...*TaskAttachmentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskAttachments1, err := insertTaskTaskAttachments0(ctx, exec, related, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.TaskAttachments = append(task0.R.TaskAttachments, taskAttachments1...)
+
+	for _, rel := range taskAttachments1 {
+		rel.R.Task = task0
+	}
+	return nil
+}
+
+func (task0 *Task) AttachTaskAttachments(ctx context.Context, exec bob.Executor, related ...*TaskAttachment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskAttachments1 := TaskAttachmentSlice(related)
+
+	_, err = attachTaskTaskAttachments0(ctx, exec, len(related), taskAttachments1, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.TaskAttachments = append(task0.R.TaskAttachments, taskAttachments1...)
+
+	for _, rel := range related {
+		rel.R.Task = task0
+	}
+
+	return nil
+}
+
+func insertTaskDependsOnTaskTaskDependencies0(ctx context.Context, exec bob.Executor, taskDependencies1 []*TaskDependencySetter, task0 *Task) (TaskDependencySlice, error) {
+	for i := range taskDependencies1 {
+		taskDependencies1[i].DependsOnTaskID = omit.From(task0.ID)
+	}
+
+	ret, err := TaskDependencies.Insert(bob.ToMods(taskDependencies1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTaskDependsOnTaskTaskDependencies0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTaskDependsOnTaskTaskDependencies0(ctx context.Context, exec bob.Executor, count int, taskDependencies1 TaskDependencySlice, task0 *Task) (TaskDependencySlice, error) {
+	setter := &TaskDependencySetter{
+		DependsOnTaskID: omit.From(task0.ID),
+	}
+
+	err := taskDependencies1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTaskDependsOnTaskTaskDependencies0: %w", err)
+	}
+
+	return taskDependencies1, nil
+}
+
+func (task0 *Task) InsertDependsOnTaskTaskDependencies(ctx context.Context, exec bob.Executor, related ...*TaskDependencySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskDependencies1, err := insertTaskDependsOnTaskTaskDependencies0(ctx, exec, related, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.DependsOnTaskTaskDependencies = append(task0.R.DependsOnTaskTaskDependencies, taskDependencies1...)
+
+	for _, rel := range taskDependencies1 {
+		rel.R.DependsOnTaskTask = task0
+	}
+	return nil
+}
+
+func (task0 *Task) AttachDependsOnTaskTaskDependencies(ctx context.Context, exec bob.Executor, related ...*TaskDependency) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskDependencies1 := TaskDependencySlice(related)
+
+	_, err = attachTaskDependsOnTaskTaskDependencies0(ctx, exec, len(related), taskDependencies1, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.DependsOnTaskTaskDependencies = append(task0.R.DependsOnTaskTaskDependencies, taskDependencies1...)
+
+	for _, rel := range related {
+		rel.R.DependsOnTaskTask = task0
+	}
+
+	return nil
+}
+
+func insertTaskTaskDependencies0(ctx context.Context, exec bob.Executor, taskDependencies1 []*TaskDependencySetter, task0 *Task) (TaskDependencySlice, error) {
+	for i := range taskDependencies1 {
+		taskDependencies1[i].TaskID = omit.From(task0.ID)
+	}
+
+	ret, err := TaskDependencies.Insert(bob.ToMods(taskDependencies1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTaskTaskDependencies0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTaskTaskDependencies0(ctx context.Context, exec bob.Executor, count int, taskDependencies1 TaskDependencySlice, task0 *Task) (TaskDependencySlice, error) {
+	setter := &TaskDependencySetter{
+		TaskID: omit.From(task0.ID),
+	}
+
+	err := taskDependencies1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTaskTaskDependencies0: %w", err)
+	}
+
+	return taskDependencies1, nil
+}
+
+func (task0 *Task) InsertTaskDependencies(ctx context.Context, exec bob.Executor, related ...*TaskDependencySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskDependencies1, err := insertTaskTaskDependencies0(ctx, exec, related, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.TaskDependencies = append(task0.R.TaskDependencies, taskDependencies1...)
+
+	for _, rel := range taskDependencies1 {
+		rel.R.Task = task0
+	}
+	return nil
+}
+
+func (task0 *Task) AttachTaskDependencies(ctx context.Context, exec bob.Executor, related ...*TaskDependency) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskDependencies1 := TaskDependencySlice(related)
+
+	_, err = attachTaskTaskDependencies0(ctx, exec, len(related), taskDependencies1, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.TaskDependencies = append(task0.R.TaskDependencies, taskDependencies1...)
+
+	for _, rel := range related {
+		rel.R.Task = task0
+	}
+
+	return nil
+}
+
+func insertTaskTaskImports0(ctx context.Context, exec bob.Executor, taskImports1 []*TaskImportSetter, task0 *Task) (TaskImportSlice, error) {
+	for i := range taskImports1 {
+		taskImports1[i].TaskID = omit.From(task0.ID)
+	}
+
+	ret, err := TaskImports.Insert(bob.ToMods(taskImports1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTaskTaskImports0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTaskTaskImports0(ctx context.Context, exec bob.Executor, count int, taskImports1 TaskImportSlice, task0 *Task) (TaskImportSlice, error) {
+	setter := &TaskImportSetter{
+		TaskID: omit.From(task0.ID),
+	}
+
+	err := taskImports1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTaskTaskImports0: %w", err)
+	}
+
+	return taskImports1, nil
+}
+
+func (task0 *Task) InsertTaskImports(ctx context.Context, exec bob.Executor, related ...*TaskImportSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskImports1, err := insertTaskTaskImports0(ctx, exec, related, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.TaskImports = append(task0.R.TaskImports, taskImports1...)
+
+	for _, rel := range taskImports1 {
+		rel.R.Task = task0
+	}
+	return nil
+}
+
+func (task0 *Task) AttachTaskImports(ctx context.Context, exec bob.Executor, related ...*TaskImport) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskImports1 := TaskImportSlice(related)
+
+	_, err = attachTaskTaskImports0(ctx, exec, len(related), taskImports1, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.TaskImports = append(task0.R.TaskImports, taskImports1...)
+
+	for _, rel := range related {
+		rel.R.Task = task0
+	}
+
+	return nil
+}
+
+func insertTaskTaskReminders0(ctx context.Context, exec bob.Executor, taskReminders1 []*TaskReminderSetter, task0 *Task) (TaskReminderSlice, error) {
+	for i := range taskReminders1 {
+		taskReminders1[i].TaskID = omit.From(task0.ID)
+	}
+
+	ret, err := TaskReminders.Insert(bob.ToMods(taskReminders1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTaskTaskReminders0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTaskTaskReminders0(ctx context.Context, exec bob.Executor, count int, taskReminders1 TaskReminderSlice, task0 *Task) (TaskReminderSlice, error) {
+	setter := &TaskReminderSetter{
+		TaskID: omit.From(task0.ID),
+	}
+
+	err := taskReminders1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTaskTaskReminders0: %w", err)
+	}
+
+	return taskReminders1, nil
+}
+
+func (task0 *Task) InsertTaskReminders(ctx context.Context, exec bob.Executor, related ...*TaskReminderSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskReminders1, err := insertTaskTaskReminders0(ctx, exec, related, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.TaskReminders = append(task0.R.TaskReminders, taskReminders1...)
+
+	for _, rel := range taskReminders1 {
+		rel.R.Task = task0
+	}
+	return nil
+}
+
+func (task0 *Task) AttachTaskReminders(ctx context.Context, exec bob.Executor, related ...*TaskReminder) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskReminders1 := TaskReminderSlice(related)
+
+	_, err = attachTaskTaskReminders0(ctx, exec, len(related), taskReminders1, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.TaskReminders = append(task0.R.TaskReminders, taskReminders1...)
+
+	for _, rel := range related {
+		rel.R.Task = task0
+	}
+
+	return nil
+}
+
+func attachTaskAiInterpretation0(ctx context.Context, exec bob.Executor, count int, task0 *Task, aiInterpretation1 *AiInterpretation) (*Task, error) {
+	setter := &TaskSetter{
+		AiInterpretationID: omitnull.From(aiInterpretation1.ID),
+	}
+
+	err := task0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTaskAiInterpretation0: %w", err)
+	}
+
+	return task0, nil
+}
+
+func (task0 *Task) InsertAiInterpretation(ctx context.Context, exec bob.Executor, related *AiInterpretationSetter) error {
+	var err error
+
+	aiInterpretation1, err := AiInterpretations.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTaskAiInterpretation0(ctx, exec, 1, task0, aiInterpretation1)
+	if err != nil {
+		return err
+	}
+
+	task0.R.AiInterpretation = aiInterpretation1
+
+	aiInterpretation1.R.Tasks = append(aiInterpretation1.R.Tasks, task0)
+
+	return nil
+}
+
+func (task0 *Task) AttachAiInterpretation(ctx context.Context, exec bob.Executor, aiInterpretation1 *AiInterpretation) error {
+	var err error
+
+	_, err = attachTaskAiInterpretation0(ctx, exec, 1, task0, aiInterpretation1)
+	if err != nil {
+		return err
+	}
+
+	task0.R.AiInterpretation = aiInterpretation1
+
+	aiInterpretation1.R.Tasks = append(aiInterpretation1.R.Tasks, task0)
+
+	return nil
+}
+
+func attachTaskProject0(ctx context.Context, exec bob.Executor, count int, task0 *Task, project1 *Project) (*Task, error) {
+	setter := &TaskSetter{
+		ProjectID: omitnull.From(project1.ID),
+	}
+
+	err := task0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTaskProject0: %w", err)
+	}
+
+	return task0, nil
+}
+
+func (task0 *Task) InsertProject(ctx context.Context, exec bob.Executor, related *ProjectSetter) error {
+	var err error
+
+	project1, err := Projects.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTaskProject0(ctx, exec, 1, task0, project1)
+	if err != nil {
+		return err
+	}
+
+	task0.R.Project = project1
+
+	project1.R.Tasks = append(project1.R.Tasks, task0)
+
+	return nil
+}
+
+func (task0 *Task) AttachProject(ctx context.Context, exec bob.Executor, project1 *Project) error {
+	var err error
+
+	_, err = attachTaskProject0(ctx, exec, 1, task0, project1)
+	if err != nil {
+		return err
+	}
+
+	task0.R.Project = project1
+
+	project1.R.Tasks = append(project1.R.Tasks, task0)
+
+	return nil
+}
+
+func attachTaskUser0(ctx context.Context, exec bob.Executor, count int, task0 *Task, user1 *User) (*Task, error) {
+	setter := &TaskSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := task0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTaskUser0: %w", err)
+	}
+
+	return task0, nil
+}
+
+func (task0 *Task) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTaskUser0(ctx, exec, 1, task0, user1)
+	if err != nil {
+		return err
+	}
+
+	task0.R.User = user1
+
+	user1.R.Tasks = append(user1.R.Tasks, task0)
+
+	return nil
+}
+
+func (task0 *Task) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
 	_, err = attachTaskUser0(ctx, exec, 1, task0, user1)
 	if err != nil {
 		return err
 	}
 
-	task0.R.User = user1
-
-	user1.R.Tasks = append(user1.R.Tasks, task0)
+	task0.R.User = user1
+
+	user1.R.Tasks = append(user1.R.Tasks, task0)
+
+	return nil
+}
+
+func insertTaskTimeEntries0(ctx context.Context, exec bob.Executor, timeEntries1 []*TimeEntrySetter, task0 *Task) (TimeEntrySlice, error) {
+	for i := range timeEntries1 {
+		timeEntries1[i].TaskID = omit.From(task0.ID)
+	}
+
+	ret, err := TimeEntries.Insert(bob.ToMods(timeEntries1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTaskTimeEntries0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTaskTimeEntries0(ctx context.Context, exec bob.Executor, count int, timeEntries1 TimeEntrySlice, task0 *Task) (TimeEntrySlice, error) {
+	setter := &TimeEntrySetter{
+		TaskID: omit.From(task0.ID),
+	}
+
+	err := timeEntries1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTaskTimeEntries0: %w", err)
+	}
+
+	return timeEntries1, nil
+}
+
+func (task0 *Task) InsertTimeEntries(ctx context.Context, exec bob.Executor, related ...*TimeEntrySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	timeEntries1, err := insertTaskTimeEntries0(ctx, exec, related, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.TimeEntries = append(task0.R.TimeEntries, timeEntries1...)
+
+	for _, rel := range timeEntries1 {
+		rel.R.Task = task0
+	}
+	return nil
+}
+
+func (task0 *Task) AttachTimeEntries(ctx context.Context, exec bob.Executor, related ...*TimeEntry) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	timeEntries1 := TimeEntrySlice(related)
+
+	_, err = attachTaskTimeEntries0(ctx, exec, len(related), timeEntries1, task0)
+	if err != nil {
+		return err
+	}
+
+	task0.R.TimeEntries = append(task0.R.TimeEntries, timeEntries1...)
+
+	for _, rel := range related {
+		rel.R.Task = task0
+	}
+
+	return nil
+}
+
+type taskWhere[Q mysql.Filterable] struct {
+	ID                 mysql.WhereMod[Q, string]
+	UserID             mysql.WhereMod[Q, string]
+	ProjectID          mysql.WhereNullMod[Q, string]
+	Title              mysql.WhereMod[Q, string]
+	Description        mysql.WhereNullMod[Q, string]
+	DueAt              mysql.WhereNullMod[Q, time.Time]
+	EstimateMinutes    mysql.WhereNullMod[Q, int32]
+	Status             mysql.WhereMod[Q, string]
+	StatusCategory     mysql.WhereMod[Q, string]
+	RankKey            mysql.WhereMod[Q, string]
+	StartedAt          mysql.WhereNullMod[Q, time.Time]
+	CompletedAt        mysql.WhereNullMod[Q, time.Time]
+	SnoozedUntil       mysql.WhereNullMod[Q, time.Time]
+	SnoozeNotify       mysql.WhereMod[Q, bool]
+	ArchivedAt         mysql.WhereNullMod[Q, time.Time]
+	Source             mysql.WhereMod[Q, string]
+	AiInterpretationID mysql.WhereNullMod[Q, string]
+	RecurrenceRule     mysql.WhereNullMod[Q, string]
+	RecurrenceAnchorAt mysql.WhereNullMod[Q, time.Time]
+	RecurrenceSeriesID mysql.WhereNullMod[Q, string]
+	Version            mysql.WhereMod[Q, int32]
+	CreatedAt          mysql.WhereMod[Q, time.Time]
+	UpdatedAt          mysql.WhereMod[Q, time.Time]
+	DeletedAt          mysql.WhereNullMod[Q, time.Time]
+}
+
+func (taskWhere[Q]) AliasedAs(alias string) taskWhere[Q] {
+	return buildTaskWhere[Q](buildTaskColumns(alias))
+}
+
+func buildTaskWhere[Q mysql.Filterable](cols taskColumns) taskWhere[Q] {
+	return taskWhere[Q]{
+		ID:                 mysql.Where[Q, string](cols.ID),
+		UserID:             mysql.Where[Q, string](cols.UserID),
+		ProjectID:          mysql.WhereNull[Q, string](cols.ProjectID),
+		Title:              mysql.Where[Q, string](cols.Title),
+		Description:        mysql.WhereNull[Q, string](cols.Description),
+		DueAt:              mysql.WhereNull[Q, time.Time](cols.DueAt),
+		EstimateMinutes:    mysql.WhereNull[Q, int32](cols.EstimateMinutes),
+		Status:             mysql.Where[Q, string](cols.Status),
+		StatusCategory:     mysql.Where[Q, string](cols.StatusCategory),
+		RankKey:            mysql.Where[Q, string](cols.RankKey),
+		StartedAt:          mysql.WhereNull[Q, time.Time](cols.StartedAt),
+		CompletedAt:        mysql.WhereNull[Q, time.Time](cols.CompletedAt),
+		SnoozedUntil:       mysql.WhereNull[Q, time.Time](cols.SnoozedUntil),
+		SnoozeNotify:       mysql.Where[Q, bool](cols.SnoozeNotify),
+		ArchivedAt:         mysql.WhereNull[Q, time.Time](cols.ArchivedAt),
+		Source:             mysql.Where[Q, string](cols.Source),
+		AiInterpretationID: mysql.WhereNull[Q, string](cols.AiInterpretationID),
+		RecurrenceRule:     mysql.WhereNull[Q, string](cols.RecurrenceRule),
+		RecurrenceAnchorAt: mysql.WhereNull[Q, time.Time](cols.RecurrenceAnchorAt),
+		RecurrenceSeriesID: mysql.WhereNull[Q, string](cols.RecurrenceSeriesID),
+		Version:            mysql.Where[Q, int32](cols.Version),
+		CreatedAt:          mysql.Where[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:          mysql.Where[Q, time.Time](cols.UpdatedAt),
+		DeletedAt:          mysql.WhereNull[Q, time.Time](cols.DeletedAt),
+	}
+}
+
+func (o *Task) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Notifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Notifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Task = o
+			}
+		}
+		return nil
+	case "TaskAttachments":
+		rels, ok := retrieved.(TaskAttachmentSlice)
+		if !ok {
+			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TaskAttachments = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Task = o
+			}
+		}
+		return nil
+	case "DependsOnTaskTaskDependencies":
+		rels, ok := retrieved.(TaskDependencySlice)
+		if !ok {
+			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.DependsOnTaskTaskDependencies = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.DependsOnTaskTask = o
+			}
+		}
+		return nil
+	case "TaskDependencies":
+		rels, ok := retrieved.(TaskDependencySlice)
+		if !ok {
+			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TaskDependencies = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Task = o
+			}
+		}
+		return nil
+	case "TaskImports":
+		rels, ok := retrieved.(TaskImportSlice)
+		if !ok {
+			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TaskImports = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Task = o
+			}
+		}
+		return nil
+	case "TaskReminders":
+		rels, ok := retrieved.(TaskReminderSlice)
+		if !ok {
+			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TaskReminders = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Task = o
+			}
+		}
+		return nil
+	case "AiInterpretation":
+		rel, ok := retrieved.(*AiInterpretation)
+		if !ok {
+			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AiInterpretation = rel
+
+		if rel != nil {
+			rel.R.Tasks = TaskSlice{o}
+		}
+		return nil
+	case "Project":
+		rel, ok := retrieved.(*Project)
+		if !ok {
+			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Project = rel
+
+		if rel != nil {
+			rel.R.Tasks = TaskSlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Tasks = TaskSlice{o}
+		}
+		return nil
+	case "TimeEntries":
+		rels, ok := retrieved.(TimeEntrySlice)
+		if !ok {
+			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TimeEntries = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Task = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("task has no relationship %q", name)
+	}
+}
+
+type taskPreloader struct {
+	AiInterpretation func(...mysql.PreloadOption) mysql.Preloader
+	Project          func(...mysql.PreloadOption) mysql.Preloader
+	User             func(...mysql.PreloadOption) mysql.Preloader
+}
+
+func buildTaskPreloader() taskPreloader {
+	return taskPreloader{
+		AiInterpretation: func(opts ...mysql.PreloadOption) mysql.Preloader {
+			return mysql.Preload[*AiInterpretation, AiInterpretationSlice](mysql.PreloadRel{
+				Name: "AiInterpretation",
+				Sides: []mysql.PreloadSide{
+					{
+						From:        Tasks,
+						To:          AiInterpretations,
+						FromColumns: []string{"ai_interpretation_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, AiInterpretations.Columns.Names(), opts...)
+		},
+		Project: func(opts ...mysql.PreloadOption) mysql.Preloader {
+			return mysql.Preload[*Project, ProjectSlice](mysql.PreloadRel{
+				Name: "Project",
+				Sides: []mysql.PreloadSide{
+					{
+						From:        Tasks,
+						To:          Projects,
+						FromColumns: []string{"project_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Projects.Columns.Names(), opts...)
+		},
+		User: func(opts ...mysql.PreloadOption) mysql.Preloader {
+			return mysql.Preload[*User, UserSlice](mysql.PreloadRel{
+				Name: "User",
+				Sides: []mysql.PreloadSide{
+					{
+						From:        Tasks,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type taskThenLoader[Q orm.Loadable] struct {
+	Notifications                 func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TaskAttachments               func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	DependsOnTaskTaskDependencies func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TaskDependencies              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TaskImports                   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TaskReminders                 func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	AiInterpretation              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Project                       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User                          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TimeEntries                   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildTaskThenLoader[Q orm.Loadable]() taskThenLoader[Q] {
+	type NotificationsLoadInterface interface {
+		LoadNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TaskAttachmentsLoadInterface interface {
+		LoadTaskAttachments(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type DependsOnTaskTaskDependenciesLoadInterface interface {
+		LoadDependsOnTaskTaskDependencies(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TaskDependenciesLoadInterface interface {
+		LoadTaskDependencies(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TaskImportsLoadInterface interface {
+		LoadTaskImports(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TaskRemindersLoadInterface interface {
+		LoadTaskReminders(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type AiInterpretationLoadInterface interface {
+		LoadAiInterpretation(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ProjectLoadInterface interface {
+		LoadProject(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TimeEntriesLoadInterface interface {
+		LoadTimeEntries(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return taskThenLoader[Q]{
+		Notifications: thenLoadBuilder[Q](
+			"Notifications",
+			func(ctx context.Context, exec bob.Executor, retrieved NotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadNotifications(ctx, exec, mods...)
+			},
+		),
+		TaskAttachments: thenLoadBuilder[Q](
+			"TaskAttachments",
+			func(ctx context.Context, exec bob.Executor, retrieved TaskAttachmentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTaskAttachments(ctx, exec, mods...)
+			},
+		),
+		DependsOnTaskTaskDependencies: thenLoadBuilder[Q](
+			"DependsOnTaskTaskDependencies",
+			func(ctx context.Context, exec bob.Executor, retrieved DependsOnTaskTaskDependenciesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadDependsOnTaskTaskDependencies(ctx, exec, mods...)
+			},
+		),
+		TaskDependencies: thenLoadBuilder[Q](
+			"TaskDependencies",
+			func(ctx context.Context, exec bob.Executor, retrieved TaskDependenciesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTaskDependencies(ctx, exec, mods...)
+			},
+		),
+		TaskImports: thenLoadBuilder[Q](
+			"TaskImports",
+			func(ctx context.Context, exec bob.Executor, retrieved TaskImportsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTaskImports(ctx, exec, mods...)
+			},
+		),
+		TaskReminders: thenLoadBuilder[Q](
+			"TaskReminders",
+			func(ctx context.Context, exec bob.Executor, retrieved TaskRemindersLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTaskReminders(ctx, exec, mods...)
+			},
+		),
+		AiInterpretation: thenLoadBuilder[Q](
+			"AiInterpretation",
+			func(ctx context.Context, exec bob.Executor, retrieved AiInterpretationLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAiInterpretation(ctx, exec, mods...)
+			},
+		),
+		Project: thenLoadBuilder[Q](
+			"Project",
+			func(ctx context.Context, exec bob.Executor, retrieved ProjectLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadProject(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+		TimeEntries: thenLoadBuilder[Q](
+			"TimeEntries",
+			func(ctx context.Context, exec bob.Executor, retrieved TimeEntriesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTimeEntries(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadNotifications loads the task's Notifications into the .R struct
+func (o *Task) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Notifications = nil
+
+	related, err := o.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Task = o
+	}
 
+	o.R.Notifications = related
 	return nil
 }
 
-func (task0 *Task) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
-	var err error
+// LoadNotifications loads the task's Notifications into the .R struct
+func (os TaskSlice) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
 
-	_, err = attachTaskUser0(ctx, exec, 1, task0, user1)
+	notifications, err := os.Notifications(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
 
-	task0.R.User = user1
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
 
-	user1.R.Tasks = append(user1.R.Tasks, task0)
+		o.R.Notifications = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notifications {
+
+			if !rel.TaskID.IsValue() {
+				continue
+			}
+			if !(rel.TaskID.IsValue() && o.ID == rel.TaskID.MustGet()) {
+				continue
+			}
+
+			rel.R.Task = o
+
+			o.R.Notifications = append(o.R.Notifications, rel)
+		}
+	}
 
 	return nil
 }
 
-type taskWhere[Q mysql.Filterable] struct {
-	ID                 mysql.WhereMod[Q, string]
-	UserID             mysql.WhereMod[Q, string]
-	Title              mysql.WhereMod[Q, string]
-	Description        mysql.WhereNullMod[Q, string]
-	DueAt              mysql.WhereNullMod[Q, time.Time]
-	Status             mysql.WhereMod[Q, string]
-	Source             mysql.WhereMod[Q, string]
-	AiInterpretationID mysql.WhereNullMod[Q, string]
-	CreatedAt          mysql.WhereMod[Q, time.Time]
-	UpdatedAt          mysql.WhereMod[Q, time.Time]
+// LoadTaskAttachments loads the task's TaskAttachments into the .R struct
+func (o *Task) LoadTaskAttachments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.TaskAttachments = nil
+
+	related, err := o.TaskAttachments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Task = o
+	}
+
+	o.R.TaskAttachments = related
+	return nil
 }
 
-func (taskWhere[Q]) AliasedAs(alias string) taskWhere[Q] {
-	return buildTaskWhere[Q](buildTaskColumns(alias))
+// LoadTaskAttachments loads the task's TaskAttachments into the .R struct
+func (os TaskSlice) LoadTaskAttachments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	taskAttachments, err := os.TaskAttachments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TaskAttachments = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range taskAttachments {
+
+			if !(o.ID == rel.TaskID) {
+				continue
+			}
+
+			rel.R.Task = o
+
+			o.R.TaskAttachments = append(o.R.TaskAttachments, rel)
+		}
+	}
+
+	return nil
 }
 
-func buildTaskWhere[Q mysql.Filterable](cols taskColumns) taskWhere[Q] {
-	return taskWhere[Q]{
-		ID:                 mysql.Where[Q, string](cols.ID),
-		UserID:             mysql.Where[Q, string](cols.UserID),
-		Title:              mysql.Where[Q, string](cols.Title),
-		Description:        mysql.WhereNull[Q, string](cols.Description),
-		DueAt:              mysql.WhereNull[Q, time.Time](cols.DueAt),
-		Status:             mysql.Where[Q, string](cols.Status),
-		Source:             mysql.Where[Q, string](cols.Source),
-		AiInterpretationID: mysql.WhereNull[Q, string](cols.AiInterpretationID),
-		CreatedAt:          mysql.Where[Q, time.Time](cols.CreatedAt),
-		UpdatedAt:          mysql.Where[Q, time.Time](cols.UpdatedAt),
+// LoadDependsOnTaskTaskDependencies loads the task's DependsOnTaskTaskDependencies into the .R struct
+func (o *Task) LoadDependsOnTaskTaskDependencies(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
 	}
+
+	// Reset the relationship
+	o.R.DependsOnTaskTaskDependencies = nil
+
+	related, err := o.DependsOnTaskTaskDependencies(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.DependsOnTaskTask = o
+	}
+
+	o.R.DependsOnTaskTaskDependencies = related
+	return nil
 }
 
-func (o *Task) Preload(name string, retrieved any) error {
+// LoadDependsOnTaskTaskDependencies loads the task's DependsOnTaskTaskDependencies into the .R struct
+func (os TaskSlice) LoadDependsOnTaskTaskDependencies(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	taskDependencies, err := os.DependsOnTaskTaskDependencies(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.DependsOnTaskTaskDependencies = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range taskDependencies {
+
+			if !(o.ID == rel.DependsOnTaskID) {
+				continue
+			}
+
+			rel.R.DependsOnTaskTask = o
+
+			o.R.DependsOnTaskTaskDependencies = append(o.R.DependsOnTaskTaskDependencies, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTaskDependencies loads the task's TaskDependencies into the .R struct
+func (o *Task) LoadTaskDependencies(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
-	switch name {
-	case "AiInterpretation":
-		rel, ok := retrieved.(*AiInterpretation)
-		if !ok {
-			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+	// Reset the relationship
+	o.R.TaskDependencies = nil
+
+	related, err := o.TaskDependencies(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Task = o
+	}
+
+	o.R.TaskDependencies = related
+	return nil
+}
+
+// LoadTaskDependencies loads the task's TaskDependencies into the .R struct
+func (os TaskSlice) LoadTaskDependencies(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	taskDependencies, err := os.TaskDependencies(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
 
-		o.R.AiInterpretation = rel
+		o.R.TaskDependencies = nil
+	}
 
-		if rel != nil {
-			rel.R.Tasks = TaskSlice{o}
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range taskDependencies {
+
+			if !(o.ID == rel.TaskID) {
+				continue
+			}
+
+			rel.R.Task = o
+
+			o.R.TaskDependencies = append(o.R.TaskDependencies, rel)
 		}
+	}
+
+	return nil
+}
+
+// LoadTaskImports loads the task's TaskImports into the .R struct
+func (o *Task) LoadTaskImports(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
 		return nil
-	case "User":
-		rel, ok := retrieved.(*User)
-		if !ok {
-			return fmt.Errorf("task cannot load %T as %q", retrieved, name)
+	}
+
+	// Reset the relationship
+	o.R.TaskImports = nil
+
+	related, err := o.TaskImports(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Task = o
+	}
+
+	o.R.TaskImports = related
+	return nil
+}
+
+// LoadTaskImports loads the task's TaskImports into the .R struct
+func (os TaskSlice) LoadTaskImports(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	taskImports, err := os.TaskImports(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TaskImports = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
 
-		o.R.User = rel
+		for _, rel := range taskImports {
+
+			if !(o.ID == rel.TaskID) {
+				continue
+			}
+
+			rel.R.Task = o
 
-		if rel != nil {
-			rel.R.Tasks = TaskSlice{o}
+			o.R.TaskImports = append(o.R.TaskImports, rel)
 		}
-		return nil
-	default:
-		return fmt.Errorf("task has no relationship %q", name)
 	}
-}
 
-type taskPreloader struct {
-	AiInterpretation func(...mysql.PreloadOption) mysql.Preloader
-	User             func(...mysql.PreloadOption) mysql.Preloader
+	return nil
 }
 
-func buildTaskPreloader() taskPreloader {
-	return taskPreloader{
-		AiInterpretation: func(opts ...mysql.PreloadOption) mysql.Preloader {
-			return mysql.Preload[*AiInterpretation, AiInterpretationSlice](mysql.PreloadRel{
-				Name: "AiInterpretation",
-				Sides: []mysql.PreloadSide{
-					{
-						From:        Tasks,
-						To:          AiInterpretations,
-						FromColumns: []string{"ai_interpretation_id"},
-						ToColumns:   []string{"id"},
-					},
-				},
-			}, AiInterpretations.Columns.Names(), opts...)
-		},
-		User: func(opts ...mysql.PreloadOption) mysql.Preloader {
-			return mysql.Preload[*User, UserSlice](mysql.PreloadRel{
-				Name: "User",
-				Sides: []mysql.PreloadSide{
-					{
-						From:        Tasks,
-						To:          Users,
-						FromColumns: []string{"user_id"},
-						ToColumns:   []string{"id"},
-					},
-				},
-			}, Users.Columns.Names(), opts...)
-		},
+// LoadTaskReminders loads the task's TaskReminders into the .R struct
+func (o *Task) LoadTaskReminders(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
 	}
-}
 
-type taskThenLoader[Q orm.Loadable] struct {
-	AiInterpretation func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
-	User             func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	// Reset the relationship
+	o.R.TaskReminders = nil
+
+	related, err := o.TaskReminders(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Task = o
+	}
+
+	o.R.TaskReminders = related
+	return nil
 }
 
-func buildTaskThenLoader[Q orm.Loadable]() taskThenLoader[Q] {
-	type AiInterpretationLoadInterface interface {
-		LoadAiInterpretation(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+// LoadTaskReminders loads the task's TaskReminders into the .R struct
+func (os TaskSlice) LoadTaskReminders(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
 	}
-	type UserLoadInterface interface {
-		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+
+	taskReminders, err := os.TaskReminders(mods...).All(ctx, exec)
+	if err != nil {
+		return err
 	}
 
-	return taskThenLoader[Q]{
-		AiInterpretation: thenLoadBuilder[Q](
-			"AiInterpretation",
-			func(ctx context.Context, exec bob.Executor, retrieved AiInterpretationLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadAiInterpretation(ctx, exec, mods...)
-			},
-		),
-		User: thenLoadBuilder[Q](
-			"User",
-			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadUser(ctx, exec, mods...)
-			},
-		),
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TaskReminders = nil
 	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range taskReminders {
+
+			if !(o.ID == rel.TaskID) {
+				continue
+			}
+
+			rel.R.Task = o
+
+			o.R.TaskReminders = append(o.R.TaskReminders, rel)
+		}
+	}
+
+	return nil
 }
 
 // LoadAiInterpretation loads the task's AiInterpretation into the .R struct
@@ -874,6 +2481,61 @@ func (os TaskSlice) LoadAiInterpretation(ctx context.Context, exec bob.Executor,
 	return nil
 }
 
+// LoadProject loads the task's Project into the .R struct
+func (o *Task) LoadProject(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Project = nil
+
+	related, err := o.Project(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Tasks = TaskSlice{o}
+
+	o.R.Project = related
+	return nil
+}
+
+// LoadProject loads the task's Project into the .R struct
+func (os TaskSlice) LoadProject(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	projects, err := os.Project(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range projects {
+			if !o.ProjectID.IsValue() {
+				continue
+			}
+
+			if !(o.ProjectID.IsValue() && o.ProjectID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.Tasks = append(rel.R.Tasks, o)
+
+			o.R.Project = rel
+			break
+		}
+	}
+
+	return nil
+}
+
 // LoadUser loads the task's User into the .R struct
 func (o *Task) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
@@ -926,10 +2588,79 @@ func (os TaskSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob
 	return nil
 }
 
+// LoadTimeEntries loads the task's TimeEntries into the .R struct
+func (o *Task) LoadTimeEntries(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.TimeEntries = nil
+
+	related, err := o.TimeEntries(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Task = o
+	}
+
+	o.R.TimeEntries = related
+	return nil
+}
+
+// LoadTimeEntries loads the task's TimeEntries into the .R struct
+func (os TaskSlice) LoadTimeEntries(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	timeEntries, err := os.TimeEntries(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TimeEntries = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range timeEntries {
+
+			if !(o.ID == rel.TaskID) {
+				continue
+			}
+
+			rel.R.Task = o
+
+			o.R.TimeEntries = append(o.R.TimeEntries, rel)
+		}
+	}
+
+	return nil
+}
+
 type taskJoins[Q dialect.Joinable] struct {
-	typ              string
-	AiInterpretation modAs[Q, aiInterpretationColumns]
-	User             modAs[Q, userColumns]
+	typ                           string
+	Notifications                 modAs[Q, notificationColumns]
+	TaskAttachments               modAs[Q, taskAttachmentColumns]
+	DependsOnTaskTaskDependencies modAs[Q, taskDependencyColumns]
+	TaskDependencies              modAs[Q, taskDependencyColumns]
+	TaskImports                   modAs[Q, taskImportColumns]
+	TaskReminders                 modAs[Q, taskReminderColumns]
+	AiInterpretation              modAs[Q, aiInterpretationColumns]
+	Project                       modAs[Q, projectColumns]
+	User                          modAs[Q, userColumns]
+	TimeEntries                   modAs[Q, timeEntryColumns]
 }
 
 func (j taskJoins[Q]) aliasedAs(alias string) taskJoins[Q] {
@@ -939,6 +2670,90 @@ func (j taskJoins[Q]) aliasedAs(alias string) taskJoins[Q] {
 func buildTaskJoins[Q dialect.Joinable](cols taskColumns, typ string) taskJoins[Q] {
 	return taskJoins[Q]{
 		typ: typ,
+		Notifications: modAs[Q, notificationColumns]{
+			c: Notifications.Columns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.TaskID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TaskAttachments: modAs[Q, taskAttachmentColumns]{
+			c: TaskAttachments.Columns,
+			f: func(to taskAttachmentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskAttachments.Name().As(to.Alias())).On(
+						to.TaskID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		DependsOnTaskTaskDependencies: modAs[Q, taskDependencyColumns]{
+			c: TaskDependencies.Columns,
+			f: func(to taskDependencyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskDependencies.Name().As(to.Alias())).On(
+						to.DependsOnTaskID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TaskDependencies: modAs[Q, taskDependencyColumns]{
+			c: TaskDependencies.Columns,
+			f: func(to taskDependencyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskDependencies.Name().As(to.Alias())).On(
+						to.TaskID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TaskImports: modAs[Q, taskImportColumns]{
+			c: TaskImports.Columns,
+			f: func(to taskImportColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskImports.Name().As(to.Alias())).On(
+						to.TaskID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TaskReminders: modAs[Q, taskReminderColumns]{
+			c: TaskReminders.Columns,
+			f: func(to taskReminderColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskReminders.Name().As(to.Alias())).On(
+						to.TaskID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		AiInterpretation: modAs[Q, aiInterpretationColumns]{
 			c: AiInterpretations.Columns,
 			f: func(to aiInterpretationColumns) bob.Mod[Q] {
@@ -953,6 +2768,20 @@ func buildTaskJoins[Q dialect.Joinable](cols taskColumns, typ string) taskJoins[
 				return mods
 			},
 		},
+		Project: modAs[Q, projectColumns]{
+			c: Projects.Columns,
+			f: func(to projectColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Projects.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ProjectID),
+					))
+				}
+
+				return mods
+			},
+		},
 		User: modAs[Q, userColumns]{
 			c: Users.Columns,
 			f: func(to userColumns) bob.Mod[Q] {
@@ -964,6 +2793,20 @@ func buildTaskJoins[Q dialect.Joinable](cols taskColumns, typ string) taskJoins[
 					))
 				}
 
+				return mods
+			},
+		},
+		TimeEntries: modAs[Q, timeEntryColumns]{
+			c: TimeEntries.Columns,
+			f: func(to timeEntryColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TimeEntries.Name().As(to.Alias())).On(
+						to.TaskID.EQ(cols.ID),
+					))
+				}
+
 				return mods
 			},
 		},
@@ -56,8 +56,21 @@ type UsersQuery = *mysql.ViewQuery[*User, UserSlice]
 // userR is where relationships are stored.
 type userR struct {
 	AiInterpretations AiInterpretationSlice // fk_ai_interpretations_user
+	CalendarFeed      *CalendarFeed         // fk_calendar_feeds_user
+	IdempotencyKeys   IdempotencyKeySlice   // fk_idempotency_keys_user
+	Notifications     NotificationSlice     // fk_notifications_user
+	Projects          ProjectSlice          // fk_projects_user
+	TaskAttachments   TaskAttachmentSlice   // fk_task_attachments_user
+	ActorTaskEvents   TaskEventSlice        // fk_task_events_actor
+	TaskEvents        TaskEventSlice        // fk_task_events_user
+	TaskImports       TaskImportSlice       // fk_task_imports_user
+	TaskStatuses      TaskStatusSlice       // fk_task_statuses_user
+	TaskTemplates     TaskTemplateSlice     // fk_task_templates_user
+	TaskViews         TaskViewSlice         // fk_task_views_user
 	Tasks             TaskSlice             // fk_tasks_user
+	TimeEntries       TimeEntrySlice        // fk_time_entries_user
 	UserAuths         UserAuthSlice         // fk_user_auths_user
+	UserSetting       *UserSetting          // fk_user_settings_user
 }
 
 func buildUserColumns(alias string) userColumns {
@@ -504,6 +517,215 @@ func (os UserSlice) AiInterpretations(mods ...bob.Mod[*dialect.SelectQuery]) AiI
 	)...)
 }
 
+// CalendarFeed starts a query for related objects on calendar_feeds
+func (o *User) CalendarFeed(mods ...bob.Mod[*dialect.SelectQuery]) CalendarFeedsQuery {
+	return CalendarFeeds.Query(append(mods,
+		sm.Where(CalendarFeeds.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) CalendarFeed(mods ...bob.Mod[*dialect.SelectQuery]) CalendarFeedsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return CalendarFeeds.Query(append(mods,
+		sm.Where(mysql.Group(CalendarFeeds.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// IdempotencyKeys starts a query for related objects on idempotency_keys
+func (o *User) IdempotencyKeys(mods ...bob.Mod[*dialect.SelectQuery]) IdempotencyKeysQuery {
+	return IdempotencyKeys.Query(append(mods,
+		sm.Where(IdempotencyKeys.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) IdempotencyKeys(mods ...bob.Mod[*dialect.SelectQuery]) IdempotencyKeysQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return IdempotencyKeys.Query(append(mods,
+		sm.Where(mysql.Group(IdempotencyKeys.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Notifications starts a query for related objects on notifications
+func (o *User) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(Notifications.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return Notifications.Query(append(mods,
+		sm.Where(mysql.Group(Notifications.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Projects starts a query for related objects on projects
+func (o *User) Projects(mods ...bob.Mod[*dialect.SelectQuery]) ProjectsQuery {
+	return Projects.Query(append(mods,
+		sm.Where(Projects.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Projects(mods ...bob.Mod[*dialect.SelectQuery]) ProjectsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return Projects.Query(append(mods,
+		sm.Where(mysql.Group(Projects.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TaskAttachments starts a query for related objects on task_attachments
+func (o *User) TaskAttachments(mods ...bob.Mod[*dialect.SelectQuery]) TaskAttachmentsQuery {
+	return TaskAttachments.Query(append(mods,
+		sm.Where(TaskAttachments.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) TaskAttachments(mods ...bob.Mod[*dialect.SelectQuery]) TaskAttachmentsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskAttachments.Query(append(mods,
+		sm.Where(mysql.Group(TaskAttachments.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ActorTaskEvents starts a query for related objects on task_events
+func (o *User) ActorTaskEvents(mods ...bob.Mod[*dialect.SelectQuery]) TaskEventsQuery {
+	return TaskEvents.Query(append(mods,
+		sm.Where(TaskEvents.Columns.ActorID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) ActorTaskEvents(mods ...bob.Mod[*dialect.SelectQuery]) TaskEventsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskEvents.Query(append(mods,
+		sm.Where(mysql.Group(TaskEvents.Columns.ActorID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TaskEvents starts a query for related objects on task_events
+func (o *User) TaskEvents(mods ...bob.Mod[*dialect.SelectQuery]) TaskEventsQuery {
+	return TaskEvents.Query(append(mods,
+		sm.Where(TaskEvents.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) TaskEvents(mods ...bob.Mod[*dialect.SelectQuery]) TaskEventsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskEvents.Query(append(mods,
+		sm.Where(mysql.Group(TaskEvents.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TaskImports starts a query for related objects on task_imports
+func (o *User) TaskImports(mods ...bob.Mod[*dialect.SelectQuery]) TaskImportsQuery {
+	return TaskImports.Query(append(mods,
+		sm.Where(TaskImports.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) TaskImports(mods ...bob.Mod[*dialect.SelectQuery]) TaskImportsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskImports.Query(append(mods,
+		sm.Where(mysql.Group(TaskImports.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TaskStatuses starts a query for related objects on task_statuses
+func (o *User) TaskStatuses(mods ...bob.Mod[*dialect.SelectQuery]) TaskStatusesQuery {
+	return TaskStatuses.Query(append(mods,
+		sm.Where(TaskStatuses.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) TaskStatuses(mods ...bob.Mod[*dialect.SelectQuery]) TaskStatusesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskStatuses.Query(append(mods,
+		sm.Where(mysql.Group(TaskStatuses.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TaskTemplates starts a query for related objects on task_templates
+func (o *User) TaskTemplates(mods ...bob.Mod[*dialect.SelectQuery]) TaskTemplatesQuery {
+	return TaskTemplates.Query(append(mods,
+		sm.Where(TaskTemplates.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) TaskTemplates(mods ...bob.Mod[*dialect.SelectQuery]) TaskTemplatesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskTemplates.Query(append(mods,
+		sm.Where(mysql.Group(TaskTemplates.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TaskViews starts a query for related objects on task_views
+func (o *User) TaskViews(mods ...bob.Mod[*dialect.SelectQuery]) TaskViewsQuery {
+	return TaskViews.Query(append(mods,
+		sm.Where(TaskViews.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) TaskViews(mods ...bob.Mod[*dialect.SelectQuery]) TaskViewsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TaskViews.Query(append(mods,
+		sm.Where(mysql.Group(TaskViews.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 // Tasks starts a query for related objects on tasks
 func (o *User) Tasks(mods ...bob.Mod[*dialect.SelectQuery]) TasksQuery {
 	return Tasks.Query(append(mods,
@@ -523,6 +745,25 @@ func (os UserSlice) Tasks(mods ...bob.Mod[*dialect.SelectQuery]) TasksQuery {
 	)...)
 }
 
+// TimeEntries starts a query for related objects on time_entries
+func (o *User) TimeEntries(mods ...bob.Mod[*dialect.SelectQuery]) TimeEntriesQuery {
+	return TimeEntries.Query(append(mods,
+		sm.Where(TimeEntries.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) TimeEntries(mods ...bob.Mod[*dialect.SelectQuery]) TimeEntriesQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return TimeEntries.Query(append(mods,
+		sm.Where(mysql.Group(TimeEntries.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 // UserAuths starts a query for related objects on user_auths
 func (o *User) UserAuths(mods ...bob.Mod[*dialect.SelectQuery]) UserAuthsQuery {
 	return UserAuths.Query(append(mods,
@@ -542,6 +783,25 @@ func (os UserSlice) UserAuths(mods ...bob.Mod[*dialect.SelectQuery]) UserAuthsQu
 	)...)
 }
 
+// UserSetting starts a query for related objects on user_settings
+func (o *User) UserSetting(mods ...bob.Mod[*dialect.SelectQuery]) UserSettingsQuery {
+	return UserSettings.Query(append(mods,
+		sm.Where(UserSettings.Columns.UserID.EQ(mysql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) UserSetting(mods ...bob.Mod[*dialect.SelectQuery]) UserSettingsQuery {
+	PKArgSlice := make([]bob.Expression, len(os))
+	for i, o := range os {
+		PKArgSlice[i] = mysql.ArgGroup(o.ID)
+	}
+	PKArgExpr := mysql.Group(PKArgSlice...)
+
+	return UserSettings.Query(append(mods,
+		sm.Where(mysql.Group(UserSettings.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 func insertUserAiInterpretations0(ctx context.Context, exec bob.Executor, aiInterpretations1 []*AiInterpretationSetter, user0 *User) (AiInterpretationSlice, error) {
 	for i := range aiInterpretations1 {
 		aiInterpretations1[i].UserID = omit.From(user0.ID)
@@ -610,66 +870,120 @@ func (user0 *User) AttachAiInterpretations(ctx context.Context, exec bob.Executo
 	return nil
 }
 
-func insertUserTasks0(ctx context.Context, exec bob.Executor, tasks1 []*TaskSetter, user0 *User) (TaskSlice, error) {
-	for i := range tasks1 {
-		tasks1[i].UserID = omit.From(user0.ID)
+func insertUserCalendarFeed0(ctx context.Context, exec bob.Executor, calendarFeed1 *CalendarFeedSetter, user0 *User) (*CalendarFeed, error) {
+	calendarFeed1.UserID = omit.From(user0.ID)
+
+	ret, err := CalendarFeeds.Insert(calendarFeed1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserCalendarFeed0: %w", err)
 	}
 
-	ret, err := Tasks.Insert(bob.ToMods(tasks1...)).All(ctx, exec)
+	return ret, nil
+}
+
+func attachUserCalendarFeed0(ctx context.Context, exec bob.Executor, count int, calendarFeed1 *CalendarFeed, user0 *User) (*CalendarFeed, error) {
+	setter := &CalendarFeedSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := calendarFeed1.Update(ctx, exec, setter)
 	if err != nil {
-		return ret, fmt.Errorf("insertUserTasks0: %w", err)
+		return nil, fmt.Errorf("attachUserCalendarFeed0: %w", err)
+	}
+
+	return calendarFeed1, nil
+}
+
+func (user0 *User) InsertCalendarFeed(ctx context.Context, exec bob.Executor, related *CalendarFeedSetter) error {
+	var err error
+
+	calendarFeed1, err := insertUserCalendarFeed0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.CalendarFeed = calendarFeed1
+
+	calendarFeed1.R.User = user0
+
+	return nil
+}
+
+func (user0 *User) AttachCalendarFeed(ctx context.Context, exec bob.Executor, calendarFeed1 *CalendarFeed) error {
+	var err error
+
+	_, err = attachUserCalendarFeed0(ctx, exec, 1, calendarFeed1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.CalendarFeed = calendarFeed1
+
+	calendarFeed1.R.User = user0
+
+	return nil
+}
+
+func insertUserIdempotencyKeys0(ctx context.Context, exec bob.Executor, idempotencyKeys1 []*IdempotencyKeySetter, user0 *User) (IdempotencyKeySlice, error) {
+	for i := range idempotencyKeys1 {
+		idempotencyKeys1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := IdempotencyKeys.Insert(bob.ToMods(idempotencyKeys1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserIdempotencyKeys0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachUserTasks0(ctx context.Context, exec bob.Executor, count int, tasks1 TaskSlice, user0 *User) (TaskSlice, error) {
-	setter := &TaskSetter{
+func attachUserIdempotencyKeys0(ctx context.Context, exec bob.Executor, count int, idempotencyKeys1 IdempotencyKeySlice, user0 *User) (IdempotencyKeySlice, error) {
+	setter := &IdempotencyKeySetter{
 		UserID: omit.From(user0.ID),
 	}
 
-	err := tasks1.UpdateAll(ctx, exec, *setter)
+	err := idempotencyKeys1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachUserTasks0: %w", err)
+		return nil, fmt.Errorf("attachUserIdempotencyKeys0: %w", err)
 	}
 
-	return tasks1, nil
+	return idempotencyKeys1, nil
 }
 
-func (user0 *User) InsertTasks(ctx context.Context, exec bob.Executor, related ...*TaskSetter) error {
+func (user0 *User) InsertIdempotencyKeys(ctx context.Context, exec bob.Executor, related ...*IdempotencyKeySetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	tasks1, err := insertUserTasks0(ctx, exec, related, user0)
+	idempotencyKeys1, err := insertUserIdempotencyKeys0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.Tasks = append(user0.R.Tasks, tasks1...)
+	user0.R.IdempotencyKeys = append(user0.R.IdempotencyKeys, idempotencyKeys1...)
 
-	for _, rel := range tasks1 {
+	for _, rel := range idempotencyKeys1 {
 		rel.R.User = user0
 	}
 	return nil
 }
 
-func (user0 *User) AttachTasks(ctx context.Context, exec bob.Executor, related ...*Task) error {
+func (user0 *User) AttachIdempotencyKeys(ctx context.Context, exec bob.Executor, related ...*IdempotencyKey) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	tasks1 := TaskSlice(related)
+	idempotencyKeys1 := IdempotencyKeySlice(related)
 
-	_, err = attachUserTasks0(ctx, exec, len(related), tasks1, user0)
+	_, err = attachUserIdempotencyKeys0(ctx, exec, len(related), idempotencyKeys1, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.Tasks = append(user0.R.Tasks, tasks1...)
+	user0.R.IdempotencyKeys = append(user0.R.IdempotencyKeys, idempotencyKeys1...)
 
 	for _, rel := range related {
 		rel.R.User = user0
@@ -678,66 +992,66 @@ func (user0 *User) AttachTasks(ctx context.Context, exec bob.Executor, related .
 	return nil
 }
 
-func insertUserUserAuths0(ctx context.Context, exec bob.Executor, userAuths1 []*UserAuthSetter, user0 *User) (UserAuthSlice, error) {
-	for i := range userAuths1 {
-		userAuths1[i].UserID = omit.From(user0.ID)
+func insertUserNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, user0 *User) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].UserID = omit.From(user0.ID)
 	}
 
-	ret, err := UserAuths.Insert(bob.ToMods(userAuths1...)).All(ctx, exec)
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
 	if err != nil {
-		return ret, fmt.Errorf("insertUserUserAuths0: %w", err)
+		return ret, fmt.Errorf("insertUserNotifications0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachUserUserAuths0(ctx context.Context, exec bob.Executor, count int, userAuths1 UserAuthSlice, user0 *User) (UserAuthSlice, error) {
-	setter := &UserAuthSetter{
+func attachUserNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, user0 *User) (NotificationSlice, error) {
+	setter := &NotificationSetter{
 		UserID: omit.From(user0.ID),
 	}
 
-	err := userAuths1.UpdateAll(ctx, exec, *setter)
+	err := notifications1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachUserUserAuths0: %w", err)
+		return nil, fmt.Errorf("attachUserNotifications0: %w", err)
 	}
 
-	return userAuths1, nil
+	return notifications1, nil
 }
 
-func (user0 *User) InsertUserAuths(ctx context.Context, exec bob.Executor, related ...*UserAuthSetter) error {
+func (user0 *User) InsertNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	userAuths1, err := insertUserUserAuths0(ctx, exec, related, user0)
+	notifications1, err := insertUserNotifications0(ctx, exec, related, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.UserAuths = append(user0.R.UserAuths, userAuths1...)
+	user0.R.Notifications = append(user0.R.Notifications, notifications1...)
 
-	for _, rel := range userAuths1 {
+	for _, rel := range notifications1 {
 		rel.R.User = user0
 	}
 	return nil
 }
 
-func (user0 *User) AttachUserAuths(ctx context.Context, exec bob.Executor, related ...*UserAuth) error {
+func (user0 *User) AttachNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	userAuths1 := UserAuthSlice(related)
+	notifications1 := NotificationSlice(related)
 
-	_, err = attachUserUserAuths0(ctx, exec, len(related), userAuths1, user0)
+	_, err = attachUserNotifications0(ctx, exec, len(related), notifications1, user0)
 	if err != nil {
 		return err
 	}
 
-	user0.R.UserAuths = append(user0.R.UserAuths, userAuths1...)
+	user0.R.Notifications = append(user0.R.Notifications, notifications1...)
 
 	for _, rel := range related {
 		rel.R.User = user0
@@ -746,140 +1060,1945 @@ func (user0 *User) AttachUserAuths(ctx context.Context, exec bob.Executor, relat
 	return nil
 }
 
-type userWhere[Q mysql.Filterable] struct {
-	ID        mysql.WhereMod[Q, string]
-	GoogleID  mysql.WhereMod[Q, string]
-	Email     mysql.WhereMod[Q, string]
-	Nickname  mysql.WhereMod[Q, string]
-	Avatar    mysql.WhereNullMod[Q, string]
-	CreatedAt mysql.WhereMod[Q, time.Time]
-	UpdatedAt mysql.WhereMod[Q, time.Time]
-}
+func insertUserProjects0(ctx context.Context, exec bob.Executor, projects1 []*ProjectSetter, user0 *User) (ProjectSlice, error) {
+	for i := range projects1 {
+		projects1[i].UserID = omit.From(user0.ID)
+	}
 
-func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
-	return buildUserWhere[Q](buildUserColumns(alias))
+	ret, err := Projects.Insert(bob.ToMods(projects1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserProjects0: %w", err)
+	}
+
+	return ret, nil
 }
 
-func buildUserWhere[Q mysql.Filterable](cols userColumns) userWhere[Q] {
-	return userWhere[Q]{
-		ID:        mysql.Where[Q, string](cols.ID),
-		GoogleID:  mysql.Where[Q, string](cols.GoogleID),
-		Email:     mysql.Where[Q, string](cols.Email),
-		Nickname:  mysql.Where[Q, string](cols.Nickname),
-		Avatar:    mysql.WhereNull[Q, string](cols.Avatar),
-		CreatedAt: mysql.Where[Q, time.Time](cols.CreatedAt),
-		UpdatedAt: mysql.Where[Q, time.Time](cols.UpdatedAt),
+func attachUserProjects0(ctx context.Context, exec bob.Executor, count int, projects1 ProjectSlice, user0 *User) (ProjectSlice, error) {
+	setter := &ProjectSetter{
+		UserID: omit.From(user0.ID),
 	}
-}
+
+	err := projects1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserProjects0: %w", err)
+	}
+
+	return projects1, nil
+}
+
+func (user0 *User) InsertProjects(ctx context.Context, exec bob.Executor, related ...*ProjectSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	projects1, err := insertUserProjects0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Projects = append(user0.R.Projects, projects1...)
+
+	for _, rel := range projects1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachProjects(ctx context.Context, exec bob.Executor, related ...*Project) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	projects1 := ProjectSlice(related)
+
+	_, err = attachUserProjects0(ctx, exec, len(related), projects1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Projects = append(user0.R.Projects, projects1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserTaskAttachments0(ctx context.Context, exec bob.Executor, taskAttachments1 []*TaskAttachmentSetter, user0 *User) (TaskAttachmentSlice, error) {
+	for i := range taskAttachments1 {
+		taskAttachments1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := TaskAttachments.Insert(bob.ToMods(taskAttachments1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTaskAttachments0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTaskAttachments0(ctx context.Context, exec bob.Executor, count int, taskAttachments1 TaskAttachmentSlice, user0 *User) (TaskAttachmentSlice, error) {
+	setter := &TaskAttachmentSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := taskAttachments1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTaskAttachments0: %w", err)
+	}
+
+	return taskAttachments1, nil
+}
+
+func (user0 *User) InsertTaskAttachments(ctx context.Context, exec bob.Executor, related ...*TaskAttachmentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskAttachments1, err := insertUserTaskAttachments0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskAttachments = append(user0.R.TaskAttachments, taskAttachments1...)
+
+	for _, rel := range taskAttachments1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTaskAttachments(ctx context.Context, exec bob.Executor, related ...*TaskAttachment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskAttachments1 := TaskAttachmentSlice(related)
+
+	_, err = attachUserTaskAttachments0(ctx, exec, len(related), taskAttachments1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskAttachments = append(user0.R.TaskAttachments, taskAttachments1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserActorTaskEvents0(ctx context.Context, exec bob.Executor, taskEvents1 []*TaskEventSetter, user0 *User) (TaskEventSlice, error) {
+	for i := range taskEvents1 {
+		taskEvents1[i].ActorID = omitnull.From(user0.ID)
+	}
+
+	ret, err := TaskEvents.Insert(bob.ToMods(taskEvents1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserActorTaskEvents0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserActorTaskEvents0(ctx context.Context, exec bob.Executor, count int, taskEvents1 TaskEventSlice, user0 *User) (TaskEventSlice, error) {
+	setter := &TaskEventSetter{
+		ActorID: omitnull.From(user0.ID),
+	}
+
+	err := taskEvents1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserActorTaskEvents0: %w", err)
+	}
+
+	return taskEvents1, nil
+}
+
+func (user0 *User) InsertActorTaskEvents(ctx context.Context, exec bob.Executor, related ...*TaskEventSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskEvents1, err := insertUserActorTaskEvents0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ActorTaskEvents = append(user0.R.ActorTaskEvents, taskEvents1...)
+
+	for _, rel := range taskEvents1 {
+		rel.R.ActorUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachActorTaskEvents(ctx context.Context, exec bob.Executor, related ...*TaskEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskEvents1 := TaskEventSlice(related)
+
+	_, err = attachUserActorTaskEvents0(ctx, exec, len(related), taskEvents1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ActorTaskEvents = append(user0.R.ActorTaskEvents, taskEvents1...)
+
+	for _, rel := range related {
+		rel.R.ActorUser = user0
+	}
+
+	return nil
+}
+
+func insertUserTaskEvents0(ctx context.Context, exec bob.Executor, taskEvents1 []*TaskEventSetter, user0 *User) (TaskEventSlice, error) {
+	for i := range taskEvents1 {
+		taskEvents1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := TaskEvents.Insert(bob.ToMods(taskEvents1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTaskEvents0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTaskEvents0(ctx context.Context, exec bob.Executor, count int, taskEvents1 TaskEventSlice, user0 *User) (TaskEventSlice, error) {
+	setter := &TaskEventSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := taskEvents1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTaskEvents0: %w", err)
+	}
+
+	return taskEvents1, nil
+}
+
+func (user0 *User) InsertTaskEvents(ctx context.Context, exec bob.Executor, related ...*TaskEventSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskEvents1, err := insertUserTaskEvents0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskEvents = append(user0.R.TaskEvents, taskEvents1...)
+
+	for _, rel := range taskEvents1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTaskEvents(ctx context.Context, exec bob.Executor, related ...*TaskEvent) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskEvents1 := TaskEventSlice(related)
+
+	_, err = attachUserTaskEvents0(ctx, exec, len(related), taskEvents1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskEvents = append(user0.R.TaskEvents, taskEvents1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserTaskImports0(ctx context.Context, exec bob.Executor, taskImports1 []*TaskImportSetter, user0 *User) (TaskImportSlice, error) {
+	for i := range taskImports1 {
+		taskImports1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := TaskImports.Insert(bob.ToMods(taskImports1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTaskImports0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTaskImports0(ctx context.Context, exec bob.Executor, count int, taskImports1 TaskImportSlice, user0 *User) (TaskImportSlice, error) {
+	setter := &TaskImportSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := taskImports1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTaskImports0: %w", err)
+	}
+
+	return taskImports1, nil
+}
+
+func (user0 *User) InsertTaskImports(ctx context.Context, exec bob.Executor, related ...*TaskImportSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskImports1, err := insertUserTaskImports0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskImports = append(user0.R.TaskImports, taskImports1...)
+
+	for _, rel := range taskImports1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTaskImports(ctx context.Context, exec bob.Executor, related ...*TaskImport) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskImports1 := TaskImportSlice(related)
+
+	_, err = attachUserTaskImports0(ctx, exec, len(related), taskImports1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskImports = append(user0.R.TaskImports, taskImports1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserTaskStatuses0(ctx context.Context, exec bob.Executor, taskStatuses1 []*TaskStatusSetter, user0 *User) (TaskStatusSlice, error) {
+	for i := range taskStatuses1 {
+		taskStatuses1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := TaskStatuses.Insert(bob.ToMods(taskStatuses1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTaskStatuses0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTaskStatuses0(ctx context.Context, exec bob.Executor, count int, taskStatuses1 TaskStatusSlice, user0 *User) (TaskStatusSlice, error) {
+	setter := &TaskStatusSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := taskStatuses1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTaskStatuses0: %w", err)
+	}
+
+	return taskStatuses1, nil
+}
+
+func (user0 *User) InsertTaskStatuses(ctx context.Context, exec bob.Executor, related ...*TaskStatusSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskStatuses1, err := insertUserTaskStatuses0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskStatuses = append(user0.R.TaskStatuses, taskStatuses1...)
+
+	for _, rel := range taskStatuses1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTaskStatuses(ctx context.Context, exec bob.Executor, related ...*TaskStatus) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskStatuses1 := TaskStatusSlice(related)
+
+	_, err = attachUserTaskStatuses0(ctx, exec, len(related), taskStatuses1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskStatuses = append(user0.R.TaskStatuses, taskStatuses1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserTaskTemplates0(ctx context.Context, exec bob.Executor, taskTemplates1 []*TaskTemplateSetter, user0 *User) (TaskTemplateSlice, error) {
+	for i := range taskTemplates1 {
+		taskTemplates1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := TaskTemplates.Insert(bob.ToMods(taskTemplates1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTaskTemplates0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTaskTemplates0(ctx context.Context, exec bob.Executor, count int, taskTemplates1 TaskTemplateSlice, user0 *User) (TaskTemplateSlice, error) {
+	setter := &TaskTemplateSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := taskTemplates1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTaskTemplates0: %w", err)
+	}
+
+	return taskTemplates1, nil
+}
+
+func (user0 *User) InsertTaskTemplates(ctx context.Context, exec bob.Executor, related ...*TaskTemplateSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskTemplates1, err := insertUserTaskTemplates0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskTemplates = append(user0.R.TaskTemplates, taskTemplates1...)
+
+	for _, rel := range taskTemplates1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTaskTemplates(ctx context.Context, exec bob.Executor, related ...*TaskTemplate) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskTemplates1 := TaskTemplateSlice(related)
+
+	_, err = attachUserTaskTemplates0(ctx, exec, len(related), taskTemplates1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskTemplates = append(user0.R.TaskTemplates, taskTemplates1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserTaskViews0(ctx context.Context, exec bob.Executor, taskViews1 []*TaskViewSetter, user0 *User) (TaskViewSlice, error) {
+	for i := range taskViews1 {
+		taskViews1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := TaskViews.Insert(bob.ToMods(taskViews1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTaskViews0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTaskViews0(ctx context.Context, exec bob.Executor, count int, taskViews1 TaskViewSlice, user0 *User) (TaskViewSlice, error) {
+	setter := &TaskViewSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := taskViews1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTaskViews0: %w", err)
+	}
+
+	return taskViews1, nil
+}
+
+func (user0 *User) InsertTaskViews(ctx context.Context, exec bob.Executor, related ...*TaskViewSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	taskViews1, err := insertUserTaskViews0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskViews = append(user0.R.TaskViews, taskViews1...)
+
+	for _, rel := range taskViews1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTaskViews(ctx context.Context, exec bob.Executor, related ...*TaskView) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	taskViews1 := TaskViewSlice(related)
+
+	_, err = attachUserTaskViews0(ctx, exec, len(related), taskViews1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TaskViews = append(user0.R.TaskViews, taskViews1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserTasks0(ctx context.Context, exec bob.Executor, tasks1 []*TaskSetter, user0 *User) (TaskSlice, error) {
+	for i := range tasks1 {
+		tasks1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Tasks.Insert(bob.ToMods(tasks1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTasks0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTasks0(ctx context.Context, exec bob.Executor, count int, tasks1 TaskSlice, user0 *User) (TaskSlice, error) {
+	setter := &TaskSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := tasks1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTasks0: %w", err)
+	}
+
+	return tasks1, nil
+}
+
+func (user0 *User) InsertTasks(ctx context.Context, exec bob.Executor, related ...*TaskSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	tasks1, err := insertUserTasks0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Tasks = append(user0.R.Tasks, tasks1...)
+
+	for _, rel := range tasks1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTasks(ctx context.Context, exec bob.Executor, related ...*Task) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	tasks1 := TaskSlice(related)
+
+	_, err = attachUserTasks0(ctx, exec, len(related), tasks1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Tasks = append(user0.R.Tasks, tasks1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserTimeEntries0(ctx context.Context, exec bob.Executor, timeEntries1 []*TimeEntrySetter, user0 *User) (TimeEntrySlice, error) {
+	for i := range timeEntries1 {
+		timeEntries1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := TimeEntries.Insert(bob.ToMods(timeEntries1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTimeEntries0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTimeEntries0(ctx context.Context, exec bob.Executor, count int, timeEntries1 TimeEntrySlice, user0 *User) (TimeEntrySlice, error) {
+	setter := &TimeEntrySetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := timeEntries1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTimeEntries0: %w", err)
+	}
+
+	return timeEntries1, nil
+}
+
+func (user0 *User) InsertTimeEntries(ctx context.Context, exec bob.Executor, related ...*TimeEntrySetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	timeEntries1, err := insertUserTimeEntries0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TimeEntries = append(user0.R.TimeEntries, timeEntries1...)
+
+	for _, rel := range timeEntries1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTimeEntries(ctx context.Context, exec bob.Executor, related ...*TimeEntry) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	timeEntries1 := TimeEntrySlice(related)
+
+	_, err = attachUserTimeEntries0(ctx, exec, len(related), timeEntries1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.TimeEntries = append(user0.R.TimeEntries, timeEntries1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserUserAuths0(ctx context.Context, exec bob.Executor, userAuths1 []*UserAuthSetter, user0 *User) (UserAuthSlice, error) {
+	for i := range userAuths1 {
+		userAuths1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := UserAuths.Insert(bob.ToMods(userAuths1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUserAuths0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUserAuths0(ctx context.Context, exec bob.Executor, count int, userAuths1 UserAuthSlice, user0 *User) (UserAuthSlice, error) {
+	setter := &UserAuthSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := userAuths1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUserAuths0: %w", err)
+	}
+
+	return userAuths1, nil
+}
+
+func (user0 *User) InsertUserAuths(ctx context.Context, exec bob.Executor, related ...*UserAuthSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	userAuths1, err := insertUserUserAuths0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserAuths = append(user0.R.UserAuths, userAuths1...)
+
+	for _, rel := range userAuths1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachUserAuths(ctx context.Context, exec bob.Executor, related ...*UserAuth) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	userAuths1 := UserAuthSlice(related)
+
+	_, err = attachUserUserAuths0(ctx, exec, len(related), userAuths1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserAuths = append(user0.R.UserAuths, userAuths1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserUserSetting0(ctx context.Context, exec bob.Executor, userSetting1 *UserSettingSetter, user0 *User) (*UserSetting, error) {
+	userSetting1.UserID = omit.From(user0.ID)
+
+	ret, err := UserSettings.Insert(userSetting1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserUserSetting0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserUserSetting0(ctx context.Context, exec bob.Executor, count int, userSetting1 *UserSetting, user0 *User) (*UserSetting, error) {
+	setter := &UserSettingSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := userSetting1.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserUserSetting0: %w", err)
+	}
+
+	return userSetting1, nil
+}
+
+func (user0 *User) InsertUserSetting(ctx context.Context, exec bob.Executor, related *UserSettingSetter) error {
+	var err error
+
+	userSetting1, err := insertUserUserSetting0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserSetting = userSetting1
+
+	userSetting1.R.User = user0
+
+	return nil
+}
+
+func (user0 *User) AttachUserSetting(ctx context.Context, exec bob.Executor, userSetting1 *UserSetting) error {
+	var err error
+
+	_, err = attachUserUserSetting0(ctx, exec, 1, userSetting1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.UserSetting = userSetting1
+
+	userSetting1.R.User = user0
+
+	return nil
+}
+
+type userWhere[Q mysql.Filterable] struct {
+	ID        mysql.WhereMod[Q, string]
+	GoogleID  mysql.WhereMod[Q, string]
+	Email     mysql.WhereMod[Q, string]
+	Nickname  mysql.WhereMod[Q, string]
+	Avatar    mysql.WhereNullMod[Q, string]
+	CreatedAt mysql.WhereMod[Q, time.Time]
+	UpdatedAt mysql.WhereMod[Q, time.Time]
+}
+
+func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
+	return buildUserWhere[Q](buildUserColumns(alias))
+}
+
+func buildUserWhere[Q mysql.Filterable](cols userColumns) userWhere[Q] {
+	return userWhere[Q]{
+		ID:        mysql.Where[Q, string](cols.ID),
+		GoogleID:  mysql.Where[Q, string](cols.GoogleID),
+		Email:     mysql.Where[Q, string](cols.Email),
+		Nickname:  mysql.Where[Q, string](cols.Nickname),
+		Avatar:    mysql.WhereNull[Q, string](cols.Avatar),
+		CreatedAt: mysql.Where[Q, time.Time](cols.CreatedAt),
+		UpdatedAt: mysql.Where[Q, time.Time](cols.UpdatedAt),
+	}
+}
 
 func (o *User) Preload(name string, retrieved any) error {
 	if o == nil {
 		return nil
 	}
 
-	switch name {
-	case "AiInterpretations":
-		rels, ok := retrieved.(AiInterpretationSlice)
-		if !ok {
-			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+	switch name {
+	case "AiInterpretations":
+		rels, ok := retrieved.(AiInterpretationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AiInterpretations = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "CalendarFeed":
+		rel, ok := retrieved.(*CalendarFeed)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.CalendarFeed = rel
+
+		if rel != nil {
+			rel.R.User = o
+		}
+		return nil
+	case "IdempotencyKeys":
+		rels, ok := retrieved.(IdempotencyKeySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.IdempotencyKeys = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Notifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Notifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Projects":
+		rels, ok := retrieved.(ProjectSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Projects = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "TaskAttachments":
+		rels, ok := retrieved.(TaskAttachmentSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TaskAttachments = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "ActorTaskEvents":
+		rels, ok := retrieved.(TaskEventSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ActorTaskEvents = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ActorUser = o
+			}
+		}
+		return nil
+	case "TaskEvents":
+		rels, ok := retrieved.(TaskEventSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TaskEvents = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "TaskImports":
+		rels, ok := retrieved.(TaskImportSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TaskImports = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "TaskStatuses":
+		rels, ok := retrieved.(TaskStatusSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TaskStatuses = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "TaskTemplates":
+		rels, ok := retrieved.(TaskTemplateSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TaskTemplates = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "TaskViews":
+		rels, ok := retrieved.(TaskViewSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TaskViews = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Tasks":
+		rels, ok := retrieved.(TaskSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Tasks = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "TimeEntries":
+		rels, ok := retrieved.(TimeEntrySlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TimeEntries = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "UserAuths":
+		rels, ok := retrieved.(UserAuthSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UserAuths = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "UserSetting":
+		rel, ok := retrieved.(*UserSetting)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.UserSetting = rel
+
+		if rel != nil {
+			rel.R.User = o
+		}
+		return nil
+	default:
+		return fmt.Errorf("user has no relationship %q", name)
+	}
+}
+
+type userPreloader struct {
+	CalendarFeed func(...mysql.PreloadOption) mysql.Preloader
+	UserSetting  func(...mysql.PreloadOption) mysql.Preloader
+}
+
+func buildUserPreloader() userPreloader {
+	return userPreloader{
+		CalendarFeed: func(opts ...mysql.PreloadOption) mysql.Preloader {
+			return mysql.Preload[*CalendarFeed, CalendarFeedSlice](mysql.PreloadRel{
+				Name: "CalendarFeed",
+				Sides: []mysql.PreloadSide{
+					{
+						From:        Users,
+						To:          CalendarFeeds,
+						FromColumns: []string{"id"},
+						ToColumns:   []string{"user_id"},
+					},
+				},
+			}, CalendarFeeds.Columns.Names(), opts...)
+		},
+		UserSetting: func(opts ...mysql.PreloadOption) mysql.Preloader {
+			return mysql.Preload[*UserSetting, UserSettingSlice](mysql.PreloadRel{
+				Name: "UserSetting",
+				Sides: []mysql.PreloadSide{
+					{
+						From:        Users,
+						To:          UserSettings,
+						FromColumns: []string{"id"},
+						ToColumns:   []string{"user_id"},
+					},
+				},
+			}, UserSettings.Columns.Names(), opts...)
+		},
+	}
+}
+
+type userThenLoader[Q orm.Loadable] struct {
+	AiInterpretations func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	CalendarFeed      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	IdempotencyKeys   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Notifications     func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Projects          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TaskAttachments   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ActorTaskEvents   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TaskEvents        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TaskImports       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TaskStatuses      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TaskTemplates     func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TaskViews         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Tasks             func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TimeEntries       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	UserAuths         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	UserSetting       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildUserThenLoader[Q orm.Loadable]() userThenLoader[Q] {
+	type AiInterpretationsLoadInterface interface {
+		LoadAiInterpretations(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type CalendarFeedLoadInterface interface {
+		LoadCalendarFeed(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type IdempotencyKeysLoadInterface interface {
+		LoadIdempotencyKeys(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type NotificationsLoadInterface interface {
+		LoadNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ProjectsLoadInterface interface {
+		LoadProjects(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TaskAttachmentsLoadInterface interface {
+		LoadTaskAttachments(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ActorTaskEventsLoadInterface interface {
+		LoadActorTaskEvents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TaskEventsLoadInterface interface {
+		LoadTaskEvents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TaskImportsLoadInterface interface {
+		LoadTaskImports(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TaskStatusesLoadInterface interface {
+		LoadTaskStatuses(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TaskTemplatesLoadInterface interface {
+		LoadTaskTemplates(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TaskViewsLoadInterface interface {
+		LoadTaskViews(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TasksLoadInterface interface {
+		LoadTasks(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TimeEntriesLoadInterface interface {
+		LoadTimeEntries(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserAuthsLoadInterface interface {
+		LoadUserAuths(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserSettingLoadInterface interface {
+		LoadUserSetting(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return userThenLoader[Q]{
+		AiInterpretations: thenLoadBuilder[Q](
+			"AiInterpretations",
+			func(ctx context.Context, exec bob.Executor, retrieved AiInterpretationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAiInterpretations(ctx, exec, mods...)
+			},
+		),
+		CalendarFeed: thenLoadBuilder[Q](
+			"CalendarFeed",
+			func(ctx context.Context, exec bob.Executor, retrieved CalendarFeedLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadCalendarFeed(ctx, exec, mods...)
+			},
+		),
+		IdempotencyKeys: thenLoadBuilder[Q](
+			"IdempotencyKeys",
+			func(ctx context.Context, exec bob.Executor, retrieved IdempotencyKeysLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIdempotencyKeys(ctx, exec, mods...)
+			},
+		),
+		Notifications: thenLoadBuilder[Q](
+			"Notifications",
+			func(ctx context.Context, exec bob.Executor, retrieved NotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadNotifications(ctx, exec, mods...)
+			},
+		),
+		Projects: thenLoadBuilder[Q](
+			"Projects",
+			func(ctx context.Context, exec bob.Executor, retrieved ProjectsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadProjects(ctx, exec, mods...)
+			},
+		),
+		TaskAttachments: thenLoadBuilder[Q](
+			"TaskAttachments",
+			func(ctx context.Context, exec bob.Executor, retrieved TaskAttachmentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTaskAttachments(ctx, exec, mods...)
+			},
+		),
+		ActorTaskEvents: thenLoadBuilder[Q](
+			"ActorTaskEvents",
+			func(ctx context.Context, exec bob.Executor, retrieved ActorTaskEventsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadActorTaskEvents(ctx, exec, mods...)
+			},
+		),
+		TaskEvents: thenLoadBuilder[Q](
+			"TaskEvents",
+			func(ctx context.Context, exec bob.Executor, retrieved TaskEventsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTaskEvents(ctx, exec, mods...)
+			},
+		),
+		TaskImports: thenLoadBuilder[Q](
+			"TaskImports",
+			func(ctx context.Context, exec bob.Executor, retrieved TaskImportsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTaskImports(ctx, exec, mods...)
+			},
+		),
+		TaskStatuses: thenLoadBuilder[Q](
+			"TaskStatuses",
+			func(ctx context.Context, exec bob.Executor, retrieved TaskStatusesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTaskStatuses(ctx, exec, mods...)
+			},
+		),
+		TaskTemplates: thenLoadBuilder[Q](
+			"TaskTemplates",
+			func(ctx context.Context, exec bob.Executor, retrieved TaskTemplatesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTaskTemplates(ctx, exec, mods...)
+			},
+		),
+		TaskViews: thenLoadBuilder[Q](
+			"TaskViews",
+			func(ctx context.Context, exec bob.Executor, retrieved TaskViewsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTaskViews(ctx, exec, mods...)
+			},
+		),
+		Tasks: thenLoadBuilder[Q](
+			"Tasks",
+			func(ctx context.Context, exec bob.Executor, retrieved TasksLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTasks(ctx, exec, mods...)
+			},
+		),
+		TimeEntries: thenLoadBuilder[Q](
+			"TimeEntries",
+			func(ctx context.Context, exec bob.Executor, retrieved TimeEntriesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTimeEntries(ctx, exec, mods...)
+			},
+		),
+		UserAuths: thenLoadBuilder[Q](
+			"UserAuths",
+			func(ctx context.Context, exec bob.Executor, retrieved UserAuthsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUserAuths(ctx, exec, mods...)
+			},
+		),
+		UserSetting: thenLoadBuilder[Q](
+			"UserSetting",
+			func(ctx context.Context, exec bob.Executor, retrieved UserSettingLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUserSetting(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadAiInterpretations loads the user's AiInterpretations into the .R struct
+func (o *User) LoadAiInterpretations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.AiInterpretations = nil
+
+	related, err := o.AiInterpretations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.AiInterpretations = related
+	return nil
+}
+
+// LoadAiInterpretations loads the user's AiInterpretations into the .R struct
+func (os UserSlice) LoadAiInterpretations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	aiInterpretations, err := os.AiInterpretations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.AiInterpretations = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range aiInterpretations {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.AiInterpretations = append(o.R.AiInterpretations, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadCalendarFeed loads the user's CalendarFeed into the .R struct
+func (o *User) LoadCalendarFeed(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.CalendarFeed = nil
+
+	related, err := o.CalendarFeed(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.User = o
+
+	o.R.CalendarFeed = related
+	return nil
+}
+
+// LoadCalendarFeed loads the user's CalendarFeed into the .R struct
+func (os UserSlice) LoadCalendarFeed(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	calendarFeeds, err := os.CalendarFeed(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range calendarFeeds {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.CalendarFeed = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadIdempotencyKeys loads the user's IdempotencyKeys into the .R struct
+func (o *User) LoadIdempotencyKeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.IdempotencyKeys = nil
+
+	related, err := o.IdempotencyKeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.IdempotencyKeys = related
+	return nil
+}
+
+// LoadIdempotencyKeys loads the user's IdempotencyKeys into the .R struct
+func (os UserSlice) LoadIdempotencyKeys(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	idempotencyKeys, err := os.IdempotencyKeys(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.IdempotencyKeys = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range idempotencyKeys {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.IdempotencyKeys = append(o.R.IdempotencyKeys, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications loads the user's Notifications into the .R struct
+func (o *User) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Notifications = nil
+
+	related, err := o.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Notifications = related
+	return nil
+}
+
+// LoadNotifications loads the user's Notifications into the .R struct
+func (os UserSlice) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notifications, err := os.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Notifications = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notifications {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Notifications = append(o.R.Notifications, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadProjects loads the user's Projects into the .R struct
+func (o *User) LoadProjects(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Projects = nil
+
+	related, err := o.Projects(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Projects = related
+	return nil
+}
+
+// LoadProjects loads the user's Projects into the .R struct
+func (os UserSlice) LoadProjects(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	projects, err := os.Projects(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Projects = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range projects {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Projects = append(o.R.Projects, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTaskAttachments loads the user's TaskAttachments into the .R struct
+func (o *User) LoadTaskAttachments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.TaskAttachments = nil
+
+	related, err := o.TaskAttachments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.TaskAttachments = related
+	return nil
+}
+
+// LoadTaskAttachments loads the user's TaskAttachments into the .R struct
+func (os UserSlice) LoadTaskAttachments(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	taskAttachments, err := os.TaskAttachments(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TaskAttachments = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range taskAttachments {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.TaskAttachments = append(o.R.TaskAttachments, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadActorTaskEvents loads the user's ActorTaskEvents into the .R struct
+func (o *User) LoadActorTaskEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ActorTaskEvents = nil
+
+	related, err := o.ActorTaskEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ActorUser = o
+	}
+
+	o.R.ActorTaskEvents = related
+	return nil
+}
+
+// LoadActorTaskEvents loads the user's ActorTaskEvents into the .R struct
+func (os UserSlice) LoadActorTaskEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	taskEvents, err := os.ActorTaskEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
 
-		o.R.AiInterpretations = rels
+		o.R.ActorTaskEvents = nil
+	}
 
-		for _, rel := range rels {
-			if rel != nil {
-				rel.R.User = o
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range taskEvents {
+
+			if !rel.ActorID.IsValue() {
+				continue
+			}
+			if !(rel.ActorID.IsValue() && o.ID == rel.ActorID.MustGet()) {
+				continue
 			}
+
+			rel.R.ActorUser = o
+
+			o.R.ActorTaskEvents = append(o.R.ActorTaskEvents, rel)
 		}
+	}
+
+	return nil
+}
+
+// LoadTaskEvents loads the user's TaskEvents into the .R struct
+func (o *User) LoadTaskEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
 		return nil
-	case "Tasks":
-		rels, ok := retrieved.(TaskSlice)
-		if !ok {
-			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+	}
+
+	// Reset the relationship
+	o.R.TaskEvents = nil
+
+	related, err := o.TaskEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.TaskEvents = related
+	return nil
+}
+
+// LoadTaskEvents loads the user's TaskEvents into the .R struct
+func (os UserSlice) LoadTaskEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	taskEvents, err := os.TaskEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
 
-		o.R.Tasks = rels
+		o.R.TaskEvents = nil
+	}
 
-		for _, rel := range rels {
-			if rel != nil {
-				rel.R.User = o
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range taskEvents {
+
+			if !(o.ID == rel.UserID) {
+				continue
 			}
+
+			rel.R.User = o
+
+			o.R.TaskEvents = append(o.R.TaskEvents, rel)
 		}
+	}
+
+	return nil
+}
+
+// LoadTaskImports loads the user's TaskImports into the .R struct
+func (o *User) LoadTaskImports(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
 		return nil
-	case "UserAuths":
-		rels, ok := retrieved.(UserAuthSlice)
-		if !ok {
-			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+	}
+
+	// Reset the relationship
+	o.R.TaskImports = nil
+
+	related, err := o.TaskImports(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.TaskImports = related
+	return nil
+}
+
+// LoadTaskImports loads the user's TaskImports into the .R struct
+func (os UserSlice) LoadTaskImports(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	taskImports, err := os.TaskImports(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
 
-		o.R.UserAuths = rels
+		o.R.TaskImports = nil
+	}
 
-		for _, rel := range rels {
-			if rel != nil {
-				rel.R.User = o
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range taskImports {
+
+			if !(o.ID == rel.UserID) {
+				continue
 			}
+
+			rel.R.User = o
+
+			o.R.TaskImports = append(o.R.TaskImports, rel)
 		}
+	}
+
+	return nil
+}
+
+// LoadTaskStatuses loads the user's TaskStatuses into the .R struct
+func (o *User) LoadTaskStatuses(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
 		return nil
-	default:
-		return fmt.Errorf("user has no relationship %q", name)
 	}
+
+	// Reset the relationship
+	o.R.TaskStatuses = nil
+
+	related, err := o.TaskStatuses(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.TaskStatuses = related
+	return nil
 }
 
-type userPreloader struct{}
+// LoadTaskStatuses loads the user's TaskStatuses into the .R struct
+func (os UserSlice) LoadTaskStatuses(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
 
-func buildUserPreloader() userPreloader {
-	return userPreloader{}
+	taskStatuses, err := os.TaskStatuses(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TaskStatuses = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range taskStatuses {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.TaskStatuses = append(o.R.TaskStatuses, rel)
+		}
+	}
+
+	return nil
 }
 
-type userThenLoader[Q orm.Loadable] struct {
-	AiInterpretations func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
-	Tasks             func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
-	UserAuths         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+// LoadTaskTemplates loads the user's TaskTemplates into the .R struct
+func (o *User) LoadTaskTemplates(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.TaskTemplates = nil
+
+	related, err := o.TaskTemplates(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.TaskTemplates = related
+	return nil
 }
 
-func buildUserThenLoader[Q orm.Loadable]() userThenLoader[Q] {
-	type AiInterpretationsLoadInterface interface {
-		LoadAiInterpretations(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+// LoadTaskTemplates loads the user's TaskTemplates into the .R struct
+func (os UserSlice) LoadTaskTemplates(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
 	}
-	type TasksLoadInterface interface {
-		LoadTasks(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+
+	taskTemplates, err := os.TaskTemplates(mods...).All(ctx, exec)
+	if err != nil {
+		return err
 	}
-	type UserAuthsLoadInterface interface {
-		LoadUserAuths(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TaskTemplates = nil
 	}
 
-	return userThenLoader[Q]{
-		AiInterpretations: thenLoadBuilder[Q](
-			"AiInterpretations",
-			func(ctx context.Context, exec bob.Executor, retrieved AiInterpretationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadAiInterpretations(ctx, exec, mods...)
-			},
-		),
-		Tasks: thenLoadBuilder[Q](
-			"Tasks",
-			func(ctx context.Context, exec bob.Executor, retrieved TasksLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadTasks(ctx, exec, mods...)
-			},
-		),
-		UserAuths: thenLoadBuilder[Q](
-			"UserAuths",
-			func(ctx context.Context, exec bob.Executor, retrieved UserAuthsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadUserAuths(ctx, exec, mods...)
-			},
-		),
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range taskTemplates {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.TaskTemplates = append(o.R.TaskTemplates, rel)
+		}
 	}
+
+	return nil
 }
 
-// LoadAiInterpretations loads the user's AiInterpretations into the .R struct
-func (o *User) LoadAiInterpretations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadTaskViews loads the user's TaskViews into the .R struct
+func (o *User) LoadTaskViews(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.AiInterpretations = nil
+	o.R.TaskViews = nil
 
-	related, err := o.AiInterpretations(mods...).All(ctx, exec)
+	related, err := o.TaskViews(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -888,17 +3007,17 @@ func (o *User) LoadAiInterpretations(ctx context.Context, exec bob.Executor, mod
 		rel.R.User = o
 	}
 
-	o.R.AiInterpretations = related
+	o.R.TaskViews = related
 	return nil
 }
 
-// LoadAiInterpretations loads the user's AiInterpretations into the .R struct
-func (os UserSlice) LoadAiInterpretations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadTaskViews loads the user's TaskViews into the .R struct
+func (os UserSlice) LoadTaskViews(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	aiInterpretations, err := os.AiInterpretations(mods...).All(ctx, exec)
+	taskViews, err := os.TaskViews(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -908,7 +3027,7 @@ func (os UserSlice) LoadAiInterpretations(ctx context.Context, exec bob.Executor
 			continue
 		}
 
-		o.R.AiInterpretations = nil
+		o.R.TaskViews = nil
 	}
 
 	for _, o := range os {
@@ -916,7 +3035,7 @@ func (os UserSlice) LoadAiInterpretations(ctx context.Context, exec bob.Executor
 			continue
 		}
 
-		for _, rel := range aiInterpretations {
+		for _, rel := range taskViews {
 
 			if !(o.ID == rel.UserID) {
 				continue
@@ -924,7 +3043,7 @@ func (os UserSlice) LoadAiInterpretations(ctx context.Context, exec bob.Executor
 
 			rel.R.User = o
 
-			o.R.AiInterpretations = append(o.R.AiInterpretations, rel)
+			o.R.TaskViews = append(o.R.TaskViews, rel)
 		}
 	}
 
@@ -992,6 +3111,67 @@ func (os UserSlice) LoadTasks(ctx context.Context, exec bob.Executor, mods ...bo
 	return nil
 }
 
+// LoadTimeEntries loads the user's TimeEntries into the .R struct
+func (o *User) LoadTimeEntries(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.TimeEntries = nil
+
+	related, err := o.TimeEntries(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.TimeEntries = related
+	return nil
+}
+
+// LoadTimeEntries loads the user's TimeEntries into the .R struct
+func (os UserSlice) LoadTimeEntries(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	timeEntries, err := os.TimeEntries(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.TimeEntries = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range timeEntries {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.TimeEntries = append(o.R.TimeEntries, rel)
+		}
+	}
+
+	return nil
+}
+
 // LoadUserAuths loads the user's UserAuths into the .R struct
 func (o *User) LoadUserAuths(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
@@ -1053,11 +3233,76 @@ func (os UserSlice) LoadUserAuths(ctx context.Context, exec bob.Executor, mods .
 	return nil
 }
 
+// LoadUserSetting loads the user's UserSetting into the .R struct
+func (o *User) LoadUserSetting(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.UserSetting = nil
+
+	related, err := o.UserSetting(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.User = o
+
+	o.R.UserSetting = related
+	return nil
+}
+
+// LoadUserSetting loads the user's UserSetting into the .R struct
+func (os UserSlice) LoadUserSetting(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	userSettings, err := os.UserSetting(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range userSettings {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.UserSetting = rel
+			break
+		}
+	}
+
+	return nil
+}
+
 type userJoins[Q dialect.Joinable] struct {
 	typ               string
 	AiInterpretations modAs[Q, aiInterpretationColumns]
+	CalendarFeed      modAs[Q, calendarFeedColumns]
+	IdempotencyKeys   modAs[Q, idempotencyKeyColumns]
+	Notifications     modAs[Q, notificationColumns]
+	Projects          modAs[Q, projectColumns]
+	TaskAttachments   modAs[Q, taskAttachmentColumns]
+	ActorTaskEvents   modAs[Q, taskEventColumns]
+	TaskEvents        modAs[Q, taskEventColumns]
+	TaskImports       modAs[Q, taskImportColumns]
+	TaskStatuses      modAs[Q, taskStatusColumns]
+	TaskTemplates     modAs[Q, taskTemplateColumns]
+	TaskViews         modAs[Q, taskViewColumns]
 	Tasks             modAs[Q, taskColumns]
+	TimeEntries       modAs[Q, timeEntryColumns]
 	UserAuths         modAs[Q, userAuthColumns]
+	UserSetting       modAs[Q, userSettingColumns]
 }
 
 func (j userJoins[Q]) aliasedAs(alias string) userJoins[Q] {
@@ -1081,6 +3326,160 @@ func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[
 				return mods
 			},
 		},
+		CalendarFeed: modAs[Q, calendarFeedColumns]{
+			c: CalendarFeeds.Columns,
+			f: func(to calendarFeedColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, CalendarFeeds.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		IdempotencyKeys: modAs[Q, idempotencyKeyColumns]{
+			c: IdempotencyKeys.Columns,
+			f: func(to idempotencyKeyColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, IdempotencyKeys.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Notifications: modAs[Q, notificationColumns]{
+			c: Notifications.Columns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Projects: modAs[Q, projectColumns]{
+			c: Projects.Columns,
+			f: func(to projectColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Projects.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TaskAttachments: modAs[Q, taskAttachmentColumns]{
+			c: TaskAttachments.Columns,
+			f: func(to taskAttachmentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskAttachments.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ActorTaskEvents: modAs[Q, taskEventColumns]{
+			c: TaskEvents.Columns,
+			f: func(to taskEventColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskEvents.Name().As(to.Alias())).On(
+						to.ActorID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TaskEvents: modAs[Q, taskEventColumns]{
+			c: TaskEvents.Columns,
+			f: func(to taskEventColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskEvents.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TaskImports: modAs[Q, taskImportColumns]{
+			c: TaskImports.Columns,
+			f: func(to taskImportColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskImports.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TaskStatuses: modAs[Q, taskStatusColumns]{
+			c: TaskStatuses.Columns,
+			f: func(to taskStatusColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskStatuses.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TaskTemplates: modAs[Q, taskTemplateColumns]{
+			c: TaskTemplates.Columns,
+			f: func(to taskTemplateColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskTemplates.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		TaskViews: modAs[Q, taskViewColumns]{
+			c: TaskViews.Columns,
+			f: func(to taskViewColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TaskViews.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		Tasks: modAs[Q, taskColumns]{
 			c: Tasks.Columns,
 			f: func(to taskColumns) bob.Mod[Q] {
@@ -1095,6 +3494,20 @@ func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[
 				return mods
 			},
 		},
+		TimeEntries: modAs[Q, timeEntryColumns]{
+			c: TimeEntries.Columns,
+			f: func(to timeEntryColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, TimeEntries.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		UserAuths: modAs[Q, userAuthColumns]{
 			c: UserAuths.Columns,
 			f: func(to userAuthColumns) bob.Mod[Q] {
@@ -1106,6 +3519,20 @@ func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[
 					))
 				}
 
+				return mods
+			},
+		},
+		UserSetting: modAs[Q, userSettingColumns]{
+			c: UserSettings.Columns,
+			f: func(to userSettingColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, UserSettings.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
 				return mods
 			},
 		},
@@ -43,6 +43,36 @@ var (
 		"Task already exists",
 	)
 
+	// 409 Conflict - Blocked by dependencies
+	ErrTaskBlocked = NewError(
+		http.StatusConflict,
+		"Task is blocked by unfinished dependencies",
+	)
+
+	// 409 Conflict - WIP limit reached
+	ErrTaskWIPLimitReached = NewError(
+		http.StatusConflict,
+		"Task status has reached its WIP limit",
+	)
+
+	// 409 Conflict - Dependency already exists
+	ErrTaskDependencyAlreadyExists = NewError(
+		http.StatusConflict,
+		"Task dependency already exists",
+	)
+
+	// 409 Conflict - Dependency cycle
+	ErrTaskDependencyCycle = NewError(
+		http.StatusConflict,
+		"Task dependency would create a cycle",
+	)
+
+	// 412 Precondition Failed - Version mismatch
+	ErrTaskPreconditionFailed = NewError(
+		http.StatusPreconditionFailed,
+		"Task has been modified by another request",
+	)
+
 	// 500 Internal Server Error
 	ErrTaskInternalError = NewError(
 		http.StatusInternalServerError,
@@ -67,3 +97,309 @@ var (
 		"Failed to delete task",
 	)
 )
+
+// Project関連のエラー
+var (
+	// 400 Bad Request
+	ErrProjectValidationError = NewError(
+		http.StatusBadRequest,
+		"Validation error",
+	)
+
+	// 404 Not Found
+	ErrProjectNotFound = NewError(
+		http.StatusNotFound,
+		"Project not found",
+	)
+
+	// 409 Conflict
+	ErrProjectAlreadyExists = NewError(
+		http.StatusConflict,
+		"Project already exists",
+	)
+
+	// 500 Internal Server Error
+	ErrProjectInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
+
+// TimeEntry関連のエラー
+var (
+	// 400 Bad Request
+	ErrTimeEntryValidationError = NewError(
+		http.StatusBadRequest,
+		"Validation error",
+	)
+
+	// 404 Not Found
+	ErrTimeEntryNotFound = NewError(
+		http.StatusNotFound,
+		"Time entry not found",
+	)
+
+	// 404 Not Found - No running timer for the task
+	ErrTimerNotRunning = NewError(
+		http.StatusNotFound,
+		"No running timer for this task",
+	)
+
+	// 409 Conflict - Another timer is running
+	ErrTimerAlreadyRunning = NewError(
+		http.StatusConflict,
+		"Another timer is already running",
+	)
+
+	// 500 Internal Server Error
+	ErrTimeEntryInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
+
+// Notification関連のエラー
+var (
+	// 400 Bad Request
+	ErrNotificationValidationError = NewError(
+		http.StatusBadRequest,
+		"Validation error",
+	)
+
+	// 404 Not Found
+	ErrNotificationNotFound = NewError(
+		http.StatusNotFound,
+		"Notification not found",
+	)
+
+	// 500 Internal Server Error
+	ErrNotificationInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
+
+// CalendarFeed関連のエラー
+var (
+	// 400 Bad Request
+	ErrCalendarFeedValidationError = NewError(
+		http.StatusBadRequest,
+		"Validation error",
+	)
+
+	// 404 Not Found
+	ErrCalendarFeedNotFound = NewError(
+		http.StatusNotFound,
+		"Calendar feed not found",
+	)
+
+	// 500 Internal Server Error
+	ErrCalendarFeedInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
+
+// Import関連のエラー
+var (
+	// 400 Bad Request
+	ErrImportValidationError = NewError(
+		http.StatusBadRequest,
+		"Validation error",
+	)
+
+	// 409 Conflict - Same entries are being imported concurrently
+	ErrImportConflict = NewError(
+		http.StatusConflict,
+		"Import conflicts with another import in progress",
+	)
+
+	// 413 Payload Too Large
+	ErrImportFileTooLarge = NewError(
+		http.StatusRequestEntityTooLarge,
+		"Import file is too large",
+	)
+
+	// 500 Internal Server Error
+	ErrImportInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
+
+// AccountArchive関連のエラー
+var (
+	// 400 Bad Request
+	ErrAccountArchiveValidationError = NewError(
+		http.StatusBadRequest,
+		"Invalid account archive",
+	)
+
+	// 401 Unauthorized
+	ErrAccountArchiveUnauthorized = NewError(
+		http.StatusUnauthorized,
+		"Unauthorized",
+	)
+
+	// 413 Payload Too Large
+	ErrAccountArchiveTooLarge = NewError(
+		http.StatusRequestEntityTooLarge,
+		"Account archive is too large",
+	)
+
+	// 500 Internal Server Error
+	ErrAccountArchiveInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
+
+// UserSetting関連のエラー
+var (
+	// 400 Bad Request
+	ErrUserSettingValidationError = NewError(
+		http.StatusBadRequest,
+		"Validation error",
+	)
+
+	// 401 Unauthorized
+	ErrUserSettingUnauthorized = NewError(
+		http.StatusUnauthorized,
+		"Unauthorized",
+	)
+
+	// 500 Internal Server Error
+	ErrUserSettingInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
+
+// Attachment関連のエラー
+var (
+	// 400 Bad Request
+	ErrAttachmentValidationError = NewError(
+		http.StatusBadRequest,
+		"Validation error",
+	)
+
+	// 404 Not Found
+	ErrAttachmentNotFound = NewError(
+		http.StatusNotFound,
+		"Attachment not found",
+	)
+
+	// 413 Payload Too Large
+	ErrAttachmentTooLarge = NewError(
+		http.StatusRequestEntityTooLarge,
+		"Attachment is too large",
+	)
+
+	// 500 Internal Server Error
+	ErrAttachmentInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
+
+// TaskTemplate関連のエラー
+var (
+	// 400 Bad Request
+	ErrTaskTemplateValidationError = NewError(
+		http.StatusBadRequest,
+		"Validation error",
+	)
+
+	// 404 Not Found
+	ErrTaskTemplateNotFound = NewError(
+		http.StatusNotFound,
+		"Template not found",
+	)
+
+	// 409 Conflict
+	ErrTaskTemplateAlreadyExists = NewError(
+		http.StatusConflict,
+		"Template already exists",
+	)
+
+	// 500 Internal Server Error
+	ErrTaskTemplateInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
+
+// TaskStatus関連のエラー
+var (
+	// 400 Bad Request
+	ErrTaskStatusValidationError = NewError(
+		http.StatusBadRequest,
+		"Validation error",
+	)
+
+	// 404 Not Found
+	ErrTaskStatusNotFound = NewError(
+		http.StatusNotFound,
+		"Status not found",
+	)
+
+	// 409 Conflict
+	ErrTaskStatusAlreadyExists = NewError(
+		http.StatusConflict,
+		"Status already exists",
+	)
+
+	// 409 Conflict - Tasks remain in the status
+	ErrTaskStatusInUse = NewError(
+		http.StatusConflict,
+		"Status is used by tasks",
+	)
+
+	// 500 Internal Server Error
+	ErrTaskStatusInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
+
+// TaskView関連のエラー
+var (
+	// 400 Bad Request
+	ErrTaskViewValidationError = NewError(
+		http.StatusBadRequest,
+		"Validation error",
+	)
+
+	// 404 Not Found
+	ErrTaskViewNotFound = NewError(
+		http.StatusNotFound,
+		"View not found",
+	)
+
+	// 409 Conflict
+	ErrTaskViewAlreadyExists = NewError(
+		http.StatusConflict,
+		"View already exists",
+	)
+
+	// 500 Internal Server Error
+	ErrTaskViewInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
+
+// Stats関連のエラー
+var (
+	// 400 Bad Request
+	ErrStatsValidationError = NewError(
+		http.StatusBadRequest,
+		"Validation error",
+	)
+
+	// 500 Internal Server Error
+	ErrStatsInternalError = NewError(
+		http.StatusInternalServerError,
+		"Internal server error",
+	)
+)
@@ -0,0 +1,298 @@
+// Package archive はアカウントデータのエクスポート用zipアーカイブの書き出しと読み込みを提供します
+//
+// アーカイブには各データをJSON（インポート用）とCSV（表計算ソフトでの閲覧用）の両方で格納します
+// インポートではJSONのみを読み込みます
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+const (
+	manifestFile            = "manifest.json"
+	profileFile             = "profile.json"
+	projectsFile            = "projects"
+	tasksFile               = "tasks"
+	interpretationsFile     = "interpretations"
+	interpretationItemsFile = "interpretation_items"
+)
+
+// maxEntrySize は展開後の1ファイルの最大サイズ（圧縮率の高いファイルによる過大なメモリ使用を防ぎます）
+const maxEntrySize = 256 << 20
+
+// ContentType はアーカイブのContent-Type
+const ContentType = "application/zip"
+
+// Write はアカウントデータをzipアーカイブとしてwに書き出します
+func Write(w io.Writer, data *entity.AccountArchive) error {
+	zw := zip.NewWriter(w)
+
+	if err := writeJSON(zw, manifestFile, data.Manifest); err != nil {
+		return err
+	}
+	if err := writeJSON(zw, profileFile, data.Profile); err != nil {
+		return err
+	}
+
+	if err := writeJSON(zw, projectsFile+".json", nonNil(data.Projects)); err != nil {
+		return err
+	}
+	if err := writeCSV(zw, projectsFile+".csv", projectHeader, data.Projects, projectRow); err != nil {
+		return err
+	}
+
+	if err := writeJSON(zw, tasksFile+".json", nonNil(data.Tasks)); err != nil {
+		return err
+	}
+	if err := writeCSV(zw, tasksFile+".csv", taskHeader, data.Tasks, taskRow); err != nil {
+		return err
+	}
+
+	if err := writeJSON(zw, interpretationsFile+".json", nonNil(data.Interpretations)); err != nil {
+		return err
+	}
+	if err := writeCSV(zw, interpretationsFile+".csv", interpretationHeader, data.Interpretations, interpretationRow); err != nil {
+		return err
+	}
+
+	if err := writeJSON(zw, interpretationItemsFile+".json", nonNil(data.InterpretationItems)); err != nil {
+		return err
+	}
+	if err := writeCSV(zw, interpretationItemsFile+".csv", interpretationItemHeader, data.InterpretationItems, interpretationItemRow); err != nil {
+		return err
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return nil
+}
+
+// Read はzipアーカイブからアカウントデータを読み込みます
+// manifest.jsonの形式とバージョンが一致しない場合はエラーを返します
+func Read(data []byte) (*entity.AccountArchive, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid zip archive: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	result := &entity.AccountArchive{}
+	if err := readJSON(files, manifestFile, true, &result.Manifest); err != nil {
+		return nil, err
+	}
+	if result.Manifest.Format != entity.AccountArchiveFormat {
+		return nil, fmt.Errorf("%s is not an account export", manifestFile)
+	}
+	if result.Manifest.Version != entity.AccountArchiveVersion {
+		return nil, fmt.Errorf("unsupported archive version: %d", result.Manifest.Version)
+	}
+
+	if err := readJSON(files, profileFile, false, &result.Profile); err != nil {
+		return nil, err
+	}
+	if err := readJSON(files, projectsFile+".json", false, &result.Projects); err != nil {
+		return nil, err
+	}
+	if err := readJSON(files, tasksFile+".json", false, &result.Tasks); err != nil {
+		return nil, err
+	}
+	if err := readJSON(files, interpretationsFile+".json", false, &result.Interpretations); err != nil {
+		return nil, err
+	}
+	if err := readJSON(files, interpretationItemsFile+".json", false, &result.InterpretationItems); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// writeJSON はvをJSONとしてアーカイブに追加します
+func writeJSON(zw *zip.Writer, name string, v any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", name, err)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// writeCSV はrecordsをヘッダー付きのCSVとしてアーカイブに追加します
+func writeCSV[T any](zw *zip.Writer, name string, header []string, records []T, row func(*T) []string) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", name, err)
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	for i := range records {
+		if err := cw.Write(row(&records[i])); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// readJSON はアーカイブ内のJSONファイルをvに読み込みます
+// requiredがfalseの場合、ファイルがなければvを変更せずに成功します
+func readJSON(files map[string]*zip.File, name string, required bool, v any) error {
+	f, ok := files[name]
+	if !ok {
+		if required {
+			return fmt.Errorf("%s is missing", name)
+		}
+		return nil
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer rc.Close()
+
+	body, err := io.ReadAll(io.LimitReader(rc, maxEntrySize+1))
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	if len(body) > maxEntrySize {
+		return fmt.Errorf("%s is too large", name)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return nil
+}
+
+// nonNil は空のデータを null ではなく [] として書き出すためにnilのスライスを空のスライスに置き換えます
+func nonNil[T any](records []T) []T {
+	if records == nil {
+		return []T{}
+	}
+	return records
+}
+
+var projectHeader = []string{"id", "name", "color", "archived", "sort_order", "created_at", "updated_at"}
+
+func projectRow(p *entity.AccountProjectRecord) []string {
+	return []string{
+		p.ID,
+		p.Name,
+		stringValue(p.Color),
+		strconv.FormatBool(p.Archived),
+		strconv.FormatInt(int64(p.SortOrder), 10),
+		timeValue(&p.CreatedAt),
+		timeValue(&p.UpdatedAt),
+	}
+}
+
+var taskHeader = []string{
+	"id", "project_id", "title", "description", "due_at", "estimate_minutes", "status", "rank_key", "started_at", "completed_at", "source",
+	"ai_interpretation_id", "recurrence_rule", "recurrence_anchor_at", "recurrence_series_id", "created_at", "updated_at",
+}
+
+func taskRow(t *entity.AccountTaskRecord) []string {
+	return []string{
+		t.ID,
+		stringValue(t.ProjectID),
+		t.Title,
+		stringValue(t.Description),
+		timeValue(t.DueAt),
+		int32Value(t.EstimateMinutes),
+		t.Status,
+		t.RankKey,
+		timeValue(t.StartedAt),
+		timeValue(t.CompletedAt),
+		t.Source,
+		stringValue(t.AIInterpretationID),
+		stringValue(t.RecurrenceRule),
+		timeValue(t.RecurrenceAnchorAt),
+		stringValue(t.RecurrenceSeriesID),
+		timeValue(&t.CreatedAt),
+		timeValue(&t.UpdatedAt),
+	}
+}
+
+var interpretationHeader = []string{
+	"id", "input_text", "structured_result", "original_result", "ai_model", "ai_prompt_tokens", "ai_completion_tokens", "created_at",
+}
+
+func interpretationRow(i *entity.AccountInterpretationRecord) []string {
+	return []string{
+		i.ID,
+		i.InputText,
+		string(i.StructuredResult),
+		string(i.OriginalResult),
+		i.AIModel,
+		int32Value(i.AIPromptTokens),
+		int32Value(i.AICompletionTokens),
+		timeValue(&i.CreatedAt),
+	}
+}
+
+var interpretationItemHeader = []string{
+	"id", "interpretation_id", "item_index", "resource_type", "resource_id", "status", "data", "original_data",
+	"reviewed_at", "created_at", "updated_at",
+}
+
+func interpretationItemRow(i *entity.AccountInterpretationItemRecord) []string {
+	return []string{
+		i.ID,
+		i.InterpretationID,
+		strconv.FormatInt(int64(i.ItemIndex), 10),
+		i.ResourceType,
+		stringValue(i.ResourceID),
+		i.Status,
+		string(i.Data),
+		string(i.OriginalData),
+		timeValue(i.ReviewedAt),
+		timeValue(&i.CreatedAt),
+		timeValue(&i.UpdatedAt),
+	}
+}
+
+// stringValue はCSVのセルの値を返します（nilは空文字列）
+func stringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+// int32Value はCSVのセルの値を返します（nilは空文字列）
+func int32Value(v *int32) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(int64(*v), 10)
+}
+
+// timeValue はCSVのセルの値をRFC 3339（UTC）で返します（nilは空文字列）
+func timeValue(v *time.Time) string {
+	if v == nil {
+		return ""
+	}
+	return v.UTC().Format(time.RFC3339)
+}
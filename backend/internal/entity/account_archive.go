@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// AccountArchiveFormat はアカウントデータのアーカイブを識別する名前
+	AccountArchiveFormat = "ai-plan-chat-account-export"
+	// AccountArchiveVersion はアーカイブの形式のバージョン（形式を変更した場合に加算）
+	AccountArchiveVersion = 1
+)
+
+// AccountArchive はアカウントのデータ一式（エクスポート・インポートの単位）
+// IDはエクスポート元の環境のもので、インポート時に新しいIDへ振り直します
+type AccountArchive struct {
+	Manifest            AccountArchiveManifest
+	Profile             AccountProfileRecord
+	Projects            []AccountProjectRecord
+	Tasks               []AccountTaskRecord
+	Interpretations     []AccountInterpretationRecord
+	InterpretationItems []AccountInterpretationItemRecord
+}
+
+// AccountArchiveManifest はアーカイブの形式と作成日時
+type AccountArchiveManifest struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+// AccountProfileRecord はユーザーのプロフィール
+type AccountProfileRecord struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Nickname  string    `json:"nickname"`
+	Avatar    *string   `json:"avatar"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AccountProjectRecord はプロジェクト
+type AccountProjectRecord struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Color     *string   `json:"color"`
+	Archived  bool      `json:"archived"`
+	SortOrder int32     `json:"sort_order"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AccountTaskRecord はタスク（ゴミ箱のタスクは含みません）
+type AccountTaskRecord struct {
+	ID                 string     `json:"id"`
+	ProjectID          *string    `json:"project_id"`
+	Title              string     `json:"title"`
+	Description        *string    `json:"description"`
+	DueAt              *time.Time `json:"due_at"`
+	EstimateMinutes    *int32     `json:"estimate_minutes"`
+	Status             string     `json:"status"`
+	RankKey            string     `json:"rank_key"`
+	StartedAt          *time.Time `json:"started_at"`
+	CompletedAt        *time.Time `json:"completed_at"`
+	ArchivedAt         *time.Time `json:"archived_at"`
+	Source             string     `json:"source"`
+	AIInterpretationID *string    `json:"ai_interpretation_id"`
+	RecurrenceRule     *string    `json:"recurrence_rule"`
+	RecurrenceAnchorAt *time.Time `json:"recurrence_anchor_at"`
+	RecurrenceSeriesID *string    `json:"recurrence_series_id"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+// AccountInterpretationRecord はAI解釈の履歴（レビュー前の原本を含みます）
+type AccountInterpretationRecord struct {
+	ID                 string          `json:"id"`
+	InputText          string          `json:"input_text"`
+	StructuredResult   json.RawMessage `json:"structured_result"`
+	OriginalResult     json.RawMessage `json:"original_result"`
+	AIModel            string          `json:"ai_model"`
+	AIPromptTokens     *int32          `json:"ai_prompt_tokens"`
+	AICompletionTokens *int32          `json:"ai_completion_tokens"`
+	CreatedAt          time.Time       `json:"created_at"`
+}
+
+// AccountInterpretationItemRecord はAI解釈のアイテム
+type AccountInterpretationItemRecord struct {
+	ID               string          `json:"id"`
+	InterpretationID string          `json:"interpretation_id"`
+	ItemIndex        int32           `json:"item_index"`
+	ResourceType     string          `json:"resource_type"`
+	ResourceID       *string         `json:"resource_id"`
+	Status           string          `json:"status"`
+	Data             json.RawMessage `json:"data"`
+	OriginalData     json.RawMessage `json:"original_data"`
+	ReviewedAt       *time.Time      `json:"reviewed_at"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+}
+
+// AccountImportResult はアーカイブのインポートで作成した件数
+type AccountImportResult struct {
+	// ProjectsCreated は新しく作成したプロジェクトの件数
+	ProjectsCreated int
+	// ProjectsMerged は同名のプロジェクトが既にあったため、それにタスクを割り当てたプロジェクトの件数
+	ProjectsMerged      int
+	Tasks               int
+	Interpretations     int
+	InterpretationItems int
+}
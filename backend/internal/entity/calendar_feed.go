@@ -0,0 +1,11 @@
+package entity
+
+// CalendarFeedComponent はiCalendarフィードでタスクを表すコンポーネントの種類
+type CalendarFeedComponent string
+
+const (
+	// CalendarFeedComponentEvent はタスクをVEVENTとして出力（Googleカレンダー等の予定表示用）
+	CalendarFeedComponentEvent CalendarFeedComponent = "event"
+	// CalendarFeedComponentTodo はタスクをVTODOとして出力（Apple リマインダー・Thunderbird等のToDo表示用）
+	CalendarFeedComponentTodo CalendarFeedComponent = "todo"
+)
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ImportSource はタスクの取り込み元
+type ImportSource string
+
+const (
+	// ImportSourceICal はiCalendar（.ics）ファイル
+	ImportSourceICal ImportSource = "ical"
+	// ImportSourceTodoist はTodoistのCSVエクスポート
+	ImportSourceTodoist ImportSource = "todoist"
+	// ImportSourceTrello はTrelloのボードのJSONエクスポート
+	ImportSourceTrello ImportSource = "trello"
+	// ImportSourceMarkdown はMarkdownのチェックリスト（- [ ] / - [x]）
+	ImportSourceMarkdown ImportSource = "markdown"
+)
+
+// ImportOptions は取り込みの実行オプション
+type ImportOptions struct {
+	// DryRun がtrueの場合はタスクを作成せずにプレビューのみ返します
+	DryRun bool
+	// ProjectID は取り込んだタスクを割り当てるプロジェクト（nilの場合は未割り当て）
+	ProjectID *string
+	// Location はタイムゾーン指定のない日時を解釈するタイムゾーン（nilの場合は取り込み元の指定またはUTC）
+	Location *time.Location
+}
+
+// ImportItemResult は取り込み候補ごとの処理結果
+type ImportItemResult string
+
+const (
+	// ImportItemResultCreated はタスクを作成した
+	ImportItemResultCreated ImportItemResult = "created"
+	// ImportItemResultWouldCreate はドライランのため作成していないが、実行すれば作成される
+	ImportItemResultWouldCreate ImportItemResult = "would_create"
+	// ImportItemResultDuplicate は取り込み済み（同じ取り込み元の識別子）のため作成しない
+	ImportItemResultDuplicate ImportItemResult = "duplicate"
+	// ImportItemResultSkipped はタスクに変換できないため作成しない
+	ImportItemResultSkipped ImportItemResult = "skipped"
+	// ImportItemResultPending はレビュー待ちのAI解釈アイテムとして登録した（承認するとタスクを作成する）
+	ImportItemResultPending ImportItemResult = "pending"
+)
+
+// ImportTask は取り込み元のデータから変換したタスクの候補
+type ImportTask struct {
+	// ExternalID は取り込み元での識別子（iCalendarのUID等）で、再取り込み時の重複判定に使います
+	ExternalID         string
+	Title              string
+	Description        *string
+	DueAt              *time.Time
+	Status             string
+	RecurrenceRule     *string
+	RecurrenceAnchorAt *time.Time
+	EstimateMinutes    *int32
+	// Labels は取り込み元のラベル・タグ
+	Labels []string
+	// Priority は取り込み元の優先度（high/medium/low、なければnil）
+	Priority *string
+	// Raw は取り込み元のエントリをJSONにしたもので、レビュー用アイテムの原本として保存します
+	Raw json.RawMessage
+	// SkipReason が空でない場合はタスクに変換できないため取り込みません
+	SkipReason string
+	// Warnings は取り込めるが一部の情報を変換できなかった場合の説明
+	Warnings []string
+}
+
+// ImportItem は取り込み候補と処理結果
+type ImportItem struct {
+	ImportTask
+	Result ImportItemResult
+	// Reason はduplicate・skippedの理由
+	Reason string
+	// TaskID は作成したタスク、または取り込み済みのタスクのID
+	TaskID string
+	// InterpretationItemID はレビュー待ちとして登録したAI解釈アイテムのID
+	InterpretationItemID string
+}
+
+// ImportResult は取り込みの結果（ドライランの場合はプレビュー）
+type ImportResult struct {
+	DryRun     bool
+	Created    int
+	Pending    int
+	Duplicates int
+	Skipped    int
+	// InterpretationID はレビュー待ちのアイテムをまとめたAI解釈のID（レビューを経由しない取り込みでは空）
+	InterpretationID string
+	Items            []ImportItem
+}
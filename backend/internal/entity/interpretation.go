@@ -24,6 +24,15 @@ type InterpretationMetadata struct {
 	Priority *string    `json:"priority,omitempty"`
 	Tags     []string   `json:"tags,omitempty"`
 
+	// 繰り返しルール（RFC 5545 RRULE、例: FREQ=WEEKLY;BYDAY=FR）
+	Recurrence *string `json:"recurrence,omitempty"`
+
+	// 見積もり工数（分）
+	EstimateMinutes *int32 `json:"estimate_minutes,omitempty"`
+
+	// 割り当て先のプロジェクト名（ユーザーの既存プロジェクトから選択）
+	Project *string `json:"project,omitempty"`
+
 	// 追加のカスタムフィールド
 	Extra map[string]interface{} `json:"extra,omitempty"`
 }
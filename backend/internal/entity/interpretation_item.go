@@ -40,10 +40,13 @@ type InterpretationItem struct {
 
 // TaskData はタスクアイテムのデータ構造
 type TaskData struct {
-	Title       string     `json:"title"`
-	Description *string    `json:"description,omitempty"`
-	DueAt       *time.Time `json:"due_at,omitempty"`
-	Priority    *string    `json:"priority,omitempty"`
-	Status      *string    `json:"status,omitempty"`
-	Tags        []string   `json:"tags,omitempty"`
+	Title           string     `json:"title"`
+	Description     *string    `json:"description,omitempty"`
+	DueAt           *time.Time `json:"due_at,omitempty"`
+	EstimateMinutes *int32     `json:"estimate_minutes,omitempty"`
+	Priority        *string    `json:"priority,omitempty"`
+	Status          *string    `json:"status,omitempty"`
+	Tags            []string   `json:"tags,omitempty"`
+	RecurrenceRule  *string    `json:"recurrence_rule,omitempty"`
+	ProjectID       *string    `json:"project_id,omitempty"`
 }
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NotificationType はアプリ内通知の種類
+type NotificationType string
+
+const (
+	// NotificationTypeTaskReminder はタスクの期限リマインダー
+	NotificationTypeTaskReminder NotificationType = "task_reminder"
+	// NotificationTypeTaskSnoozeEnded はタスクのスヌーズの解除
+	NotificationTypeTaskSnoozeEnded NotificationType = "task_snooze_ended"
+)
+
+// NewNotification は他の機能からアプリ内通知を発行する際の入力
+type NewNotification struct {
+	UserID string
+	Type   NotificationType
+	Title  string
+	Body   *string
+	TaskID *string
+}
+
+// NotificationCursor は通知一覧のページ位置（作成日時の新しい順に並べた最後の通知）
+type NotificationCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// Encode はカーソルをクエリパラメータに使える文字列に変換します
+func (c NotificationCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseNotificationCursor はEncodeで生成した文字列をカーソルに戻します
+func ParseNotificationCursor(value string) (*NotificationCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor encoding: %w", err)
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok || id == "" {
+		return nil, fmt.Errorf("invalid cursor format")
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor timestamp: %w", err)
+	}
+
+	return &NotificationCursor{
+		CreatedAt: time.Unix(0, unixNano).UTC(),
+		ID:        id,
+	}, nil
+}
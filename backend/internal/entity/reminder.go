@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// ReminderDeliveryStatus はリマインダーの配信状態
+type ReminderDeliveryStatus string
+
+const (
+	// ReminderDeliveryStatusPending は配信中（配信前に停止した場合は一定時間後に再試行）
+	ReminderDeliveryStatusPending ReminderDeliveryStatus = "pending"
+	// ReminderDeliveryStatusSent は配信済み
+	ReminderDeliveryStatusSent ReminderDeliveryStatus = "sent"
+	// ReminderDeliveryStatusFailed は配信失敗（試行回数の上限まで再試行）
+	ReminderDeliveryStatusFailed ReminderDeliveryStatus = "failed"
+)
+
+// Reminder は配信するタスクの期限リマインダー
+type Reminder struct {
+	TaskID    string
+	TaskTitle string
+	DueAt     time.Time
+	// Offset は期限のどれだけ前のリマインダーか（0は期限到来時）
+	Offset    time.Duration
+	UserID    string
+	UserEmail string
+	UserName  string
+}
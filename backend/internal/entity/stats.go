@@ -0,0 +1,104 @@
+package entity
+
+import "time"
+
+// StatsGroupBy は生産性統計の期間ごとの集計単位
+type StatsGroupBy string
+
+const (
+	// StatsGroupByDay は日ごとの集計（指定したタイムゾーンの日付で区切る）
+	StatsGroupByDay StatsGroupBy = "day"
+	// StatsGroupByWeek は週ごとの集計（指定したタイムゾーンの月曜日0時で区切る）
+	StatsGroupByWeek StatsGroupBy = "week"
+)
+
+// TaskStatsSlotSeconds はタスク件数を集計する時間枠の長さ（秒）
+// すべてのタイムゾーンのUTCオフセットは15分の倍数のため、この枠を日・週にまとめ直せば任意のタイムゾーンで区切れます
+const TaskStatsSlotSeconds = 15 * 60
+
+// TaskStatsSlot は時間枠・作成元ごとのタスク件数の集計行
+type TaskStatsSlot struct {
+	// Slot はUNIX時刻をTaskStatsSlotSecondsで割った時間枠の番号
+	Slot   int64
+	Source string
+	Count  int64
+	// DoneCount は作成日時で集計した場合の、そのうち現在完了しているタスクの件数
+	DoneCount int64
+	// LeadSeconds は完了日時で集計した場合の、作成から完了までの秒数の合計
+	LeadSeconds int64
+	// CycleCount・CycleSeconds は完了日時で集計した場合の、着手日時を持つタスクの件数と着手から完了までの秒数の合計
+	CycleCount   int64
+	CycleSeconds int64
+}
+
+// Time は時間枠の開始日時を返します
+func (s *TaskStatsSlot) Time() time.Time {
+	return time.Unix(s.Slot*TaskStatsSlotSeconds, 0)
+}
+
+// TaskStatsPeriod は期間（日または週）ごとの作成・完了件数
+type TaskStatsPeriod struct {
+	// Start は期間の開始日時（指定したタイムゾーンの0時）
+	Start          time.Time
+	CreatedCount   int64
+	CompletedCount int64
+}
+
+// TaskStatsSource は作成元（ai/manual）ごとの作成・完了件数
+type TaskStatsSource struct {
+	Source         string
+	CreatedCount   int64
+	CompletedCount int64
+}
+
+// TaskStats は期間内のタスクの生産性統計（ゴミ箱内のタスクは除く）
+type TaskStats struct {
+	From    time.Time
+	To      time.Time
+	GroupBy StatsGroupBy
+	// CreatedCount は期間内に作成したタスクの件数
+	CreatedCount int64
+	// CompletedCount は期間内に完了したタスクの件数
+	CompletedCount int64
+	// CompletionRate は期間内に作成したタスクのうち現在完了している割合（作成がない場合はnil）
+	CompletionRate *float64
+	// AverageLeadTimeSeconds は期間内に完了したタスクの作成から完了までの平均秒数（完了がない場合はnil）
+	AverageLeadTimeSeconds *float64
+	// OverdueCount は現在期限を過ぎている未完了タスクの件数（期間によらない）
+	OverdueCount int64
+	Periods      []*TaskStatsPeriod
+	Sources      []*TaskStatsSource
+}
+
+// TaskFlowTotals はある日時より前に作成・着手・完了したタスクの累計件数
+// 着手せずに完了したタスクは完了日時に着手したものとして数えます
+type TaskFlowTotals struct {
+	Created   int64
+	Started   int64
+	Completed int64
+}
+
+// TaskFlowPeriod は期間（日または週）ごとの着手・完了件数とサイクルタイム、期間末時点の累計件数
+type TaskFlowPeriod struct {
+	// Start は期間の開始日時（指定したタイムゾーンの0時）
+	Start          time.Time
+	StartedCount   int64
+	CompletedCount int64
+	// AverageCycleTimeSeconds は期間内に完了したタスクの着手から完了までの平均秒数（対象がない場合はnil）
+	AverageCycleTimeSeconds *float64
+	// Cumulative は期間末時点の累計件数（累積フロー図・バーンダウンチャート用）
+	Cumulative TaskFlowTotals
+}
+
+// TaskFlowReport は期間内のサイクルタイムとスループットの集計結果（ゴミ箱内のタスクは除く）
+// 着手・完了日時は現在の値で集計するため、ステータスを戻したタスクは戻す前の着手・完了を含みません
+type TaskFlowReport struct {
+	From    time.Time
+	To      time.Time
+	GroupBy StatsGroupBy
+	// Throughput は期間内に完了したタスクの件数
+	Throughput int64
+	// AverageCycleTimeSeconds は期間内に完了したタスクの着手から完了までの平均秒数（着手日時のないタスクは除く、対象がない場合はnil）
+	AverageCycleTimeSeconds *float64
+	Periods                 []*TaskFlowPeriod
+}
@@ -0,0 +1,98 @@
+package entity
+
+import "time"
+
+// TaskOccurrence は繰り返しタスクを展開した発生予定（まだタスクとして生成されていない回）
+type TaskOccurrence struct {
+	TaskID   string
+	SeriesID string
+	Title    string
+	OccursAt time.Time
+}
+
+// TaskEventType はタスク変更履歴のイベント種別
+type TaskEventType string
+
+const (
+	TaskEventTypeCreated  TaskEventType = "created"
+	TaskEventTypeUpdated  TaskEventType = "updated"
+	TaskEventTypeDeleted  TaskEventType = "deleted"
+	TaskEventTypeRestored TaskEventType = "restored"
+)
+
+// TaskEventSource はタスク変更の発生元
+type TaskEventSource string
+
+const (
+	// TaskEventSourceManual はユーザーによる画面操作・API呼び出し
+	TaskEventSourceManual TaskEventSource = "manual"
+	// TaskEventSourceAI はAI解釈アイテムの承認
+	TaskEventSourceAI TaskEventSource = "ai"
+)
+
+// TaskFieldChange はタスクのフィールド単位の変更前後の値（値がない場合はnull）
+type TaskFieldChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// TaskBatchOperationType はタスク一括操作の種類
+type TaskBatchOperationType string
+
+const (
+	// TaskBatchOperationUpdate は指定したフィールドの部分更新
+	TaskBatchOperationUpdate TaskBatchOperationType = "update"
+	// TaskBatchOperationSetStatus はステータスの変更
+	TaskBatchOperationSetStatus TaskBatchOperationType = "set_status"
+	// TaskBatchOperationDelete はゴミ箱への移動
+	TaskBatchOperationDelete TaskBatchOperationType = "delete"
+	// TaskBatchOperationMoveProject はプロジェクトの変更（ProjectIDがnilの場合はプロジェクトから除外）
+	TaskBatchOperationMoveProject TaskBatchOperationType = "move_project"
+)
+
+// TaskBatchOperation はタスク一括操作の1件分の内容
+type TaskBatchOperation struct {
+	Type        TaskBatchOperationType
+	TaskID      string
+	Title       *string
+	Description *string
+	DueAt       *time.Time
+	Status      *string
+	ProjectID   *string
+	Force       bool
+	// Version は取得時のバージョン（指定した場合は一致しなければ失敗）
+	Version *int32
+}
+
+// TaskBatchResultStatus はタスク一括操作の1件分の結果
+type TaskBatchResultStatus string
+
+const (
+	// TaskBatchResultSucceeded は操作が反映されたことを表す
+	TaskBatchResultSucceeded TaskBatchResultStatus = "succeeded"
+	// TaskBatchResultFailed は操作が失敗したことを表す（Errに原因を設定）
+	TaskBatchResultFailed TaskBatchResultStatus = "failed"
+	// TaskBatchResultRolledBack は成功したが全件ロールバックにより取り消されたことを表す
+	TaskBatchResultRolledBack TaskBatchResultStatus = "rolled_back"
+	// TaskBatchResultSkipped は全件ロールバックにより実行されなかったことを表す
+	TaskBatchResultSkipped TaskBatchResultStatus = "skipped"
+)
+
+// TaskBatchResult はタスク一括操作の1件分の実行結果
+type TaskBatchResult struct {
+	Index  int
+	TaskID string
+	Type   TaskBatchOperationType
+	Status TaskBatchResultStatus
+	Err    error
+}
+
+// TaskSummary はタスクのレスポンスに含める算出値（依存関係と作業時間記録から集計）
+type TaskSummary struct {
+	// Blocked は未完了の先行タスクがあるかどうか
+	Blocked bool
+	// TrackedSeconds は記録された作業時間の合計（計測中の記録は現在までの経過時間を含む）
+	TrackedSeconds int64
+	// TimerRunning はタイマーが計測中かどうか
+	TimerRunning bool
+}
@@ -0,0 +1,25 @@
+package entity
+
+// ワークフローのステータスのカテゴリ（tasks.status_categoryに複製し、着手・完了の判定や集計に使用）
+const (
+	// TaskStatusCategoryTodo は未着手
+	TaskStatusCategoryTodo = "todo"
+	// TaskStatusCategoryDoing は作業中（このカテゴリへの変更で着手日時を記録）
+	TaskStatusCategoryDoing = "doing"
+	// TaskStatusCategoryDone は完了（このカテゴリへの変更で完了日時を記録）
+	TaskStatusCategoryDone = "done"
+)
+
+// DefaultTaskStatus はユーザーごとに最初に作成するステータスの定義
+type DefaultTaskStatus struct {
+	Name     string
+	Category string
+}
+
+// DefaultTaskStatuses はユーザーごとに最初に作成するステータス（表示順）
+// 以前の固定のステータス（todo/in_progress/done）と同じ名前にし、既存のタスクや取り込み元の値をそのまま使えるようにしています
+var DefaultTaskStatuses = []DefaultTaskStatus{
+	{Name: "todo", Category: TaskStatusCategoryTodo},
+	{Name: "in_progress", Category: TaskStatusCategoryDoing},
+	{Name: "done", Category: TaskStatusCategoryDone},
+}
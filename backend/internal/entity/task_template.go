@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TaskTemplate はタスクテンプレート（展開すると定義したタスクをまとめて作成する）
+type TaskTemplate struct {
+	ID          string
+	UserID      string
+	Name        string
+	Description *string
+	// ProjectID は作成したタスクを割り当てる既定のプロジェクト（展開時に上書き可能）
+	ProjectID *string
+	Items     []TaskTemplateItem
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TaskTemplateItem はテンプレートに含まれるタスクの定義（task_templates.itemsに保存する構造）
+type TaskTemplateItem struct {
+	Title       string  `json:"title"`
+	Description *string `json:"description,omitempty"`
+	// DueOffset は展開時の基準日時からの相対期限（例: "+3d"、"-1d12h"、nilの場合は期限なし）
+	DueOffset       *string `json:"due_offset,omitempty"`
+	EstimateMinutes *int32  `json:"estimate_minutes,omitempty"`
+	// Subtasks はサブタスクの定義（展開時は親タスクがサブタスクに依存する関係として作成します）
+	Subtasks []TaskTemplateItem `json:"subtasks,omitempty"`
+}
+
+// CountTaskTemplateItems はサブタスクを含むタスク定義の総数を返します
+func CountTaskTemplateItems(items []TaskTemplateItem) int {
+	count := len(items)
+	for _, item := range items {
+		count += CountTaskTemplateItems(item.Subtasks)
+	}
+	return count
+}
+
+// DueOffset はテンプレートの相対期限
+// 日・週の単位はカレンダー上の日数として加算するため、夏時間の切り替えをまたいでも時刻がずれません
+type DueOffset struct {
+	Days     int
+	Duration time.Duration
+}
+
+// Apply は基準日時に相対期限を加算した日時を返します
+func (o DueOffset) Apply(anchor time.Time) time.Time {
+	return anchor.AddDate(0, 0, o.Days).Add(o.Duration)
+}
+
+// String は相対期限を "+1w2d3h30m" 形式の文字列で返します（週・日・時間・分の順）
+func (o DueOffset) String() string {
+	days, duration := o.Days, o.Duration
+	sign := "+"
+	if days < 0 || duration < 0 {
+		sign = "-"
+		days, duration = -days, -duration
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	if weeks := days / 7; weeks > 0 {
+		fmt.Fprintf(&b, "%dw", weeks)
+	}
+	if days%7 > 0 {
+		fmt.Fprintf(&b, "%dd", days%7)
+	}
+	if hours := int(duration / time.Hour); hours > 0 {
+		fmt.Fprintf(&b, "%dh", hours)
+	}
+	if minutes := int(duration % time.Hour / time.Minute); minutes > 0 {
+		fmt.Fprintf(&b, "%dm", minutes)
+	}
+	if b.Len() == 1 {
+		b.WriteString("0d")
+	}
+	return b.String()
+}
+
+// DueOffsetBetween は基準日時から期限までの相対期限を返します
+// 日数は基準日時のタイムゾーンでの日付の差とし、端数を時間・分で表します（分未満は切り捨て）
+func DueOffsetBetween(anchor, due time.Time) DueOffset {
+	due = due.In(anchor.Location())
+	negative := due.Before(anchor)
+	if negative {
+		anchor, due = due, anchor
+	}
+
+	days := int(due.Sub(anchor) / (24 * time.Hour))
+	for days > 0 && anchor.AddDate(0, 0, days).After(due) {
+		days--
+	}
+	for !anchor.AddDate(0, 0, days+1).After(due) {
+		days++
+	}
+	duration := due.Sub(anchor.AddDate(0, 0, days)).Truncate(time.Minute)
+
+	if negative {
+		return DueOffset{Days: -days, Duration: -duration}
+	}
+	return DueOffset{Days: days, Duration: duration}
+}
@@ -0,0 +1,80 @@
+package entity
+
+import "time"
+
+// システムビューのID（保存したビューのUUIDとは重複しない固定の値）
+const (
+	// SystemTaskViewToday は今日が期限の未完了タスク
+	SystemTaskViewToday = "today"
+	// SystemTaskViewOverdue は期限を過ぎた未完了タスク
+	SystemTaskViewOverdue = "overdue"
+	// SystemTaskViewUpcoming は明日から7日以内が期限の未完了タスク
+	SystemTaskViewUpcoming = "upcoming"
+	// SystemTaskViewAICreated はAIが作成したタスク
+	SystemTaskViewAICreated = "ai-created"
+)
+
+// TaskDueNow は期限の絞り込みで現在時刻を表す値
+const TaskDueNow = "now"
+
+// TaskSort はビューのタスクの並び順
+type TaskSort string
+
+const (
+	// TaskSortRank はタスク一覧と同じ表示順（既定）
+	TaskSortRank TaskSort = "rank"
+	// TaskSortDueAt は期限の早い順（期限なしは最後）
+	TaskSortDueAt TaskSort = "due_at"
+	// TaskSortCreatedAt は作成日時の新しい順
+	TaskSortCreatedAt TaskSort = "created_at"
+)
+
+// TaskView はタスクのビュー（保存した絞り込み条件、またはシステムビュー）
+type TaskView struct {
+	ID     string
+	UserID string
+	Name   string
+	Filter TaskFilter
+	// System はシステムビュー（全ユーザー共通で編集・削除できない）かどうか
+	System    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TaskFilter はビューの絞り込み条件（task_views.filterに保存する構造）
+// 指定した条件はすべて満たす必要があり（AND）、一覧で指定した値はいずれかに一致すれば満たします（OR）
+type TaskFilter struct {
+	Statuses []string `json:"statuses,omitempty"`
+	// StatusCategories はステータスのカテゴリ（todo/doing/done）で、ユーザーが追加したステータスも含めて絞り込みます
+	StatusCategories []string `json:"status_categories,omitempty"`
+	ProjectIDs       []string `json:"project_ids,omitempty"`
+	Sources          []string `json:"sources,omitempty"`
+	// DueFrom・DueTo は期限の範囲（DueFromは含み、DueToは含まない）で、
+	// 実行時の当日0時からの相対期限（例: "+0d"、"+7d"）または現在時刻を表す "now" で指定します
+	DueFrom *string `json:"due_from,omitempty"`
+	DueTo   *string `json:"due_to,omitempty"`
+	// HasDueDate は期限の有無（nilの場合は問わない）
+	HasDueDate *bool `json:"has_due_date,omitempty"`
+	// TitleContains はタイトルに含まれる文字列（大文字・小文字は区別しない）
+	TitleContains *string `json:"title_contains,omitempty"`
+	// IncludeSnoozed はスヌーズ中のタスクを含めるかどうか（既定では含めない）
+	IncludeSnoozed bool `json:"include_snoozed,omitempty"`
+	// IncludeArchived はアーカイブしたタスクを含めるかどうか（既定では含めない）
+	IncludeArchived bool     `json:"include_archived,omitempty"`
+	Sort            TaskSort `json:"sort,omitempty"`
+}
+
+// TaskQuery はビューの絞り込み条件の相対期限を実行時の日時に解決した、タスクの検索条件
+type TaskQuery struct {
+	Statuses         []string
+	StatusCategories []string
+	ProjectIDs       []string
+	Sources          []string
+	DueFrom          *time.Time
+	DueTo            *time.Time
+	HasDueDate       *bool
+	TitleContains    *string
+	IncludeSnoozed   bool
+	IncludeArchived  bool
+	Sort             TaskSort
+}
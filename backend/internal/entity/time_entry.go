@@ -0,0 +1,58 @@
+package entity
+
+import "time"
+
+// TimeReportGroupBy は作業時間レポートの集計単位
+type TimeReportGroupBy string
+
+const (
+	// TimeReportGroupByDay は日ごとの集計（指定したタイムゾーンの日付で区切る）
+	TimeReportGroupByDay TimeReportGroupBy = "day"
+	// TimeReportGroupByProject はタスクのプロジェクトごとの集計
+	TimeReportGroupByProject TimeReportGroupBy = "project"
+	// TimeReportGroupByTag はタスクのタグごとの集計（複数のタグを持つタスクの時間は各タグに計上する）
+	TimeReportGroupByTag TimeReportGroupBy = "tag"
+)
+
+// TimeReportNoProjectKey はプロジェクトに属さないタスクの集計キー
+const TimeReportNoProjectKey = "none"
+
+// TimeReportNoTagKey はタグのないタスクの集計キー（タグの集計と区別するためLabelはnil）
+const TimeReportNoTagKey = "none"
+
+// TimeReportGroup は作業時間レポートの集計単位ごとの合計
+type TimeReportGroup struct {
+	// Key は日付（YYYY-MM-DD）、プロジェクトIDまたはタグ
+	Key string
+	// Label はプロジェクト名またはタグ（日ごとの集計やプロジェクト・タグなしの場合はnil）
+	Label        *string
+	TotalSeconds int64
+	EntryCount   int
+}
+
+// TimeReport は期間内の作業時間の集計結果（期間外にはみ出した記録は期間内の分のみ集計）
+type TimeReport struct {
+	From         time.Time
+	To           time.Time
+	GroupBy      TimeReportGroupBy
+	TotalSeconds int64
+	Groups       []*TimeReportGroup
+}
+
+// EstimateReportTask は完了タスクごとの見積もり工数と実績時間
+type EstimateReportTask struct {
+	TaskID          string
+	Title           string
+	EstimateMinutes int32
+	ActualSeconds   int64
+}
+
+// EstimateReport は完了タスクの見積もり工数と実績時間（時間記録の合計）の比較
+type EstimateReport struct {
+	TaskCount            int
+	TotalEstimateSeconds int64
+	TotalActualSeconds   int64
+	// ActualToEstimateRatio は実績÷見積もりの比率（1より大きいと見積もりより時間がかかっている、対象がない場合はnil）
+	ActualToEstimateRatio *float64
+	Tasks                 []*EstimateReportTask
+}
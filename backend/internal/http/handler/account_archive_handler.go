@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/archive"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// AccountArchiveHandler はアカウントデータのエクスポート・インポート関連のHTTPハンドラー
+type AccountArchiveHandler struct {
+	usecase   interfaces.AccountArchiveUsecase
+	presenter *presenter.AccountArchivePresenter
+}
+
+func NewAccountArchiveHandler(usecase interfaces.AccountArchiveUsecase, presenter *presenter.AccountArchivePresenter) *AccountArchiveHandler {
+	return &AccountArchiveHandler{
+		usecase:   usecase,
+		presenter: presenter,
+	}
+}
+
+// ExportAccount はアカウントのデータ一式をzipアーカイブとして返します (GET /me/export)
+func (h *AccountArchiveHandler) ExportAccount(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	data, err := h.usecase.ExportAccount(ctx)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	filename := h.presenter.GetExportFilename(data.Manifest.ExportedAt)
+	c.Header("Content-Type", archive.ContentType)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Status(http.StatusOK)
+
+	// アーカイブはレスポンスに直接書き出すため、書き出し中のエラーはステータスに反映できずログに残すのみ
+	if err := archive.Write(c.Writer, data); err != nil {
+		slog.ErrorContext(ctx, "Handler: Failed to write account archive",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// ImportAccount はエクスポートしたzipアーカイブのデータをアカウントに復元します (POST /me/import)
+func (h *AccountArchiveHandler) ImportAccount(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	body, err := readUploadedFile(c, validation.MaxAccountArchiveSize)
+	if err != nil {
+		if errors.Is(err, errUploadTooLarge) {
+			_ = c.Error(apperr.ErrAccountArchiveTooLarge)
+		} else if errors.Is(err, errUploadOpen) {
+			_ = c.Error(apperr.ErrAccountArchiveInternalError)
+		} else {
+			_ = c.Error(apperr.ErrAccountArchiveValidationError)
+		}
+		return
+	}
+
+	data, err := archive.Read(body)
+	if err != nil {
+		_ = c.Error(apperr.ErrAccountArchiveValidationError)
+		return
+	}
+
+	result, err := h.usecase.ImportAccount(ctx, data)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetAccountImportResult(result)
+	c.JSON(http.StatusOK, response)
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+func (h *AccountArchiveHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "unauthorized"):
+		_ = c.Error(apperr.ErrAccountArchiveUnauthorized)
+	case strings.Contains(err.Error(), "validation"):
+		_ = c.Error(apperr.ErrAccountArchiveValidationError)
+	default:
+		_ = c.Error(apperr.ErrAccountArchiveInternalError)
+	}
+}
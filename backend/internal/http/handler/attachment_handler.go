@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// AttachmentHandler はタスクの添付ファイル関連のHTTPハンドラー
+type AttachmentHandler struct {
+	usecase   interfaces.AttachmentUsecase
+	presenter *presenter.AttachmentPresenter
+	maxSize   int64
+}
+
+// NewAttachmentHandler は新しいAttachmentHandlerを生成します（maxSizeはアップロードを受け付ける最大サイズ）
+func NewAttachmentHandler(usecase interfaces.AttachmentUsecase, presenter *presenter.AttachmentPresenter, maxSize int64) *AttachmentHandler {
+	return &AttachmentHandler{
+		usecase:   usecase,
+		presenter: presenter,
+		maxSize:   maxSize,
+	}
+}
+
+// GetTaskAttachments はタスクの添付ファイル一覧を取得します (GET /tasks/:id/attachments)
+func (h *AttachmentHandler) GetTaskAttachments(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrAttachmentValidationError)
+		return
+	}
+
+	attachments, err := h.usecase.GetTaskAttachments(ctx, taskID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetAttachmentList(attachments)
+	c.JSON(http.StatusOK, response)
+}
+
+// UploadTaskAttachment はmultipart/form-dataのfileフィールドのファイルをタスクに添付します (POST /tasks/:id/attachments)
+func (h *AttachmentHandler) UploadTaskAttachment(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrAttachmentValidationError)
+		return
+	}
+
+	// ファイル名が必要なためmultipart/form-dataのみ受け付ける
+	if !strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		_ = c.Error(apperr.ErrAttachmentValidationError)
+		return
+	}
+
+	data, err := readUploadedFile(c, h.maxSize)
+	if err != nil {
+		if errors.Is(err, errUploadTooLarge) {
+			_ = c.Error(apperr.ErrAttachmentTooLarge)
+		} else if errors.Is(err, errUploadOpen) {
+			_ = c.Error(apperr.ErrAttachmentInternalError)
+		} else {
+			_ = c.Error(apperr.ErrAttachmentValidationError)
+		}
+		return
+	}
+
+	// readUploadedFileで解析済みのフォームからファイル名を取得
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		_ = c.Error(apperr.ErrAttachmentValidationError)
+		return
+	}
+
+	attachment, err := h.usecase.UploadAttachment(ctx, taskID, fileHeader.Filename, data)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetAttachment(attachment)
+	c.JSON(http.StatusCreated, response)
+}
+
+// DownloadTaskAttachment は添付ファイルをダウンロードします (GET /tasks/:id/attachments/:attachment_id)
+func (h *AttachmentHandler) DownloadTaskAttachment(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID, attachmentID, ok := h.bindAttachmentParams(c)
+	if !ok {
+		return
+	}
+
+	attachment, body, err := h.usecase.DownloadAttachment(ctx, taskID, attachmentID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	defer body.Close()
+
+	// 保存時に判定したMIMEタイプをブラウザに再判定させず、常にダウンロードとして扱わせる
+	c.DataFromReader(http.StatusOK, attachment.SizeBytes, attachment.ContentType, body, map[string]string{
+		"Content-Disposition":    h.presenter.GetContentDisposition(attachment),
+		"X-Content-Type-Options": "nosniff",
+		"Cache-Control":          "private, no-store",
+	})
+}
+
+// DeleteTaskAttachment は添付ファイルを削除します (DELETE /tasks/:id/attachments/:attachment_id)
+func (h *AttachmentHandler) DeleteTaskAttachment(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID, attachmentID, ok := h.bindAttachmentParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.DeleteAttachment(ctx, taskID, attachmentID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// bindAttachmentParams はパスのタスクIDと添付ファイルIDを検証して返します
+func (h *AttachmentHandler) bindAttachmentParams(c *gin.Context) (string, string, bool) {
+	taskID := c.Param("id")
+	attachmentID := c.Param("attachment_id")
+
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrAttachmentValidationError)
+		return "", "", false
+	}
+	if err := validation.ValidateAttachmentID(attachmentID); err != nil {
+		_ = c.Error(apperr.ErrAttachmentValidationError)
+		return "", "", false
+	}
+	return taskID, attachmentID, true
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+func (h *AttachmentHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "task not found"):
+		_ = c.Error(apperr.ErrTaskNotFound)
+	case strings.Contains(err.Error(), "not found"), strings.Contains(err.Error(), "unauthorized"):
+		_ = c.Error(apperr.ErrAttachmentNotFound)
+	case strings.Contains(err.Error(), "too large"):
+		_ = c.Error(apperr.ErrAttachmentTooLarge)
+	case strings.Contains(err.Error(), "validation"):
+		_ = c.Error(apperr.ErrAttachmentValidationError)
+	default:
+		_ = c.Error(apperr.ErrAttachmentInternalError)
+	}
+}
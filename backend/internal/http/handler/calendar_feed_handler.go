@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/ical"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// calendarFeedFileSuffix は購読URLのファイル名の拡張子（カレンダーアプリがiCalendarと認識しやすいように付ける）
+const calendarFeedFileSuffix = ".ics"
+
+// CalendarFeedHandler はiCalendar購読フィード関連のHTTPハンドラー
+type CalendarFeedHandler struct {
+	usecase   interfaces.CalendarFeedUsecase
+	presenter *presenter.CalendarFeedPresenter
+}
+
+func NewCalendarFeedHandler(usecase interfaces.CalendarFeedUsecase, presenter *presenter.CalendarFeedPresenter) *CalendarFeedHandler {
+	return &CalendarFeedHandler{
+		usecase:   usecase,
+		presenter: presenter,
+	}
+}
+
+// GetCalendarFeed はログインユーザーの購読フィードの発行状態を取得します (GET /calendar-feed)
+func (h *CalendarFeedHandler) GetCalendarFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	feed, err := h.usecase.GetFeed(ctx)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetFeedStatus(feed)
+	c.JSON(http.StatusOK, response)
+}
+
+// RotateCalendarFeedToken は購読フィードのトークンを発行・再発行します (POST /calendar-feed/rotate)
+func (h *CalendarFeedHandler) RotateCalendarFeedToken(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	feed, token, err := h.usecase.RotateToken(ctx)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetFeedToken(feed, token)
+	c.JSON(http.StatusOK, response)
+}
+
+// RevokeCalendarFeed は購読フィードのトークンを無効化します (DELETE /calendar-feed)
+func (h *CalendarFeedHandler) RevokeCalendarFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := h.usecase.RevokeToken(ctx); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetCalendarFeedICS は期限付きタスクをiCalendar形式で返します (GET /ical/:token.ics)
+// ルーターのパスパラメータは拡張子を含むファイル名全体として受け取ります
+func (h *CalendarFeedHandler) GetCalendarFeedICS(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	token, ok := strings.CutSuffix(c.Param("file"), calendarFeedFileSuffix)
+	if !ok || token == "" {
+		_ = c.Error(apperr.ErrCalendarFeedNotFound)
+		return
+	}
+
+	var params api.GetCalendarFeedICSParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		_ = c.Error(apperr.ErrCalendarFeedValidationError)
+		return
+	}
+
+	component := entity.CalendarFeedComponentEvent
+	if params.Type != nil {
+		switch entity.CalendarFeedComponent(*params.Type) {
+		case entity.CalendarFeedComponentEvent, entity.CalendarFeedComponentTodo:
+			component = entity.CalendarFeedComponent(*params.Type)
+		default:
+			_ = c.Error(apperr.ErrCalendarFeedValidationError)
+			return
+		}
+	}
+
+	tasks, err := h.usecase.GetFeedTasks(ctx, token)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	body := h.presenter.RenderCalendar(tasks, component)
+	c.Header("Content-Disposition", `inline; filename="tasks.ics"`)
+	c.Data(http.StatusOK, ical.ContentType, []byte(body))
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+func (h *CalendarFeedHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "not found"), strings.Contains(err.Error(), "unauthorized"):
+		_ = c.Error(apperr.ErrCalendarFeedNotFound)
+	default:
+		_ = c.Error(apperr.ErrCalendarFeedInternalError)
+	}
+}
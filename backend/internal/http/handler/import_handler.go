@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// uploadMultipartOverhead はmultipart/form-dataの境界やヘッダーの分としてファイルサイズの上限に加える余裕
+const uploadMultipartOverhead = 64 << 10
+
+var (
+	errUploadTooLarge = errors.New("uploaded file is too large")
+	errUploadEmpty    = errors.New("uploaded file is empty")
+	errUploadOpen     = errors.New("failed to open uploaded file")
+)
+
+// readUploadedFile はmultipart/form-dataのfileフィールド、またはリクエストボディからアップロードされたファイルを読み取ります
+// maxSizeを超える場合はerrUploadTooLarge、空の場合はerrUploadEmptyを返します
+func readUploadedFile(c *gin.Context, maxSize int64) ([]byte, error) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxSize+uploadMultipartOverhead)
+
+	var reader io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return nil, uploadReadError(err)
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errUploadOpen, err)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return nil, uploadReadError(err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, errUploadTooLarge
+	}
+	if len(data) == 0 {
+		return nil, errUploadEmpty
+	}
+	return data, nil
+}
+
+// uploadReadError はリクエストボディの上限超過をerrUploadTooLargeに変換します
+func uploadReadError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return errUploadTooLarge
+	}
+	return err
+}
+
+// ImportHandler は外部データからのタスクの取り込み関連のHTTPハンドラー
+type ImportHandler struct {
+	usecase   interfaces.ImportUsecase
+	presenter *presenter.ImportPresenter
+}
+
+func NewImportHandler(usecase interfaces.ImportUsecase, presenter *presenter.ImportPresenter) *ImportHandler {
+	return &ImportHandler{
+		usecase:   usecase,
+		presenter: presenter,
+	}
+}
+
+// ImportICal はiCalendarファイルのVTODO・VEVENTをタスクとして取り込みます (POST /import/ical)
+func (h *ImportHandler) ImportICal(c *gin.Context) {
+	h.importFile(c, h.usecase.ImportICal)
+}
+
+// ImportTodoist はTodoistのCSVエクスポートのタスクをレビュー待ちのアイテムとして登録します (POST /import/todoist)
+func (h *ImportHandler) ImportTodoist(c *gin.Context) {
+	h.importFile(c, h.usecase.ImportTodoist)
+}
+
+// ImportTrello はTrelloのボードのJSONエクスポートのカードをレビュー待ちのアイテムとして登録します (POST /import/trello)
+func (h *ImportHandler) ImportTrello(c *gin.Context) {
+	h.importFile(c, h.usecase.ImportTrello)
+}
+
+// ImportMarkdown はMarkdownのチェックリストをレビュー待ちのアイテムとして登録します (POST /import/markdown)
+func (h *ImportHandler) ImportMarkdown(c *gin.Context) {
+	h.importFile(c, h.usecase.ImportMarkdown)
+}
+
+// importFile はオプションとファイルを読み取り、取り込み元に応じたユースケースで取り込みます
+func (h *ImportHandler) importFile(c *gin.Context, importFunc func(context.Context, []byte, entity.ImportOptions) (*entity.ImportResult, error)) {
+	ctx := c.Request.Context()
+
+	options, ok := h.bindImportOptions(c)
+	if !ok {
+		return
+	}
+
+	data, ok := h.readImportFile(c)
+	if !ok {
+		return
+	}
+
+	result, err := importFunc(ctx, data, options)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetImportResult(result)
+	c.JSON(http.StatusOK, response)
+}
+
+// bindImportOptions はクエリパラメータから取り込みのオプションを読み取ります
+func (h *ImportHandler) bindImportOptions(c *gin.Context) (entity.ImportOptions, bool) {
+	var options entity.ImportOptions
+
+	if value := c.Query("dry_run"); value != "" {
+		dryRun, err := strconv.ParseBool(value)
+		if err != nil {
+			_ = c.Error(apperr.ErrImportValidationError)
+			return options, false
+		}
+		options.DryRun = dryRun
+	}
+
+	if value := c.Query("project_id"); value != "" {
+		if err := validation.ValidateProjectID(value); err != nil {
+			_ = c.Error(apperr.ErrImportValidationError)
+			return options, false
+		}
+		options.ProjectID = &value
+	}
+
+	if value := c.Query("timezone"); value != "" {
+		loc, err := time.LoadLocation(value)
+		if err != nil {
+			_ = c.Error(apperr.ErrImportValidationError)
+			return options, false
+		}
+		options.Location = loc
+	}
+
+	return options, true
+}
+
+// readImportFile はmultipart/form-dataのfileフィールド、またはリクエストボディから取り込むファイルを読み取ります
+func (h *ImportHandler) readImportFile(c *gin.Context) ([]byte, bool) {
+	data, err := readUploadedFile(c, validation.MaxImportFileSize)
+	if err != nil {
+		if errors.Is(err, errUploadTooLarge) {
+			_ = c.Error(apperr.ErrImportFileTooLarge)
+		} else if errors.Is(err, errUploadOpen) {
+			_ = c.Error(apperr.ErrImportInternalError)
+		} else {
+			_ = c.Error(apperr.ErrImportValidationError)
+		}
+		return nil, false
+	}
+	return data, true
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+func (h *ImportHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "already exists"):
+		_ = c.Error(apperr.ErrImportConflict)
+	case strings.Contains(err.Error(), "validation"):
+		_ = c.Error(apperr.ErrImportValidationError)
+	default:
+		_ = c.Error(apperr.ErrImportInternalError)
+	}
+}
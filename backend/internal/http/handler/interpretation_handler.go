@@ -3,6 +3,7 @@ package handler
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -21,14 +22,21 @@ type InterpretationHandler struct {
 	geminiService          *service.GeminiService
 	interpretationRepo     interfaces.InterpretationRepository
 	interpretationItemRepo interfaces.InterpretationItemRepository
+	projectRepo            interfaces.ProjectRepository
+	timeEntryUsecase       interfaces.TimeEntryUsecase
 }
 
+// minEstimateFeedbackTasks は見積もりの実績比率をAIに伝えるために必要な完了タスク数
+const minEstimateFeedbackTasks = 3
+
 // NewInterpretationHandler はInterpretationHandlerを作成します
-func NewInterpretationHandler(geminiService *service.GeminiService, interpretationRepo interfaces.InterpretationRepository, interpretationItemRepo interfaces.InterpretationItemRepository) *InterpretationHandler {
+func NewInterpretationHandler(geminiService *service.GeminiService, interpretationRepo interfaces.InterpretationRepository, interpretationItemRepo interfaces.InterpretationItemRepository, projectRepo interfaces.ProjectRepository, timeEntryUsecase interfaces.TimeEntryUsecase) *InterpretationHandler {
 	return &InterpretationHandler{
 		geminiService:          geminiService,
 		interpretationRepo:     interpretationRepo,
 		interpretationItemRepo: interpretationItemRepo,
+		projectRepo:            projectRepo,
+		timeEntryUsecase:       timeEntryUsecase,
 	}
 }
 
@@ -48,13 +56,6 @@ func (h *InterpretationHandler) CreateInterpretation(c *gin.Context) {
 		return
 	}
 
-	// Gemini APIで解析
-	aiResult, err := h.geminiService.InterpretInput(c.Request.Context(), inputText)
-	if err != nil {
-		apperrors.RespondWithError(c, apperrors.ErrAIInterpretationError, "Failed to interpret input: "+err.Error())
-		return
-	}
-
 	// 認証ミドルウェアから設定されたユーザーIDを取得
 	userIDValue, exists := c.Get("user_id")
 	if !exists {
@@ -67,6 +68,26 @@ func (h *InterpretationHandler) CreateInterpretation(c *gin.Context) {
 		return
 	}
 
+	// 割り当て先候補のプロジェクト（アーカイブ済みは除く）
+	projects, err := h.projectRepo.GetProjectsByUserID(c.Request.Context(), userID, false)
+	if err != nil {
+		apperrors.RespondWithError(c, apperrors.ErrDatabaseError, "Failed to get projects: "+err.Error())
+		return
+	}
+	projectNames := make([]string, len(projects))
+	projectIDs := make(map[string]string, len(projects))
+	for i, project := range projects {
+		projectNames[i] = project.Name
+		projectIDs[project.Name] = project.ID
+	}
+
+	// Gemini APIで解析
+	aiResult, err := h.geminiService.InterpretInput(c.Request.Context(), inputText, projectNames, h.estimateRatio(c))
+	if err != nil {
+		apperrors.RespondWithError(c, apperrors.ErrAIInterpretationError, "Failed to interpret input: "+err.Error())
+		return
+	}
+
 	interpretationID := uuid.New().String()
 
 	// Entity型でデータベースに保存
@@ -92,7 +113,7 @@ func (h *InterpretationHandler) CreateInterpretation(c *gin.Context) {
 		return
 	}
 
-	items, err := buildInterpretationItems(interpretationID, aiResult.Result, aiResult.OriginalJSON)
+	items, err := buildInterpretationItems(interpretationID, aiResult.Result, aiResult.OriginalJSON, projectIDs)
 	if err != nil {
 		apperrors.RespondWithError(c, apperrors.ErrInternalServer, "Failed to prepare interpretation items: "+err.Error())
 		return
@@ -204,8 +225,29 @@ func (h *InterpretationHandler) GetInterpretation(c *gin.Context) {
 	c.JSON(http.StatusOK, apiInterp)
 }
 
+// estimateRatio はユーザーの見積もりに対する実績の比率を返します（見積もり提案の補正に使用）
+// 完了タスクが少なく比率が当てにならない場合や取得に失敗した場合は補正せずnilを返します
+func (h *InterpretationHandler) estimateRatio(c *gin.Context) *float64 {
+	if h.timeEntryUsecase == nil {
+		return nil
+	}
+
+	report, err := h.timeEntryUsecase.GetEstimateReport(c.Request.Context())
+	if err != nil {
+		slog.WarnContext(c.Request.Context(), "Failed to get estimate report for interpretation",
+			slog.String("error", err.Error()),
+		)
+		return nil
+	}
+	if report.TaskCount < minEstimateFeedbackTasks {
+		return nil
+	}
+	return report.ActualToEstimateRatio
+}
+
 // buildInterpretationItems はAI解釈結果からレビュー用アイテムを組み立てます
-func buildInterpretationItems(interpretationID string, result *entity.InterpretationResult, originalJSON []byte) ([]*entity.InterpretationItem, error) {
+// projectIDsはプロジェクト名からIDへの対応で、AIが提案したプロジェクト名の解決に使用します
+func buildInterpretationItems(interpretationID string, result *entity.InterpretationResult, originalJSON []byte, projectIDs map[string]string) ([]*entity.InterpretationItem, error) {
 	if result == nil {
 		return nil, fmt.Errorf("interpretation result is nil")
 	}
@@ -230,6 +272,21 @@ func buildInterpretationItems(interpretationID string, result *entity.Interpreta
 		taskData.Tags = result.Metadata.Tags
 	}
 
+	if result.Metadata.Recurrence != nil {
+		taskData.RecurrenceRule = result.Metadata.Recurrence
+	}
+
+	if result.Metadata.EstimateMinutes != nil {
+		taskData.EstimateMinutes = result.Metadata.EstimateMinutes
+	}
+
+	// 既存プロジェクトに一致しない名前は無視する
+	if result.Metadata.Project != nil {
+		if projectID, ok := projectIDs[*result.Metadata.Project]; ok {
+			taskData.ProjectID = &projectID
+		}
+	}
+
 	dataBytes, err := json.Marshal(taskData)
 	if err != nil {
 		return nil, err
@@ -265,9 +322,12 @@ func buildAIInterpretation(
 	structuredResult := struct {
 		Description *string `json:"description,omitempty"`
 		Metadata    *struct {
-			Deadline *time.Time                                            `json:"deadline,omitempty"`
-			Priority *api.AIInterpretationStructuredResultMetadataPriority `json:"priority,omitempty"`
-			Tags     *[]string                                             `json:"tags,omitempty"`
+			Deadline        *time.Time                                            `json:"deadline,omitempty"`
+			EstimateMinutes *int32                                                `json:"estimate_minutes,omitempty"`
+			Priority        *api.AIInterpretationStructuredResultMetadataPriority `json:"priority,omitempty"`
+			Project         *string                                               `json:"project,omitempty"`
+			Recurrence      *string                                               `json:"recurrence,omitempty"`
+			Tags            *[]string                                             `json:"tags,omitempty"`
 		} `json:"metadata,omitempty"`
 		Title *string                                   `json:"title,omitempty"`
 		Type  *api.AIInterpretationStructuredResultType `json:"type,omitempty"`
@@ -278,11 +338,17 @@ func buildAIInterpretation(
 
 	// Metadataの処理（Todoのみ）
 	metadata := &struct {
-		Deadline *time.Time                                            `json:"deadline,omitempty"`
-		Priority *api.AIInterpretationStructuredResultMetadataPriority `json:"priority,omitempty"`
-		Tags     *[]string                                             `json:"tags,omitempty"`
+		Deadline        *time.Time                                            `json:"deadline,omitempty"`
+		EstimateMinutes *int32                                                `json:"estimate_minutes,omitempty"`
+		Priority        *api.AIInterpretationStructuredResultMetadataPriority `json:"priority,omitempty"`
+		Project         *string                                               `json:"project,omitempty"`
+		Recurrence      *string                                               `json:"recurrence,omitempty"`
+		Tags            *[]string                                             `json:"tags,omitempty"`
 	}{
-		Deadline: result.Metadata.Deadline,
+		Deadline:        result.Metadata.Deadline,
+		EstimateMinutes: result.Metadata.EstimateMinutes,
+		Project:         result.Metadata.Project,
+		Recurrence:      result.Metadata.Recurrence,
 	}
 
 	// タグの設定
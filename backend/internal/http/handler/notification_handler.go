@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// defaultNotificationListLimit は通知一覧のデフォルト取得件数
+const defaultNotificationListLimit = 20
+
+// NotificationHandler はアプリ内通知の受信箱関連のHTTPハンドラー
+type NotificationHandler struct {
+	usecase   interfaces.NotificationUsecase
+	presenter *presenter.NotificationPresenter
+}
+
+func NewNotificationHandler(usecase interfaces.NotificationUsecase, presenter *presenter.NotificationPresenter) *NotificationHandler {
+	return &NotificationHandler{
+		usecase:   usecase,
+		presenter: presenter,
+	}
+}
+
+// ListNotifications はログインユーザーの通知一覧を取得します (GET /notifications)
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params api.ListNotificationsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		_ = c.Error(apperr.ErrNotificationValidationError)
+		return
+	}
+
+	limit := defaultNotificationListLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	var cursor *entity.NotificationCursor
+	if params.Cursor != nil && *params.Cursor != "" {
+		parsed, err := entity.ParseNotificationCursor(*params.Cursor)
+		if err != nil {
+			_ = c.Error(apperr.ErrNotificationValidationError)
+			return
+		}
+		cursor = parsed
+	}
+
+	unreadOnly := params.Unread != nil && *params.Unread
+
+	notifications, next, err := h.usecase.ListNotifications(ctx, unreadOnly, cursor, limit)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetNotificationList(notifications, next)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetUnreadNotificationCount はログインユーザーの未読の通知数を取得します (GET /notifications/unread-count)
+func (h *NotificationHandler) GetUnreadNotificationCount(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	count, err := h.usecase.GetUnreadCount(ctx)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetUnreadCount(count)
+	c.JSON(http.StatusOK, response)
+}
+
+// MarkAllNotificationsRead はログインユーザーの未読の通知をすべて既読にします (POST /notifications/read-all)
+func (h *NotificationHandler) MarkAllNotificationsRead(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	count, err := h.usecase.MarkAllNotificationsRead(ctx)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetMarkAllRead(count)
+	c.JSON(http.StatusOK, response)
+}
+
+// MarkNotificationRead は通知を既読にします (POST /notifications/:id/read)
+func (h *NotificationHandler) MarkNotificationRead(c *gin.Context) {
+	ctx := c.Request.Context()
+	notificationID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateNotificationID(notificationID); err != nil {
+		_ = c.Error(apperr.ErrNotificationValidationError)
+		return
+	}
+
+	notification, err := h.usecase.MarkNotificationRead(ctx, notificationID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetNotification(notification)
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteNotification は通知を削除します (DELETE /notifications/:id)
+func (h *NotificationHandler) DeleteNotification(c *gin.Context) {
+	ctx := c.Request.Context()
+	notificationID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateNotificationID(notificationID); err != nil {
+		_ = c.Error(apperr.ErrNotificationValidationError)
+		return
+	}
+
+	if err := h.usecase.DeleteNotification(ctx, notificationID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+func (h *NotificationHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "not found"), strings.Contains(err.Error(), "unauthorized"):
+		_ = c.Error(apperr.ErrNotificationNotFound)
+	case strings.Contains(err.Error(), "validation"):
+		_ = c.Error(apperr.ErrNotificationValidationError)
+	default:
+		_ = c.Error(apperr.ErrNotificationInternalError)
+	}
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// ProjectHandler はプロジェクト関連のHTTPハンドラー
+type ProjectHandler struct {
+	usecase   interfaces.ProjectUsecase
+	presenter *presenter.ProjectPresenter
+}
+
+func NewProjectHandler(usecase interfaces.ProjectUsecase, presenter *presenter.ProjectPresenter) *ProjectHandler {
+	return &ProjectHandler{
+		usecase:   usecase,
+		presenter: presenter,
+	}
+}
+
+// GetProjectList はプロジェクト一覧を取得します (GET /projects)
+func (h *ProjectHandler) GetProjectList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params api.GetProjectListParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		_ = c.Error(apperr.ErrProjectValidationError)
+		return
+	}
+
+	includeArchived := params.IncludeArchived != nil && *params.IncludeArchived
+
+	projects, err := h.usecase.GetProjectList(ctx, includeArchived)
+	if err != nil {
+		_ = c.Error(apperr.ErrProjectInternalError)
+		return
+	}
+
+	response := h.presenter.GetProjectList(projects)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetProject はプロジェクトの単一取得 (GET /projects/:id)
+func (h *ProjectHandler) GetProject(c *gin.Context) {
+	ctx := c.Request.Context()
+	projectID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateProjectID(projectID); err != nil {
+		_ = c.Error(apperr.ErrProjectValidationError)
+		return
+	}
+
+	project, err := h.usecase.GetProject(ctx, projectID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetProject(project)
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateProject は新しいプロジェクトを作成します (POST /projects)
+func (h *ProjectHandler) CreateProject(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req api.CreateProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrProjectValidationError)
+		return
+	}
+
+	project, err := h.usecase.CreateProject(ctx, req.Name, req.Color, req.SortOrder)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetProject(project)
+	c.JSON(http.StatusCreated, response)
+}
+
+// EditProject はプロジェクトを部分更新します (PATCH /projects/:id)
+func (h *ProjectHandler) EditProject(c *gin.Context) {
+	ctx := c.Request.Context()
+	projectID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateProjectID(projectID); err != nil {
+		_ = c.Error(apperr.ErrProjectValidationError)
+		return
+	}
+
+	var req api.EditProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrProjectValidationError)
+		return
+	}
+
+	project, err := h.usecase.EditProject(ctx, projectID, req.Name, req.Color, req.Archived, req.SortOrder)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetProject(project)
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteProject はプロジェクトを削除します (DELETE /projects/:id)
+func (h *ProjectHandler) DeleteProject(c *gin.Context) {
+	ctx := c.Request.Context()
+	projectID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateProjectID(projectID); err != nil {
+		_ = c.Error(apperr.ErrProjectValidationError)
+		return
+	}
+
+	if err := h.usecase.DeleteProject(ctx, projectID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+// 他ユーザーのプロジェクトは存在を明かさないためNot Foundとして扱います
+func (h *ProjectHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "not found"), strings.Contains(err.Error(), "unauthorized"):
+		_ = c.Error(apperr.ErrProjectNotFound)
+	case strings.Contains(err.Error(), "already exists"):
+		_ = c.Error(apperr.ErrProjectAlreadyExists)
+	case strings.Contains(err.Error(), "validation"):
+		_ = c.Error(apperr.ErrProjectValidationError)
+	default:
+		_ = c.Error(apperr.ErrProjectInternalError)
+	}
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// StatsHandler は生産性統計関連のHTTPハンドラー
+type StatsHandler struct {
+	usecase   interfaces.StatsUsecase
+	presenter *presenter.StatsPresenter
+}
+
+func NewStatsHandler(usecase interfaces.StatsUsecase, presenter *presenter.StatsPresenter) *StatsHandler {
+	return &StatsHandler{
+		usecase:   usecase,
+		presenter: presenter,
+	}
+}
+
+// GetTaskStats は期間内のタスクの生産性統計を取得します (GET /stats)
+func (h *StatsHandler) GetTaskStats(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params api.GetTaskStatsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		_ = c.Error(apperr.ErrStatsValidationError)
+		return
+	}
+
+	// 期間ごとの集計単位（デフォルトday）
+	groupBy := entity.StatsGroupByDay
+	if params.GroupBy != nil {
+		groupBy = entity.StatsGroupBy(*params.GroupBy)
+	}
+
+	// 日・週を区切るタイムゾーン（デフォルトUTC）
+	loc := time.UTC
+	if params.Timezone != nil && *params.Timezone != "" {
+		location, err := time.LoadLocation(*params.Timezone)
+		if err != nil {
+			_ = c.Error(apperr.ErrStatsValidationError)
+			return
+		}
+		loc = location
+	}
+
+	stats, err := h.usecase.GetTaskStats(ctx, params.From, params.To, groupBy, loc)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTaskStats(stats)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTaskFlowReport は期間内のサイクルタイムとスループットを集計します (GET /stats/flow)
+func (h *StatsHandler) GetTaskFlowReport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params api.GetTaskFlowReportParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		_ = c.Error(apperr.ErrStatsValidationError)
+		return
+	}
+
+	// 期間ごとの集計単位（デフォルトday）
+	groupBy := entity.StatsGroupByDay
+	if params.GroupBy != nil {
+		groupBy = entity.StatsGroupBy(*params.GroupBy)
+	}
+
+	// 日・週を区切るタイムゾーン（デフォルトUTC）
+	loc := time.UTC
+	if params.Timezone != nil && *params.Timezone != "" {
+		location, err := time.LoadLocation(*params.Timezone)
+		if err != nil {
+			_ = c.Error(apperr.ErrStatsValidationError)
+			return
+		}
+		loc = location
+	}
+
+	report, err := h.usecase.GetTaskFlowReport(ctx, params.From, params.To, groupBy, loc)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTaskFlowReport(report)
+	c.JSON(http.StatusOK, response)
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+func (h *StatsHandler) handleError(c *gin.Context, err error) {
+	if strings.Contains(err.Error(), "validation") {
+		_ = c.Error(apperr.ErrStatsValidationError)
+		return
+	}
+	_ = c.Error(apperr.ErrStatsInternalError)
+}
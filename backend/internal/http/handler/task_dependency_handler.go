@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// GetTaskDependencies はタスクの依存関係を取得します (GET /tasks/:id/dependencies)
+func (h *TaskHandler) GetTaskDependencies(c *gin.Context) {
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	h.respondTaskDependencies(c, taskID, http.StatusOK)
+}
+
+// AddTaskDependency はタスクに先行タスクを追加します (POST /tasks/:id/dependencies)
+func (h *TaskHandler) AddTaskDependency(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	var req api.AddTaskDependencyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	if err := h.usecase.AddTaskDependency(ctx, taskID, req.DependsOnTaskId.String()); err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			_ = c.Error(apperr.ErrTaskNotFound)
+		case strings.Contains(err.Error(), "validation"):
+			_ = c.Error(apperr.ErrTaskValidationError)
+		case strings.Contains(err.Error(), "already exists"):
+			_ = c.Error(apperr.ErrTaskDependencyAlreadyExists)
+		case strings.Contains(err.Error(), "cycle"):
+			_ = c.Error(apperr.ErrTaskDependencyCycle)
+		default:
+			_ = c.Error(apperr.ErrTaskUpdateFailed)
+		}
+		return
+	}
+
+	h.respondTaskDependencies(c, taskID, http.StatusCreated)
+}
+
+// RemoveTaskDependency はタスクから先行タスクの依存関係を削除します (DELETE /tasks/:id/dependencies/:depends_on_id)
+func (h *TaskHandler) RemoveTaskDependency(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+	dependsOnTaskID := c.Param("depends_on_id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+	if err := validation.ValidationTaskID(dependsOnTaskID); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	if err := h.usecase.RemoveTaskDependency(ctx, taskID, dependsOnTaskID); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			_ = c.Error(apperr.ErrTaskNotFound)
+			return
+		}
+		_ = c.Error(apperr.ErrTaskUpdateFailed)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// respondTaskDependencies はタスクの依存関係を取得してレスポンスを返します
+func (h *TaskHandler) respondTaskDependencies(c *gin.Context, taskID string, status int) {
+	ctx := c.Request.Context()
+
+	blockedBy, blocks, err := h.usecase.GetTaskDependencies(ctx, taskID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			_ = c.Error(apperr.ErrTaskNotFound)
+			return
+		}
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	summaries, err := h.taskSummaries(ctx, append(blockedBy, blocks...)...)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	response := h.presenter.GetTaskDependencies(blockedBy, blocks, summaries)
+	c.JSON(status, response)
+}
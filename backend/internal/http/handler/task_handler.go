@@ -1,17 +1,26 @@
 package handler
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
 	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
 	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
 	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
 	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
 )
 
+// defaultOccurrenceLimit は発生予定取得時のデフォルト件数
+const defaultOccurrenceLimit = 10
+
 // TaskHandler はタスク関連のHTTPハンドラー
 type TaskHandler struct {
 	usecase   interfaces.TaskUsecase
@@ -40,7 +49,14 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 		return
 	}
 
-	response := h.presenter.GetTask(task)
+	summaries, err := h.taskSummaries(ctx, task)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	setTaskETag(c, task)
+	response := h.presenter.GetTask(task, summaries[task.ID])
 	c.JSON(http.StatusOK, response)
 }
 
@@ -48,13 +64,40 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 func (h *TaskHandler) GetTaskList(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	tasks, err := h.usecase.GetTaskList(ctx)
+	// プロジェクトでの絞り込み
+	var projectID *string
+	if value := c.Query("project_id"); value != "" {
+		if err := validation.ValidateProjectID(value); err != nil {
+			_ = c.Error(apperr.ErrTaskValidationError)
+			return
+		}
+		projectID = &value
+	}
+
+	// スヌーズ中のタスクは指定がない限り含めない
+	includeSnoozed := false
+	if value := c.Query("include_snoozed"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			_ = c.Error(apperr.ErrTaskValidationError)
+			return
+		}
+		includeSnoozed = parsed
+	}
+
+	tasks, err := h.usecase.GetTaskList(ctx, projectID, includeSnoozed)
 	if err != nil {
 		_ = c.Error(apperr.ErrTaskInternalError)
 		return
 	}
 
-	response := h.presenter.GetTaskList(tasks)
+	summaries, err := h.taskSummaries(ctx, tasks...)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	response := h.presenter.GetTaskList(tasks, summaries)
 	c.JSON(http.StatusOK, response)
 }
 
@@ -68,20 +111,24 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 		return
 	}
 
-	// statusのデフォルト値設定
-	status := "todo" // デフォルト値
+	// statusの省略時はユーザーのtodoカテゴリの最初のステータスを使う
+	status := ""
 	if req.Status != nil {
-		status = string(*req.Status)
+		status = *req.Status
 	}
 
 	// バリデーション
-	if err := validation.ValidateCreateTaskRequest(req.Title, req.Description, req.DueAt, status); err != nil {
+	if err := validation.ValidateCreateTaskRequest(req.Title, req.Description, req.DueAt, status, req.RecurrenceRule, req.EstimateMinutes); err != nil {
 		_ = c.Error(apperr.ErrTaskValidationError)
 		return
 	}
 
-	task, err := h.usecase.CreateTask(ctx, req.Title, req.Description, req.DueAt, status)
+	task, err := h.usecase.CreateTask(ctx, req.Title, req.Description, req.DueAt, status, req.RecurrenceRule, req.RecurrenceAnchorAt, uuidToStringPtr(req.ProjectId), req.EstimateMinutes)
 	if err != nil {
+		if strings.Contains(err.Error(), "wip limit") {
+			_ = c.Error(apperr.ErrTaskWIPLimitReached)
+			return
+		}
 		if strings.Contains(err.Error(), "validation") {
 			_ = c.Error(apperr.ErrTaskValidationError)
 			return
@@ -90,6 +137,7 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 		return
 	}
 
+	setTaskETag(c, task)
 	response := h.presenter.CreateTask(task)
 	c.JSON(http.StatusCreated, response)
 }
@@ -112,24 +160,44 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	}
 
 	// statusの検証（空文字列の場合はエラー）
-	status := string(req.Status)
+	status := req.Status
 	if status == "" {
 		_ = c.Error(apperr.ErrTaskValidationError)
 		return
 	}
 
 	// バリデーション
-	if err := validation.ValidateUpdateTaskRequest(req.Title, req.Description, req.DueAt, status); err != nil {
+	if err := validation.ValidateUpdateTaskRequest(req.Title, req.Description, req.DueAt, status, req.RecurrenceRule, req.EstimateMinutes); err != nil {
 		_ = c.Error(apperr.ErrTaskValidationError)
 		return
 	}
 
-	task, err := h.usecase.UpdateTask(ctx, taskID, req.Title, req.Description, req.DueAt, status)
+	expectedVersion, err := parseTaskIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	force := req.Force != nil && *req.Force
+
+	task, err := h.usecase.UpdateTask(ctx, taskID, req.Title, req.Description, req.DueAt, status, req.RecurrenceRule, req.RecurrenceAnchorAt, uuidToStringPtr(req.ProjectId), req.EstimateMinutes, force, expectedVersion)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			_ = c.Error(apperr.ErrTaskNotFound)
 			return
 		}
+		if strings.Contains(err.Error(), "version conflict") {
+			h.respondTaskPreconditionFailed(c, taskID)
+			return
+		}
+		if strings.Contains(err.Error(), "blocked") {
+			_ = c.Error(apperr.ErrTaskBlocked)
+			return
+		}
+		if strings.Contains(err.Error(), "wip limit") {
+			_ = c.Error(apperr.ErrTaskWIPLimitReached)
+			return
+		}
 		if strings.Contains(err.Error(), "validation") {
 			_ = c.Error(apperr.ErrTaskValidationError)
 			return
@@ -138,7 +206,14 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 		return
 	}
 
-	response := h.presenter.UpdateTask(task)
+	summaries, err := h.taskSummaries(ctx, task)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	setTaskETag(c, task)
+	response := h.presenter.UpdateTask(task, summaries[task.ID])
 	c.JSON(http.StatusOK, response)
 }
 
@@ -160,24 +235,37 @@ func (h *TaskHandler) EditTask(c *gin.Context) {
 	}
 
 	// バリデーション
-	if err := validation.ValidateEditTaskRequest(req.Title, req.Description, req.DueAt, (*string)(req.Status)); err != nil {
+	if err := validation.ValidateEditTaskRequest(req.Title, req.Description, req.DueAt, req.Status, req.RecurrenceRule, req.EstimateMinutes); err != nil {
 		_ = c.Error(apperr.ErrTaskValidationError)
 		return
 	}
 
-	// ステータスの型変換
-	var status *string
-	if req.Status != nil {
-		s := string(*req.Status)
-		status = &s
+	force := req.Force != nil && *req.Force
+
+	expectedVersion, err := parseTaskIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
 	}
 
-	task, err := h.usecase.EditTask(ctx, taskID, req.Title, req.Description, req.DueAt, status)
+	task, err := h.usecase.EditTask(ctx, taskID, req.Title, req.Description, req.DueAt, req.Status, req.RecurrenceRule, req.RecurrenceAnchorAt, uuidToStringPtr(req.ProjectId), req.EstimateMinutes, force, expectedVersion)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			_ = c.Error(apperr.ErrTaskNotFound)
 			return
 		}
+		if strings.Contains(err.Error(), "version conflict") {
+			h.respondTaskPreconditionFailed(c, taskID)
+			return
+		}
+		if strings.Contains(err.Error(), "blocked") {
+			_ = c.Error(apperr.ErrTaskBlocked)
+			return
+		}
+		if strings.Contains(err.Error(), "wip limit") {
+			_ = c.Error(apperr.ErrTaskWIPLimitReached)
+			return
+		}
 		if strings.Contains(err.Error(), "validation") {
 			_ = c.Error(apperr.ErrTaskValidationError)
 			return
@@ -186,11 +274,18 @@ func (h *TaskHandler) EditTask(c *gin.Context) {
 		return
 	}
 
-	response := h.presenter.EditTask(task)
+	summaries, err := h.taskSummaries(ctx, task)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	setTaskETag(c, task)
+	response := h.presenter.EditTask(task, summaries[task.ID])
 	c.JSON(http.StatusOK, response)
 }
 
-// DeleteTask はタスクを削除します (DELETE /tasks/:id)
+// DeleteTask はタスクをゴミ箱に移動します (DELETE /tasks/:id)
 func (h *TaskHandler) DeleteTask(c *gin.Context) {
 	ctx := c.Request.Context()
 	taskID := c.Param("id")
@@ -201,15 +296,449 @@ func (h *TaskHandler) DeleteTask(c *gin.Context) {
 		return
 	}
 
-	err := h.usecase.DeleteTask(ctx, taskID)
+	expectedVersion, err := parseTaskIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	err = h.usecase.DeleteTask(ctx, taskID, expectedVersion)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			_ = c.Error(apperr.ErrTaskNotFound)
 			return
 		}
+		if strings.Contains(err.Error(), "version conflict") {
+			h.respondTaskPreconditionFailed(c, taskID)
+			return
+		}
 		_ = c.Error(apperr.ErrTaskDeleteFailed)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
+
+// BatchTasks は複数のタスク操作をまとめて実行します (POST /tasks/batch)
+func (h *TaskHandler) BatchTasks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req api.BatchTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	operations := make([]entity.TaskBatchOperation, len(req.Operations))
+	for i, op := range req.Operations {
+		operations[i] = entity.TaskBatchOperation{
+			Type:        entity.TaskBatchOperationType(op.Op),
+			TaskID:      op.TaskId.String(),
+			Title:       op.Title,
+			Description: op.Description,
+			DueAt:       op.DueAt,
+			Status:      op.Status,
+			ProjectID:   uuidToStringPtr(op.ProjectId),
+			Force:       op.Force != nil && *op.Force,
+		}
+		if op.Version != nil {
+			version := int32(*op.Version)
+			operations[i].Version = &version
+		}
+	}
+	allOrNothing := req.AllOrNothing != nil && *req.AllOrNothing
+
+	results, err := h.usecase.BatchTasks(ctx, operations, allOrNothing)
+	if err != nil {
+		if strings.Contains(err.Error(), "validation") {
+			_ = c.Error(apperr.ErrTaskValidationError)
+			return
+		}
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	response := h.presenter.BatchTasks(results)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTrash はゴミ箱内のタスク一覧を取得します (GET /tasks/trash)
+func (h *TaskHandler) GetTrash(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	tasks, err := h.usecase.GetTrash(ctx)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	response := h.presenter.GetTrash(tasks)
+	c.JSON(http.StatusOK, response)
+}
+
+// RestoreTask はゴミ箱内のタスクを復元します (POST /tasks/:id/restore)
+func (h *TaskHandler) RestoreTask(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	task, err := h.usecase.RestoreTask(ctx, taskID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			_ = c.Error(apperr.ErrTaskNotFound)
+			return
+		}
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	summaries, err := h.taskSummaries(ctx, task)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	setTaskETag(c, task)
+	response := h.presenter.RestoreTask(task, summaries[task.ID])
+	c.JSON(http.StatusOK, response)
+}
+
+// GetArchivedTasks はアーカイブ済みのタスク一覧を取得します (GET /tasks/archived)
+func (h *TaskHandler) GetArchivedTasks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	tasks, err := h.usecase.GetArchivedTasks(ctx)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	response := h.presenter.GetArchivedTasks(tasks)
+	c.JSON(http.StatusOK, response)
+}
+
+// UnarchiveTask はアーカイブ済みのタスクを既定の一覧に戻します (POST /tasks/:id/unarchive)
+func (h *TaskHandler) UnarchiveTask(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	task, err := h.usecase.UnarchiveTask(ctx, taskID)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not found"):
+			_ = c.Error(apperr.ErrTaskNotFound)
+		case strings.Contains(err.Error(), "validation"):
+			_ = c.Error(apperr.ErrTaskValidationError)
+		default:
+			_ = c.Error(apperr.ErrTaskUpdateFailed)
+		}
+		return
+	}
+
+	summaries, err := h.taskSummaries(ctx, task)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	setTaskETag(c, task)
+	response := h.presenter.UnarchiveTask(task, summaries[task.ID])
+	c.JSON(http.StatusOK, response)
+}
+
+// MoveTask はタスクのステータスと列内の表示順を更新します (POST /tasks/:id/move)
+func (h *TaskHandler) MoveTask(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	var req api.MoveTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	force := req.Force != nil && *req.Force
+
+	task, err := h.usecase.MoveTask(ctx, taskID, req.Status, req.Position, force)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			_ = c.Error(apperr.ErrTaskNotFound)
+			return
+		}
+		if strings.Contains(err.Error(), "blocked") {
+			_ = c.Error(apperr.ErrTaskBlocked)
+			return
+		}
+		if strings.Contains(err.Error(), "wip limit") {
+			_ = c.Error(apperr.ErrTaskWIPLimitReached)
+			return
+		}
+		if strings.Contains(err.Error(), "validation") {
+			_ = c.Error(apperr.ErrTaskValidationError)
+			return
+		}
+		_ = c.Error(apperr.ErrTaskUpdateFailed)
+		return
+	}
+
+	summaries, err := h.taskSummaries(ctx, task)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	setTaskETag(c, task)
+	response := h.presenter.MoveTask(task, summaries[task.ID])
+	c.JSON(http.StatusOK, response)
+}
+
+// SnoozeTask はタスクを解除日時までスヌーズします (POST /tasks/:id/snooze)
+func (h *TaskHandler) SnoozeTask(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	var req api.SnoozeTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	notify := req.Notify != nil && *req.Notify
+
+	task, err := h.usecase.SnoozeTask(ctx, taskID, req.Until, req.Duration, notify)
+	if err != nil {
+		h.respondSnoozeError(c, err)
+		return
+	}
+
+	h.respondSnoozedTask(c, task)
+}
+
+// UnsnoozeTask はタスクのスヌーズを解除します (DELETE /tasks/:id/snooze)
+func (h *TaskHandler) UnsnoozeTask(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	task, err := h.usecase.UnsnoozeTask(ctx, taskID)
+	if err != nil {
+		h.respondSnoozeError(c, err)
+		return
+	}
+
+	h.respondSnoozedTask(c, task)
+}
+
+// respondSnoozedTask はスヌーズ・スヌーズ解除したタスクをレスポンスとして返します
+func (h *TaskHandler) respondSnoozedTask(c *gin.Context, task *models.Task) {
+	summaries, err := h.taskSummaries(c.Request.Context(), task)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	setTaskETag(c, task)
+	response := h.presenter.SnoozeTask(task, summaries[task.ID])
+	c.JSON(http.StatusOK, response)
+}
+
+// respondSnoozeError はスヌーズ・スヌーズ解除のエラーをAPIエラーに変換します
+func (h *TaskHandler) respondSnoozeError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "not found"):
+		_ = c.Error(apperr.ErrTaskNotFound)
+	case strings.Contains(err.Error(), "validation"):
+		_ = c.Error(apperr.ErrTaskValidationError)
+	default:
+		_ = c.Error(apperr.ErrTaskUpdateFailed)
+	}
+}
+
+// GetTaskOccurrences は期間内の繰り返しタスクの発生予定を展開します (GET /tasks/occurrences)
+func (h *TaskHandler) GetTaskOccurrences(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params api.GetTaskOccurrencesParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	// 期間のバリデーション
+	if err := validation.ValidateOccurrenceRange(params.From, params.To); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	occurrences, err := h.usecase.GetOccurrences(ctx, params.From, params.To)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	response := h.presenter.GetTaskOccurrences(occurrences)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTaskOccurrencesByID は繰り返しタスクの今後の発生予定を取得します (GET /tasks/:id/occurrences)
+func (h *TaskHandler) GetTaskOccurrencesByID(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	var params api.GetTaskOccurrencesByIDParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	limit := defaultOccurrenceLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if err := validation.ValidateOccurrenceLimit(limit); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	occurrences, err := h.usecase.GetTaskOccurrences(ctx, taskID, limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			_ = c.Error(apperr.ErrTaskNotFound)
+			return
+		}
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	response := h.presenter.GetTaskOccurrences(occurrences)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTaskHistory はタスクの変更履歴を取得します (GET /tasks/:id/history)
+func (h *TaskHandler) GetTaskHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTaskValidationError)
+		return
+	}
+
+	events, err := h.usecase.GetTaskHistory(ctx, taskID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			_ = c.Error(apperr.ErrTaskNotFound)
+			return
+		}
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	response := h.presenter.GetTaskHistory(events)
+	c.JSON(http.StatusOK, response)
+}
+
+// respondTaskPreconditionFailed は他の更新と競合した場合に現在のタスクを412で返します
+// クライアントは返されたETagで再取得せずに変更を適用し直せます
+func (h *TaskHandler) respondTaskPreconditionFailed(c *gin.Context, taskID string) {
+	ctx := c.Request.Context()
+
+	task, err := h.usecase.GetTask(ctx, taskID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			_ = c.Error(apperr.ErrTaskNotFound)
+			return
+		}
+		_ = c.Error(apperr.ErrTaskPreconditionFailed)
+		return
+	}
+
+	summaries, err := h.taskSummaries(ctx, task)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskInternalError)
+		return
+	}
+
+	setTaskETag(c, task)
+	response := h.presenter.GetTask(task, summaries[task.ID])
+	c.JSON(http.StatusPreconditionFailed, response)
+}
+
+// setTaskETag はタスクのバージョンをETagヘッダーに設定します
+func setTaskETag(c *gin.Context, task *models.Task) {
+	c.Header("ETag", fmt.Sprintf(`"%d"`, task.Version))
+}
+
+// parseTaskIfMatch はIf-MatchヘッダーからETagのバージョンを取り出します
+// ヘッダーがない場合や"*"の場合はnil（バージョンを確認しない）を返します
+func parseTaskIfMatch(header string) (*int32, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	tag := strings.TrimPrefix(header, "W/")
+	if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		return nil, fmt.Errorf("invalid If-Match header: %s", header)
+	}
+	version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid If-Match header: %s", header)
+	}
+
+	v := int32(version)
+	return &v, nil
+}
+
+// taskSummaries はレスポンスに含めるタスクのブロック状態と作業時間の合計を取得します
+func (h *TaskHandler) taskSummaries(ctx context.Context, tasks ...*models.Task) (map[string]*entity.TaskSummary, error) {
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+	return h.usecase.GetTaskSummaries(ctx, ids)
+}
+
+// uuidToStringPtr はリクエストのUUIDを文字列ポインタに変換します
+func uuidToStringPtr(id *openapi_types.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
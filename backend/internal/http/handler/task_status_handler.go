@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// TaskStatusHandler はワークフローのステータス関連のHTTPハンドラー
+type TaskStatusHandler struct {
+	usecase   interfaces.TaskStatusUsecase
+	presenter *presenter.TaskStatusPresenter
+}
+
+func NewTaskStatusHandler(usecase interfaces.TaskStatusUsecase, presenter *presenter.TaskStatusPresenter) *TaskStatusHandler {
+	return &TaskStatusHandler{
+		usecase:   usecase,
+		presenter: presenter,
+	}
+}
+
+// GetTaskStatusList はステータス一覧を取得します (GET /statuses)
+func (h *TaskStatusHandler) GetTaskStatusList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	statuses, err := h.usecase.GetStatusList(ctx)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskStatusInternalError)
+		return
+	}
+
+	response := h.presenter.GetStatusList(statuses)
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateTaskStatus は新しいステータスを作成します (POST /statuses)
+func (h *TaskStatusHandler) CreateTaskStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req api.CreateTaskStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTaskStatusValidationError)
+		return
+	}
+
+	status, err := h.usecase.CreateStatus(ctx, req.Name, string(req.Category), req.SortOrder, req.WipLimit)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetStatus(status)
+	c.JSON(http.StatusCreated, response)
+}
+
+// EditTaskStatus はステータスを部分更新します (PATCH /statuses/:id)
+func (h *TaskStatusHandler) EditTaskStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+	statusID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTaskStatusID(statusID); err != nil {
+		_ = c.Error(apperr.ErrTaskStatusValidationError)
+		return
+	}
+
+	var req api.EditTaskStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTaskStatusValidationError)
+		return
+	}
+
+	var category *string
+	if req.Category != nil {
+		value := string(*req.Category)
+		category = &value
+	}
+
+	status, err := h.usecase.EditStatus(ctx, statusID, category, req.SortOrder, req.WipLimit)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetStatus(status)
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteTaskStatus はステータスを削除します (DELETE /statuses/:id)
+func (h *TaskStatusHandler) DeleteTaskStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+	statusID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTaskStatusID(statusID); err != nil {
+		_ = c.Error(apperr.ErrTaskStatusValidationError)
+		return
+	}
+
+	if err := h.usecase.DeleteStatus(ctx, statusID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+// 他ユーザーのステータスは存在を明かさないためNot Foundとして扱います
+func (h *TaskStatusHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "not found"), strings.Contains(err.Error(), "unauthorized"):
+		_ = c.Error(apperr.ErrTaskStatusNotFound)
+	case strings.Contains(err.Error(), "already exists"):
+		_ = c.Error(apperr.ErrTaskStatusAlreadyExists)
+	case strings.Contains(err.Error(), "in use"):
+		_ = c.Error(apperr.ErrTaskStatusInUse)
+	case strings.Contains(err.Error(), "validation"):
+		_ = c.Error(apperr.ErrTaskStatusValidationError)
+	default:
+		_ = c.Error(apperr.ErrTaskStatusInternalError)
+	}
+}
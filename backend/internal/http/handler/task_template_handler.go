@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// TaskTemplateHandler はタスクテンプレート関連のHTTPハンドラー
+type TaskTemplateHandler struct {
+	usecase       interfaces.TaskTemplateUsecase
+	presenter     *presenter.TaskTemplatePresenter
+	taskPresenter *presenter.TaskPresenter
+}
+
+// NewTaskTemplateHandler は新しいTaskTemplateHandlerを生成します（taskPresenterは展開で作成したタスクの整形に使用）
+func NewTaskTemplateHandler(usecase interfaces.TaskTemplateUsecase, presenter *presenter.TaskTemplatePresenter, taskPresenter *presenter.TaskPresenter) *TaskTemplateHandler {
+	return &TaskTemplateHandler{
+		usecase:       usecase,
+		presenter:     presenter,
+		taskPresenter: taskPresenter,
+	}
+}
+
+// GetTaskTemplateList はテンプレート一覧を取得します (GET /templates)
+func (h *TaskTemplateHandler) GetTaskTemplateList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	templates, err := h.usecase.GetTemplateList(ctx)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskTemplateInternalError)
+		return
+	}
+
+	response := h.presenter.GetTaskTemplateList(templates)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTaskTemplate はテンプレートの単一取得 (GET /templates/:id)
+func (h *TaskTemplateHandler) GetTaskTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+	templateID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTaskTemplateID(templateID); err != nil {
+		_ = c.Error(apperr.ErrTaskTemplateValidationError)
+		return
+	}
+
+	template, err := h.usecase.GetTemplate(ctx, templateID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTaskTemplate(template)
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateTaskTemplate は新しいテンプレートを作成します (POST /templates)
+func (h *TaskTemplateHandler) CreateTaskTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req api.CreateTaskTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTaskTemplateValidationError)
+		return
+	}
+
+	template, err := h.usecase.CreateTemplate(ctx, req.Name, req.Description, uuidToStringPtr(req.ProjectId), taskTemplateItemsFromRequest(req.Items))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTaskTemplate(template)
+	c.JSON(http.StatusCreated, response)
+}
+
+// EditTaskTemplate はテンプレートを部分更新します (PATCH /templates/:id)
+func (h *TaskTemplateHandler) EditTaskTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+	templateID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTaskTemplateID(templateID); err != nil {
+		_ = c.Error(apperr.ErrTaskTemplateValidationError)
+		return
+	}
+
+	var req api.EditTaskTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTaskTemplateValidationError)
+		return
+	}
+
+	var items []entity.TaskTemplateItem
+	if req.Items != nil {
+		items = taskTemplateItemsFromRequest(*req.Items)
+	}
+
+	template, err := h.usecase.EditTemplate(ctx, templateID, req.Name, req.Description, uuidToStringPtr(req.ProjectId), items)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTaskTemplate(template)
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteTaskTemplate はテンプレートを削除します (DELETE /templates/:id)
+func (h *TaskTemplateHandler) DeleteTaskTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+	templateID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTaskTemplateID(templateID); err != nil {
+		_ = c.Error(apperr.ErrTaskTemplateValidationError)
+		return
+	}
+
+	if err := h.usecase.DeleteTemplate(ctx, templateID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// InstantiateTaskTemplate はテンプレートからタスクをまとめて作成します (POST /templates/:id/instantiate)
+// リクエストボディは省略可能で、省略した場合は現在時刻を基準にテンプレートの既定のプロジェクトへ作成します
+func (h *TaskTemplateHandler) InstantiateTaskTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+	templateID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTaskTemplateID(templateID); err != nil {
+		_ = c.Error(apperr.ErrTaskTemplateValidationError)
+		return
+	}
+
+	var req api.InstantiateTaskTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		_ = c.Error(apperr.ErrTaskTemplateValidationError)
+		return
+	}
+
+	tasks, summaries, err := h.usecase.InstantiateTemplate(ctx, templateID, req.AnchorAt, uuidToStringPtr(req.ProjectId))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.taskPresenter.GetTaskList(tasks, summaries)
+	c.JSON(http.StatusCreated, response)
+}
+
+// SaveTaskAsTemplate はタスクと依存する先行タスクのツリーをテンプレートとして保存します (POST /tasks/:id/save-as-template)
+func (h *TaskTemplateHandler) SaveTaskAsTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTaskTemplateValidationError)
+		return
+	}
+
+	var req api.SaveTaskAsTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTaskTemplateValidationError)
+		return
+	}
+
+	template, err := h.usecase.SaveTaskAsTemplate(ctx, taskID, req.Name, req.Description)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTaskTemplate(template)
+	c.JSON(http.StatusCreated, response)
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+// 他ユーザーのテンプレートは存在を明かさないためNot Foundとして扱います
+func (h *TaskTemplateHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "task not found"):
+		_ = c.Error(apperr.ErrTaskNotFound)
+	case strings.Contains(err.Error(), "not found"), strings.Contains(err.Error(), "unauthorized"):
+		_ = c.Error(apperr.ErrTaskTemplateNotFound)
+	case strings.Contains(err.Error(), "already exists"):
+		_ = c.Error(apperr.ErrTaskTemplateAlreadyExists)
+	case strings.Contains(err.Error(), "validation"):
+		_ = c.Error(apperr.ErrTaskTemplateValidationError)
+	default:
+		_ = c.Error(apperr.ErrTaskTemplateInternalError)
+	}
+}
+
+// taskTemplateItemsFromRequest はリクエストのタスク定義をサブタスクを含めてエンティティに変換します
+func taskTemplateItemsFromRequest(items []api.TaskTemplateItem) []entity.TaskTemplateItem {
+	result := make([]entity.TaskTemplateItem, len(items))
+	for i, item := range items {
+		result[i] = entity.TaskTemplateItem{
+			Title:           item.Title,
+			Description:     item.Description,
+			DueOffset:       item.DueOffset,
+			EstimateMinutes: item.EstimateMinutes,
+		}
+		if item.Subtasks != nil {
+			result[i].Subtasks = taskTemplateItemsFromRequest(*item.Subtasks)
+		}
+	}
+	return result
+}
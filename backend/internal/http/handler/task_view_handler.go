@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// TaskViewHandler はタスクのビュー関連のHTTPハンドラー
+type TaskViewHandler struct {
+	usecase       interfaces.TaskViewUsecase
+	presenter     *presenter.TaskViewPresenter
+	taskUsecase   interfaces.TaskUsecase
+	taskPresenter *presenter.TaskPresenter
+}
+
+// NewTaskViewHandler は新しいTaskViewHandlerを生成します（taskUsecase・taskPresenterはビューのタスクの整形に使用）
+func NewTaskViewHandler(usecase interfaces.TaskViewUsecase, presenter *presenter.TaskViewPresenter, taskUsecase interfaces.TaskUsecase, taskPresenter *presenter.TaskPresenter) *TaskViewHandler {
+	return &TaskViewHandler{
+		usecase:       usecase,
+		presenter:     presenter,
+		taskUsecase:   taskUsecase,
+		taskPresenter: taskPresenter,
+	}
+}
+
+// GetTaskViewList はビュー一覧を取得します (GET /views)
+func (h *TaskViewHandler) GetTaskViewList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	views, err := h.usecase.GetViewList(ctx)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskViewInternalError)
+		return
+	}
+
+	response := h.presenter.GetTaskViewList(views)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTaskView はビューの単一取得 (GET /views/:id)
+func (h *TaskViewHandler) GetTaskView(c *gin.Context) {
+	ctx := c.Request.Context()
+	viewID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTaskViewID(viewID); err != nil {
+		_ = c.Error(apperr.ErrTaskViewValidationError)
+		return
+	}
+
+	view, err := h.usecase.GetView(ctx, viewID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTaskView(view)
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateTaskView は絞り込み条件をビューとして保存します (POST /views)
+func (h *TaskViewHandler) CreateTaskView(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req api.CreateTaskViewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTaskViewValidationError)
+		return
+	}
+
+	view, err := h.usecase.CreateView(ctx, req.Name, taskFilterFromRequest(req.Filter))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTaskView(view)
+	c.JSON(http.StatusCreated, response)
+}
+
+// EditTaskView は保存したビューを部分更新します (PATCH /views/:id)
+func (h *TaskViewHandler) EditTaskView(c *gin.Context) {
+	ctx := c.Request.Context()
+	viewID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTaskViewID(viewID); err != nil {
+		_ = c.Error(apperr.ErrTaskViewValidationError)
+		return
+	}
+
+	var req api.EditTaskViewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTaskViewValidationError)
+		return
+	}
+
+	var filter *entity.TaskFilter
+	if req.Filter != nil {
+		value := taskFilterFromRequest(*req.Filter)
+		filter = &value
+	}
+
+	view, err := h.usecase.EditView(ctx, viewID, req.Name, filter)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTaskView(view)
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteTaskView は保存したビューを削除します (DELETE /views/:id)
+func (h *TaskViewHandler) DeleteTaskView(c *gin.Context) {
+	ctx := c.Request.Context()
+	viewID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTaskViewID(viewID); err != nil {
+		_ = c.Error(apperr.ErrTaskViewValidationError)
+		return
+	}
+
+	if err := h.usecase.DeleteView(ctx, viewID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetTaskViewTasks はビューの絞り込み条件に一致するタスクを取得します (GET /views/:id/tasks)
+func (h *TaskViewHandler) GetTaskViewTasks(c *gin.Context) {
+	ctx := c.Request.Context()
+	viewID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTaskViewID(viewID); err != nil {
+		_ = c.Error(apperr.ErrTaskViewValidationError)
+		return
+	}
+
+	var params api.GetTaskViewTasksParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		_ = c.Error(apperr.ErrTaskViewValidationError)
+		return
+	}
+
+	// 相対期限の基準とするタイムゾーン（デフォルトUTC）
+	loc := time.UTC
+	if params.Timezone != nil && *params.Timezone != "" {
+		location, err := time.LoadLocation(*params.Timezone)
+		if err != nil {
+			_ = c.Error(apperr.ErrTaskViewValidationError)
+			return
+		}
+		loc = location
+	}
+
+	tasks, err := h.usecase.GetViewTasks(ctx, viewID, loc)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+	summaries, err := h.taskUsecase.GetTaskSummaries(ctx, ids)
+	if err != nil {
+		_ = c.Error(apperr.ErrTaskViewInternalError)
+		return
+	}
+
+	response := h.taskPresenter.GetTaskList(tasks, summaries)
+	c.JSON(http.StatusOK, response)
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+// 他ユーザーのビューは存在を明かさないためNot Foundとして扱います
+func (h *TaskViewHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "not found"), strings.Contains(err.Error(), "unauthorized"):
+		_ = c.Error(apperr.ErrTaskViewNotFound)
+	case strings.Contains(err.Error(), "already exists"):
+		_ = c.Error(apperr.ErrTaskViewAlreadyExists)
+	case strings.Contains(err.Error(), "validation"):
+		_ = c.Error(apperr.ErrTaskViewValidationError)
+	default:
+		_ = c.Error(apperr.ErrTaskViewInternalError)
+	}
+}
+
+// taskFilterFromRequest はリクエストの絞り込み条件をエンティティに変換します
+func taskFilterFromRequest(filter api.TaskFilter) entity.TaskFilter {
+	result := entity.TaskFilter{
+		DueFrom:       filter.DueFrom,
+		DueTo:         filter.DueTo,
+		HasDueDate:    filter.HasDueDate,
+		TitleContains: filter.TitleContains,
+	}
+
+	if filter.IncludeSnoozed != nil {
+		result.IncludeSnoozed = *filter.IncludeSnoozed
+	}
+	if filter.IncludeArchived != nil {
+		result.IncludeArchived = *filter.IncludeArchived
+	}
+
+	if filter.Statuses != nil {
+		for _, status := range *filter.Statuses {
+			result.Statuses = append(result.Statuses, status)
+		}
+	}
+	if filter.StatusCategories != nil {
+		for _, category := range *filter.StatusCategories {
+			result.StatusCategories = append(result.StatusCategories, string(category))
+		}
+	}
+	if filter.ProjectIds != nil {
+		for _, projectID := range *filter.ProjectIds {
+			result.ProjectIDs = append(result.ProjectIDs, projectID.String())
+		}
+	}
+	if filter.Sources != nil {
+		for _, source := range *filter.Sources {
+			result.Sources = append(result.Sources, string(source))
+		}
+	}
+	if filter.Sort != nil {
+		result.Sort = entity.TaskSort(*filter.Sort)
+	}
+
+	return result
+}
@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// TimeEntryHandler はタスクの作業時間記録関連のHTTPハンドラー
+type TimeEntryHandler struct {
+	usecase   interfaces.TimeEntryUsecase
+	presenter *presenter.TimeEntryPresenter
+}
+
+func NewTimeEntryHandler(usecase interfaces.TimeEntryUsecase, presenter *presenter.TimeEntryPresenter) *TimeEntryHandler {
+	return &TimeEntryHandler{
+		usecase:   usecase,
+		presenter: presenter,
+	}
+}
+
+// StartTaskTimer はタスクのタイマーを開始します (POST /tasks/:id/timer/start)
+func (h *TimeEntryHandler) StartTaskTimer(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTimeEntryValidationError)
+		return
+	}
+
+	// リクエストボディは省略可能
+	var req api.StartTimerRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		_ = c.Error(apperr.ErrTimeEntryValidationError)
+		return
+	}
+
+	entry, err := h.usecase.StartTimer(ctx, taskID, req.Note)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTimeEntry(entry)
+	c.JSON(http.StatusCreated, response)
+}
+
+// StopTaskTimer はタスクの計測中のタイマーを停止します (POST /tasks/:id/timer/stop)
+func (h *TimeEntryHandler) StopTaskTimer(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTimeEntryValidationError)
+		return
+	}
+
+	entry, err := h.usecase.StopTimer(ctx, taskID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTimeEntry(entry)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTaskTimeEntries はタスクの時間記録一覧を取得します (GET /tasks/:id/time-entries)
+func (h *TimeEntryHandler) GetTaskTimeEntries(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTimeEntryValidationError)
+		return
+	}
+
+	entries, err := h.usecase.GetTaskTimeEntries(ctx, taskID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTimeEntryList(entries)
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateTaskTimeEntry はタスクに時間記録を手動で追加します (POST /tasks/:id/time-entries)
+func (h *TimeEntryHandler) CreateTaskTimeEntry(c *gin.Context) {
+	ctx := c.Request.Context()
+	taskID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidationTaskID(taskID); err != nil {
+		_ = c.Error(apperr.ErrTimeEntryValidationError)
+		return
+	}
+
+	var req api.CreateTimeEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTimeEntryValidationError)
+		return
+	}
+
+	entry, err := h.usecase.CreateTimeEntry(ctx, taskID, req.StartedAt, req.EndedAt, req.Note)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTimeEntry(entry)
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetRunningTimer はログインユーザーの計測中のタイマーを取得します (GET /time-entries/running)
+func (h *TimeEntryHandler) GetRunningTimer(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	entry, err := h.usecase.GetRunningTimer(ctx)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetRunningTimer(entry)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTimeReport は期間内の作業時間を集計します (GET /time-entries/report)
+func (h *TimeEntryHandler) GetTimeReport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params api.GetTimeReportParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		_ = c.Error(apperr.ErrTimeEntryValidationError)
+		return
+	}
+
+	// 日付を区切るタイムゾーン（デフォルトUTC）
+	loc := time.UTC
+	if params.Timezone != nil && *params.Timezone != "" {
+		location, err := time.LoadLocation(*params.Timezone)
+		if err != nil {
+			_ = c.Error(apperr.ErrTimeEntryValidationError)
+			return
+		}
+		loc = location
+	}
+
+	report, err := h.usecase.GetTimeReport(ctx, params.From, params.To, entity.TimeReportGroupBy(params.GroupBy), loc)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTimeReport(report)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEstimateReport は完了タスクの見積もり工数と実績時間を比較します (GET /time-entries/estimate-report)
+func (h *TimeEntryHandler) GetEstimateReport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	report, err := h.usecase.GetEstimateReport(ctx)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetEstimateReport(report)
+	c.JSON(http.StatusOK, response)
+}
+
+// EditTimeEntry は時間記録を部分更新します (PATCH /time-entries/:id)
+func (h *TimeEntryHandler) EditTimeEntry(c *gin.Context) {
+	ctx := c.Request.Context()
+	entryID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTimeEntryID(entryID); err != nil {
+		_ = c.Error(apperr.ErrTimeEntryValidationError)
+		return
+	}
+
+	var req api.EditTimeEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrTimeEntryValidationError)
+		return
+	}
+
+	entry, err := h.usecase.EditTimeEntry(ctx, entryID, req.StartedAt, req.EndedAt, req.Note)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetTimeEntry(entry)
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteTimeEntry は時間記録を削除します (DELETE /time-entries/:id)
+func (h *TimeEntryHandler) DeleteTimeEntry(c *gin.Context) {
+	ctx := c.Request.Context()
+	entryID := c.Param("id")
+
+	// IDのバリデーション
+	if err := validation.ValidateTimeEntryID(entryID); err != nil {
+		_ = c.Error(apperr.ErrTimeEntryValidationError)
+		return
+	}
+
+	if err := h.usecase.DeleteTimeEntry(ctx, entryID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+func (h *TimeEntryHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "running timer not found"):
+		_ = c.Error(apperr.ErrTimerNotRunning)
+	case strings.Contains(err.Error(), "timer already running"):
+		_ = c.Error(apperr.ErrTimerAlreadyRunning)
+	case strings.Contains(err.Error(), "task not found"):
+		_ = c.Error(apperr.ErrTaskNotFound)
+	case strings.Contains(err.Error(), "not found"), strings.Contains(err.Error(), "unauthorized"):
+		_ = c.Error(apperr.ErrTimeEntryNotFound)
+	case strings.Contains(err.Error(), "validation"):
+		_ = c.Error(apperr.ErrTimeEntryValidationError)
+	default:
+		_ = c.Error(apperr.ErrTimeEntryInternalError)
+	}
+}
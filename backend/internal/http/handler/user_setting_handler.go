@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/http/presenter"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// UserSettingHandler はユーザー設定関連のHTTPハンドラー
+type UserSettingHandler struct {
+	usecase   interfaces.UserSettingUsecase
+	presenter *presenter.UserSettingPresenter
+}
+
+func NewUserSettingHandler(usecase interfaces.UserSettingUsecase, presenter *presenter.UserSettingPresenter) *UserSettingHandler {
+	return &UserSettingHandler{
+		usecase:   usecase,
+		presenter: presenter,
+	}
+}
+
+// GetUserSettings はログインユーザーの設定を取得します (GET /me/settings)
+func (h *UserSettingHandler) GetUserSettings(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	setting, err := h.usecase.GetSettings(ctx)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.GetUserSettings(setting)
+	c.JSON(http.StatusOK, response)
+}
+
+// EditUserSettings はログインユーザーの設定を部分更新します (PATCH /me/settings)
+func (h *UserSettingHandler) EditUserSettings(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req api.EditUserSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(apperr.ErrUserSettingValidationError)
+		return
+	}
+
+	setting, err := h.usecase.EditSettings(ctx, req.AutoArchiveDays, req.Timezone)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := h.presenter.EditUserSettings(setting)
+	c.JSON(http.StatusOK, response)
+}
+
+// handleError はユースケースのエラーをHTTPエラーに変換します
+func (h *UserSettingHandler) handleError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "validation"):
+		_ = c.Error(apperr.ErrUserSettingValidationError)
+	case strings.Contains(err.Error(), "unauthorized"):
+		_ = c.Error(apperr.ErrUserSettingUnauthorized)
+	default:
+		_ = c.Error(apperr.ErrUserSettingInternalError)
+	}
+}
@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+// AccountArchivePresenter はアカウントデータのエクスポート・インポートのレスポンス整形を担当します
+type AccountArchivePresenter struct{}
+
+func NewAccountArchivePresenter() *AccountArchivePresenter {
+	return &AccountArchivePresenter{}
+}
+
+// GetExportFilename はエクスポートしたアーカイブのダウンロード時のファイル名を返します
+func (p *AccountArchivePresenter) GetExportFilename(exportedAt time.Time) string {
+	return fmt.Sprintf("ai-plan-chat-export-%s.zip", exportedAt.UTC().Format("20060102-150405"))
+}
+
+// GetAccountImportResult はインポート結果をAPIレスポンスに変換します
+func (p *AccountArchivePresenter) GetAccountImportResult(result *entity.AccountImportResult) api.AccountImportResult {
+	return api.AccountImportResult{
+		ProjectsCreated:     result.ProjectsCreated,
+		ProjectsMerged:      result.ProjectsMerged,
+		Tasks:               result.Tasks,
+		Interpretations:     result.Interpretations,
+		InterpretationItems: result.InterpretationItems,
+	}
+}
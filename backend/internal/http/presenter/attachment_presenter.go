@@ -0,0 +1,59 @@
+package presenter
+
+import (
+	"log"
+	"mime"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+)
+
+// AttachmentPresenter はタスクの添付ファイルのレスポンス整形を担当します
+type AttachmentPresenter struct{}
+
+func NewAttachmentPresenter() *AttachmentPresenter {
+	return &AttachmentPresenter{}
+}
+
+// GetAttachment はBOBモデルを添付ファイルのAPIレスポンスに変換します
+func (p *AttachmentPresenter) GetAttachment(attachment *models.TaskAttachment) api.TaskAttachment {
+	id, err := uuid.Parse(attachment.ID)
+	if err != nil {
+		// DB整合性が保たれていれば発生しないはず
+		log.Printf("Warning: invalid UUID in database: %s, error: %v", attachment.ID, err)
+		id = uuid.Nil
+	}
+
+	taskID, err := uuid.Parse(attachment.TaskID)
+	if err != nil {
+		log.Printf("Warning: invalid task UUID in database: %s, error: %v", attachment.TaskID, err)
+		taskID = uuid.Nil
+	}
+
+	return api.TaskAttachment{
+		Id:             types.UUID(id),
+		TaskId:         types.UUID(taskID),
+		Filename:       attachment.Filename,
+		ContentType:    attachment.ContentType,
+		SizeBytes:      attachment.SizeBytes,
+		ChecksumSha256: attachment.ChecksumSha256,
+		CreatedAt:      attachment.CreatedAt,
+	}
+}
+
+// GetAttachmentList は添付ファイル一覧をAPIレスポンスに変換します
+func (p *AttachmentPresenter) GetAttachmentList(attachments models.TaskAttachmentSlice) api.TaskAttachmentListResponse {
+	result := make([]api.TaskAttachment, len(attachments))
+	for i, attachment := range attachments {
+		result[i] = p.GetAttachment(attachment)
+	}
+	return api.TaskAttachmentListResponse{Attachments: result}
+}
+
+// GetContentDisposition はダウンロード時のContent-Dispositionヘッダーの値を返します
+// ASCII以外の文字を含むファイル名はfilename*（RFC 2231形式）で伝えます
+func (p *AttachmentPresenter) GetContentDisposition(attachment *models.TaskAttachment) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename})
+}
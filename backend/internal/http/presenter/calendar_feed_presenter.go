@@ -0,0 +1,152 @@
+package presenter
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/ical"
+)
+
+const (
+	// calendarProductID はiCalendarのPRODID
+	calendarProductID = "-//AI Plan Chat//Tasks//JA"
+	// calendarName はカレンダーアプリに表示するカレンダー名
+	calendarName = "AI Plan Chat タスク"
+	// calendarRefreshInterval はカレンダーアプリに推奨する再取得間隔（RFC 7986 REFRESH-INTERVAL）
+	calendarRefreshInterval = "PT1H"
+	// calendarUIDDomain はUIDのドメイン部（タスクIDと組み合わせて再生成しても同じUIDになる）
+	calendarUIDDomain = "ai-plan-chat"
+	// calendarDoneSummaryPrefix は完了タスクの予定のタイトルの接頭辞（VEVENTには完了状態がないため）
+	calendarDoneSummaryPrefix = "✓ "
+)
+
+// CalendarFeedPresenter はiCalendar購読フィードのレスポンス整形を担当します
+type CalendarFeedPresenter struct {
+	baseURL string
+}
+
+// NewCalendarFeedPresenter は新しいCalendarFeedPresenterを生成します
+// baseURLはフィードのURLを組み立てるための公開URL（例: https://api.example.com）です
+func NewCalendarFeedPresenter(baseURL string) *CalendarFeedPresenter {
+	return &CalendarFeedPresenter{baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+// GetFeedStatus はフィードの発行状態をAPIレスポンスに変換します（発行されていない場合はfeedがnil）
+func (p *CalendarFeedPresenter) GetFeedStatus(feed *models.CalendarFeed) api.CalendarFeedStatus {
+	if feed == nil {
+		return api.CalendarFeedStatus{Enabled: false}
+	}
+	createdAt := feed.CreatedAt
+	return api.CalendarFeedStatus{
+		Enabled:   true,
+		CreatedAt: &createdAt,
+	}
+}
+
+// GetFeedToken は発行したトークンと購読URLをAPIレスポンスに変換します
+func (p *CalendarFeedPresenter) GetFeedToken(feed *models.CalendarFeed, token string) api.CalendarFeedToken {
+	return api.CalendarFeedToken{
+		Token:     token,
+		Url:       p.baseURL + "/ical/" + token + ".ics",
+		CreatedAt: feed.CreatedAt,
+	}
+}
+
+// RenderCalendar はタスクをiCalendar（RFC 5545）形式に変換します
+// 日時はすべてUTCで出力するため、VTIMEZONEを使わずにカレンダーアプリ側で各自のタイムゾーンに変換されます
+func (p *CalendarFeedPresenter) RenderCalendar(tasks models.TaskSlice, component entity.CalendarFeedComponent) string {
+	w := ical.NewWriter()
+	w.Begin("VCALENDAR")
+	w.Property("VERSION", "2.0")
+	w.Text("PRODID", calendarProductID)
+	w.Property("CALSCALE", "GREGORIAN")
+	w.Property("METHOD", "PUBLISH")
+	w.Text("NAME", calendarName)
+	w.Text("X-WR-CALNAME", calendarName)
+	w.Property("REFRESH-INTERVAL;VALUE=DURATION", calendarRefreshInterval)
+	w.Property("X-PUBLISHED-TTL", calendarRefreshInterval)
+
+	for _, task := range tasks {
+		dueAt, ok := task.DueAt.Get()
+		if !ok {
+			continue
+		}
+		if component == entity.CalendarFeedComponentTodo {
+			p.writeTodo(w, task, dueAt)
+		} else {
+			p.writeEvent(w, task, dueAt)
+		}
+	}
+
+	w.End("VCALENDAR")
+	return w.String()
+}
+
+// writeEvent はタスクをVEVENTとして出力します
+// 見積もり工数がある場合は期限に終わる予定、ない場合は期限時刻の予定とします
+func (p *CalendarFeedPresenter) writeEvent(w *ical.Writer, task *models.Task, dueAt time.Time) {
+	startAt := dueAt
+	if estimate, ok := task.EstimateMinutes.Get(); ok && estimate > 0 {
+		startAt = dueAt.Add(-time.Duration(estimate) * time.Minute)
+	}
+
+	summary := task.Title
+	if task.StatusCategory == entity.TaskStatusCategoryDone {
+		summary = calendarDoneSummaryPrefix + summary
+	}
+
+	w.Begin("VEVENT")
+	p.writeCommon(w, task)
+	w.DateTime("DTSTART", startAt)
+	w.DateTime("DTEND", dueAt)
+	w.Text("SUMMARY", summary)
+	w.Property("STATUS", "CONFIRMED")
+	// タスクの期限は予定の空き時間を埋めない
+	w.Property("TRANSP", "TRANSPARENT")
+	w.End("VEVENT")
+}
+
+// writeTodo はタスクをVTODOとして出力します
+func (p *CalendarFeedPresenter) writeTodo(w *ical.Writer, task *models.Task, dueAt time.Time) {
+	w.Begin("VTODO")
+	p.writeCommon(w, task)
+	w.DateTime("DUE", dueAt)
+	w.Text("SUMMARY", task.Title)
+	switch task.StatusCategory {
+	case entity.TaskStatusCategoryDone:
+		w.Property("STATUS", "COMPLETED")
+		// 完了日時のない古いタスクのみ更新日時で代用する（完了後の編集で完了日時がずれないようにする）
+		w.DateTime("COMPLETED", task.CompletedAt.GetOr(task.UpdatedAt))
+		w.Property("PERCENT-COMPLETE", "100")
+	case entity.TaskStatusCategoryDoing:
+		w.Property("STATUS", "IN-PROCESS")
+	default:
+		w.Property("STATUS", "NEEDS-ACTION")
+	}
+	w.End("VTODO")
+}
+
+// writeCommon はVEVENT・VTODO共通のプロパティを出力します
+// DTSTAMPは更新日時とし、タスクが変わらない限りフィードの内容が変わらないようにします
+func (p *CalendarFeedPresenter) writeCommon(w *ical.Writer, task *models.Task) {
+	w.Text("UID", task.ID+"@"+calendarUIDDomain)
+	w.DateTime("DTSTAMP", task.UpdatedAt)
+	w.DateTime("CREATED", task.CreatedAt)
+	w.DateTime("LAST-MODIFIED", task.UpdatedAt)
+	w.Property("SEQUENCE", formatSequence(task.Version))
+	if description, ok := task.Description.Get(); ok && description != "" {
+		w.Text("DESCRIPTION", description)
+	}
+}
+
+// formatSequence はタスクのバージョンをSEQUENCE（0始まり）に変換します
+func formatSequence(version int32) string {
+	if version < 1 {
+		version = 1
+	}
+	return strconv.FormatInt(int64(version-1), 10)
+}
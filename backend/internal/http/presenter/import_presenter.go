@@ -0,0 +1,93 @@
+package presenter
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+// ImportPresenter はタスクの取り込み結果のレスポンス整形を担当します
+type ImportPresenter struct{}
+
+func NewImportPresenter() *ImportPresenter {
+	return &ImportPresenter{}
+}
+
+// GetImportResult は取り込み結果をAPIレスポンスに変換します
+func (p *ImportPresenter) GetImportResult(result *entity.ImportResult) api.ImportResult {
+	items := make([]api.ImportResultItem, len(result.Items))
+	for i, item := range result.Items {
+		items[i] = p.getImportResultItem(item)
+	}
+
+	response := api.ImportResult{
+		DryRun:     result.DryRun,
+		Created:    result.Created,
+		Pending:    result.Pending,
+		Duplicates: result.Duplicates,
+		Skipped:    result.Skipped,
+		Items:      items,
+	}
+
+	if result.InterpretationID != "" {
+		response.InterpretationId = parseUUIDPtr(result.InterpretationID)
+	}
+
+	return response
+}
+
+// getImportResultItem は取り込み候補ごとの処理結果をAPIレスポンスに変換します
+func (p *ImportPresenter) getImportResultItem(item entity.ImportItem) api.ImportResultItem {
+	response := api.ImportResultItem{
+		ExternalId:      item.ExternalID,
+		Title:           item.Title,
+		Description:     item.Description,
+		DueAt:           item.DueAt,
+		Status:          api.ImportResultItemStatus(item.Status),
+		RecurrenceRule:  item.RecurrenceRule,
+		EstimateMinutes: item.EstimateMinutes,
+		Labels:          item.Labels,
+		Result:          api.ImportResultItemResult(item.Result),
+		Warnings:        item.Warnings,
+	}
+	if response.Labels == nil {
+		response.Labels = []string{}
+	}
+	if response.Warnings == nil {
+		response.Warnings = []string{}
+	}
+
+	if item.Priority != nil {
+		priority := api.ImportResultItemPriority(*item.Priority)
+		response.Priority = &priority
+	}
+
+	if item.Reason != "" {
+		reason := item.Reason
+		response.Reason = &reason
+	}
+
+	if item.TaskID != "" {
+		response.TaskId = parseUUIDPtr(item.TaskID)
+	}
+
+	if item.InterpretationItemID != "" {
+		response.InterpretationItemId = parseUUIDPtr(item.InterpretationItemID)
+	}
+
+	return response
+}
+
+// parseUUIDPtr はIDをAPIレスポンスのUUIDに変換します（不正な値の場合はnil）
+func parseUUIDPtr(id string) *types.UUID {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		log.Printf("Warning: invalid UUID in database: %s, error: %v", id, err)
+		return nil
+	}
+	value := types.UUID(parsed)
+	return &value
+}
@@ -0,0 +1,81 @@
+package presenter
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+// NotificationPresenter はアプリ内通知のレスポンス整形を担当します
+type NotificationPresenter struct{}
+
+func NewNotificationPresenter() *NotificationPresenter {
+	return &NotificationPresenter{}
+}
+
+// GetNotification はBOBモデルを通知のAPIレスポンスに変換します
+func (p *NotificationPresenter) GetNotification(notification *models.Notification) api.Notification {
+	id, err := uuid.Parse(notification.ID)
+	if err != nil {
+		// DB整合性が保たれていれば発生しないはず
+		log.Printf("Warning: invalid UUID in database: %s, error: %v", notification.ID, err)
+		id = uuid.Nil
+	}
+
+	response := api.Notification{
+		Id:        types.UUID(id),
+		Type:      notification.Type,
+		Title:     notification.Title,
+		CreatedAt: notification.CreatedAt,
+	}
+
+	if val, ok := notification.Body.Get(); ok {
+		response.Body = &val
+	}
+
+	if val, ok := notification.TaskID.Get(); ok {
+		taskID, err := uuid.Parse(val)
+		if err != nil {
+			log.Printf("Warning: invalid task UUID in database: %s, error: %v", val, err)
+		} else {
+			taskUUID := types.UUID(taskID)
+			response.TaskId = &taskUUID
+		}
+	}
+
+	if val, ok := notification.ReadAt.Get(); ok {
+		response.Read = true
+		response.ReadAt = &val
+	}
+
+	return response
+}
+
+// GetNotificationList は通知一覧と次のページのカーソルをAPIレスポンスに変換します
+func (p *NotificationPresenter) GetNotificationList(notifications models.NotificationSlice, next *entity.NotificationCursor) api.NotificationListResponse {
+	result := make([]api.Notification, len(notifications))
+	for i, notification := range notifications {
+		result[i] = p.GetNotification(notification)
+	}
+
+	response := api.NotificationListResponse{Notifications: result}
+	if next != nil {
+		cursor := next.Encode()
+		response.NextCursor = &cursor
+	}
+	return response
+}
+
+// GetUnreadCount は未読の通知数をAPIレスポンスに変換します
+func (p *NotificationPresenter) GetUnreadCount(count int64) api.UnreadNotificationCountResponse {
+	return api.UnreadNotificationCountResponse{Count: count}
+}
+
+// GetMarkAllRead は既読にした通知数をAPIレスポンスに変換します
+func (p *NotificationPresenter) GetMarkAllRead(count int64) api.ReadAllNotificationsResponse {
+	return api.ReadAllNotificationsResponse{Updated: count}
+}
@@ -0,0 +1,58 @@
+package presenter
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+)
+
+// ProjectPresenter はプロジェクトのレスポンス整形を担当します
+type ProjectPresenter struct{}
+
+func NewProjectPresenter() *ProjectPresenter {
+	return &ProjectPresenter{}
+}
+
+// GetProject はBOBモデルをGetProject APIレスポンスに変換します
+func (p *ProjectPresenter) GetProject(project *models.Project) api.Project {
+	id, err := uuid.Parse(project.ID)
+	if err != nil {
+		// DB整合性が保たれていれば発生しないはず
+		log.Printf("Warning: invalid UUID in database: %s, error: %v", project.ID, err)
+		id = uuid.Nil
+	}
+
+	userID, err := uuid.Parse(project.UserID)
+	if err != nil {
+		log.Printf("Warning: invalid user UUID in database: %s, error: %v", project.UserID, err)
+		userID = uuid.Nil
+	}
+
+	response := api.Project{
+		Id:        types.UUID(id),
+		UserId:    types.UUID(userID),
+		Name:      project.Name,
+		Archived:  project.Archived,
+		SortOrder: int(project.SortOrder),
+		CreatedAt: project.CreatedAt,
+		UpdatedAt: project.UpdatedAt,
+	}
+
+	if val, ok := project.Color.Get(); ok {
+		response.Color = &val
+	}
+
+	return response
+}
+
+// GetProjectList はBOBモデルスライスをGetProjectList APIレスポンスに変換します
+func (p *ProjectPresenter) GetProjectList(projects models.ProjectSlice) []api.Project {
+	result := make([]api.Project, len(projects))
+	for i, project := range projects {
+		result[i] = p.GetProject(project)
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package presenter
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+// StatsPresenter は生産性統計のレスポンス整形を担当します
+type StatsPresenter struct{}
+
+func NewStatsPresenter() *StatsPresenter {
+	return &StatsPresenter{}
+}
+
+// GetTaskStats はタスクの生産性統計をAPIレスポンスに変換します
+func (p *StatsPresenter) GetTaskStats(stats *entity.TaskStats) api.TaskStats {
+	periods := make([]api.TaskStatsPeriod, len(stats.Periods))
+	for i, period := range stats.Periods {
+		periods[i] = api.TaskStatsPeriod{
+			Date:           types.Date{Time: period.Start},
+			CreatedCount:   period.CreatedCount,
+			CompletedCount: period.CompletedCount,
+		}
+	}
+
+	sources := make([]api.TaskStatsSource, len(stats.Sources))
+	for i, source := range stats.Sources {
+		sources[i] = api.TaskStatsSource{
+			Source:         api.TaskStatsSourceSource(source.Source),
+			CreatedCount:   source.CreatedCount,
+			CompletedCount: source.CompletedCount,
+		}
+	}
+
+	return api.TaskStats{
+		From:                   stats.From,
+		To:                     stats.To,
+		GroupBy:                api.TaskStatsGroupBy(stats.GroupBy),
+		CreatedCount:           stats.CreatedCount,
+		CompletedCount:         stats.CompletedCount,
+		CompletionRate:         stats.CompletionRate,
+		AverageLeadTimeSeconds: stats.AverageLeadTimeSeconds,
+		OverdueCount:           stats.OverdueCount,
+		Periods:                periods,
+		Sources:                sources,
+	}
+}
+
+// GetTaskFlowReport はサイクルタイム・スループットの集計結果をAPIレスポンスに変換します
+func (p *StatsPresenter) GetTaskFlowReport(report *entity.TaskFlowReport) api.TaskFlowReport {
+	periods := make([]api.TaskFlowPeriod, len(report.Periods))
+	for i, period := range report.Periods {
+		periods[i] = api.TaskFlowPeriod{
+			Date:                    types.Date{Time: period.Start},
+			StartedCount:            period.StartedCount,
+			CompletedCount:          period.CompletedCount,
+			AverageCycleTimeSeconds: period.AverageCycleTimeSeconds,
+			CumulativeCreated:       period.Cumulative.Created,
+			CumulativeStarted:       period.Cumulative.Started,
+			CumulativeCompleted:     period.Cumulative.Completed,
+		}
+	}
+
+	return api.TaskFlowReport{
+		From:                    report.From,
+		To:                      report.To,
+		GroupBy:                 api.TaskFlowReportGroupBy(report.GroupBy),
+		Throughput:              report.Throughput,
+		AverageCycleTimeSeconds: report.AverageCycleTimeSeconds,
+		Periods:                 periods,
+	}
+}
@@ -1,12 +1,16 @@
 package presenter
 
 import (
+	"encoding/json"
 	"log"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/yoshioka0101/ai_plan_chat/gen/api"
 	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/apperr"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
 )
 
 // TaskPresenter はタスクのレスポンス整形を担当します
@@ -17,7 +21,8 @@ func NewTaskPresenter() *TaskPresenter {
 }
 
 // GetTask はBOBモデルをGetTask APIレスポンスに変換します
-func (p *TaskPresenter) GetTask(task *models.Task) api.Task {
+// summaryは依存関係と作業時間記録から算出した値です（nilの場合はブロックなし・記録なし）
+func (p *TaskPresenter) GetTask(task *models.Task, summary *entity.TaskSummary) api.Task {
 	// 文字列IDをUUIDにパース
 
 	id, err := uuid.Parse(task.ID)
@@ -34,13 +39,22 @@ func (p *TaskPresenter) GetTask(task *models.Task) api.Task {
 	}
 
 	response := api.Task{
-		Id:        types.UUID(id),
-		UserId:    types.UUID(userID),
-		Title:     task.Title,
-		Source:    api.TaskSource(task.Source),
-		Status:    api.TaskStatus(task.Status),
-		CreatedAt: task.CreatedAt,
-		UpdatedAt: task.UpdatedAt,
+		Id:             types.UUID(id),
+		UserId:         types.UUID(userID),
+		Title:          task.Title,
+		Source:         api.TaskSource(task.Source),
+		Status:         task.Status,
+		StatusCategory: api.TaskStatusCategory(task.StatusCategory),
+		Rank:           task.RankKey,
+		Version:        int(task.Version),
+		CreatedAt:      task.CreatedAt,
+		UpdatedAt:      task.UpdatedAt,
+	}
+
+	if summary != nil {
+		response.Blocked = summary.Blocked
+		response.TrackedSeconds = summary.TrackedSeconds
+		response.TimerRunning = summary.TimerRunning
 	}
 
 	// Null可能なフィールドの処理
@@ -52,6 +66,10 @@ func (p *TaskPresenter) GetTask(task *models.Task) api.Task {
 		response.DueAt = &val
 	}
 
+	if val, ok := task.EstimateMinutes.Get(); ok {
+		response.EstimateMinutes = &val
+	}
+
 	if task.AiInterpretationID.IsValue() {
 		if val, ok := task.AiInterpretationID.Get(); ok {
 			if parsed, err := uuid.Parse(val); err == nil {
@@ -60,29 +78,234 @@ func (p *TaskPresenter) GetTask(task *models.Task) api.Task {
 		}
 	}
 
+	if val, ok := task.ProjectID.Get(); ok {
+		if parsed, err := uuid.Parse(val); err == nil {
+			response.ProjectId = (*types.UUID)(&parsed)
+		}
+	}
+
+	if val, ok := task.RecurrenceRule.Get(); ok {
+		response.RecurrenceRule = &val
+	}
+
+	if val, ok := task.RecurrenceAnchorAt.Get(); ok {
+		response.RecurrenceAnchorAt = &val
+	}
+
+	if val, ok := task.RecurrenceSeriesID.Get(); ok {
+		if parsed, err := uuid.Parse(val); err == nil {
+			response.RecurrenceSeriesId = (*types.UUID)(&parsed)
+		}
+	}
+
+	if val, ok := task.StartedAt.Get(); ok {
+		response.StartedAt = &val
+	}
+
+	if val, ok := task.CompletedAt.Get(); ok {
+		response.CompletedAt = &val
+	}
+
+	if val, ok := task.SnoozedUntil.Get(); ok {
+		response.SnoozedUntil = &val
+	}
+
+	if val, ok := task.ArchivedAt.Get(); ok {
+		response.ArchivedAt = &val
+	}
+
+	if val, ok := task.DeletedAt.Get(); ok {
+		response.DeletedAt = &val
+	}
+
 	return response
 }
 
 // GetTaskList はBOBモデルスライスをGetTaskList APIレスポンスに変換します
-func (p *TaskPresenter) GetTaskList(tasks models.TaskSlice) []api.Task {
+func (p *TaskPresenter) GetTaskList(tasks models.TaskSlice, summaries map[string]*entity.TaskSummary) []api.Task {
 	result := make([]api.Task, len(tasks))
 	for i, task := range tasks {
-		result[i] = p.GetTask(task)
+		result[i] = p.GetTask(task, summaries[task.ID])
 	}
 	return result
 }
 
 // CreateTask はBOBモデルをCreateTask APIレスポンスに変換します
+// 作成直後のタスクは依存関係も作業時間の記録も持ちません
 func (p *TaskPresenter) CreateTask(task *models.Task) api.Task {
-	return p.GetTask(task)
+	return p.GetTask(task, nil)
 }
 
 // UpdateTask はBOBモデルをUpdateTask APIレスポンスに変換します
-func (p *TaskPresenter) UpdateTask(task *models.Task) api.Task {
-	return p.GetTask(task)
+func (p *TaskPresenter) UpdateTask(task *models.Task, summary *entity.TaskSummary) api.Task {
+	return p.GetTask(task, summary)
 }
 
 // EditTask はBOBモデルをEditTask APIレスポンスに変換します
-func (p *TaskPresenter) EditTask(task *models.Task) api.Task {
-	return p.GetTask(task)
+func (p *TaskPresenter) EditTask(task *models.Task, summary *entity.TaskSummary) api.Task {
+	return p.GetTask(task, summary)
+}
+
+// MoveTask はBOBモデルをMoveTask APIレスポンスに変換します
+func (p *TaskPresenter) MoveTask(task *models.Task, summary *entity.TaskSummary) api.Task {
+	return p.GetTask(task, summary)
+}
+
+// SnoozeTask はスヌーズ・スヌーズ解除したタスクをAPIレスポンスに変換します
+func (p *TaskPresenter) SnoozeTask(task *models.Task, summary *entity.TaskSummary) api.Task {
+	return p.GetTask(task, summary)
+}
+
+// GetTrash はゴミ箱内のタスク一覧をAPIレスポンスに変換します
+// ゴミ箱内のタスクは着手できないためブロック状態や作業時間は算出しません
+func (p *TaskPresenter) GetTrash(tasks models.TaskSlice) []api.Task {
+	return p.GetTaskList(tasks, nil)
+}
+
+// GetArchivedTasks はアーカイブ済みのタスク一覧をAPIレスポンスに変換します
+// アーカイブ済みのタスクは完了済みのためブロック状態や作業時間は算出しません
+func (p *TaskPresenter) GetArchivedTasks(tasks models.TaskSlice) []api.Task {
+	return p.GetTaskList(tasks, nil)
+}
+
+// UnarchiveTask はBOBモデルをUnarchiveTask APIレスポンスに変換します
+func (p *TaskPresenter) UnarchiveTask(task *models.Task, summary *entity.TaskSummary) api.Task {
+	return p.GetTask(task, summary)
+}
+
+// RestoreTask はBOBモデルをRestoreTask APIレスポンスに変換します
+func (p *TaskPresenter) RestoreTask(task *models.Task, summary *entity.TaskSummary) api.Task {
+	return p.GetTask(task, summary)
+}
+
+// GetTaskDependencies は先行タスク・後続タスクをAPIレスポンスに変換します
+func (p *TaskPresenter) GetTaskDependencies(blockedBy, blocks models.TaskSlice, summaries map[string]*entity.TaskSummary) api.TaskDependencies {
+	return api.TaskDependencies{
+		BlockedBy: p.GetTaskList(blockedBy, summaries),
+		Blocks:    p.GetTaskList(blocks, summaries),
+	}
+}
+
+// GetTaskOccurrences は発生予定一覧をAPIレスポンスに変換します
+func (p *TaskPresenter) GetTaskOccurrences(occurrences []*entity.TaskOccurrence) api.TaskOccurrencesResponse {
+	result := make([]api.TaskOccurrence, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		taskID, err := uuid.Parse(occurrence.TaskID)
+		if err != nil {
+			log.Printf("Warning: invalid UUID in database: %s, error: %v", occurrence.TaskID, err)
+			taskID = uuid.Nil
+		}
+
+		seriesID, err := uuid.Parse(occurrence.SeriesID)
+		if err != nil {
+			log.Printf("Warning: invalid series UUID in database: %s, error: %v", occurrence.SeriesID, err)
+			seriesID = uuid.Nil
+		}
+
+		result = append(result, api.TaskOccurrence{
+			TaskId:   types.UUID(taskID),
+			SeriesId: types.UUID(seriesID),
+			Title:    occurrence.Title,
+			OccursAt: occurrence.OccursAt,
+		})
+	}
+	return api.TaskOccurrencesResponse{Occurrences: result}
+}
+
+// GetTaskHistory は変更履歴一覧をAPIレスポンスに変換します
+func (p *TaskPresenter) GetTaskHistory(events models.TaskEventSlice) api.TaskHistoryResponse {
+	result := make([]api.TaskEvent, 0, len(events))
+	for _, event := range events {
+		id, err := uuid.Parse(event.ID)
+		if err != nil {
+			log.Printf("Warning: invalid UUID in database: %s, error: %v", event.ID, err)
+			id = uuid.Nil
+		}
+
+		taskID, err := uuid.Parse(event.TaskID)
+		if err != nil {
+			log.Printf("Warning: invalid task UUID in database: %s, error: %v", event.TaskID, err)
+			taskID = uuid.Nil
+		}
+
+		var actorID *types.UUID
+		if val, ok := event.ActorID.Get(); ok {
+			if parsed, err := uuid.Parse(val); err == nil {
+				uid := types.UUID(parsed)
+				actorID = &uid
+			} else {
+				log.Printf("Warning: invalid actor UUID in database: %s, error: %v", val, err)
+			}
+		}
+
+		changes := make(map[string]api.TaskFieldChange)
+		if err := json.Unmarshal(event.Changes.Val, &changes); err != nil {
+			log.Printf("Warning: invalid task event changes in database: %s, error: %v", event.ID, err)
+		}
+
+		result = append(result, api.TaskEvent{
+			Id:        types.UUID(id),
+			TaskId:    types.UUID(taskID),
+			ActorId:   actorID,
+			EventType: api.TaskEventEventType(event.EventType),
+			Source:    api.TaskEventSource(event.Source),
+			Changes:   changes,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+	return api.TaskHistoryResponse{Events: result}
+}
+
+// BatchTasks は一括操作の結果をAPIレスポンスに変換します
+func (p *TaskPresenter) BatchTasks(results []*entity.TaskBatchResult) api.BatchTaskResponse {
+	response := api.BatchTaskResponse{
+		Results: make([]api.BatchTaskResult, 0, len(results)),
+	}
+	for _, result := range results {
+		item := api.BatchTaskResult{
+			Index:  result.Index,
+			TaskId: result.TaskID,
+			Op:     string(result.Type),
+			Status: api.BatchTaskResultStatus(result.Status),
+		}
+		if result.Err != nil {
+			item.Error = batchTaskError(result.Err)
+		}
+
+		switch result.Status {
+		case entity.TaskBatchResultSucceeded:
+			response.Succeeded++
+		case entity.TaskBatchResultFailed:
+			response.Failed++
+		}
+		response.Results = append(response.Results, item)
+	}
+	return response
+}
+
+// batchTaskError は一括操作の失敗理由を単体のAPIと同じ区分のエラーに変換します
+func batchTaskError(err error) *api.ErrorResponse {
+	code, appErr := "internal_error", apperr.ErrTaskInternalError
+	switch {
+	case strings.Contains(err.Error(), "not found"):
+		code, appErr = "not_found", apperr.ErrTaskNotFound
+	case strings.Contains(err.Error(), "version conflict"):
+		code, appErr = "precondition_failed", apperr.ErrTaskPreconditionFailed
+	case strings.Contains(err.Error(), "blocked"):
+		code, appErr = "blocked", apperr.ErrTaskBlocked
+	case strings.Contains(err.Error(), "wip limit"):
+		code, appErr = "wip_limit_reached", apperr.ErrTaskWIPLimitReached
+	case strings.Contains(err.Error(), "validation"):
+		code, appErr = "validation_error", apperr.ErrTaskValidationError
+	}
+
+	message := appErr.Message
+	if code == "validation_error" {
+		// 入力の誤りは修正できるよう詳細を返す
+		message = strings.TrimPrefix(err.Error(), "validation error: ")
+	}
+	return &api.ErrorResponse{
+		Code:    &code,
+		Message: &message,
+	}
 }
@@ -0,0 +1,51 @@
+package presenter
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+)
+
+// TaskStatusPresenter はワークフローのステータスのレスポンス整形を担当します
+type TaskStatusPresenter struct{}
+
+func NewTaskStatusPresenter() *TaskStatusPresenter {
+	return &TaskStatusPresenter{}
+}
+
+// GetStatus はBOBモデルをTaskStatus APIレスポンスに変換します
+func (p *TaskStatusPresenter) GetStatus(status *models.TaskStatus) api.TaskStatus {
+	id, err := uuid.Parse(status.ID)
+	if err != nil {
+		// DB整合性が保たれていれば発生しないはず
+		log.Printf("Warning: invalid UUID in database: %s, error: %v", status.ID, err)
+		id = uuid.Nil
+	}
+
+	response := api.TaskStatus{
+		Id:        types.UUID(id),
+		Name:      status.Name,
+		Category:  api.TaskStatusCategory(status.Category),
+		SortOrder: status.SortOrder,
+		CreatedAt: status.CreatedAt,
+		UpdatedAt: status.UpdatedAt,
+	}
+
+	if val, ok := status.WipLimit.Get(); ok {
+		response.WipLimit = &val
+	}
+
+	return response
+}
+
+// GetStatusList はBOBモデルスライスをステータス一覧のAPIレスポンスに変換します
+func (p *TaskStatusPresenter) GetStatusList(statuses models.TaskStatusSlice) []api.TaskStatus {
+	result := make([]api.TaskStatus, len(statuses))
+	for i, status := range statuses {
+		result[i] = p.GetStatus(status)
+	}
+	return result
+}
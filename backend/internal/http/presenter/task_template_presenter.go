@@ -0,0 +1,78 @@
+package presenter
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+// TaskTemplatePresenter はタスクテンプレートのレスポンス整形を担当します
+type TaskTemplatePresenter struct{}
+
+func NewTaskTemplatePresenter() *TaskTemplatePresenter {
+	return &TaskTemplatePresenter{}
+}
+
+// GetTaskTemplate はテンプレートをGetTaskTemplate APIレスポンスに変換します
+func (p *TaskTemplatePresenter) GetTaskTemplate(template *entity.TaskTemplate) api.TaskTemplate {
+	id, err := uuid.Parse(template.ID)
+	if err != nil {
+		// DB整合性が保たれていれば発生しないはず
+		log.Printf("Warning: invalid UUID in database: %s, error: %v", template.ID, err)
+		id = uuid.Nil
+	}
+
+	userID, err := uuid.Parse(template.UserID)
+	if err != nil {
+		log.Printf("Warning: invalid user UUID in database: %s, error: %v", template.UserID, err)
+		userID = uuid.Nil
+	}
+
+	response := api.TaskTemplate{
+		Id:          types.UUID(id),
+		UserId:      types.UUID(userID),
+		Name:        template.Name,
+		Description: template.Description,
+		Items:       p.getTaskTemplateItems(template.Items),
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+
+	if template.ProjectID != nil {
+		if parsed, err := uuid.Parse(*template.ProjectID); err == nil {
+			response.ProjectId = (*types.UUID)(&parsed)
+		}
+	}
+
+	return response
+}
+
+// GetTaskTemplateList はテンプレートのスライスをGetTaskTemplateList APIレスポンスに変換します
+func (p *TaskTemplatePresenter) GetTaskTemplateList(templates []*entity.TaskTemplate) []api.TaskTemplate {
+	result := make([]api.TaskTemplate, len(templates))
+	for i, template := range templates {
+		result[i] = p.GetTaskTemplate(template)
+	}
+	return result
+}
+
+// getTaskTemplateItems はタスク定義をサブタスクを含めてレスポンスの形に変換します
+func (p *TaskTemplatePresenter) getTaskTemplateItems(items []entity.TaskTemplateItem) []api.TaskTemplateItem {
+	result := make([]api.TaskTemplateItem, len(items))
+	for i, item := range items {
+		result[i] = api.TaskTemplateItem{
+			Title:           item.Title,
+			Description:     item.Description,
+			DueOffset:       item.DueOffset,
+			EstimateMinutes: item.EstimateMinutes,
+		}
+		if len(item.Subtasks) > 0 {
+			subtasks := p.getTaskTemplateItems(item.Subtasks)
+			result[i].Subtasks = &subtasks
+		}
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+package presenter
+
+import (
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+// TaskViewPresenter はタスクのビューのレスポンス整形を担当します
+type TaskViewPresenter struct{}
+
+func NewTaskViewPresenter() *TaskViewPresenter {
+	return &TaskViewPresenter{}
+}
+
+// GetTaskView はビューをGetTaskView APIレスポンスに変換します（システムビューはユーザーIDと日時を省略）
+func (p *TaskViewPresenter) GetTaskView(view *entity.TaskView) api.TaskView {
+	response := api.TaskView{
+		Id:     view.ID,
+		Name:   view.Name,
+		Filter: p.getTaskFilter(view.Filter),
+		System: view.System,
+	}
+
+	if view.System {
+		return response
+	}
+
+	userID, err := uuid.Parse(view.UserID)
+	if err != nil {
+		// DB整合性が保たれていれば発生しないはず
+		log.Printf("Warning: invalid user UUID in database: %s, error: %v", view.UserID, err)
+		userID = uuid.Nil
+	}
+	response.UserId = (*types.UUID)(&userID)
+	response.CreatedAt = &view.CreatedAt
+	response.UpdatedAt = &view.UpdatedAt
+
+	return response
+}
+
+// GetTaskViewList はビューのスライスをGetTaskViewList APIレスポンスに変換します
+func (p *TaskViewPresenter) GetTaskViewList(views []*entity.TaskView) []api.TaskView {
+	result := make([]api.TaskView, len(views))
+	for i, view := range views {
+		result[i] = p.GetTaskView(view)
+	}
+	return result
+}
+
+// getTaskFilter は絞り込み条件をレスポンスの形に変換します（指定のない条件は省略）
+func (p *TaskViewPresenter) getTaskFilter(filter entity.TaskFilter) api.TaskFilter {
+	response := api.TaskFilter{
+		DueFrom:       filter.DueFrom,
+		DueTo:         filter.DueTo,
+		HasDueDate:    filter.HasDueDate,
+		TitleContains: filter.TitleContains,
+	}
+
+	if filter.IncludeSnoozed {
+		includeSnoozed := true
+		response.IncludeSnoozed = &includeSnoozed
+	}
+	if filter.IncludeArchived {
+		includeArchived := true
+		response.IncludeArchived = &includeArchived
+	}
+
+	if len(filter.Statuses) > 0 {
+		statuses := append([]string(nil), filter.Statuses...)
+		response.Statuses = &statuses
+	}
+
+	if len(filter.StatusCategories) > 0 {
+		categories := make([]api.TaskStatusCategory, len(filter.StatusCategories))
+		for i, category := range filter.StatusCategories {
+			categories[i] = api.TaskStatusCategory(category)
+		}
+		response.StatusCategories = &categories
+	}
+
+	if len(filter.ProjectIDs) > 0 {
+		projectIDs := make([]types.UUID, 0, len(filter.ProjectIDs))
+		for _, projectID := range filter.ProjectIDs {
+			if parsed, err := uuid.Parse(projectID); err == nil {
+				projectIDs = append(projectIDs, types.UUID(parsed))
+			}
+		}
+		response.ProjectIds = &projectIDs
+	}
+
+	if len(filter.Sources) > 0 {
+		sources := make([]api.TaskFilterSources, len(filter.Sources))
+		for i, source := range filter.Sources {
+			sources[i] = api.TaskFilterSources(source)
+		}
+		response.Sources = &sources
+	}
+
+	if filter.Sort != "" {
+		sort := api.TaskFilterSort(filter.Sort)
+		response.Sort = &sort
+	}
+
+	return response
+}
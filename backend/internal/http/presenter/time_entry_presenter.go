@@ -0,0 +1,123 @@
+package presenter
+
+import (
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+// TimeEntryPresenter は作業時間記録のレスポンス整形を担当します
+type TimeEntryPresenter struct{}
+
+func NewTimeEntryPresenter() *TimeEntryPresenter {
+	return &TimeEntryPresenter{}
+}
+
+// GetTimeEntry はBOBモデルを時間記録のAPIレスポンスに変換します
+// 計測中の記録の記録時間は現在までの経過時間です
+func (p *TimeEntryPresenter) GetTimeEntry(entry *models.TimeEntry) api.TimeEntry {
+	id, err := uuid.Parse(entry.ID)
+	if err != nil {
+		// DB整合性が保たれていれば発生しないはず
+		log.Printf("Warning: invalid UUID in database: %s, error: %v", entry.ID, err)
+		id = uuid.Nil
+	}
+
+	taskID, err := uuid.Parse(entry.TaskID)
+	if err != nil {
+		log.Printf("Warning: invalid task UUID in database: %s, error: %v", entry.TaskID, err)
+		taskID = uuid.Nil
+	}
+
+	response := api.TimeEntry{
+		Id:              types.UUID(id),
+		TaskId:          types.UUID(taskID),
+		StartedAt:       entry.StartedAt,
+		DurationSeconds: int64(entry.DurationSeconds),
+		CreatedAt:       entry.CreatedAt,
+		UpdatedAt:       entry.UpdatedAt,
+	}
+
+	if val, ok := entry.EndedAt.Get(); ok {
+		response.EndedAt = &val
+	} else {
+		response.Running = true
+		if elapsed := time.Since(entry.StartedAt); elapsed > 0 {
+			response.DurationSeconds = int64(elapsed / time.Second)
+		}
+	}
+
+	if val, ok := entry.Note.Get(); ok {
+		response.Note = &val
+	}
+
+	return response
+}
+
+// GetTimeEntryList は時間記録一覧をAPIレスポンスに変換します
+func (p *TimeEntryPresenter) GetTimeEntryList(entries models.TimeEntrySlice) api.TimeEntryListResponse {
+	result := make([]api.TimeEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = p.GetTimeEntry(entry)
+	}
+	return api.TimeEntryListResponse{Entries: result}
+}
+
+// GetRunningTimer は計測中の時間記録をAPIレスポンスに変換します（計測中のものがない場合はnull）
+func (p *TimeEntryPresenter) GetRunningTimer(entry *models.TimeEntry) api.RunningTimerResponse {
+	if entry == nil {
+		return api.RunningTimerResponse{}
+	}
+	response := p.GetTimeEntry(entry)
+	return api.RunningTimerResponse{Entry: &response}
+}
+
+// GetTimeReport は作業時間の集計結果をAPIレスポンスに変換します
+func (p *TimeEntryPresenter) GetTimeReport(report *entity.TimeReport) api.TimeReport {
+	groups := make([]api.TimeReportGroup, len(report.Groups))
+	for i, group := range report.Groups {
+		groups[i] = api.TimeReportGroup{
+			Key:          group.Key,
+			Label:        group.Label,
+			TotalSeconds: group.TotalSeconds,
+			EntryCount:   group.EntryCount,
+		}
+	}
+	return api.TimeReport{
+		From:         report.From,
+		To:           report.To,
+		GroupBy:      api.TimeReportGroupBy(report.GroupBy),
+		TotalSeconds: report.TotalSeconds,
+		Groups:       groups,
+	}
+}
+
+// GetEstimateReport は見積もりと実績の比較結果をAPIレスポンスに変換します
+func (p *TimeEntryPresenter) GetEstimateReport(report *entity.EstimateReport) api.EstimateReport {
+	tasks := make([]api.EstimateReportTask, len(report.Tasks))
+	for i, task := range report.Tasks {
+		taskID, err := uuid.Parse(task.TaskID)
+		if err != nil {
+			log.Printf("Warning: invalid task UUID in database: %s, error: %v", task.TaskID, err)
+			taskID = uuid.Nil
+		}
+		tasks[i] = api.EstimateReportTask{
+			TaskId:          types.UUID(taskID),
+			Title:           task.Title,
+			EstimateMinutes: task.EstimateMinutes,
+			ActualSeconds:   task.ActualSeconds,
+		}
+	}
+	return api.EstimateReport{
+		TaskCount:             report.TaskCount,
+		TotalEstimateSeconds:  report.TotalEstimateSeconds,
+		TotalActualSeconds:    report.TotalActualSeconds,
+		ActualToEstimateRatio: report.ActualToEstimateRatio,
+		Tasks:                 tasks,
+	}
+}
@@ -0,0 +1,30 @@
+package presenter
+
+import (
+	"github.com/yoshioka0101/ai_plan_chat/gen/api"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+)
+
+// UserSettingPresenter はユーザー設定のレスポンス整形を担当します
+type UserSettingPresenter struct{}
+
+func NewUserSettingPresenter() *UserSettingPresenter {
+	return &UserSettingPresenter{}
+}
+
+// GetUserSettings はBOBモデルをGetUserSettings APIレスポンスに変換します
+func (p *UserSettingPresenter) GetUserSettings(setting *models.UserSetting) api.UserSettings {
+	response := api.UserSettings{}
+	if days, ok := setting.AutoArchiveDays.Get(); ok {
+		response.AutoArchiveDays = &days
+	}
+	if timezone, ok := setting.Timezone.Get(); ok {
+		response.Timezone = &timezone
+	}
+	return response
+}
+
+// EditUserSettings はBOBモデルをEditUserSettings APIレスポンスに変換します
+func (p *UserSettingPresenter) EditUserSettings(setting *models.UserSetting) api.UserSettings {
+	return p.GetUserSettings(setting)
+}
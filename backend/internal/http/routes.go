@@ -11,16 +11,40 @@ import (
 type Server struct {
 	*handler.HealthHandler
 	*handler.TaskHandler
+	*handler.ProjectHandler
+	*handler.TaskStatusHandler
+	*handler.TaskTemplateHandler
+	*handler.TaskViewHandler
+	*handler.TimeEntryHandler
+	*handler.StatsHandler
+	*handler.AttachmentHandler
+	*handler.NotificationHandler
+	*handler.CalendarFeedHandler
+	*handler.ImportHandler
+	*handler.AccountArchiveHandler
+	*handler.UserSettingHandler
 	*handler.AuthHandler
 	*handler.InterpretationHandler
 	*handler.InterpretationItemHandler
 }
 
 // NewServer は統合ハンドラーを作成します
-func NewServer(healthHandler *handler.HealthHandler, taskHandler *handler.TaskHandler, authHandler *handler.AuthHandler, interpretationHandler *handler.InterpretationHandler, interpretationItemHandler *handler.InterpretationItemHandler) *Server {
+func NewServer(healthHandler *handler.HealthHandler, taskHandler *handler.TaskHandler, projectHandler *handler.ProjectHandler, taskStatusHandler *handler.TaskStatusHandler, taskTemplateHandler *handler.TaskTemplateHandler, taskViewHandler *handler.TaskViewHandler, timeEntryHandler *handler.TimeEntryHandler, statsHandler *handler.StatsHandler, attachmentHandler *handler.AttachmentHandler, notificationHandler *handler.NotificationHandler, calendarFeedHandler *handler.CalendarFeedHandler, importHandler *handler.ImportHandler, accountArchiveHandler *handler.AccountArchiveHandler, userSettingHandler *handler.UserSettingHandler, authHandler *handler.AuthHandler, interpretationHandler *handler.InterpretationHandler, interpretationItemHandler *handler.InterpretationItemHandler) *Server {
 	return &Server{
 		HealthHandler:              healthHandler,
 		TaskHandler:                taskHandler,
+		ProjectHandler:             projectHandler,
+		TaskStatusHandler:          taskStatusHandler,
+		TaskTemplateHandler:        taskTemplateHandler,
+		TaskViewHandler:            taskViewHandler,
+		TimeEntryHandler:           timeEntryHandler,
+		StatsHandler:               statsHandler,
+		AttachmentHandler:          attachmentHandler,
+		NotificationHandler:        notificationHandler,
+		CalendarFeedHandler:        calendarFeedHandler,
+		ImportHandler:              importHandler,
+		AccountArchiveHandler:      accountArchiveHandler,
+		UserSettingHandler:         userSettingHandler,
 		AuthHandler:                authHandler,
 		InterpretationHandler:      interpretationHandler,
 		InterpretationItemHandler: interpretationItemHandler,
@@ -28,7 +52,7 @@ func NewServer(healthHandler *handler.HealthHandler, taskHandler *handler.TaskHa
 }
 
 // SetupRoutes はルーターをセットアップします
-func SetupRoutes(server *Server, authMiddleware *middleware.AuthMiddleware) *gin.Engine {
+func SetupRoutes(server *Server, authMiddleware *middleware.AuthMiddleware, idempotencyMiddleware *middleware.IdempotencyMiddleware) *gin.Engine {
 	logger := middleware.NewLogger()
 
 	r := gin.New()
@@ -39,7 +63,8 @@ func SetupRoutes(server *Server, authMiddleware *middleware.AuthMiddleware) *gin
 	config := cors.DefaultConfig()
 	config.AllowOrigins = []string{"http://localhost:5173", "https://app.hubplanner-ai.click"} // Add production frontend URL
 	config.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
-	config.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization"}
+	config.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization", "If-Match", middleware.IdempotencyKeyHeader}
+	config.ExposeHeaders = []string{"ETag", middleware.IdempotentReplayedHeader}
 	r.Use(cors.New(config))
 
 	// Explicitly handle OPTIONS for all routes as a fallback for CORS preflight
@@ -55,24 +80,154 @@ func SetupRoutes(server *Server, authMiddleware *middleware.AuthMiddleware) *gin
 	r.GET("/auth/google/callback", server.AuthHandler.GoogleCallback)
 	r.POST("/auth/google/callback", server.AuthHandler.GoogleCallback)
 
+	// iCalendar feed (authenticated by the token in the URL for calendar apps)
+	r.GET("/ical/:file", server.CalendarFeedHandler.GetCalendarFeedICS)
+
 	// API v1 routes
 	v1 := r.Group("/api/v1")
 	{
 		// Task endpoints
 		tasks := v1.Group("/tasks")
-		tasks.Use(authMiddleware.RequireAuth())
+		tasks.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
 		{
 			tasks.GET("", server.TaskHandler.GetTaskList)
 			tasks.POST("", server.TaskHandler.CreateTask)
+			tasks.GET("/occurrences", server.TaskHandler.GetTaskOccurrences)
+			tasks.POST("/batch", server.TaskHandler.BatchTasks)
+			tasks.GET("/trash", server.TaskHandler.GetTrash)
+			tasks.GET("/archived", server.TaskHandler.GetArchivedTasks)
 			tasks.GET("/:id", server.TaskHandler.GetTask)
 			tasks.PUT("/:id", server.TaskHandler.UpdateTask)
 			tasks.PATCH("/:id", server.TaskHandler.EditTask)
 			tasks.DELETE("/:id", server.TaskHandler.DeleteTask)
+			tasks.POST("/:id/restore", server.TaskHandler.RestoreTask)
+			tasks.POST("/:id/unarchive", server.TaskHandler.UnarchiveTask)
+			tasks.POST("/:id/move", server.TaskHandler.MoveTask)
+			tasks.POST("/:id/snooze", server.TaskHandler.SnoozeTask)
+			tasks.DELETE("/:id/snooze", server.TaskHandler.UnsnoozeTask)
+			tasks.GET("/:id/occurrences", server.TaskHandler.GetTaskOccurrencesByID)
+			tasks.GET("/:id/history", server.TaskHandler.GetTaskHistory)
+			tasks.GET("/:id/dependencies", server.TaskHandler.GetTaskDependencies)
+			tasks.POST("/:id/dependencies", server.TaskHandler.AddTaskDependency)
+			tasks.DELETE("/:id/dependencies/:depends_on_id", server.TaskHandler.RemoveTaskDependency)
+			tasks.POST("/:id/timer/start", server.TimeEntryHandler.StartTaskTimer)
+			tasks.POST("/:id/timer/stop", server.TimeEntryHandler.StopTaskTimer)
+			tasks.GET("/:id/time-entries", server.TimeEntryHandler.GetTaskTimeEntries)
+			tasks.POST("/:id/time-entries", server.TimeEntryHandler.CreateTaskTimeEntry)
+			tasks.GET("/:id/attachments", server.AttachmentHandler.GetTaskAttachments)
+			tasks.POST("/:id/attachments", server.AttachmentHandler.UploadTaskAttachment)
+			tasks.GET("/:id/attachments/:attachment_id", server.AttachmentHandler.DownloadTaskAttachment)
+			tasks.DELETE("/:id/attachments/:attachment_id", server.AttachmentHandler.DeleteTaskAttachment)
+			tasks.POST("/:id/save-as-template", server.TaskTemplateHandler.SaveTaskAsTemplate)
+		}
+
+		// Project endpoints
+		projects := v1.Group("/projects")
+		projects.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
+		{
+			projects.GET("", server.ProjectHandler.GetProjectList)
+			projects.POST("", server.ProjectHandler.CreateProject)
+			projects.GET("/:id", server.ProjectHandler.GetProject)
+			projects.PATCH("/:id", server.ProjectHandler.EditProject)
+			projects.DELETE("/:id", server.ProjectHandler.DeleteProject)
+		}
+
+		// Task template endpoints
+		templates := v1.Group("/templates")
+		templates.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
+		{
+			templates.GET("", server.TaskTemplateHandler.GetTaskTemplateList)
+			templates.POST("", server.TaskTemplateHandler.CreateTaskTemplate)
+			templates.GET("/:id", server.TaskTemplateHandler.GetTaskTemplate)
+			templates.PATCH("/:id", server.TaskTemplateHandler.EditTaskTemplate)
+			templates.DELETE("/:id", server.TaskTemplateHandler.DeleteTaskTemplate)
+			templates.POST("/:id/instantiate", server.TaskTemplateHandler.InstantiateTaskTemplate)
+		}
+
+		// Task status endpoints
+		statuses := v1.Group("/statuses")
+		statuses.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
+		{
+			statuses.GET("", server.TaskStatusHandler.GetTaskStatusList)
+			statuses.POST("", server.TaskStatusHandler.CreateTaskStatus)
+			statuses.PATCH("/:id", server.TaskStatusHandler.EditTaskStatus)
+			statuses.DELETE("/:id", server.TaskStatusHandler.DeleteTaskStatus)
+		}
+
+		// Task view endpoints
+		views := v1.Group("/views")
+		views.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
+		{
+			views.GET("", server.TaskViewHandler.GetTaskViewList)
+			views.POST("", server.TaskViewHandler.CreateTaskView)
+			views.GET("/:id", server.TaskViewHandler.GetTaskView)
+			views.PATCH("/:id", server.TaskViewHandler.EditTaskView)
+			views.DELETE("/:id", server.TaskViewHandler.DeleteTaskView)
+			views.GET("/:id/tasks", server.TaskViewHandler.GetTaskViewTasks)
+		}
+
+		// Time entry endpoints
+		timeEntries := v1.Group("/time-entries")
+		timeEntries.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
+		{
+			timeEntries.GET("/running", server.TimeEntryHandler.GetRunningTimer)
+			timeEntries.GET("/report", server.TimeEntryHandler.GetTimeReport)
+			timeEntries.GET("/estimate-report", server.TimeEntryHandler.GetEstimateReport)
+			timeEntries.PATCH("/:id", server.TimeEntryHandler.EditTimeEntry)
+			timeEntries.DELETE("/:id", server.TimeEntryHandler.DeleteTimeEntry)
+		}
+
+		// Stats endpoints
+		stats := v1.Group("/stats")
+		stats.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
+		{
+			stats.GET("", server.StatsHandler.GetTaskStats)
+			stats.GET("/flow", server.StatsHandler.GetTaskFlowReport)
+		}
+
+		// Notification endpoints
+		notifications := v1.Group("/notifications")
+		notifications.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
+		{
+			notifications.GET("", server.NotificationHandler.ListNotifications)
+			notifications.GET("/unread-count", server.NotificationHandler.GetUnreadNotificationCount)
+			notifications.POST("/read-all", server.NotificationHandler.MarkAllNotificationsRead)
+			notifications.POST("/:id/read", server.NotificationHandler.MarkNotificationRead)
+			notifications.DELETE("/:id", server.NotificationHandler.DeleteNotification)
+		}
+
+		// Calendar feed endpoints
+		calendarFeed := v1.Group("/calendar-feed")
+		calendarFeed.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
+		{
+			calendarFeed.GET("", server.CalendarFeedHandler.GetCalendarFeed)
+			calendarFeed.DELETE("", server.CalendarFeedHandler.RevokeCalendarFeed)
+			calendarFeed.POST("/rotate", server.CalendarFeedHandler.RotateCalendarFeedToken)
+		}
+
+		// Import endpoints
+		imports := v1.Group("/import")
+		imports.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
+		{
+			imports.POST("/ical", server.ImportHandler.ImportICal)
+			imports.POST("/todoist", server.ImportHandler.ImportTodoist)
+			imports.POST("/trello", server.ImportHandler.ImportTrello)
+			imports.POST("/markdown", server.ImportHandler.ImportMarkdown)
+		}
+
+		// Account data endpoints
+		me := v1.Group("/me")
+		me.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
+		{
+			me.GET("/export", server.AccountArchiveHandler.ExportAccount)
+			me.POST("/import", server.AccountArchiveHandler.ImportAccount)
+			me.GET("/settings", server.UserSettingHandler.GetUserSettings)
+			me.PATCH("/settings", server.UserSettingHandler.EditUserSettings)
 		}
 
 		// Interpretation endpoints
 		interpretations := v1.Group("/interpretations")
-		interpretations.Use(authMiddleware.RequireAuth())
+		interpretations.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
 		{
 			interpretations.POST("", server.InterpretationHandler.CreateInterpretation)
 			interpretations.GET("", server.InterpretationHandler.ListInterpretations)
@@ -83,7 +238,7 @@ func SetupRoutes(server *Server, authMiddleware *middleware.AuthMiddleware) *gin
 
 		// Interpretation Item endpoints
 		items := v1.Group("/interpretation-items")
-		items.Use(authMiddleware.RequireAuth())
+		items.Use(authMiddleware.RequireAuth(), idempotencyMiddleware.HandleIdempotencyKey())
 		{
 			items.GET("/:id", server.InterpretationItemHandler.GetInterpretationItem)
 			items.PATCH("/:id", server.InterpretationItemHandler.UpdateInterpretationItem)
@@ -0,0 +1,93 @@
+package ical
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType はiCalendarのMIMEタイプ
+const ContentType = "text/calendar; charset=utf-8"
+
+// maxLineOctets は折り返し前の1行の最大オクテット数（RFC 5545 3.1、改行を除く）
+const maxLineOctets = 75
+
+// dateTimeLayout はUTCの日時の書式（RFC 5545 3.3.5 FORM #2）
+const dateTimeLayout = "20060102T150405Z"
+
+// Writer はiCalendar（RFC 5545）形式のテキストを組み立てます
+// 行は必要に応じて75オクテットで折り返し、CRLFで区切ります
+type Writer struct {
+	b strings.Builder
+}
+
+// NewWriter は新しいWriterを生成します
+func NewWriter() *Writer {
+	return &Writer{}
+}
+
+// Begin はコンポーネント（VCALENDAR・VEVENT・VTODO等）を開始します
+func (w *Writer) Begin(component string) {
+	w.line("BEGIN:" + component)
+}
+
+// End はコンポーネントを終了します
+func (w *Writer) End(component string) {
+	w.line("END:" + component)
+}
+
+// Property はエスケープ済みの値でプロパティを出力します（nameにはパラメータを含められます）
+func (w *Writer) Property(name, value string) {
+	w.line(name + ":" + value)
+}
+
+// Text はTEXT型のプロパティを出力します（値はエスケープされます）
+func (w *Writer) Text(name, value string) {
+	w.Property(name, EscapeText(value))
+}
+
+// DateTime はDATE-TIME型のプロパティをUTCで出力します
+func (w *Writer) DateTime(name string, t time.Time) {
+	w.Property(name, FormatDateTime(t))
+}
+
+// String は組み立てたiCalendarテキストを返します
+func (w *Writer) String() string {
+	return w.b.String()
+}
+
+// line は1行を折り返して出力します（マルチバイト文字の途中では折り返しません）
+func (w *Writer) line(content string) {
+	limit := maxLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		w.b.WriteString(content[:cut])
+		w.b.WriteString("\r\n ")
+		content = content[cut:]
+		// 継続行は先頭の空白1オクテット分短くする
+		limit = maxLineOctets - 1
+	}
+	w.b.WriteString(content)
+	w.b.WriteString("\r\n")
+}
+
+// EscapeText はTEXT型の値をエスケープします（RFC 5545 3.3.11）
+func EscapeText(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(value)
+}
+
+// FormatDateTime は日時をUTCのDATE-TIME型の値に変換します
+func FormatDateTime(t time.Time) string {
+	return t.UTC().Format(dateTimeLayout)
+}
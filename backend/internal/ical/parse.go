@@ -0,0 +1,298 @@
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxLineLength は展開後の1行の最大長（異常なデータでメモリを使い切らないようにする）
+	maxLineLength = 1 << 20
+	// maxDepth はコンポーネントの最大の入れ子の深さ
+	maxDepth = 8
+	// dateLayout はDATE型の値の書式
+	dateLayout = "20060102"
+	// localDateTimeLayout はタイムゾーン指定なし（TZIDまたはフローティング）のDATE-TIME型の値の書式
+	localDateTimeLayout = "20060102T150405"
+)
+
+// Component はiCalendarのコンポーネント（VCALENDAR・VEVENT・VTODO・VTIMEZONE等）
+type Component struct {
+	Name       string
+	Properties []*Property
+	Components []*Component
+}
+
+// Property はiCalendarのプロパティ（値はエスケープされたまま保持します）
+type Property struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// Property は指定した名前の最初のプロパティを返します（ない場合はnil）
+func (c *Component) Property(name string) *Property {
+	for _, property := range c.Properties {
+		if property.Name == name {
+			return property
+		}
+	}
+	return nil
+}
+
+// Text は指定した名前の最初のTEXT型プロパティの値をエスケープを戻して返します（ない場合は空文字列）
+func (c *Component) Text(name string) string {
+	if property := c.Property(name); property != nil {
+		return UnescapeText(property.Value)
+	}
+	return ""
+}
+
+// Parse はiCalendarデータを解析して最初のVCALENDARを返します
+func Parse(data []byte) (*Component, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	lines, err := unfold(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var stack []*Component
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		property, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch property.Name {
+		case "BEGIN":
+			if len(stack) >= maxDepth {
+				return nil, fmt.Errorf("line %d: components are nested too deeply", i+1)
+			}
+			component := &Component{Name: strings.ToUpper(property.Value)}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Components = append(parent.Components, component)
+			} else if component.Name != "VCALENDAR" {
+				return nil, fmt.Errorf("line %d: expected BEGIN:VCALENDAR", i+1)
+			}
+			stack = append(stack, component)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(property.Value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", i+1, property.Value)
+			}
+			if len(stack) == 1 {
+				return stack[0], nil
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: property outside of VCALENDAR", i+1)
+			}
+			current := stack[len(stack)-1]
+			current.Properties = append(current.Properties, property)
+		}
+	}
+
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("missing END:%s", stack[len(stack)-1].Name)
+	}
+	return nil, fmt.Errorf("no VCALENDAR found")
+}
+
+// unfold は折り返された行（空白またはタブで始まる行）を元の1行に戻します（RFC 5545 3.1）
+func unfold(data []byte) ([]string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			last := lines[len(lines)-1] + line[1:]
+			if len(last) > maxLineLength {
+				return nil, fmt.Errorf("line is too long")
+			}
+			lines[len(lines)-1] = last
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read iCalendar data: %w", err)
+	}
+	return lines, nil
+}
+
+// parseProperty は1行を名前・パラメータ・値に分解します（パラメータ値は引用符で囲まれていてもよい）
+func parseProperty(line string) (*Property, error) {
+	nameEnd := strings.IndexAny(line, ";:")
+	if nameEnd <= 0 {
+		return nil, fmt.Errorf("invalid content line")
+	}
+
+	property := &Property{
+		Name:   strings.ToUpper(line[:nameEnd]),
+		Params: map[string]string{},
+	}
+
+	rest := line[nameEnd:]
+	for strings.HasPrefix(rest, ";") {
+		rest = rest[1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return nil, fmt.Errorf("invalid parameter in %s", property.Name)
+		}
+		paramName := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+
+		var value strings.Builder
+		for len(rest) > 0 && rest[0] != ';' && rest[0] != ':' {
+			if rest[0] == '"' {
+				end := strings.IndexByte(rest[1:], '"')
+				if end < 0 {
+					return nil, fmt.Errorf("unterminated quoted parameter in %s", property.Name)
+				}
+				value.WriteString(rest[1 : end+1])
+				rest = rest[end+2:]
+				continue
+			}
+			value.WriteByte(rest[0])
+			rest = rest[1:]
+		}
+		property.Params[paramName] = value.String()
+	}
+
+	if !strings.HasPrefix(rest, ":") {
+		return nil, fmt.Errorf("missing value in %s", property.Name)
+	}
+	property.Value = rest[1:]
+	return property, nil
+}
+
+// UnescapeText はTEXT型の値のエスケープを戻します（RFC 5545 3.3.11）
+func UnescapeText(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i == len(value)-1 {
+			b.WriteByte(value[i])
+			continue
+		}
+		i++
+		switch value[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(value[i])
+		}
+	}
+	return b.String()
+}
+
+// ParseDateTime はDATE型またはDATE-TIME型のプロパティを日時に変換します
+// UTC（末尾がZ）以外の値はTZIDパラメータのタイムゾーン、なければdefaultLocで解釈し、
+// DATE型の場合はその日の0時とdateOnly=trueを返します
+func ParseDateTime(property *Property, zones *Zones, defaultLoc *time.Location) (t time.Time, dateOnly bool, err error) {
+	value := strings.TrimSpace(property.Value)
+	// 複数の値が並ぶ場合（EXDATE等）は先頭のみ
+	if i := strings.IndexByte(value, ','); i >= 0 {
+		value = value[:i]
+	}
+
+	loc := defaultLoc
+	if tzid, ok := property.Params["TZID"]; ok && tzid != "" {
+		loc = zones.Location(tzid, defaultLoc)
+	}
+
+	if strings.EqualFold(property.Params["VALUE"], "DATE") || len(value) == len(dateLayout) {
+		t, err := time.ParseInLocation(dateLayout, value, loc)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date in %s: %s", property.Name, value)
+		}
+		return t, true, nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(dateTimeLayout, value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date-time in %s: %s", property.Name, value)
+		}
+		return t, false, nil
+	}
+
+	t, err = time.ParseInLocation(localDateTimeLayout, value, loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date-time in %s: %s", property.Name, value)
+	}
+	return t, false, nil
+}
+
+// ParseDuration はDURATION型の値（例: PT1H30M, P1D, -P1W）を変換します（RFC 5545 3.3.6）
+func ParseDuration(value string) (time.Duration, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign = -1
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("invalid duration: %s", value)
+	}
+	s = s[1:]
+
+	var total time.Duration
+	inTime := false
+	number := ""
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			number += string(r)
+		case r == 'T':
+			if inTime || number != "" {
+				return 0, fmt.Errorf("invalid duration: %s", value)
+			}
+			inTime = true
+		default:
+			n, err := strconv.Atoi(number)
+			if err != nil {
+				return 0, fmt.Errorf("invalid duration: %s", value)
+			}
+			number = ""
+
+			var unit time.Duration
+			switch {
+			case r == 'W' && !inTime:
+				unit = 7 * 24 * time.Hour
+			case r == 'D' && !inTime:
+				unit = 24 * time.Hour
+			case r == 'H' && inTime:
+				unit = time.Hour
+			case r == 'M' && inTime:
+				unit = time.Minute
+			case r == 'S' && inTime:
+				unit = time.Second
+			default:
+				return 0, fmt.Errorf("invalid duration: %s", value)
+			}
+			total += time.Duration(n) * unit
+		}
+	}
+	if number != "" {
+		return 0, fmt.Errorf("invalid duration: %s", value)
+	}
+	return sign * total, nil
+}
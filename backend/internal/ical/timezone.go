@@ -0,0 +1,152 @@
+package ical
+
+import (
+	"strings"
+	"time"
+)
+
+// windowsZones はOutlook等が出力するWindowsのタイムゾーン名とIANAタイムゾーン名の対応（よく使われるもののみ）
+var windowsZones = map[string]string{
+	"UTC":                            "UTC",
+	"GMT Standard Time":              "Europe/London",
+	"W. Europe Standard Time":        "Europe/Berlin",
+	"Romance Standard Time":          "Europe/Paris",
+	"Central Europe Standard Time":   "Europe/Budapest",
+	"E. Europe Standard Time":        "Europe/Chisinau",
+	"Russian Standard Time":          "Europe/Moscow",
+	"India Standard Time":            "Asia/Kolkata",
+	"SE Asia Standard Time":          "Asia/Bangkok",
+	"China Standard Time":            "Asia/Shanghai",
+	"Singapore Standard Time":        "Asia/Singapore",
+	"Taipei Standard Time":           "Asia/Taipei",
+	"Korea Standard Time":            "Asia/Seoul",
+	"Tokyo Standard Time":            "Asia/Tokyo",
+	"AUS Eastern Standard Time":      "Australia/Sydney",
+	"New Zealand Standard Time":      "Pacific/Auckland",
+	"Hawaiian Standard Time":         "Pacific/Honolulu",
+	"Alaskan Standard Time":          "America/Anchorage",
+	"Pacific Standard Time":          "America/Los_Angeles",
+	"Mountain Standard Time":         "America/Denver",
+	"Central Standard Time":          "America/Chicago",
+	"Eastern Standard Time":          "America/New_York",
+	"Atlantic Standard Time":         "America/Halifax",
+	"E. South America Standard Time": "America/Sao_Paulo",
+}
+
+// Zones はカレンダー内のTZIDをタイムゾーンに解決します
+type Zones struct {
+	calendar *Component
+	cache    map[string]*time.Location
+}
+
+// NewZones はカレンダーのVTIMEZONEを参照するZonesを生成します
+func NewZones(calendar *Component) *Zones {
+	return &Zones{
+		calendar: calendar,
+		cache:    map[string]*time.Location{},
+	}
+}
+
+// Location はTZIDをタイムゾーンに解決します
+// IANAタイムゾーン名、Windowsのタイムゾーン名、VTIMEZONEの標準時のオフセットの順に試し、
+// いずれも解決できない場合はfallbackを返します
+func (z *Zones) Location(tzid string, fallback *time.Location) *time.Location {
+	if loc, ok := z.cache[tzid]; ok {
+		return loc
+	}
+
+	loc := z.resolve(tzid)
+	if loc == nil {
+		loc = fallback
+	}
+	z.cache[tzid] = loc
+	return loc
+}
+
+func (z *Zones) resolve(tzid string) *time.Location {
+	if loc := loadIANALocation(tzid); loc != nil {
+		return loc
+	}
+	if name, ok := windowsZones[tzid]; ok {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+	return z.fixedZone(tzid)
+}
+
+// loadIANALocation はIANAタイムゾーン名を読み込みます
+// "/mozilla.org/20050126_1/Europe/Berlin"のような接頭辞付きの名前は末尾の部分から解決します
+func loadIANALocation(tzid string) *time.Location {
+	name := strings.Trim(tzid, "/ ")
+	for name != "" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+		i := strings.IndexByte(name, '/')
+		if i < 0 {
+			break
+		}
+		name = name[i+1:]
+	}
+	return nil
+}
+
+// fixedZone はVTIMEZONEの標準時（STANDARD、なければ最初の定義）のオフセットで固定オフセットのタイムゾーンを生成します
+// 夏時間の切り替えは反映されないため、IANA名で解決できない場合の近似として使います
+func (z *Zones) fixedZone(tzid string) *time.Location {
+	if z.calendar == nil {
+		return nil
+	}
+	for _, component := range z.calendar.Components {
+		if component.Name != "VTIMEZONE" || component.Text("TZID") != tzid {
+			continue
+		}
+
+		var observance *Component
+		for _, child := range component.Components {
+			if child.Name == "STANDARD" || observance == nil {
+				observance = child
+			}
+			if child.Name == "STANDARD" {
+				break
+			}
+		}
+		if observance == nil {
+			return nil
+		}
+		if offset, ok := parseUTCOffset(observance.Text("TZOFFSETTO")); ok {
+			return time.FixedZone(tzid, offset)
+		}
+	}
+	return nil
+}
+
+// parseUTCOffset はUTC-OFFSET型の値（例: +0900, -0430, +053000）を秒に変換します
+func parseUTCOffset(value string) (int, bool) {
+	if len(value) != 5 && len(value) != 7 {
+		return 0, false
+	}
+	sign := 1
+	switch value[0] {
+	case '+':
+	case '-':
+		sign = -1
+	default:
+		return 0, false
+	}
+
+	digits := value[1:]
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return 0, false
+		}
+	}
+	hours := int(digits[0]-'0')*10 + int(digits[1]-'0')
+	minutes := int(digits[2]-'0')*10 + int(digits[3]-'0')
+	seconds := 0
+	if len(digits) == 6 {
+		seconds = int(digits[4]-'0')*10 + int(digits[5]-'0')
+	}
+	return sign * (hours*3600 + minutes*60 + seconds), true
+}
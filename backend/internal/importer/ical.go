@@ -0,0 +1,212 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/ical"
+	"github.com/yoshioka0101/ai_plan_chat/internal/recurrence"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// ParseICal はiCalendarデータのVTODO・VEVENTをタスクの候補に変換します
+// タイムゾーン指定のない日時はdefaultLoc（nilの場合はカレンダーのX-WR-TIMEZONE、それもなければUTC）で解釈します
+func ParseICal(data []byte, defaultLoc *time.Location, now time.Time) ([]entity.ImportTask, error) {
+	calendar, err := ical.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid iCalendar data: %w", err)
+	}
+
+	zones := ical.NewZones(calendar)
+	if defaultLoc == nil {
+		defaultLoc = time.UTC
+		if tzid := calendar.Text("X-WR-TIMEZONE"); tzid != "" {
+			defaultLoc = zones.Location(tzid, time.UTC)
+		}
+	}
+
+	var tasks []entity.ImportTask
+	for _, component := range calendar.Components {
+		switch component.Name {
+		case "VTODO", "VEVENT":
+			tasks = append(tasks, convertICalComponent(component, zones, defaultLoc, now))
+		}
+	}
+	return tasks, nil
+}
+
+// convertICalComponent はVTODO・VEVENTを1件のタスクの候補に変換します
+// VTODOは期限（DUE）、VEVENTは開始日時を期限とし、VEVENTの長さは見積もり工数にします
+func convertICalComponent(component *ical.Component, zones *ical.Zones, loc *time.Location, now time.Time) entity.ImportTask {
+	task := entity.ImportTask{Status: "todo"}
+
+	summary := component.Text("SUMMARY")
+	uid := component.Text("UID")
+	startProperty := component.Property("DTSTART")
+	if uid != "" {
+		task.ExternalID = externalID(uid)
+	} else {
+		// UIDがない場合は内容から識別子を生成（同じファイルの再取り込みで重複しないようにする）
+		start := ""
+		if startProperty != nil {
+			start = startProperty.Value
+		}
+		task.ExternalID = hashExternalID(component.Name, summary, start, component.Text("DUE"))
+	}
+
+	task.Title = normalizeTitle(&task, summary)
+	if task.Title == "" {
+		task.SkipReason = "summary is empty"
+		return task
+	}
+
+	// 繰り返し予定の特定の回だけを変更した定義は、元の予定と同じUIDを持つため取り込まない
+	if component.Property("RECURRENCE-ID") != nil {
+		task.SkipReason = "modified instance of a recurring entry"
+		return task
+	}
+
+	if strings.EqualFold(component.Text("STATUS"), "CANCELLED") {
+		task.SkipReason = "cancelled"
+		return task
+	}
+
+	description := component.Text("DESCRIPTION")
+	if location := component.Text("LOCATION"); location != "" {
+		if description != "" {
+			description += "\n\n"
+		}
+		description += "場所: " + location
+	}
+	if description != "" {
+		task.Description = &description
+	}
+
+	var start, end *time.Time
+	var allDay bool
+	if startProperty != nil {
+		t, dateOnly, err := ical.ParseDateTime(startProperty, zones, loc)
+		if err != nil {
+			task.SkipReason = err.Error()
+			return task
+		}
+		start, allDay = &t, dateOnly
+	}
+
+	endName := "DTEND"
+	if component.Name == "VTODO" {
+		endName = "DUE"
+	}
+	if endProperty := component.Property(endName); endProperty != nil {
+		t, _, err := ical.ParseDateTime(endProperty, zones, loc)
+		if err != nil {
+			task.SkipReason = err.Error()
+			return task
+		}
+		end = &t
+	} else if start != nil {
+		if durationProperty := component.Property("DURATION"); durationProperty != nil {
+			duration, err := ical.ParseDuration(durationProperty.Value)
+			if err != nil {
+				task.Warnings = append(task.Warnings, err.Error())
+			} else {
+				t := start.Add(duration)
+				end = &t
+			}
+		}
+	}
+
+	if component.Name == "VTODO" {
+		switch {
+		case end != nil:
+			task.DueAt = end
+		case start != nil:
+			task.DueAt = start
+		}
+		if start != nil && end != nil && !allDay {
+			setEstimate(&task, end.Sub(*start))
+		}
+		task.Status = icalTodoStatus(component)
+	} else {
+		task.DueAt = start
+		if start != nil && end != nil && !allDay {
+			setEstimate(&task, end.Sub(*start))
+		}
+	}
+
+	setRecurrence(&task, component, now)
+
+	// 終了した（繰り返さない）予定は完了済みのタスクとして取り込む
+	if component.Name == "VEVENT" && task.RecurrenceRule == nil {
+		finishedAt := task.DueAt
+		if end != nil {
+			finishedAt = end
+		}
+		if finishedAt != nil && finishedAt.Before(now) {
+			task.Status = "done"
+		}
+	}
+
+	return task
+}
+
+// icalTodoStatus はVTODOの状態をタスクのステータスに変換します
+func icalTodoStatus(component *ical.Component) string {
+	switch strings.ToUpper(component.Text("STATUS")) {
+	case "COMPLETED":
+		return "done"
+	case "IN-PROCESS":
+		return "in_progress"
+	}
+	if component.Property("COMPLETED") != nil || component.Text("PERCENT-COMPLETE") == "100" {
+		return "done"
+	}
+	return "todo"
+}
+
+// setEstimate は予定の長さを見積もり工数（分）に設定します（範囲外の場合は設定しません）
+func setEstimate(task *entity.ImportTask, duration time.Duration) {
+	minutes := int64(duration / time.Minute)
+	if minutes <= 0 || minutes > validation.MaxTaskEstimateMinutes {
+		return
+	}
+	estimate := int32(minutes)
+	task.EstimateMinutes = &estimate
+}
+
+// setRecurrence はRRULEを繰り返しルールに設定します
+// 起点は元の期限とし、期限が過ぎている場合は次の回を期限にします
+// タスクで扱えないルール（HOURLY等）や、RDATE・EXDATEは取り込みません
+func setRecurrence(task *entity.ImportTask, component *ical.Component, now time.Time) {
+	ruleProperty := component.Property("RRULE")
+	if ruleProperty == nil {
+		return
+	}
+	if task.DueAt == nil {
+		task.Warnings = append(task.Warnings, "recurrence rule without a start date was ignored")
+		return
+	}
+
+	rule := recurrence.Normalize(ruleProperty.Value)
+	if err := recurrence.Validate(rule); err != nil {
+		task.Warnings = append(task.Warnings, fmt.Sprintf("recurrence rule was ignored: %s", err.Error()))
+		return
+	}
+	if component.Property("RDATE") != nil || component.Property("EXDATE") != nil {
+		task.Warnings = append(task.Warnings, "RDATE and EXDATE were ignored")
+	}
+
+	anchor := *task.DueAt
+	task.RecurrenceRule = &rule
+	task.RecurrenceAnchorAt = &anchor
+
+	if anchor.Before(now) && task.Status != "done" {
+		// 曜日・日付はカレンダーに指定されたタイムゾーンで判定する
+		next, ok, err := recurrence.Next(rule, anchor, now, anchor.Location())
+		if err == nil && ok {
+			task.DueAt = &next
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+const (
+	// maxExternalIDLength は取り込み元の識別子の最大長（task_imports.external_idのカラム長）
+	maxExternalIDLength = 255
+	// maxTitleLength はタスクタイトルの最大長（tasks.titleのカラム長）
+	maxTitleLength = 500
+)
+
+// externalID は取り込み元の識別子を保存できる長さに収めます（長すぎる場合はSHA-256で代用）
+func externalID(raw string) string {
+	if raw != "" && len(raw) <= maxExternalIDLength {
+		return raw
+	}
+	return hashExternalID(raw)
+}
+
+// hashExternalID は識別子を持たないデータの内容から重複判定用の識別子を生成します
+func hashExternalID(parts ...string) string {
+	hash := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return "sha256:" + hex.EncodeToString(hash[:])
+}
+
+// normalizeTitle はタイトルの前後の空白と改行を取り除き、長すぎる場合は切り詰めます
+func normalizeTitle(task *entity.ImportTask, title string) string {
+	title = strings.Join(strings.Fields(title), " ")
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		title = string([]rune(title)[:maxTitleLength])
+		task.Warnings = append(task.Warnings, "title was truncated")
+	}
+	return title
+}
+
+// dateLayouts は取り込み元の日付・日時として解釈する形式（タイムゾーン指定のあるRFC 3339は別に扱います）
+var dateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02T15:04:05",
+}
+
+// parseDate は日付・日時の文字列を解釈します
+// タイムゾーン指定のない値はlocで解釈し、日付のみの場合はその日の0時とします
+func parseDate(value string, loc *time.Location) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, true
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// addLabel は重複しないようにラベルを追加します
+func addLabel(task *entity.ImportTask, label string) {
+	label = strings.TrimSpace(label)
+	if label == "" {
+		return
+	}
+	for _, existing := range task.Labels {
+		if strings.EqualFold(existing, label) {
+			return
+		}
+	}
+	task.Labels = append(task.Labels, label)
+}
+
+// rawJSON は取り込み元のエントリをレビュー用の原本としてJSONにします
+func rawJSON(v any) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return data
+}
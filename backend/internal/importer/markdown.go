@@ -0,0 +1,117 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+var (
+	// markdownChecklistPattern はチェックリストの行（- [ ] / - [x]、*・+の箇条書きや番号付きリストも可）
+	markdownChecklistPattern = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+\[([ xX])\]\s?(.*)$`)
+	// markdownDuePattern は期限の記法（due:2024-05-01、@due(2024-05-01 18:00)、📅 2024-05-01）
+	markdownDuePattern = regexp.MustCompile(`(?:\bdue:\s*|@due\(\s*|📅\s*)(\d{4}-\d{2}-\d{2}(?:[ T]\d{2}:\d{2})?)\)?`)
+	// markdownTagPattern はタグの記法（#label）
+	markdownTagPattern = regexp.MustCompile(`(^|\s)#([\p{L}\p{N}_\-/]+)`)
+	// markdownPriorityPattern は優先度の記法（!high・!medium・!low、Obsidian Tasksの⏫🔼🔽）
+	markdownPriorityPattern = regexp.MustCompile(`(^|\s)(!high|!medium|!low|⏫|🔼|🔽)`)
+)
+
+// ParseMarkdown はMarkdownのチェックリスト（- [ ] / - [x]）の各行をタスクの候補に変換します
+// 行内のdue:・@due()・📅 を期限、#label をラベルとして取り出し、タイトルからは取り除きます
+// 入れ子のチェックリストもそれぞれ1件のタスクとして扱います
+func ParseMarkdown(data []byte, defaultLoc *time.Location) ([]entity.ImportTask, error) {
+	if defaultLoc == nil {
+		defaultLoc = time.UTC
+	}
+
+	var tasks []entity.ImportTask
+	scanner := bufio.NewScanner(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+
+	lineNumber := 0
+	inCodeBlock := false
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+
+		// コードブロック内の例示は取り込まない
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+		if inCodeBlock {
+			continue
+		}
+
+		match := markdownChecklistPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		tasks = append(tasks, convertMarkdownLine(lineNumber, line, match[1] != " ", match[2], defaultLoc))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("invalid markdown: %w", err)
+	}
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no checklist items found")
+	}
+	return tasks, nil
+}
+
+// convertMarkdownLine はチェックリストの1行をタスクの候補に変換します
+func convertMarkdownLine(lineNumber int, line string, checked bool, text string, loc *time.Location) entity.ImportTask {
+	task := entity.ImportTask{
+		Status: "todo",
+		Raw:    rawJSON(map[string]any{"line": lineNumber, "text": line}),
+	}
+	if checked {
+		task.Status = "done"
+	}
+
+	if match := markdownDuePattern.FindStringSubmatch(text); match != nil {
+		if dueAt, ok := parseDate(match[1], loc); ok {
+			task.DueAt = &dueAt
+		} else {
+			task.Warnings = append(task.Warnings, fmt.Sprintf("due date was ignored: %s", match[1]))
+		}
+		text = strings.Replace(text, match[0], "", 1)
+	}
+
+	if match := markdownPriorityPattern.FindStringSubmatch(text); match != nil {
+		priority := markdownPriority(match[2])
+		task.Priority = &priority
+		text = strings.Replace(text, match[0], match[1], 1)
+	}
+
+	for _, match := range markdownTagPattern.FindAllStringSubmatch(text, -1) {
+		addLabel(&task, match[2])
+	}
+	text = markdownTagPattern.ReplaceAllString(text, "$1")
+
+	task.Title = normalizeTitle(&task, text)
+	// 同じタイトルの項目が別の見出しの下にあることもあるため、行番号を含めて識別子を生成する
+	task.ExternalID = hashExternalID(string(entity.ImportSourceMarkdown), strconv.Itoa(lineNumber), task.Title)
+	if task.Title == "" {
+		task.SkipReason = "checklist item is empty"
+	}
+	return task
+}
+
+// markdownPriority は優先度の記法をhigh・medium・lowに変換します
+func markdownPriority(token string) string {
+	switch token {
+	case "!high", "⏫":
+		return "high"
+	case "!low", "🔽":
+		return "low"
+	default:
+		return "medium"
+	}
+}
@@ -0,0 +1,167 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+// todoistLabelPattern はTodoistのタスク名に含まれるラベルの記法（@label）
+var todoistLabelPattern = regexp.MustCompile(`(^|\s)@([\p{L}\p{N}_\-]+)`)
+
+// ParseTodoistCSV はTodoistのCSVエクスポート（TYPE・CONTENT・DATE等の列）をタスクの候補に変換します
+// TYPEがtaskの行をタスクとし、直後のnoteの行は説明に追記します（sectionの行は取り込みません）
+// 日付はTIMEZONE列（なければdefaultLoc、それもなければUTC）で解釈し、自然言語の日付や繰り返し（every ...）は警告とともに無視します
+func ParseTodoistCSV(data []byte, defaultLoc *time.Location) ([]entity.ImportTask, error) {
+	if defaultLoc == nil {
+		defaultLoc = time.UTC
+	}
+
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid Todoist CSV: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToUpper(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["TYPE"]; !ok {
+		return nil, fmt.Errorf("invalid Todoist CSV: TYPE column is missing")
+	}
+	if _, ok := columns["CONTENT"]; !ok {
+		return nil, fmt.Errorf("invalid Todoist CSV: CONTENT column is missing")
+	}
+
+	var tasks []entity.ImportTask
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid Todoist CSV: %w", err)
+		}
+
+		row := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		switch strings.ToLower(row("TYPE")) {
+		case "task":
+			raw := make(map[string]string, len(header))
+			for name, i := range columns {
+				if i < len(record) && record[i] != "" {
+					raw[strings.ToLower(name)] = record[i]
+				}
+			}
+			tasks = append(tasks, convertTodoistTask(row, raw, len(tasks), defaultLoc))
+		case "note":
+			// コメントは直前のタスクの説明に追記する
+			if len(tasks) > 0 && row("CONTENT") != "" {
+				appendDescription(&tasks[len(tasks)-1], row("CONTENT"))
+			}
+		}
+	}
+
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no tasks found in Todoist CSV")
+	}
+	return tasks, nil
+}
+
+// convertTodoistTask はTodoistのtaskの行をタスクの候補に変換します
+func convertTodoistTask(row func(string) string, raw map[string]string, index int, defaultLoc *time.Location) entity.ImportTask {
+	task := entity.ImportTask{
+		Status: "todo",
+		Raw:    rawJSON(raw),
+	}
+
+	content := row("CONTENT")
+	for _, match := range todoistLabelPattern.FindAllStringSubmatch(content, -1) {
+		addLabel(&task, match[2])
+	}
+	content = todoistLabelPattern.ReplaceAllString(content, "$1")
+
+	task.Title = normalizeTitle(&task, content)
+	// CSVには識別子がないため、内容と行の位置から生成する
+	task.ExternalID = hashExternalID(string(entity.ImportSourceTodoist), strconv.Itoa(index), task.Title, row("DATE"))
+	if task.Title == "" {
+		task.SkipReason = "content is empty"
+		return task
+	}
+
+	if description := row("DESCRIPTION"); description != "" {
+		task.Description = &description
+	}
+
+	// Todoistの画面表示と同じく1が最も高い優先度（p1）
+	switch row("PRIORITY") {
+	case "1":
+		priority := "high"
+		task.Priority = &priority
+	case "2":
+		priority := "medium"
+		task.Priority = &priority
+	case "3":
+		priority := "low"
+		task.Priority = &priority
+	}
+
+	loc := defaultLoc
+	if name := row("TIMEZONE"); name != "" {
+		if tz, err := time.LoadLocation(name); err == nil {
+			loc = tz
+		}
+	}
+
+	date := row("DATE")
+	if date == "" {
+		date = row("DEADLINE")
+	}
+	if date != "" {
+		if dueAt, ok := parseDate(date, loc); ok {
+			task.DueAt = &dueAt
+		} else if strings.HasPrefix(strings.ToLower(date), "every") || strings.HasPrefix(date, "毎") {
+			task.Warnings = append(task.Warnings, fmt.Sprintf("recurring date was ignored: %s", date))
+		} else {
+			task.Warnings = append(task.Warnings, fmt.Sprintf("due date was ignored: %s", date))
+		}
+	}
+
+	if duration, err := strconv.Atoi(row("DURATION")); err == nil && duration > 0 {
+		switch strings.ToLower(row("DURATION_UNIT")) {
+		case "day":
+			setEstimate(&task, time.Duration(duration)*24*time.Hour)
+		default:
+			setEstimate(&task, time.Duration(duration)*time.Minute)
+		}
+	}
+
+	return task
+}
+
+// appendDescription はタスクの説明の末尾に段落を追加します
+func appendDescription(task *entity.ImportTask, text string) {
+	if task.Description == nil || *task.Description == "" {
+		task.Description = &text
+		return
+	}
+	description := *task.Description + "\n\n" + text
+	task.Description = &description
+}
@@ -0,0 +1,162 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+// trelloBoard はTrelloのボードのJSONエクスポートのうち取り込みに使う部分
+type trelloBoard struct {
+	Lists      []trelloList      `json:"lists"`
+	Cards      []json.RawMessage `json:"cards"`
+	Checklists []trelloChecklist `json:"checklists"`
+}
+
+type trelloList struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Closed bool   `json:"closed"`
+}
+
+type trelloCard struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Desc        string        `json:"desc"`
+	Closed      bool          `json:"closed"`
+	Due         *time.Time    `json:"due"`
+	DueComplete bool          `json:"dueComplete"`
+	IDList      string        `json:"idList"`
+	Labels      []trelloLabel `json:"labels"`
+}
+
+type trelloLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type trelloChecklist struct {
+	IDCard     string `json:"idCard"`
+	Name       string `json:"name"`
+	CheckItems []struct {
+		Name  string `json:"name"`
+		State string `json:"state"`
+	} `json:"checkItems"`
+}
+
+// ParseTrelloJSON はTrelloのボードのJSONエクスポートのカードをタスクの候補に変換します
+// カードのリスト名からステータスを判定し（Done・完了等はdone、Doing・作業中等はin_progress）、
+// 期限完了の印が付いたカードはdoneとします。チェックリストは説明にMarkdownのチェックリストとして追記します
+func ParseTrelloJSON(data []byte) ([]entity.ImportTask, error) {
+	var board trelloBoard
+	if err := json.Unmarshal(data, &board); err != nil {
+		return nil, fmt.Errorf("invalid Trello JSON: %w", err)
+	}
+	if board.Cards == nil {
+		return nil, fmt.Errorf("invalid Trello JSON: cards are missing")
+	}
+
+	lists := make(map[string]trelloList, len(board.Lists))
+	for _, list := range board.Lists {
+		lists[list.ID] = list
+	}
+	checklists := make(map[string][]trelloChecklist)
+	for _, checklist := range board.Checklists {
+		checklists[checklist.IDCard] = append(checklists[checklist.IDCard], checklist)
+	}
+
+	tasks := make([]entity.ImportTask, 0, len(board.Cards))
+	for _, raw := range board.Cards {
+		var card trelloCard
+		if err := json.Unmarshal(raw, &card); err != nil {
+			return nil, fmt.Errorf("invalid Trello card: %w", err)
+		}
+		tasks = append(tasks, convertTrelloCard(card, raw, lists[card.IDList], checklists[card.ID]))
+	}
+
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no cards found in Trello JSON")
+	}
+	return tasks, nil
+}
+
+// convertTrelloCard はTrelloのカードをタスクの候補に変換します
+func convertTrelloCard(card trelloCard, raw json.RawMessage, list trelloList, checklists []trelloChecklist) entity.ImportTask {
+	task := entity.ImportTask{
+		ExternalID: externalID(card.ID),
+		Status:     trelloListStatus(list.Name),
+		Raw:        raw,
+	}
+	if card.ID == "" {
+		task.ExternalID = hashExternalID(string(entity.ImportSourceTrello), card.IDList, card.Name)
+	}
+
+	task.Title = normalizeTitle(&task, card.Name)
+	switch {
+	case task.Title == "":
+		task.SkipReason = "card name is empty"
+		return task
+	case card.Closed:
+		task.SkipReason = "archived card"
+		return task
+	case list.Closed:
+		task.SkipReason = "archived list"
+		return task
+	}
+
+	if card.Desc != "" {
+		description := card.Desc
+		task.Description = &description
+	}
+	for _, checklist := range checklists {
+		var b strings.Builder
+		if checklist.Name != "" {
+			b.WriteString(checklist.Name + ":\n")
+		}
+		for _, item := range checklist.CheckItems {
+			mark := " "
+			if item.State == "complete" {
+				mark = "x"
+			}
+			fmt.Fprintf(&b, "- [%s] %s\n", mark, item.Name)
+		}
+		appendDescription(&task, strings.TrimRight(b.String(), "\n"))
+	}
+
+	for _, label := range card.Labels {
+		if label.Name != "" {
+			addLabel(&task, label.Name)
+		} else {
+			addLabel(&task, label.Color)
+		}
+	}
+
+	if card.Due != nil {
+		dueAt := *card.Due
+		task.DueAt = &dueAt
+	}
+	if card.DueComplete {
+		task.Status = "done"
+	}
+
+	return task
+}
+
+// trelloListStatus はリスト名からタスクのステータスを判定します
+func trelloListStatus(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, keyword := range []string{"done", "completed", "finished", "完了", "済み"} {
+		if strings.Contains(name, keyword) {
+			return "done"
+		}
+	}
+	for _, keyword := range []string{"doing", "in progress", "wip", "作業中", "進行中", "対応中"} {
+		if strings.Contains(name, keyword) {
+			return "in_progress"
+		}
+	}
+	return "todo"
+}
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/yoshioka0101/ai_plan_chat/gen/models"
@@ -12,21 +13,348 @@ import (
 type TaskRepository interface {
 	GetTaskByID(ctx context.Context, id string) (*models.Task, error)
 	GetAllTasks(ctx context.Context) (models.TaskSlice, error)
-	GetTasksByUserID(ctx context.Context, userID string) (models.TaskSlice, error)
+	GetTasksByUserID(ctx context.Context, userID string, includeSnoozed bool, includeArchived bool) (models.TaskSlice, error)
+	GetTasksByProjectID(ctx context.Context, userID string, projectID string, includeSnoozed bool, includeArchived bool) (models.TaskSlice, error)
+	GetTasksByQuery(ctx context.Context, userID string, query entity.TaskQuery) (models.TaskSlice, error)
+	GetColumnTasksForUpdate(ctx context.Context, userID string, projectID *string, status string) (models.TaskSlice, error)
+	UpdateRankKey(ctx context.Context, id string, rankKey string) error
+	CountTasksByStatus(ctx context.Context, userID string, status string, includeDeleted bool) (int64, error)
+	UpdateTasksStatusCategory(ctx context.Context, userID string, status string, category string, now time.Time) (int64, error)
+	GetRecurringTasksByUserID(ctx context.Context, userID string) (models.TaskSlice, error)
+	ExistsRecurrenceOccurrence(ctx context.Context, seriesID string, dueAt time.Time) (bool, error)
 	CreateTask(ctx context.Context, task *models.Task) error
 	UpdateTask(ctx context.Context, task *models.Task) error
-	EditTask(ctx context.Context, id string, updates map[string]interface{}) (*models.Task, error)
-	DeleteTask(ctx context.Context, id string) error
+	EditTask(ctx context.Context, id string, version int32, updates map[string]interface{}) (*models.Task, error)
+	DeleteTask(ctx context.Context, id string, version int32) error
+	GetDeletedTaskByID(ctx context.Context, id string) (*models.Task, error)
+	GetDeletedTasksByUserID(ctx context.Context, userID string) (models.TaskSlice, error)
+	RestoreTask(ctx context.Context, id string) error
+	PurgeDeletedTasks(ctx context.Context, deletedBefore time.Time, limit int) ([]string, error)
+	GetTasksDueBetween(ctx context.Context, now, from, to time.Time, after *models.Task, limit int) (models.TaskSlice, error)
+	GetTasksWithDueAtByUserID(ctx context.Context, userID string, dueFrom time.Time) (models.TaskSlice, error)
+	GetSnoozeEndedTasks(ctx context.Context, now time.Time, limit int) (models.TaskSlice, error)
+	ClearSnooze(ctx context.Context, id string, snoozedUntil time.Time) (bool, error)
+	GetArchivedTasksByUserID(ctx context.Context, userID string) (models.TaskSlice, error)
+	ArchiveDoneTasks(ctx context.Context, userID string, before time.Time, now time.Time, limit int) (int64, error)
+}
+
+// TaskDependencyRepository はタスク依存関係のデータアクセスを提供します
+type TaskDependencyRepository interface {
+	GetDependenciesByUserID(ctx context.Context, userID string) (models.TaskDependencySlice, error)
+	GetDependenciesByUserIDForUpdate(ctx context.Context, userID string) (models.TaskDependencySlice, error)
+	GetBlockingTasks(ctx context.Context, taskID string) (models.TaskSlice, error)
+	GetDependentTasks(ctx context.Context, taskID string) (models.TaskSlice, error)
+	GetBlockedTaskIDs(ctx context.Context, taskIDs []string) (map[string]bool, error)
+	CreateDependency(ctx context.Context, dependency *models.TaskDependency) error
+	DeleteDependency(ctx context.Context, taskID, dependsOnTaskID string) error
+}
+
+// TaskEventRepository はタスク変更履歴のデータアクセスを提供します
+type TaskEventRepository interface {
+	GetEventsByTaskID(ctx context.Context, userID string, taskID string) (models.TaskEventSlice, error)
+	CreateEvent(ctx context.Context, event *models.TaskEvent) error
+}
+
+// TaskStatsRepository はタスクの生産性統計の集計クエリを提供します
+type TaskStatsRepository interface {
+	CountCreatedTasksBySlot(ctx context.Context, userID string, from, to time.Time) ([]*entity.TaskStatsSlot, error)
+	CountStartedTasksBySlot(ctx context.Context, userID string, from, to time.Time) ([]*entity.TaskStatsSlot, error)
+	CountCompletedTasksBySlot(ctx context.Context, userID string, from, to time.Time) ([]*entity.TaskStatsSlot, error)
+	CountTasksBefore(ctx context.Context, userID string, before time.Time) (*entity.TaskFlowTotals, error)
+	CountOverdueTasks(ctx context.Context, userID string, now time.Time) (int64, error)
+}
+
+// TimeEntryRepository はタスクの作業時間記録のデータアクセスを提供します
+type TimeEntryRepository interface {
+	GetEntryByID(ctx context.Context, id string) (*models.TimeEntry, error)
+	GetEntriesByTaskIDs(ctx context.Context, taskIDs []string) (models.TimeEntrySlice, error)
+	GetEntriesInRange(ctx context.Context, userID string, from, to time.Time) (models.TimeEntrySlice, error)
+	GetRunningEntry(ctx context.Context, userID string) (*models.TimeEntry, error)
+	GetRunningEntryForUpdate(ctx context.Context, userID string) (*models.TimeEntry, error)
+	CreateEntry(ctx context.Context, entry *models.TimeEntry) error
+	UpdateEntry(ctx context.Context, entry *models.TimeEntry) error
+	StopRunningEntriesByTaskID(ctx context.Context, taskID string, endedAt time.Time) (int64, error)
+	DeleteEntry(ctx context.Context, id string) error
+}
+
+// ProjectRepository はプロジェクトのデータアクセスを提供します
+type ProjectRepository interface {
+	GetProjectByID(ctx context.Context, id string) (*models.Project, error)
+	GetProjectsByUserID(ctx context.Context, userID string, includeArchived bool) (models.ProjectSlice, error)
+	CreateProject(ctx context.Context, project *models.Project) error
+	UpdateProject(ctx context.Context, project *models.Project) error
+	DeleteProject(ctx context.Context, id string) error
+}
+
+// ProjectUsecase はプロジェクトのビジネスロジックを提供します
+type ProjectUsecase interface {
+	GetProject(ctx context.Context, id string) (*models.Project, error)
+	GetProjectList(ctx context.Context, includeArchived bool) (models.ProjectSlice, error)
+	CreateProject(ctx context.Context, name string, color *string, sortOrder *int) (*models.Project, error)
+	EditProject(ctx context.Context, id string, name *string, color *string, archived *bool, sortOrder *int) (*models.Project, error)
+	DeleteProject(ctx context.Context, id string) error
 }
 
 // TaskUsecase はタスクのビジネスロジックを提供します
 type TaskUsecase interface {
 	GetTask(ctx context.Context, id string) (*models.Task, error)
-	GetTaskList(ctx context.Context) (models.TaskSlice, error)
-	CreateTask(ctx context.Context, title string, description *string, dueAt *time.Time, status string) (*models.Task, error)
-	UpdateTask(ctx context.Context, id string, title string, description *string, dueAt *time.Time, status string) (*models.Task, error)
-	EditTask(ctx context.Context, id string, title *string, description *string, dueAt *time.Time, status *string) (*models.Task, error)
-	DeleteTask(ctx context.Context, id string) error
+	GetTaskList(ctx context.Context, projectID *string, includeSnoozed bool) (models.TaskSlice, error)
+	CreateTask(ctx context.Context, title string, description *string, dueAt *time.Time, status string, recurrenceRule *string, recurrenceAnchorAt *time.Time, projectID *string, estimateMinutes *int32) (*models.Task, error)
+	UpdateTask(ctx context.Context, id string, title string, description *string, dueAt *time.Time, status string, recurrenceRule *string, recurrenceAnchorAt *time.Time, projectID *string, estimateMinutes *int32, force bool, expectedVersion *int32) (*models.Task, error)
+	EditTask(ctx context.Context, id string, title *string, description *string, dueAt *time.Time, status *string, recurrenceRule *string, recurrenceAnchorAt *time.Time, projectID *string, estimateMinutes *int32, force bool, expectedVersion *int32) (*models.Task, error)
+	DeleteTask(ctx context.Context, id string, expectedVersion *int32) error
+	GetTrash(ctx context.Context) (models.TaskSlice, error)
+	RestoreTask(ctx context.Context, id string) (*models.Task, error)
+	PurgeDeletedTasks(ctx context.Context, deletedBefore time.Time) (int64, error)
+	MoveTask(ctx context.Context, id string, status string, position int, force bool) (*models.Task, error)
+	SnoozeTask(ctx context.Context, id string, until *time.Time, duration *string, notify bool) (*models.Task, error)
+	UnsnoozeTask(ctx context.Context, id string) (*models.Task, error)
+	GetArchivedTasks(ctx context.Context) (models.TaskSlice, error)
+	UnarchiveTask(ctx context.Context, id string) (*models.Task, error)
+	BatchTasks(ctx context.Context, operations []entity.TaskBatchOperation, allOrNothing bool) ([]*entity.TaskBatchResult, error)
+	GetOccurrences(ctx context.Context, from, to time.Time) ([]*entity.TaskOccurrence, error)
+	GetTaskOccurrences(ctx context.Context, id string, limit int) ([]*entity.TaskOccurrence, error)
+	GetTaskHistory(ctx context.Context, id string) (models.TaskEventSlice, error)
+	GetTaskSummaries(ctx context.Context, taskIDs []string) (map[string]*entity.TaskSummary, error)
+	GetTaskDependencies(ctx context.Context, id string) (blockedBy models.TaskSlice, blocks models.TaskSlice, err error)
+	AddTaskDependency(ctx context.Context, id string, dependsOnTaskID string) error
+	RemoveTaskDependency(ctx context.Context, id string, dependsOnTaskID string) error
+}
+
+// TimeEntryUsecase はタスクの作業時間記録のビジネスロジックを提供します
+type TimeEntryUsecase interface {
+	StartTimer(ctx context.Context, taskID string, note *string) (*models.TimeEntry, error)
+	StopTimer(ctx context.Context, taskID string) (*models.TimeEntry, error)
+	GetRunningTimer(ctx context.Context) (*models.TimeEntry, error)
+	GetTaskTimeEntries(ctx context.Context, taskID string) (models.TimeEntrySlice, error)
+	CreateTimeEntry(ctx context.Context, taskID string, startedAt, endedAt time.Time, note *string) (*models.TimeEntry, error)
+	EditTimeEntry(ctx context.Context, id string, startedAt, endedAt *time.Time, note *string) (*models.TimeEntry, error)
+	DeleteTimeEntry(ctx context.Context, id string) error
+	GetTimeReport(ctx context.Context, from, to time.Time, groupBy entity.TimeReportGroupBy, loc *time.Location) (*entity.TimeReport, error)
+	GetEstimateReport(ctx context.Context) (*entity.EstimateReport, error)
+}
+
+// StatsUsecase はタスクの生産性統計のビジネスロジックを提供します
+type StatsUsecase interface {
+	GetTaskStats(ctx context.Context, from, to time.Time, groupBy entity.StatsGroupBy, loc *time.Location) (*entity.TaskStats, error)
+	GetTaskFlowReport(ctx context.Context, from, to time.Time, groupBy entity.StatsGroupBy, loc *time.Location) (*entity.TaskFlowReport, error)
+}
+
+// IdempotencyKeyRepository は冪等性キーのデータアクセスを提供します
+type IdempotencyKeyRepository interface {
+	GetKey(ctx context.Context, userID string, key string) (*models.IdempotencyKey, error)
+	CreateKey(ctx context.Context, idempotencyKey *models.IdempotencyKey) error
+	CompleteKey(ctx context.Context, id string, statusCode int32, headers []byte, body []byte) error
+	DeleteKey(ctx context.Context, id string) error
+	DeleteExpiredKeys(ctx context.Context, expiredBefore time.Time, limit int) (int64, error)
+}
+
+// IdempotencyUsecase は冪等性キーによるPOSTリクエストの重複実行防止を提供します
+type IdempotencyUsecase interface {
+	BeginRequest(ctx context.Context, key string, method string, path string, requestHash string) (record *models.IdempotencyKey, replay bool, err error)
+	CompleteRequest(ctx context.Context, record *models.IdempotencyKey, statusCode int, headers map[string]string, body []byte) error
+	ReleaseRequest(ctx context.Context, record *models.IdempotencyKey) error
+	PurgeExpiredKeys(ctx context.Context, expiredBefore time.Time) (int64, error)
+}
+
+// NotificationRepository はアプリ内通知のデータアクセスを提供します
+type NotificationRepository interface {
+	CreateNotification(ctx context.Context, notification *models.Notification) error
+	GetNotificationByID(ctx context.Context, id string) (*models.Notification, error)
+	ListNotifications(ctx context.Context, userID string, unreadOnly bool, cursor *entity.NotificationCursor, limit int) (models.NotificationSlice, error)
+	CountUnreadNotifications(ctx context.Context, userID string) (int64, error)
+	MarkNotificationRead(ctx context.Context, id string, readAt time.Time) error
+	MarkAllNotificationsRead(ctx context.Context, userID string, readAt time.Time) (int64, error)
+	DeleteNotification(ctx context.Context, id string) error
+}
+
+// NotificationProducer は他の機能（リマインダー等）がユーザーにアプリ内通知を発行するためのAPIを提供します
+// リクエストのユーザーではなく通知先のユーザーを明示的に指定します
+type NotificationProducer interface {
+	Notify(ctx context.Context, notification *entity.NewNotification) (*models.Notification, error)
+}
+
+// NotificationUsecase はログインユーザーの通知受信箱のビジネスロジックを提供します
+type NotificationUsecase interface {
+	NotificationProducer
+	ListNotifications(ctx context.Context, unreadOnly bool, cursor *entity.NotificationCursor, limit int) (models.NotificationSlice, *entity.NotificationCursor, error)
+	GetUnreadCount(ctx context.Context) (int64, error)
+	MarkNotificationRead(ctx context.Context, id string) (*models.Notification, error)
+	MarkAllNotificationsRead(ctx context.Context) (int64, error)
+	DeleteNotification(ctx context.Context, id string) error
+}
+
+// TaskReminderRepository は期限リマインダーの配信状態のデータアクセスを提供します
+type TaskReminderRepository interface {
+	CreateDelivery(ctx context.Context, delivery *models.TaskReminder) error
+	RetryDelivery(ctx context.Context, taskID string, dueAt time.Time, offsetMinutes int32, channel string, staleBefore time.Time, maxAttempts int32) (*models.TaskReminder, error)
+	MarkDeliverySent(ctx context.Context, id string, sentAt time.Time) error
+	MarkDeliveryFailed(ctx context.Context, id string, lastError string) error
+}
+
+// NotificationChannel はリマインダーの配信チャネル（アプリ内通知・メール・Webhook）を表します
+type NotificationChannel interface {
+	// Name は配信状態の記録に使うチャネル名を返します
+	Name() string
+	Send(ctx context.Context, reminder *entity.Reminder) error
+}
+
+// ReminderUsecase は期限が近づいたタスクのリマインダー配信を提供します
+type ReminderUsecase interface {
+	DeliverDueReminders(ctx context.Context, now time.Time) (int, error)
+}
+
+// SnoozeUsecase は解除日時を過ぎたタスクのスヌーズ解除を提供します
+type SnoozeUsecase interface {
+	WakeSnoozedTasks(ctx context.Context, now time.Time) (int, error)
+}
+
+// UserSettingRepository はユーザーごとの設定のデータアクセスを提供します
+type UserSettingRepository interface {
+	GetSettingByUserID(ctx context.Context, userID string) (*models.UserSetting, error)
+	SaveSetting(ctx context.Context, setting *models.UserSetting) error
+	GetAutoArchiveSettings(ctx context.Context) (models.UserSettingSlice, error)
+}
+
+// UserSettingUsecase はログインユーザーの設定のビジネスロジックを提供します
+type UserSettingUsecase interface {
+	GetSettings(ctx context.Context) (*models.UserSetting, error)
+	EditSettings(ctx context.Context, autoArchiveDays *int32, timezone *string) (*models.UserSetting, error)
+}
+
+// AutoArchiveUsecase は完了したタスクの自動アーカイブを提供します（バックグラウンド処理用）
+type AutoArchiveUsecase interface {
+	ArchiveDoneTasks(ctx context.Context, now time.Time) (int64, error)
+}
+
+// CalendarFeedRepository はiCalendar購読フィードのトークンのデータアクセスを提供します
+type CalendarFeedRepository interface {
+	GetFeedByUserID(ctx context.Context, userID string) (*models.CalendarFeed, error)
+	GetFeedByTokenHash(ctx context.Context, tokenHash string) (*models.CalendarFeed, error)
+	CreateFeed(ctx context.Context, feed *models.CalendarFeed) error
+	DeleteFeedByUserID(ctx context.Context, userID string) (int64, error)
+}
+
+// CalendarFeedUsecase はiCalendar購読フィードのビジネスロジックを提供します
+type CalendarFeedUsecase interface {
+	GetFeed(ctx context.Context) (*models.CalendarFeed, error)
+	RotateToken(ctx context.Context) (feed *models.CalendarFeed, token string, err error)
+	RevokeToken(ctx context.Context) error
+	GetFeedTasks(ctx context.Context, token string) (models.TaskSlice, error)
+}
+
+// TaskImportRepository は外部データから取り込んだタスクの対応表のデータアクセスを提供します
+type TaskImportRepository interface {
+	GetImportedTaskIDs(ctx context.Context, userID string, source string, externalIDs []string) (map[string]string, error)
+	CreateImport(ctx context.Context, record *models.TaskImport) error
+}
+
+// ImportUsecase は外部データからのタスクの取り込みを提供します
+type ImportUsecase interface {
+	ImportICal(ctx context.Context, data []byte, options entity.ImportOptions) (*entity.ImportResult, error)
+	ImportTodoist(ctx context.Context, data []byte, options entity.ImportOptions) (*entity.ImportResult, error)
+	ImportTrello(ctx context.Context, data []byte, options entity.ImportOptions) (*entity.ImportResult, error)
+	ImportMarkdown(ctx context.Context, data []byte, options entity.ImportOptions) (*entity.ImportResult, error)
+}
+
+// AccountArchiveRepository はアカウントデータのエクスポート・インポートのデータアクセスを提供します
+type AccountArchiveRepository interface {
+	GetInterpretationsByUserID(ctx context.Context, userID string) (models.AiInterpretationSlice, error)
+	GetInterpretationItemsByUserID(ctx context.Context, userID string) (models.InterpretationItemSlice, error)
+	RestoreInterpretation(ctx context.Context, interpretation *models.AiInterpretation) error
+	RestoreInterpretationItem(ctx context.Context, item *models.InterpretationItem) error
+	RestoreTask(ctx context.Context, task *models.Task) error
+}
+
+// AccountArchiveUsecase はアカウントデータのエクスポート・インポートを提供します
+type AccountArchiveUsecase interface {
+	ExportAccount(ctx context.Context) (*entity.AccountArchive, error)
+	ImportAccount(ctx context.Context, archive *entity.AccountArchive) (*entity.AccountImportResult, error)
+}
+
+// TaskAttachmentRepository はタスクの添付ファイルのメタデータのデータアクセスを提供します
+type TaskAttachmentRepository interface {
+	GetAttachmentByID(ctx context.Context, id string) (*models.TaskAttachment, error)
+	GetAttachmentsByTaskID(ctx context.Context, taskID string) (models.TaskAttachmentSlice, error)
+	CountAttachmentsByTaskID(ctx context.Context, taskID string) (int64, error)
+	GetAttachmentsOfDeletedTasks(ctx context.Context, deletedBefore time.Time, limit int) (models.TaskAttachmentSlice, error)
+	CreateAttachment(ctx context.Context, attachment *models.TaskAttachment) error
+	DeleteAttachment(ctx context.Context, id string) error
+}
+
+// AttachmentStorage は添付ファイルの本体を保存するストレージ（ローカルファイルシステム・S3互換ストレージ）を表します
+type AttachmentStorage interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get はファイルを読み込みます（存在しない場合はstorage.ErrObjectNotFound）
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete はファイルを削除します（存在しない場合も成功）
+	Delete(ctx context.Context, key string) error
+}
+
+// AttachmentUsecase はタスクの添付ファイルのビジネスロジックを提供します
+type AttachmentUsecase interface {
+	GetTaskAttachments(ctx context.Context, taskID string) (models.TaskAttachmentSlice, error)
+	UploadAttachment(ctx context.Context, taskID string, filename string, data []byte) (*models.TaskAttachment, error)
+	DownloadAttachment(ctx context.Context, taskID string, attachmentID string) (*models.TaskAttachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, taskID string, attachmentID string) error
+	PurgeDeletedTaskAttachments(ctx context.Context, deletedBefore time.Time) (int64, error)
+}
+
+// TaskTemplateRepository はタスクテンプレートのデータアクセスを提供します
+type TaskTemplateRepository interface {
+	GetTemplateByID(ctx context.Context, id string) (*entity.TaskTemplate, error)
+	GetTemplatesByUserID(ctx context.Context, userID string) ([]*entity.TaskTemplate, error)
+	CreateTemplate(ctx context.Context, template *entity.TaskTemplate) error
+	UpdateTemplate(ctx context.Context, template *entity.TaskTemplate) error
+	DeleteTemplate(ctx context.Context, id string) error
+}
+
+// TaskTemplateUsecase はタスクテンプレートのビジネスロジックを提供します
+type TaskTemplateUsecase interface {
+	GetTemplate(ctx context.Context, id string) (*entity.TaskTemplate, error)
+	GetTemplateList(ctx context.Context) ([]*entity.TaskTemplate, error)
+	CreateTemplate(ctx context.Context, name string, description *string, projectID *string, items []entity.TaskTemplateItem) (*entity.TaskTemplate, error)
+	EditTemplate(ctx context.Context, id string, name *string, description *string, projectID *string, items []entity.TaskTemplateItem) (*entity.TaskTemplate, error)
+	DeleteTemplate(ctx context.Context, id string) error
+	InstantiateTemplate(ctx context.Context, id string, anchorAt *time.Time, projectID *string) (models.TaskSlice, map[string]*entity.TaskSummary, error)
+	SaveTaskAsTemplate(ctx context.Context, taskID string, name string, description *string) (*entity.TaskTemplate, error)
+}
+
+// TaskViewRepository は保存したタスクのビューのデータアクセスを提供します
+type TaskViewRepository interface {
+	GetViewByID(ctx context.Context, id string) (*entity.TaskView, error)
+	GetViewsByUserID(ctx context.Context, userID string) ([]*entity.TaskView, error)
+	CreateView(ctx context.Context, view *entity.TaskView) error
+	UpdateView(ctx context.Context, view *entity.TaskView) error
+	DeleteView(ctx context.Context, id string) error
+}
+
+// TaskViewUsecase はタスクのビュー（保存した絞り込み条件・システムビュー）のビジネスロジックを提供します
+type TaskViewUsecase interface {
+	GetView(ctx context.Context, id string) (*entity.TaskView, error)
+	GetViewList(ctx context.Context) ([]*entity.TaskView, error)
+	CreateView(ctx context.Context, name string, filter entity.TaskFilter) (*entity.TaskView, error)
+	EditView(ctx context.Context, id string, name *string, filter *entity.TaskFilter) (*entity.TaskView, error)
+	DeleteView(ctx context.Context, id string) error
+	GetViewTasks(ctx context.Context, id string, loc *time.Location) (models.TaskSlice, error)
+}
+
+// TaskStatusRepository はワークフローのステータス定義のデータアクセスを提供します
+type TaskStatusRepository interface {
+	GetStatusByID(ctx context.Context, id string) (*models.TaskStatus, error)
+	GetStatusesByUserID(ctx context.Context, userID string) (models.TaskStatusSlice, error)
+	GetStatusByNameForUpdate(ctx context.Context, userID string, name string) (*models.TaskStatus, error)
+	CreateStatus(ctx context.Context, status *models.TaskStatus) error
+	UpdateStatus(ctx context.Context, status *models.TaskStatus) error
+	DeleteStatus(ctx context.Context, id string) error
+}
+
+// TaskStatusUsecase はワークフローのステータス定義のビジネスロジックを提供します
+type TaskStatusUsecase interface {
+	GetStatusList(ctx context.Context) (models.TaskStatusSlice, error)
+	CreateStatus(ctx context.Context, name string, category string, sortOrder *int32, wipLimit *int32) (*models.TaskStatus, error)
+	EditStatus(ctx context.Context, id string, category *string, sortOrder *int32, wipLimit *int32) (*models.TaskStatus, error)
+	DeleteStatus(ctx context.Context, id string) error
 }
 
 // InterpretationRepository はAI解釈のデータアクセスを提供します
@@ -55,6 +383,12 @@ type InterpretationItemRepository interface {
 
 	// 複数アイテム承認
 	ApproveItems(ctx context.Context, approvals map[string]string) error
+
+	// 承認済みアイテムから作成されたタスクのタグ取得（タスクIDごと）
+	GetTaskTagsByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]string, error)
+
+	// 完全削除したタスクへの参照解除
+	ClearTaskResourceIDs(ctx context.Context, taskIDs []string) error
 }
 
 // InterpretationItemUseCase はAI解釈アイテムのビジネスロジックを提供します
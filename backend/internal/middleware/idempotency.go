@@ -0,0 +1,239 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+const (
+	// IdempotencyKeyHeader はクライアントが冪等性キーを指定するヘッダー
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader は保存済みのレスポンスを再送したことを示すヘッダー
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	// maxIdempotencyKeyLength は冪等性キーの最大長（idempotency_keys.idempotency_keyのカラム長）
+	maxIdempotencyKeyLength = 255
+)
+
+// replayedResponseHeaders は再送時に復元するレスポンスヘッダー
+var replayedResponseHeaders = []string{"Content-Type", "ETag", "Location"}
+
+// IdempotencyMiddleware はIdempotency-Keyヘッダー付きのPOSTリクエストの重複実行を防ぐミドルウェアです
+type IdempotencyMiddleware struct {
+	usecase     interfaces.IdempotencyUsecase
+	maxBodySize int64
+	logger      *slog.Logger
+}
+
+// NewIdempotencyMiddleware は新しい冪等性キーミドルウェアを作成します
+// maxBodySizeはフィンガープリントのためにメモリに読み込むリクエストボディの最大サイズ（バイト）です
+func NewIdempotencyMiddleware(usecase interfaces.IdempotencyUsecase, maxBodySize int64) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		usecase:     usecase,
+		maxBodySize: maxBodySize,
+		logger:      slog.Default(),
+	}
+}
+
+// HandleIdempotencyKey はIdempotency-Keyヘッダー付きのPOSTリクエストを冪等に処理するミドルウェアです
+// 認証済みのユーザーIDが必要なため、RequireAuthの後に適用します
+// 同じキーの再送には最初のレスポンスをそのまま返し、異なる内容でのキーの再利用は422で拒否します
+func (m *IdempotencyMiddleware) HandleIdempotencyKey() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+
+		if len(key) > maxIdempotencyKeyLength {
+			m.logger.WarnContext(ctx, "Idempotency key too long",
+				slog.Int("length", len(key)),
+			)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"code":    "validation_error",
+				"message": "Idempotency-Key must be 255 characters or less",
+			})
+			c.Abort()
+			return
+		}
+
+		// ボディを読み取ってハンドラー用に戻す（ハンドラーのサイズ制限より先に全体を読み込むため、ここでも上限を設ける）
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, m.maxBodySize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				m.logger.WarnContext(ctx, "Idempotent request body too large",
+					slog.Int64("limit", maxBytesErr.Limit),
+				)
+				c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+					"code":    "request_too_large",
+					"message": fmt.Sprintf("Request body must be %d bytes or less", maxBytesErr.Limit),
+				})
+				c.Abort()
+				return
+			}
+			m.logger.WarnContext(ctx, "Failed to read request body",
+				slog.String("error", err.Error()),
+			)
+			c.JSON(http.StatusBadRequest, gin.H{
+				"code":    "validation_error",
+				"message": "Failed to read request body",
+			})
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		path := c.Request.URL.RequestURI()
+		record, replay, err := m.usecase.BeginRequest(ctx, key, c.Request.Method, path, requestFingerprint(c.Request.Method, path, body))
+		if err != nil {
+			m.respondError(c, err)
+			return
+		}
+
+		if replay {
+			m.replay(c, record)
+			return
+		}
+
+		// クライアントの切断後もキーの状態を確定させるため、キャンセルされないcontextで保存する
+		saveCtx := context.WithoutCancel(ctx)
+
+		writer := &idempotencyResponseWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		completed := false
+		defer func() {
+			// パニック時はキーを解放して再試行できるようにする（パニックはRecoveryに任せる）
+			if !completed {
+				_ = m.usecase.ReleaseRequest(saveCtx, record)
+			}
+		}()
+
+		c.Next()
+
+		status := c.Writer.Status()
+		if len(c.Errors) > 0 || status >= http.StatusInternalServerError {
+			// ハンドラーがc.Errorで報告したエラーとサーバーエラーは保存せず、同じキーでの再試行を受け付ける
+			// （c.Errorで報告したエラーはレスポンスに書き込まれないため、保存すると空の成功レスポンスを再送してしまう）
+			if len(c.Errors) > 0 {
+				m.logger.InfoContext(ctx, "Idempotent request failed, releasing key",
+					slog.String("idempotency_key_id", record.ID),
+					slog.String("error", c.Errors.Last().Error()),
+				)
+			}
+			_ = m.usecase.ReleaseRequest(saveCtx, record)
+			completed = true
+			return
+		}
+
+		headers := make(map[string]string)
+		for _, name := range replayedResponseHeaders {
+			if value := c.Writer.Header().Get(name); value != "" {
+				headers[name] = value
+			}
+		}
+		if err := m.usecase.CompleteRequest(saveCtx, record, status, headers, writer.body.Bytes()); err != nil {
+			// 保存に失敗したキーは処理中のまま残さず解放する
+			_ = m.usecase.ReleaseRequest(saveCtx, record)
+		}
+		completed = true
+	}
+}
+
+// replay は保存済みのレスポンスを返します
+func (m *IdempotencyMiddleware) replay(c *gin.Context, record *models.IdempotencyKey) {
+	if data, ok := record.ResponseHeaders.Get(); ok {
+		var headers map[string]string
+		if err := json.Unmarshal(data.Val, &headers); err == nil {
+			for name, value := range headers {
+				c.Header(name, value)
+			}
+		}
+	}
+	c.Header(IdempotentReplayedHeader, "true")
+
+	m.logger.InfoContext(c.Request.Context(), "Idempotent request replayed",
+		slog.String("idempotency_key_id", record.ID),
+	)
+
+	status, _ := record.StatusCode.Get()
+	body, _ := record.ResponseBody.Get()
+	c.Status(int(status))
+	_, _ = c.Writer.Write(body)
+	c.Abort()
+}
+
+// respondError は冪等性キーの検証エラーをレスポンスに変換します
+func (m *IdempotencyMiddleware) respondError(c *gin.Context, err error) {
+	switch {
+	case strings.Contains(err.Error(), "unauthorized"):
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"code":    "unauthorized",
+			"message": "Authentication required",
+		})
+	case strings.Contains(err.Error(), "different payload"):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"code":    "idempotency_key_reused",
+			"message": "Idempotency-Key has already been used with a different request",
+		})
+	case strings.Contains(err.Error(), "in progress"):
+		c.JSON(http.StatusConflict, gin.H{
+			"code":    "idempotency_key_in_progress",
+			"message": "A request with the same Idempotency-Key is still being processed",
+		})
+	default:
+		m.logger.ErrorContext(c.Request.Context(), "Failed to begin idempotent request",
+			slog.String("error", err.Error()),
+		)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    "internal_error",
+			"message": "Internal server error",
+		})
+	}
+	c.Abort()
+}
+
+// requestFingerprint はメソッド・パス・ボディからリクエストのフィンガープリントを生成します
+func requestFingerprint(method, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{'\n'})
+	hash.Write([]byte(path))
+	hash.Write([]byte{'\n'})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// idempotencyResponseWriter はクライアントに書き込んだレスポンスボディを保存用に記録します
+type idempotencyResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write はレスポンスボディを記録しつつクライアントへ書き込みます
+func (w *idempotencyResponseWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString はレスポンスボディを記録しつつクライアントへ書き込みます
+func (w *idempotencyResponseWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
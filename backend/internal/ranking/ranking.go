@@ -0,0 +1,144 @@
+package ranking
+
+import (
+	"fmt"
+	"strings"
+)
+
+// digits はキーに使用する文字（ASCII順に並んだ62進数の桁）
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+const (
+	// MaxKeyLength はキーの最大長（tasks.rank_keyのカラム長）
+	MaxKeyLength = 255
+	// rebalanceThreshold はこの長さを超えたキーが生成された場合に列全体を振り直す閾値
+	rebalanceThreshold = 32
+)
+
+// キーは62進数の小数部（0.xxx）を表す文字列で、辞書順がそのまま数値の大小になります
+// 末尾が"0"のキーは直前に挿入する余地がなくなるため生成しません
+
+// Validate はキーの形式の検証を行います
+func Validate(key string) error {
+	if key == "" {
+		return fmt.Errorf("rank key is empty")
+	}
+	if len(key) > MaxKeyLength {
+		return fmt.Errorf("rank key must be %d characters or less", MaxKeyLength)
+	}
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return fmt.Errorf("invalid rank key: %q", key)
+		}
+	}
+	if key[len(key)-1] == digits[0] {
+		return fmt.Errorf("invalid rank key: %q must not end with %q", key, digits[0])
+	}
+	return nil
+}
+
+// Between はbeforeとafterの間に位置するキーを返します
+// beforeが空文字列の場合は先頭、afterが空文字列の場合は末尾への挿入として扱います
+func Between(before, after string) (string, error) {
+	if before != "" {
+		if err := Validate(before); err != nil {
+			return "", err
+		}
+	}
+	if after != "" {
+		if err := Validate(after); err != nil {
+			return "", err
+		}
+	}
+	if before != "" && after != "" && before >= after {
+		return "", fmt.Errorf("rank key %q must be less than %q", before, after)
+	}
+	return midpoint(before, after), nil
+}
+
+// NeedsRebalance はキーが長くなりすぎたため列全体の振り直しが必要かを判定します
+func NeedsRebalance(key string) bool {
+	return len(key) > rebalanceThreshold
+}
+
+// Spread はn件分のキーを等間隔に生成します（列全体の振り直し用）
+func Spread(n int) []string {
+	keys := make([]string, n)
+	if n == 0 {
+		return keys
+	}
+
+	// 各キーの間に少なくとも1桁分の余地が残る桁数を選ぶ
+	width := 1
+	space := int64(len(digits))
+	for space/int64(n+1) < int64(len(digits)) {
+		width++
+		space *= int64(len(digits))
+	}
+
+	step := space / int64(n+1)
+	for i := range keys {
+		keys[i] = encode(int64(i+1)*step, width)
+	}
+	return keys
+}
+
+// midpoint はa < b（bが空文字列の場合は1.0）を満たすキーの中間値を返します
+func midpoint(a, b string) string {
+	// 共通の接頭辞は残して残りの桁で中間値を求める
+	if b != "" {
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(a) {
+				rest = a[n:]
+			}
+			return b[:n] + midpoint(rest, b[n:])
+		}
+	}
+
+	lower := 0
+	if a != "" {
+		lower = strings.IndexByte(digits, a[0])
+	}
+	upper := len(digits)
+	if b != "" {
+		upper = strings.IndexByte(digits, b[0])
+	}
+
+	if upper-lower > 1 {
+		return string(digits[(lower+upper)/2])
+	}
+
+	// 先頭の桁が隣接している場合
+	if len(b) > 1 {
+		return b[:1]
+	}
+	rest := ""
+	if len(a) > 1 {
+		rest = a[1:]
+	}
+	return string(digits[lower]) + midpoint(rest, "")
+}
+
+// digitAt はキーのi桁目を返します（桁数を超える場合は"0"）
+func digitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return digits[0]
+}
+
+// encode は値を指定桁数の62進数に変換し、末尾の"0"を取り除きます
+func encode(value int64, width int) string {
+	buf := make([]byte, width)
+	base := int64(len(digits))
+	for i := width - 1; i >= 0; i-- {
+		buf[i] = digits[value%base]
+		value /= base
+	}
+	return strings.TrimRight(string(buf), digits[:1])
+}
@@ -0,0 +1,117 @@
+package recurrence
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// MaxRuleLength はRRULE文字列の最大長（tasks.recurrence_ruleのカラム長）
+const MaxRuleLength = 500
+
+// Normalize はRRULE文字列の前後の空白と"RRULE:"接頭辞を取り除きます
+func Normalize(rule string) string {
+	rule = strings.TrimSpace(rule)
+	return strings.TrimPrefix(rule, "RRULE:")
+}
+
+// Validate はRRULE文字列（RFC 5545）の検証を行います
+// タスクの繰り返しとして意味を持たない時間未満の頻度（HOURLY等）は受け付けません
+func Validate(rule string) error {
+	rule = Normalize(rule)
+	if rule == "" {
+		return fmt.Errorf("recurrence rule is empty")
+	}
+	if len(rule) > MaxRuleLength {
+		return fmt.Errorf("recurrence rule must be %d characters or less", MaxRuleLength)
+	}
+	if strings.ContainsAny(rule, "\r\n") {
+		return fmt.Errorf("recurrence rule must be a single RRULE line")
+	}
+
+	option, err := rrule.StrToROption(rule)
+	if err != nil {
+		return fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+
+	switch option.Freq {
+	case rrule.DAILY, rrule.WEEKLY, rrule.MONTHLY, rrule.YEARLY:
+	default:
+		return fmt.Errorf("invalid recurrence rule: FREQ must be one of DAILY, WEEKLY, MONTHLY, YEARLY")
+	}
+	if !option.Dtstart.IsZero() {
+		return fmt.Errorf("invalid recurrence rule: DTSTART must be given as recurrence_anchor_at")
+	}
+
+	return nil
+}
+
+// parse はRRULE文字列と起点日時（DTSTART）からルールを生成します
+// BYDAY・BYMONTHDAY等の曜日・日付はlocのカレンダーで判定するため、起点日時をlocに変換してから展開します
+func parse(rule string, anchor time.Time, loc *time.Location) (*rrule.RRule, error) {
+	option, err := rrule.StrToROption(Normalize(rule))
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	option.Dtstart = anchor.In(loc)
+
+	r, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+	return r, nil
+}
+
+// Next はafterより後の次回発生日時をUTCで返します（locはルールを展開するタイムゾーン、nilの場合はUTC）
+// ルールがCOUNT/UNTILで終了している場合はfalseを返します
+func Next(rule string, anchor, after time.Time, loc *time.Location) (time.Time, bool, error) {
+	r, err := parse(rule, anchor, loc)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	next := r.After(after, false)
+	if next.IsZero() {
+		return time.Time{}, false, nil
+	}
+	return next.UTC(), true, nil
+}
+
+// Upcoming はafterより後の発生日時をUTCで最大limit件返します（locはルールを展開するタイムゾーン）
+func Upcoming(rule string, anchor, after time.Time, limit int, loc *time.Location) ([]time.Time, error) {
+	r, err := parse(rule, anchor, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := make([]time.Time, 0, limit)
+	next := r.Iterator()
+	for len(occurrences) < limit {
+		t, ok := next()
+		if !ok {
+			break
+		}
+		if t.After(after) {
+			occurrences = append(occurrences, t.UTC())
+		}
+	}
+	return occurrences, nil
+}
+
+// Between はfrom以上to以下の発生日時をUTCで返します（locはルールを展開するタイムゾーン）
+func Between(rule string, anchor, from, to time.Time, loc *time.Location) ([]time.Time, error) {
+	r, err := parse(rule, anchor, loc)
+	if err != nil {
+		return nil, err
+	}
+	times := r.Between(from, to, true)
+	for i, t := range times {
+		times[i] = t.UTC()
+	}
+	return times, nil
+}
@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type accountArchiveRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewAccountArchiveRepository は新しいAccountArchiveRepositoryを生成します
+func NewAccountArchiveRepository(db *sql.DB, logger *slog.Logger) interfaces.AccountArchiveRepository {
+	return NewAccountArchiveRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewAccountArchiveRepositoryWithExecutor は既存のexecutorを使ってAccountArchiveRepositoryを生成します
+func NewAccountArchiveRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.AccountArchiveRepository {
+	return &accountArchiveRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetInterpretationsByUserID はユーザーのAI解釈をすべて作成日時の昇順で取得します
+func (r *accountArchiveRepository) GetInterpretationsByUserID(ctx context.Context, userID string) (models.AiInterpretationSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetInterpretationsByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	interpretations, err := models.AiInterpretations.Query(
+		sm.Where(models.AiInterpretations.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.OrderBy(mysql.Raw("created_at ASC, id ASC")),
+	).All(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query interpretations by user",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get interpretations: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetInterpretationsByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(interpretations)),
+	)
+	return interpretations, nil
+}
+
+// GetInterpretationItemsByUserID はユーザーのAI解釈に属するアイテムをすべて取得します
+func (r *accountArchiveRepository) GetInterpretationItemsByUserID(ctx context.Context, userID string) (models.InterpretationItemSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetInterpretationItemsByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	items, err := models.InterpretationItems.Query(
+		sm.Where(mysql.Raw("interpretation_id IN (SELECT id FROM ai_interpretations WHERE user_id = ?)", userID)),
+		sm.OrderBy(mysql.Raw("interpretation_id ASC, item_index ASC")),
+	).All(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query interpretation items by user",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get interpretation items: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetInterpretationItemsByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(items)),
+	)
+	return items, nil
+}
+
+// RestoreInterpretation はAI解釈を作成日時を含めてそのまま保存します
+func (r *accountArchiveRepository) RestoreInterpretation(ctx context.Context, interpretation *models.AiInterpretation) error {
+	r.logger.InfoContext(ctx, "Repository: RestoreInterpretation started",
+		slog.String("interpretation_id", interpretation.ID),
+		slog.String("user_id", interpretation.UserID),
+	)
+
+	_, err := models.AiInterpretations.Insert(
+		&models.AiInterpretationSetter{
+			ID:                 omit.From(interpretation.ID),
+			UserID:             omit.From(interpretation.UserID),
+			InputText:          omit.From(interpretation.InputText),
+			StructuredResult:   omit.From(interpretation.StructuredResult),
+			OriginalResult:     omitnull.FromNull(interpretation.OriginalResult),
+			AiModel:            omit.From(interpretation.AiModel),
+			AiPromptTokens:     omitnull.FromNull(interpretation.AiPromptTokens),
+			AiCompletionTokens: omitnull.FromNull(interpretation.AiCompletionTokens),
+			CreatedAt:          omit.From(interpretation.CreatedAt),
+		},
+	).Exec(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to restore interpretation",
+			slog.String("interpretation_id", interpretation.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to restore interpretation: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: RestoreInterpretation completed",
+		slog.String("interpretation_id", interpretation.ID),
+	)
+	return nil
+}
+
+// RestoreInterpretationItem はAI解釈のアイテムを作成日時を含めてそのまま保存します
+func (r *accountArchiveRepository) RestoreInterpretationItem(ctx context.Context, item *models.InterpretationItem) error {
+	r.logger.InfoContext(ctx, "Repository: RestoreInterpretationItem started",
+		slog.String("item_id", item.ID),
+		slog.String("interpretation_id", item.InterpretationID),
+	)
+
+	_, err := models.InterpretationItems.Insert(
+		&models.InterpretationItemSetter{
+			ID:               omit.From(item.ID),
+			InterpretationID: omit.From(item.InterpretationID),
+			ItemIndex:        omit.From(item.ItemIndex),
+			ResourceType:     omit.From(item.ResourceType),
+			ResourceID:       omitnull.FromNull(item.ResourceID),
+			Status:           omit.From(item.Status),
+			Data:             omit.From(item.Data),
+			OriginalData:     omit.From(item.OriginalData),
+			ReviewedAt:       omitnull.FromNull(item.ReviewedAt),
+			CreatedAt:        omit.From(item.CreatedAt),
+			UpdatedAt:        omit.From(item.UpdatedAt),
+		},
+	).Exec(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to restore interpretation item",
+			slog.String("item_id", item.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to restore interpretation item: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: RestoreInterpretationItem completed",
+		slog.String("item_id", item.ID),
+	)
+	return nil
+}
+
+// RestoreTask はタスクを作成日時・更新日時を含めてそのまま保存します（バージョンは1から始めます）
+func (r *accountArchiveRepository) RestoreTask(ctx context.Context, task *models.Task) error {
+	r.logger.InfoContext(ctx, "Repository: RestoreTask started",
+		slog.String("task_id", task.ID),
+		slog.String("user_id", task.UserID),
+	)
+
+	task.Version = 1
+
+	_, err := models.Tasks.Insert(
+		&models.TaskSetter{
+			ID:                 omit.From(task.ID),
+			UserID:             omit.From(task.UserID),
+			ProjectID:          omitnull.FromNull(task.ProjectID),
+			Title:              omit.From(task.Title),
+			Description:        omitnull.FromNull(task.Description),
+			DueAt:              omitnull.FromNull(task.DueAt),
+			EstimateMinutes:    omitnull.FromNull(task.EstimateMinutes),
+			Status:             omit.From(task.Status),
+			StatusCategory:     omit.From(task.StatusCategory),
+			RankKey:            omit.From(task.RankKey),
+			StartedAt:          omitnull.FromNull(task.StartedAt),
+			CompletedAt:        omitnull.FromNull(task.CompletedAt),
+			ArchivedAt:         omitnull.FromNull(task.ArchivedAt),
+			Source:             omit.From(task.Source),
+			AiInterpretationID: omitnull.FromNull(task.AiInterpretationID),
+			RecurrenceRule:     omitnull.FromNull(task.RecurrenceRule),
+			RecurrenceAnchorAt: omitnull.FromNull(task.RecurrenceAnchorAt),
+			RecurrenceSeriesID: omitnull.FromNull(task.RecurrenceSeriesID),
+			Version:            omit.From(task.Version),
+			CreatedAt:          omit.From(task.CreatedAt),
+			UpdatedAt:          omit.From(task.UpdatedAt),
+		},
+	).Exec(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to restore task",
+			slog.String("task_id", task.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to restore task: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: RestoreTask completed",
+		slog.String("task_id", task.ID),
+	)
+	return nil
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/dm"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/yoshioka0101/ai_plan_chat/dberrors"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type calendarFeedRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewCalendarFeedRepository は新しいCalendarFeedRepositoryを生成します
+func NewCalendarFeedRepository(db *sql.DB, logger *slog.Logger) interfaces.CalendarFeedRepository {
+	return NewCalendarFeedRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewCalendarFeedRepositoryWithExecutor は既存のexecutorを使ってCalendarFeedRepositoryを生成します
+func NewCalendarFeedRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.CalendarFeedRepository {
+	return &calendarFeedRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetFeedByUserID はユーザーのフィードを取得します（発行されていない場合はnil）
+func (r *calendarFeedRepository) GetFeedByUserID(ctx context.Context, userID string) (*models.CalendarFeed, error) {
+	r.logger.InfoContext(ctx, "Repository: GetFeedByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	feed, err := models.CalendarFeeds.Query(
+		sm.Where(models.CalendarFeeds.Columns.UserID.EQ(mysql.Arg(userID))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query calendar feed",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find calendar feed: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetFeedByUserID completed",
+		slog.String("calendar_feed_id", feed.ID),
+	)
+	return feed, nil
+}
+
+// GetFeedByTokenHash はトークンのハッシュでフィードを取得します
+func (r *calendarFeedRepository) GetFeedByTokenHash(ctx context.Context, tokenHash string) (*models.CalendarFeed, error) {
+	feed, err := models.CalendarFeeds.Query(
+		sm.Where(models.CalendarFeeds.Columns.TokenHash.EQ(mysql.Arg(tokenHash))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "Repository: Calendar feed not found for token")
+			return nil, fmt.Errorf("calendar feed not found")
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query calendar feed by token",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find calendar feed: %w", err)
+	}
+	return feed, nil
+}
+
+// CreateFeed は新しいフィードを作成します（ユーザーごとに1つまで）
+func (r *calendarFeedRepository) CreateFeed(ctx context.Context, feed *models.CalendarFeed) error {
+	r.logger.InfoContext(ctx, "Repository: CreateFeed started",
+		slog.String("user_id", feed.UserID),
+	)
+
+	// UUIDを生成
+	if feed.ID == "" {
+		feed.ID = uuid.New().String()
+	}
+	feed.CreatedAt = time.Now()
+
+	_, err := models.CalendarFeeds.Insert(
+		&models.CalendarFeedSetter{
+			ID:        omit.From(feed.ID),
+			UserID:    omit.From(feed.UserID),
+			TokenHash: omit.From(feed.TokenHash),
+			CreatedAt: omit.From(feed.CreatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		if errors.Is(dberrors.CalendarFeedErrors.ErrUniqueUkCalendarFeedsUser, err) {
+			r.logger.WarnContext(ctx, "Repository: Calendar feed already exists",
+				slog.String("user_id", feed.UserID),
+			)
+			return fmt.Errorf("calendar feed already exists")
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to create calendar feed",
+			slog.String("user_id", feed.UserID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create calendar feed: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateFeed completed",
+		slog.String("calendar_feed_id", feed.ID),
+	)
+	return nil
+}
+
+// DeleteFeedByUserID はユーザーのフィードを削除し、削除した件数を返します
+func (r *calendarFeedRepository) DeleteFeedByUserID(ctx context.Context, userID string) (int64, error) {
+	r.logger.InfoContext(ctx, "Repository: DeleteFeedByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	rowsAffected, err := models.CalendarFeeds.Delete(
+		dm.Where(models.CalendarFeeds.Columns.UserID.EQ(mysql.Arg(userID))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to delete calendar feed",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: DeleteFeedByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int64("count", rowsAffected),
+	)
+	return rowsAffected, nil
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/dm"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/stephenafamo/bob/dialect/mysql/um"
+	"github.com/stephenafamo/bob/types"
+	"github.com/yoshioka0101/ai_plan_chat/dberrors"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type idempotencyKeyRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewIdempotencyKeyRepository は新しいIdempotencyKeyRepositoryを生成します
+func NewIdempotencyKeyRepository(db *sql.DB, logger *slog.Logger) interfaces.IdempotencyKeyRepository {
+	return NewIdempotencyKeyRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewIdempotencyKeyRepositoryWithExecutor は既存のexecutorを使ってIdempotencyKeyRepositoryを生成します
+func NewIdempotencyKeyRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.IdempotencyKeyRepository {
+	return &idempotencyKeyRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetKey はユーザーとキーの組で冪等性キーを取得します
+func (r *idempotencyKeyRepository) GetKey(ctx context.Context, userID string, key string) (*models.IdempotencyKey, error) {
+	r.logger.InfoContext(ctx, "Repository: GetKey started",
+		slog.String("user_id", userID),
+	)
+
+	record, err := models.IdempotencyKeys.Query(
+		sm.Where(models.IdempotencyKeys.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.IdempotencyKeys.Columns.IdempotencyKey.EQ(mysql.Arg(key))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "Repository: Idempotency key not found",
+				slog.String("user_id", userID),
+			)
+			return nil, fmt.Errorf("idempotency key not found: %s", key)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query idempotency key",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find idempotency key: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetKey completed",
+		slog.String("idempotency_key_id", record.ID),
+	)
+	return record, nil
+}
+
+// CreateKey は処理中の冪等性キーを登録します（同じユーザーとキーの組が既にある場合はエラー）
+func (r *idempotencyKeyRepository) CreateKey(ctx context.Context, idempotencyKey *models.IdempotencyKey) error {
+	r.logger.InfoContext(ctx, "Repository: CreateKey started",
+		slog.String("user_id", idempotencyKey.UserID),
+		slog.String("request_path", idempotencyKey.RequestPath),
+	)
+
+	// UUIDを生成
+	if idempotencyKey.ID == "" {
+		idempotencyKey.ID = uuid.New().String()
+	}
+	idempotencyKey.CreatedAt = time.Now()
+
+	_, err := models.IdempotencyKeys.Insert(
+		&models.IdempotencyKeySetter{
+			ID:             omit.From(idempotencyKey.ID),
+			UserID:         omit.From(idempotencyKey.UserID),
+			IdempotencyKey: omit.From(idempotencyKey.IdempotencyKey),
+			RequestMethod:  omit.From(idempotencyKey.RequestMethod),
+			RequestPath:    omit.From(idempotencyKey.RequestPath),
+			RequestHash:    omit.From(idempotencyKey.RequestHash),
+			CreatedAt:      omit.From(idempotencyKey.CreatedAt),
+			ExpiresAt:      omit.From(idempotencyKey.ExpiresAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		if errors.Is(dberrors.IdempotencyKeyErrors.ErrUniqueUkIdempotencyKeysUserKey, err) {
+			r.logger.WarnContext(ctx, "Repository: Idempotency key already exists",
+				slog.String("user_id", idempotencyKey.UserID),
+			)
+			return fmt.Errorf("idempotency key already exists: %s", idempotencyKey.IdempotencyKey)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to create idempotency key",
+			slog.String("idempotency_key_id", idempotencyKey.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create idempotency key: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateKey completed",
+		slog.String("idempotency_key_id", idempotencyKey.ID),
+	)
+	return nil
+}
+
+// CompleteKey は処理が完了した冪等性キーにレスポンスを保存します
+func (r *idempotencyKeyRepository) CompleteKey(ctx context.Context, id string, statusCode int32, headers []byte, body []byte) error {
+	r.logger.InfoContext(ctx, "Repository: CompleteKey started",
+		slog.String("idempotency_key_id", id),
+		slog.Int("status_code", int(statusCode)),
+	)
+
+	setter := &models.IdempotencyKeySetter{
+		StatusCode:      omitnull.From(statusCode),
+		ResponseHeaders: omitnull.From(types.NewJSON(json.RawMessage(headers))),
+		ResponseBody:    omitnull.From(body),
+	}
+
+	_, err := models.IdempotencyKeys.Update(
+		setter.UpdateMod(),
+		um.Where(models.IdempotencyKeys.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to complete idempotency key",
+			slog.String("idempotency_key_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CompleteKey completed",
+		slog.String("idempotency_key_id", id),
+	)
+	return nil
+}
+
+// DeleteKey は冪等性キーを削除します（同じキーで再実行できるようにする）
+func (r *idempotencyKeyRepository) DeleteKey(ctx context.Context, id string) error {
+	r.logger.InfoContext(ctx, "Repository: DeleteKey started",
+		slog.String("idempotency_key_id", id),
+	)
+
+	_, err := models.IdempotencyKeys.Delete(
+		dm.Where(models.IdempotencyKeys.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to delete idempotency key",
+			slog.String("idempotency_key_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: DeleteKey completed",
+		slog.String("idempotency_key_id", id),
+	)
+	return nil
+}
+
+// DeleteExpiredKeys は有効期限が指定日時より前の冪等性キーを最大limit件まで削除し、削除件数を返します
+func (r *idempotencyKeyRepository) DeleteExpiredKeys(ctx context.Context, expiredBefore time.Time, limit int) (int64, error) {
+	r.logger.InfoContext(ctx, "Repository: DeleteExpiredKeys started",
+		slog.Time("expired_before", expiredBefore),
+		slog.Int("limit", limit),
+	)
+
+	rowsAffected, err := models.IdempotencyKeys.Delete(
+		dm.Where(models.IdempotencyKeys.Columns.ExpiresAt.LT(mysql.Arg(expiredBefore))),
+		dm.OrderBy(mysql.Raw("expires_at ASC")),
+		dm.Limit(int64(limit)),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to delete expired idempotency keys",
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: DeleteExpiredKeys completed",
+		slog.Int64("count", rowsAffected),
+	)
+	return rowsAffected, nil
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aarondl/opt/null"
@@ -259,6 +261,97 @@ func (r *interpretationItemRepository) ApproveItems(ctx context.Context, approva
 	return nil
 }
 
+// ClearTaskResourceIDs は完全削除したタスクを参照するアイテムのresource_idを解除します（トランザクション内で実行されることを想定）
+// resource_idには外部キーがないため、タスクの物理削除と合わせて解除しないと存在しないタスクを指したまま残ります
+func (r *interpretationItemRepository) ClearTaskResourceIDs(ctx context.Context, taskIDs []string) error {
+	r.logger.InfoContext(ctx, "Repository: ClearTaskResourceIDs started",
+		slog.Int("count", len(taskIDs)),
+	)
+
+	if len(taskIDs) == 0 {
+		return nil
+	}
+
+	setter := &models.InterpretationItemSetter{
+		ResourceID: omitnull.FromNull(null.Val[string]{}),
+		UpdatedAt:  omit.From(time.Now()),
+	}
+
+	rowsAffected, err := models.InterpretationItems.Update(
+		setter.UpdateMod(),
+		um.Where(models.InterpretationItems.Columns.ResourceType.EQ(mysql.Arg(string(entity.ResourceTypeTask)))),
+		um.Where(models.InterpretationItems.Columns.ResourceID.In(stringArgs(taskIDs)...)),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to clear task resource ids",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to clear task resource ids: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: ClearTaskResourceIDs completed",
+		slog.Int64("count", rowsAffected),
+	)
+	return nil
+}
+
+// GetTaskTagsByTaskIDs は承認済みのアイテムから作成されたタスクのタグをタスクIDごとに取得します
+// タグはアイテムのデータ（AI解釈・インポートの内容）にのみ保持されるため、アイテムのないタスクは含みません
+func (r *interpretationItemRepository) GetTaskTagsByTaskIDs(ctx context.Context, taskIDs []string) (map[string][]string, error) {
+	r.logger.InfoContext(ctx, "Repository: GetTaskTagsByTaskIDs started",
+		slog.Int("count", len(taskIDs)),
+	)
+
+	tags := make(map[string][]string)
+	if len(taskIDs) == 0 {
+		return tags, nil
+	}
+
+	args := make([]bob.Expression, len(taskIDs))
+	for i, id := range taskIDs {
+		args[i] = mysql.Arg(id)
+	}
+
+	dbItems, err := models.InterpretationItems.Query(
+		sm.Where(models.InterpretationItems.Columns.ResourceType.EQ(mysql.Arg(string(entity.ResourceTypeTask)))),
+		sm.Where(models.InterpretationItems.Columns.Status.EQ(mysql.Arg(string(entity.ItemStatusCreated)))),
+		sm.Where(models.InterpretationItems.Columns.ResourceID.In(args...)),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query task items",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get task tags: %w", err)
+	}
+
+	for _, dbItem := range dbItems {
+		taskID, ok := dbItem.ResourceID.Get()
+		if !ok {
+			continue
+		}
+		var data entity.TaskData
+		if err := json.Unmarshal(dbItem.Data.Val, &data); err != nil {
+			r.logger.WarnContext(ctx, "Repository: Failed to parse task item data",
+				slog.String("item_id", dbItem.ID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		for _, tag := range data.Tags {
+			if tag = strings.TrimSpace(tag); tag != "" && !slices.Contains(tags[taskID], tag) {
+				tags[taskID] = append(tags[taskID], tag)
+			}
+		}
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetTaskTagsByTaskIDs completed",
+		slog.Int("count", len(tags)),
+	)
+	return tags, nil
+}
+
 // toEntity はDB modelをentityに変換します
 func (r *interpretationItemRepository) toEntity(dbItem *models.InterpretationItem) (*entity.InterpretationItem, error) {
 	item := &entity.InterpretationItem{
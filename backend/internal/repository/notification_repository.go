@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/dialect"
+	"github.com/stephenafamo/bob/dialect/mysql/dm"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/stephenafamo/bob/dialect/mysql/um"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type notificationRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewNotificationRepository は新しいNotificationRepositoryを生成します
+func NewNotificationRepository(db *sql.DB, logger *slog.Logger) interfaces.NotificationRepository {
+	return NewNotificationRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewNotificationRepositoryWithExecutor は既存のexecutorを使ってNotificationRepositoryを生成します
+func NewNotificationRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.NotificationRepository {
+	return &notificationRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// CreateNotification は未読のアプリ内通知を作成します
+func (r *notificationRepository) CreateNotification(ctx context.Context, notification *models.Notification) error {
+	r.logger.InfoContext(ctx, "Repository: CreateNotification started",
+		slog.String("user_id", notification.UserID),
+		slog.String("type", notification.Type),
+	)
+
+	// UUIDを生成
+	if notification.ID == "" {
+		notification.ID = uuid.New().String()
+	}
+	notification.CreatedAt = time.Now()
+
+	_, err := models.Notifications.Insert(
+		&models.NotificationSetter{
+			ID:        omit.From(notification.ID),
+			UserID:    omit.From(notification.UserID),
+			Type:      omit.From(notification.Type),
+			Title:     omit.From(notification.Title),
+			Body:      omitnull.FromNull(notification.Body),
+			TaskID:    omitnull.FromNull(notification.TaskID),
+			CreatedAt: omit.From(notification.CreatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to create notification",
+			slog.String("notification_id", notification.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateNotification completed",
+		slog.String("notification_id", notification.ID),
+	)
+	return nil
+}
+
+// GetNotificationByID はIDで通知を取得します
+func (r *notificationRepository) GetNotificationByID(ctx context.Context, id string) (*models.Notification, error) {
+	r.logger.InfoContext(ctx, "Repository: GetNotificationByID started",
+		slog.String("notification_id", id),
+	)
+
+	notification, err := models.Notifications.Query(
+		sm.Where(models.Notifications.Columns.ID.EQ(mysql.Arg(id))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "Repository: Notification not found",
+				slog.String("notification_id", id),
+			)
+			return nil, fmt.Errorf("notification not found: %s", id)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query notification",
+			slog.String("notification_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find notification: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetNotificationByID completed",
+		slog.String("notification_id", id),
+	)
+	return notification, nil
+}
+
+// ListNotifications はユーザーの通知を作成日時の新しい順に取得します
+// cursorを指定した場合はその通知より古いものだけを取得します
+func (r *notificationRepository) ListNotifications(ctx context.Context, userID string, unreadOnly bool, cursor *entity.NotificationCursor, limit int) (models.NotificationSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: ListNotifications started",
+		slog.String("user_id", userID),
+		slog.Bool("unread_only", unreadOnly),
+		slog.Int("limit", limit),
+	)
+
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Notifications.Columns.UserID.EQ(mysql.Arg(userID))),
+	}
+	if unreadOnly {
+		mods = append(mods, sm.Where(models.Notifications.Columns.ReadAt.IsNull()))
+	}
+	if cursor != nil {
+		mods = append(mods, sm.Where(mysql.Raw("(created_at < ? OR (created_at = ? AND id < ?))", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)))
+	}
+	mods = append(mods,
+		sm.OrderBy(mysql.Raw("created_at DESC, id DESC")),
+		sm.Limit(int64(limit)),
+	)
+
+	notifications, err := models.Notifications.Query(mods...).All(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query notifications",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: ListNotifications completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(notifications)),
+	)
+	return notifications, nil
+}
+
+// CountUnreadNotifications はユーザーの未読の通知数を取得します
+func (r *notificationRepository) CountUnreadNotifications(ctx context.Context, userID string) (int64, error) {
+	count, err := models.Notifications.Query(
+		sm.Where(models.Notifications.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.Notifications.Columns.ReadAt.IsNull()),
+	).Count(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to count unread notifications",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+// MarkNotificationRead は未読の通知を既読にします（既読の場合は何もしません）
+func (r *notificationRepository) MarkNotificationRead(ctx context.Context, id string, readAt time.Time) error {
+	r.logger.InfoContext(ctx, "Repository: MarkNotificationRead started",
+		slog.String("notification_id", id),
+	)
+
+	_, err := models.Notifications.Update(
+		um.SetCol("read_at").ToArg(readAt),
+		um.Where(models.Notifications.Columns.ID.EQ(mysql.Arg(id))),
+		um.Where(models.Notifications.Columns.ReadAt.IsNull()),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to mark notification read",
+			slog.String("notification_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to mark notification read: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: MarkNotificationRead completed",
+		slog.String("notification_id", id),
+	)
+	return nil
+}
+
+// MarkAllNotificationsRead はユーザーの未読の通知をすべて既読にし、既読にした件数を返します
+func (r *notificationRepository) MarkAllNotificationsRead(ctx context.Context, userID string, readAt time.Time) (int64, error) {
+	r.logger.InfoContext(ctx, "Repository: MarkAllNotificationsRead started",
+		slog.String("user_id", userID),
+	)
+
+	rowsAffected, err := models.Notifications.Update(
+		um.SetCol("read_at").ToArg(readAt),
+		um.Where(models.Notifications.Columns.UserID.EQ(mysql.Arg(userID))),
+		um.Where(models.Notifications.Columns.ReadAt.IsNull()),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to mark all notifications read",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("failed to mark all notifications read: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: MarkAllNotificationsRead completed",
+		slog.String("user_id", userID),
+		slog.Int64("count", rowsAffected),
+	)
+	return rowsAffected, nil
+}
+
+// DeleteNotification は通知を削除します
+func (r *notificationRepository) DeleteNotification(ctx context.Context, id string) error {
+	r.logger.InfoContext(ctx, "Repository: DeleteNotification started",
+		slog.String("notification_id", id),
+	)
+
+	_, err := models.Notifications.Delete(
+		dm.Where(models.Notifications.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to delete notification",
+			slog.String("notification_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to delete notification: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: DeleteNotification completed",
+		slog.String("notification_id", id),
+	)
+	return nil
+}
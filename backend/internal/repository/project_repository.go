@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/dialect"
+	"github.com/stephenafamo/bob/dialect/mysql/dm"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/stephenafamo/bob/dialect/mysql/um"
+	"github.com/yoshioka0101/ai_plan_chat/dberrors"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type projectRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewProjectRepository は新しいProjectRepositoryを生成します
+func NewProjectRepository(db *sql.DB, logger *slog.Logger) interfaces.ProjectRepository {
+	return NewProjectRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewProjectRepositoryWithExecutor は既存のexecutorを使ってProjectRepositoryを生成します
+func NewProjectRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.ProjectRepository {
+	return &projectRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetProjectByID はIDでプロジェクトを取得します
+func (r *projectRepository) GetProjectByID(ctx context.Context, id string) (*models.Project, error) {
+	r.logger.InfoContext(ctx, "Repository: GetProjectByID started",
+		slog.String("project_id", id),
+	)
+
+	project, err := models.Projects.Query(
+		sm.Where(models.Projects.Columns.ID.EQ(mysql.Arg(id))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "Repository: Project not found",
+				slog.String("project_id", id),
+			)
+			return nil, fmt.Errorf("project not found: %s", id)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query project",
+			slog.String("project_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find project: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetProjectByID completed",
+		slog.String("project_id", id),
+	)
+	return project, nil
+}
+
+// GetProjectsByUserID はユーザーのプロジェクト一覧を表示順で取得します
+func (r *projectRepository) GetProjectsByUserID(ctx context.Context, userID string, includeArchived bool) (models.ProjectSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetProjectsByUserID started",
+		slog.String("user_id", userID),
+		slog.Bool("include_archived", includeArchived),
+	)
+
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Projects.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.OrderBy(mysql.Raw("sort_order ASC, created_at ASC")),
+	}
+	if !includeArchived {
+		mods = append(mods, sm.Where(models.Projects.Columns.Archived.EQ(mysql.Arg(false))))
+	}
+
+	projects, err := models.Projects.Query(mods...).All(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query projects by user",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetProjectsByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(projects)),
+	)
+	return projects, nil
+}
+
+// CreateProject は新しいプロジェクトを作成します
+func (r *projectRepository) CreateProject(ctx context.Context, project *models.Project) error {
+	r.logger.InfoContext(ctx, "Repository: CreateProject started",
+		slog.String("project_id", project.ID),
+		slog.String("name", project.Name),
+	)
+
+	// UUIDを生成
+	if project.ID == "" {
+		project.ID = uuid.New().String()
+	}
+
+	// 現在時刻を設定
+	now := time.Now()
+	project.CreatedAt = now
+	project.UpdatedAt = now
+
+	_, err := models.Projects.Insert(
+		&models.ProjectSetter{
+			ID:        omit.From(project.ID),
+			UserID:    omit.From(project.UserID),
+			Name:      omit.From(project.Name),
+			Color:     omitnull.FromNull(project.Color),
+			Archived:  omit.From(project.Archived),
+			SortOrder: omit.From(project.SortOrder),
+			CreatedAt: omit.From(project.CreatedAt),
+			UpdatedAt: omit.From(project.UpdatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		if errors.Is(dberrors.ProjectErrors.ErrUniqueUkProjectsUserName, err) {
+			r.logger.WarnContext(ctx, "Repository: Project name already exists",
+				slog.String("name", project.Name),
+			)
+			return fmt.Errorf("project already exists: %s", project.Name)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to create project",
+			slog.String("project_id", project.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create project: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateProject completed",
+		slog.String("project_id", project.ID),
+	)
+	return nil
+}
+
+// UpdateProject はプロジェクトを更新します
+func (r *projectRepository) UpdateProject(ctx context.Context, project *models.Project) error {
+	r.logger.InfoContext(ctx, "Repository: UpdateProject started",
+		slog.String("project_id", project.ID),
+	)
+
+	// 更新時刻を設定
+	project.UpdatedAt = time.Now()
+
+	setter := &models.ProjectSetter{
+		Name:      omit.From(project.Name),
+		Color:     omitnull.FromNull(project.Color),
+		Archived:  omit.From(project.Archived),
+		SortOrder: omit.From(project.SortOrder),
+		UpdatedAt: omit.From(project.UpdatedAt),
+	}
+
+	_, err := models.Projects.Update(
+		setter.UpdateMod(),
+		um.Where(models.Projects.Columns.ID.EQ(mysql.Arg(project.ID))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		if errors.Is(dberrors.ProjectErrors.ErrUniqueUkProjectsUserName, err) {
+			r.logger.WarnContext(ctx, "Repository: Project name already exists",
+				slog.String("name", project.Name),
+			)
+			return fmt.Errorf("project already exists: %s", project.Name)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to update project",
+			slog.String("project_id", project.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: UpdateProject completed",
+		slog.String("project_id", project.ID),
+	)
+	return nil
+}
+
+// DeleteProject はプロジェクトを削除します（所属タスクのproject_idは外部キーによりNULLになる）
+func (r *projectRepository) DeleteProject(ctx context.Context, id string) error {
+	r.logger.InfoContext(ctx, "Repository: DeleteProject started",
+		slog.String("project_id", id),
+	)
+
+	_, err := models.Projects.Delete(
+		dm.Where(models.Projects.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to delete project",
+			slog.String("project_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: DeleteProject completed",
+		slog.String("project_id", id),
+	)
+	return nil
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/dm"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type taskAttachmentRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewTaskAttachmentRepository は新しいTaskAttachmentRepositoryを生成します
+func NewTaskAttachmentRepository(db *sql.DB, logger *slog.Logger) interfaces.TaskAttachmentRepository {
+	return NewTaskAttachmentRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewTaskAttachmentRepositoryWithExecutor は既存のexecutorを使ってTaskAttachmentRepositoryを生成します
+func NewTaskAttachmentRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.TaskAttachmentRepository {
+	return &taskAttachmentRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetAttachmentByID はIDで添付ファイルを取得します
+func (r *taskAttachmentRepository) GetAttachmentByID(ctx context.Context, id string) (*models.TaskAttachment, error) {
+	r.logger.InfoContext(ctx, "Repository: GetAttachmentByID started",
+		slog.String("attachment_id", id),
+	)
+
+	attachment, err := models.TaskAttachments.Query(
+		sm.Where(models.TaskAttachments.Columns.ID.EQ(mysql.Arg(id))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "Repository: Attachment not found",
+				slog.String("attachment_id", id),
+			)
+			return nil, fmt.Errorf("attachment not found: %s", id)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query attachment",
+			slog.String("attachment_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find attachment: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetAttachmentByID completed",
+		slog.String("attachment_id", id),
+	)
+	return attachment, nil
+}
+
+// GetAttachmentsByTaskID はタスクの添付ファイルをアップロード日時の古い順に取得します
+func (r *taskAttachmentRepository) GetAttachmentsByTaskID(ctx context.Context, taskID string) (models.TaskAttachmentSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetAttachmentsByTaskID started",
+		slog.String("task_id", taskID),
+	)
+
+	attachments, err := models.TaskAttachments.Query(
+		sm.Where(models.TaskAttachments.Columns.TaskID.EQ(mysql.Arg(taskID))),
+		sm.OrderBy(mysql.Raw("created_at ASC, id ASC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query attachments by task",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetAttachmentsByTaskID completed",
+		slog.String("task_id", taskID),
+		slog.Int("count", len(attachments)),
+	)
+	return attachments, nil
+}
+
+// CountAttachmentsByTaskID はタスクの添付ファイルの件数を取得します
+func (r *taskAttachmentRepository) CountAttachmentsByTaskID(ctx context.Context, taskID string) (int64, error) {
+	count, err := models.TaskAttachments.Query(
+		sm.Where(models.TaskAttachments.Columns.TaskID.EQ(mysql.Arg(taskID))),
+	).Count(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to count attachments",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("failed to count attachments: %w", err)
+	}
+	return count, nil
+}
+
+// GetAttachmentsOfDeletedTasks は指定日時より前にゴミ箱へ移動したタスクの添付ファイルを最大limit件取得します
+// タスクの完全削除の前にストレージ上のファイルを削除するために使用します
+func (r *taskAttachmentRepository) GetAttachmentsOfDeletedTasks(ctx context.Context, deletedBefore time.Time, limit int) (models.TaskAttachmentSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetAttachmentsOfDeletedTasks started",
+		slog.Time("deleted_before", deletedBefore),
+		slog.Int("limit", limit),
+	)
+
+	attachments, err := models.TaskAttachments.Query(
+		sm.Where(mysql.Raw("task_id IN (SELECT id FROM tasks WHERE deleted_at IS NOT NULL AND deleted_at < ?)", deletedBefore)),
+		sm.OrderBy(mysql.Raw("created_at ASC, id ASC")),
+		sm.Limit(int64(limit)),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query attachments of deleted tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetAttachmentsOfDeletedTasks completed",
+		slog.Int("count", len(attachments)),
+	)
+	return attachments, nil
+}
+
+// CreateAttachment は添付ファイルのメタデータを作成します
+func (r *taskAttachmentRepository) CreateAttachment(ctx context.Context, attachment *models.TaskAttachment) error {
+	r.logger.InfoContext(ctx, "Repository: CreateAttachment started",
+		slog.String("task_id", attachment.TaskID),
+	)
+
+	// UUIDを生成
+	if attachment.ID == "" {
+		attachment.ID = uuid.New().String()
+	}
+
+	// 現在時刻を設定
+	attachment.CreatedAt = time.Now()
+
+	_, err := models.TaskAttachments.Insert(
+		&models.TaskAttachmentSetter{
+			ID:             omit.From(attachment.ID),
+			UserID:         omit.From(attachment.UserID),
+			TaskID:         omit.From(attachment.TaskID),
+			Filename:       omit.From(attachment.Filename),
+			ContentType:    omit.From(attachment.ContentType),
+			SizeBytes:      omit.From(attachment.SizeBytes),
+			ChecksumSha256: omit.From(attachment.ChecksumSha256),
+			StorageKey:     omit.From(attachment.StorageKey),
+			CreatedAt:      omit.From(attachment.CreatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to create attachment",
+			slog.String("attachment_id", attachment.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateAttachment completed",
+		slog.String("attachment_id", attachment.ID),
+	)
+	return nil
+}
+
+// DeleteAttachment は添付ファイルのメタデータを削除します
+func (r *taskAttachmentRepository) DeleteAttachment(ctx context.Context, id string) error {
+	r.logger.InfoContext(ctx, "Repository: DeleteAttachment started",
+		slog.String("attachment_id", id),
+	)
+
+	_, err := models.TaskAttachments.Delete(
+		dm.Where(models.TaskAttachments.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to delete attachment",
+			slog.String("attachment_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: DeleteAttachment completed",
+		slog.String("attachment_id", id),
+	)
+	return nil
+}
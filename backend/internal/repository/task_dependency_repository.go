@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/dm"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type taskDependencyRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewTaskDependencyRepository は新しいTaskDependencyRepositoryを生成します
+func NewTaskDependencyRepository(db *sql.DB, logger *slog.Logger) interfaces.TaskDependencyRepository {
+	return NewTaskDependencyRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewTaskDependencyRepositoryWithExecutor は既存のexecutorを使ってTaskDependencyRepositoryを生成します
+func NewTaskDependencyRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.TaskDependencyRepository {
+	return &taskDependencyRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetDependenciesByUserID はユーザーのタスク間の依存関係を全て取得します（循環検出用）
+// 復元時に循環が生じないよう、ゴミ箱内のタスクの依存関係も含めます
+func (r *taskDependencyRepository) GetDependenciesByUserID(ctx context.Context, userID string) (models.TaskDependencySlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetDependenciesByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	dependencies, err := models.TaskDependencies.Query(
+		sm.Where(mysql.Raw("task_id IN (SELECT id FROM tasks WHERE user_id = ?)", userID)),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query task dependencies",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get task dependencies: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetDependenciesByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(dependencies)),
+	)
+	return dependencies, nil
+}
+
+// GetDependenciesByUserIDForUpdate はユーザー行をロックした上でユーザーのタスク間の依存関係を全て取得します
+// 同時に追加された依存関係が互いに循環検出をすり抜けないよう、トランザクション内で使用して直列化します
+func (r *taskDependencyRepository) GetDependenciesByUserIDForUpdate(ctx context.Context, userID string) (models.TaskDependencySlice, error) {
+	_, err := models.Users.Query(
+		sm.Where(models.Users.Columns.ID.EQ(mysql.Arg(userID))),
+		sm.ForUpdate(),
+	).One(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to lock user for task dependencies",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to lock user for task dependencies: %w", err)
+	}
+
+	return r.GetDependenciesByUserID(ctx, userID)
+}
+
+// GetBlockingTasks は指定タスクが依存している先行タスク一覧を取得します
+func (r *taskDependencyRepository) GetBlockingTasks(ctx context.Context, taskID string) (models.TaskSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetBlockingTasks started",
+		slog.String("task_id", taskID),
+	)
+
+	tasks, err := models.Tasks.Query(
+		sm.Where(mysql.Raw("id IN (SELECT depends_on_task_id FROM task_dependencies WHERE task_id = ?)", taskID)),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(mysql.Raw("created_at DESC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query blocking tasks",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get blocking tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetBlockingTasks completed",
+		slog.String("task_id", taskID),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// GetDependentTasks は指定タスクに依存している後続タスク一覧を取得します
+func (r *taskDependencyRepository) GetDependentTasks(ctx context.Context, taskID string) (models.TaskSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetDependentTasks started",
+		slog.String("task_id", taskID),
+	)
+
+	tasks, err := models.Tasks.Query(
+		sm.Where(mysql.Raw("id IN (SELECT task_id FROM task_dependencies WHERE depends_on_task_id = ?)", taskID)),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(mysql.Raw("created_at DESC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query dependent tasks",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get dependent tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetDependentTasks completed",
+		slog.String("task_id", taskID),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// GetBlockedTaskIDs は指定タスクのうち未完了の先行タスクを持つもののIDを取得します（削除済みの先行タスクはブロックしない）
+func (r *taskDependencyRepository) GetBlockedTaskIDs(ctx context.Context, taskIDs []string) (map[string]bool, error) {
+	r.logger.InfoContext(ctx, "Repository: GetBlockedTaskIDs started",
+		slog.Int("count", len(taskIDs)),
+	)
+
+	blocked := make(map[string]bool)
+	if len(taskIDs) == 0 {
+		return blocked, nil
+	}
+
+	args := make([]bob.Expression, len(taskIDs))
+	for i, id := range taskIDs {
+		args[i] = mysql.Arg(id)
+	}
+
+	dependencies, err := models.TaskDependencies.Query(
+		sm.Where(models.TaskDependencies.Columns.TaskID.In(args...)),
+		sm.Where(mysql.Raw("depends_on_task_id IN (SELECT id FROM tasks WHERE status_category <> 'done' AND deleted_at IS NULL)")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query blocked tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get blocked tasks: %w", err)
+	}
+
+	for _, dependency := range dependencies {
+		blocked[dependency.TaskID] = true
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetBlockedTaskIDs completed",
+		slog.Int("blocked_count", len(blocked)),
+	)
+	return blocked, nil
+}
+
+// CreateDependency は依存関係を作成します
+func (r *taskDependencyRepository) CreateDependency(ctx context.Context, dependency *models.TaskDependency) error {
+	r.logger.InfoContext(ctx, "Repository: CreateDependency started",
+		slog.String("task_id", dependency.TaskID),
+		slog.String("depends_on_task_id", dependency.DependsOnTaskID),
+	)
+
+	if dependency.ID == "" {
+		dependency.ID = uuid.New().String()
+	}
+	dependency.CreatedAt = time.Now()
+
+	_, err := models.TaskDependencies.Insert(
+		&models.TaskDependencySetter{
+			ID:              omit.From(dependency.ID),
+			TaskID:          omit.From(dependency.TaskID),
+			DependsOnTaskID: omit.From(dependency.DependsOnTaskID),
+			CreatedAt:       omit.From(dependency.CreatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to create dependency",
+			slog.String("task_id", dependency.TaskID),
+			slog.String("depends_on_task_id", dependency.DependsOnTaskID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create task dependency: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateDependency completed",
+		slog.String("dependency_id", dependency.ID),
+	)
+	return nil
+}
+
+// DeleteDependency は依存関係を削除します
+func (r *taskDependencyRepository) DeleteDependency(ctx context.Context, taskID, dependsOnTaskID string) error {
+	r.logger.InfoContext(ctx, "Repository: DeleteDependency started",
+		slog.String("task_id", taskID),
+		slog.String("depends_on_task_id", dependsOnTaskID),
+	)
+
+	rowsAffected, err := models.TaskDependencies.Delete(
+		dm.Where(models.TaskDependencies.Columns.TaskID.EQ(mysql.Arg(taskID))),
+		dm.Where(models.TaskDependencies.Columns.DependsOnTaskID.EQ(mysql.Arg(dependsOnTaskID))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to delete dependency",
+			slog.String("task_id", taskID),
+			slog.String("depends_on_task_id", dependsOnTaskID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to delete task dependency: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("task dependency not found: %s -> %s", taskID, dependsOnTaskID)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: DeleteDependency completed",
+		slog.String("task_id", taskID),
+		slog.String("depends_on_task_id", dependsOnTaskID),
+	)
+	return nil
+}
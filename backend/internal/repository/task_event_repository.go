@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type taskEventRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewTaskEventRepository は新しいTaskEventRepositoryを生成します
+func NewTaskEventRepository(db *sql.DB, logger *slog.Logger) interfaces.TaskEventRepository {
+	return NewTaskEventRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewTaskEventRepositoryWithExecutor は既存のexecutorを使ってTaskEventRepositoryを生成します
+func NewTaskEventRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.TaskEventRepository {
+	return &taskEventRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetEventsByTaskID はタスクの変更履歴を記録順に取得します
+func (r *taskEventRepository) GetEventsByTaskID(ctx context.Context, userID string, taskID string) (models.TaskEventSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetEventsByTaskID started",
+		slog.String("task_id", taskID),
+	)
+
+	events, err := models.TaskEvents.Query(
+		sm.Where(models.TaskEvents.Columns.TaskID.EQ(mysql.Arg(taskID))),
+		sm.Where(models.TaskEvents.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.OrderBy(mysql.Raw("created_at ASC, id ASC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query task events",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get task events: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetEventsByTaskID completed",
+		slog.String("task_id", taskID),
+		slog.Int("count", len(events)),
+	)
+	return events, nil
+}
+
+// CreateEvent は変更履歴を追記します（履歴は更新・削除しない）
+func (r *taskEventRepository) CreateEvent(ctx context.Context, event *models.TaskEvent) error {
+	r.logger.InfoContext(ctx, "Repository: CreateEvent started",
+		slog.String("task_id", event.TaskID),
+		slog.String("event_type", event.EventType),
+	)
+
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+	event.CreatedAt = time.Now()
+
+	_, err := models.TaskEvents.Insert(
+		&models.TaskEventSetter{
+			ID:        omit.From(event.ID),
+			TaskID:    omit.From(event.TaskID),
+			UserID:    omit.From(event.UserID),
+			ActorID:   omitnull.FromNull(event.ActorID),
+			EventType: omit.From(event.EventType),
+			Source:    omit.From(event.Source),
+			Changes:   omit.From(event.Changes),
+			CreatedAt: omit.From(event.CreatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to create task event",
+			slog.String("task_id", event.TaskID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create task event: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateEvent completed",
+		slog.String("event_id", event.ID),
+	)
+	return nil
+}
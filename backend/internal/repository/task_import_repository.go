@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/yoshioka0101/ai_plan_chat/dberrors"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// taskImportLookupBatchSize は取り込み済みの識別子を1回のクエリで照合する最大件数
+const taskImportLookupBatchSize = 500
+
+type taskImportRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewTaskImportRepository は新しいTaskImportRepositoryを生成します
+func NewTaskImportRepository(db *sql.DB, logger *slog.Logger) interfaces.TaskImportRepository {
+	return NewTaskImportRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewTaskImportRepositoryWithExecutor は既存のexecutorを使ってTaskImportRepositoryを生成します
+func NewTaskImportRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.TaskImportRepository {
+	return &taskImportRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetImportedTaskIDs は取り込み済みの識別子と対応するタスクIDを返します（未取り込みの識別子は含まれません）
+func (r *taskImportRepository) GetImportedTaskIDs(ctx context.Context, userID string, source string, externalIDs []string) (map[string]string, error) {
+	r.logger.InfoContext(ctx, "Repository: GetImportedTaskIDs started",
+		slog.String("user_id", userID),
+		slog.String("source", source),
+		slog.Int("count", len(externalIDs)),
+	)
+
+	result := make(map[string]string)
+	for start := 0; start < len(externalIDs); start += taskImportLookupBatchSize {
+		end := min(start+taskImportLookupBatchSize, len(externalIDs))
+
+		args := make([]bob.Expression, 0, end-start)
+		for _, id := range externalIDs[start:end] {
+			args = append(args, mysql.Arg(id))
+		}
+
+		imports, err := models.TaskImports.Query(
+			sm.Where(models.TaskImports.Columns.UserID.EQ(mysql.Arg(userID))),
+			sm.Where(models.TaskImports.Columns.Source.EQ(mysql.Arg(source))),
+			sm.Where(models.TaskImports.Columns.ExternalID.In(args...)),
+		).All(ctx, r.db)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "Repository: Failed to query task imports",
+				slog.String("user_id", userID),
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("failed to get task imports: %w", err)
+		}
+
+		for _, record := range imports {
+			result[record.ExternalID] = record.TaskID
+		}
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetImportedTaskIDs completed",
+		slog.Int("count", len(result)),
+	)
+	return result, nil
+}
+
+// CreateImport は取り込んだタスクと取り込み元の識別子の対応を記録します
+func (r *taskImportRepository) CreateImport(ctx context.Context, record *models.TaskImport) error {
+	// UUIDを生成
+	if record.ID == "" {
+		record.ID = uuid.New().String()
+	}
+	record.CreatedAt = time.Now()
+
+	_, err := models.TaskImports.Insert(
+		&models.TaskImportSetter{
+			ID:         omit.From(record.ID),
+			UserID:     omit.From(record.UserID),
+			Source:     omit.From(record.Source),
+			ExternalID: omit.From(record.ExternalID),
+			TaskID:     omit.From(record.TaskID),
+			CreatedAt:  omit.From(record.CreatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		if errors.Is(dberrors.TaskImportErrors.ErrUniqueUkTaskImportsUserSourceExternal, err) {
+			r.logger.WarnContext(ctx, "Repository: Task import already exists",
+				slog.String("source", record.Source),
+				slog.String("external_id", record.ExternalID),
+			)
+			return fmt.Errorf("task import already exists: %s", record.ExternalID)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to create task import",
+			slog.String("task_id", record.TaskID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create task import: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/stephenafamo/bob/dialect/mysql/um"
+	"github.com/yoshioka0101/ai_plan_chat/dberrors"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// maxReminderErrorLength は記録する配信エラーの最大長（カラムの長さに合わせる）
+const maxReminderErrorLength = 1000
+
+type taskReminderRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewTaskReminderRepository は新しいTaskReminderRepositoryを生成します
+func NewTaskReminderRepository(db *sql.DB, logger *slog.Logger) interfaces.TaskReminderRepository {
+	return NewTaskReminderRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewTaskReminderRepositoryWithExecutor は既存のexecutorを使ってTaskReminderRepositoryを生成します
+func NewTaskReminderRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.TaskReminderRepository {
+	return &taskReminderRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// CreateDelivery は配信中のリマインダーを登録して配信権を得ます
+// 同じタスク・期限・タイミング・チャネルの組が既にある場合はエラーを返します
+func (r *taskReminderRepository) CreateDelivery(ctx context.Context, delivery *models.TaskReminder) error {
+	r.logger.InfoContext(ctx, "Repository: CreateDelivery started",
+		slog.String("task_id", delivery.TaskID),
+		slog.String("channel", delivery.Channel),
+	)
+
+	// UUIDを生成
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
+	now := time.Now()
+	delivery.Status = string(entity.ReminderDeliveryStatusPending)
+	delivery.Attempts = 1
+	delivery.CreatedAt = now
+	delivery.UpdatedAt = now
+
+	_, err := models.TaskReminders.Insert(
+		&models.TaskReminderSetter{
+			ID:            omit.From(delivery.ID),
+			TaskID:        omit.From(delivery.TaskID),
+			DueAt:         omit.From(delivery.DueAt),
+			OffsetMinutes: omit.From(delivery.OffsetMinutes),
+			Channel:       omit.From(delivery.Channel),
+			Status:        omit.From(delivery.Status),
+			Attempts:      omit.From(delivery.Attempts),
+			CreatedAt:     omit.From(delivery.CreatedAt),
+			UpdatedAt:     omit.From(delivery.UpdatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		if errors.Is(dberrors.TaskReminderErrors.ErrUniqueUkTaskRemindersDelivery, err) {
+			return fmt.Errorf("task reminder already exists: %s", delivery.TaskID)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to create task reminder",
+			slog.String("task_id", delivery.TaskID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create task reminder: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateDelivery completed",
+		slog.String("task_reminder_id", delivery.ID),
+	)
+	return nil
+}
+
+// RetryDelivery は配信に失敗したリマインダー、またはstaleBeforeより前から配信中のままのリマインダーを
+// 試行回数がmaxAttempts未満の場合に限り配信中に戻して配信権を得ます（再試行できない場合はnil）
+func (r *taskReminderRepository) RetryDelivery(ctx context.Context, taskID string, dueAt time.Time, offsetMinutes int32, channel string, staleBefore time.Time, maxAttempts int32) (*models.TaskReminder, error) {
+	r.logger.InfoContext(ctx, "Repository: RetryDelivery started",
+		slog.String("task_id", taskID),
+		slog.String("channel", channel),
+	)
+
+	rowsAffected, err := models.TaskReminders.Update(
+		um.SetCol("status").ToArg(string(entity.ReminderDeliveryStatusPending)),
+		um.SetCol("attempts").To(mysql.Raw("attempts + 1")),
+		um.SetCol("updated_at").ToArg(time.Now()),
+		um.Where(models.TaskReminders.Columns.TaskID.EQ(mysql.Arg(taskID))),
+		um.Where(models.TaskReminders.Columns.DueAt.EQ(mysql.Arg(dueAt))),
+		um.Where(models.TaskReminders.Columns.OffsetMinutes.EQ(mysql.Arg(offsetMinutes))),
+		um.Where(models.TaskReminders.Columns.Channel.EQ(mysql.Arg(channel))),
+		um.Where(models.TaskReminders.Columns.Attempts.LT(mysql.Arg(maxAttempts))),
+		um.Where(mysql.Or(
+			models.TaskReminders.Columns.Status.EQ(mysql.Arg(string(entity.ReminderDeliveryStatusFailed))),
+			mysql.And(
+				models.TaskReminders.Columns.Status.EQ(mysql.Arg(string(entity.ReminderDeliveryStatusPending))),
+				models.TaskReminders.Columns.UpdatedAt.LT(mysql.Arg(staleBefore)),
+			),
+		)),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to retry task reminder",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to retry task reminder: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, nil
+	}
+
+	delivery, err := models.TaskReminders.Query(
+		sm.Where(models.TaskReminders.Columns.TaskID.EQ(mysql.Arg(taskID))),
+		sm.Where(models.TaskReminders.Columns.DueAt.EQ(mysql.Arg(dueAt))),
+		sm.Where(models.TaskReminders.Columns.OffsetMinutes.EQ(mysql.Arg(offsetMinutes))),
+		sm.Where(models.TaskReminders.Columns.Channel.EQ(mysql.Arg(channel))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query task reminder",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find task reminder: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: RetryDelivery completed",
+		slog.String("task_reminder_id", delivery.ID),
+		slog.Int("attempts", int(delivery.Attempts)),
+	)
+	return delivery, nil
+}
+
+// MarkDeliverySent はリマインダーを配信済みにします
+func (r *taskReminderRepository) MarkDeliverySent(ctx context.Context, id string, sentAt time.Time) error {
+	r.logger.InfoContext(ctx, "Repository: MarkDeliverySent started",
+		slog.String("task_reminder_id", id),
+	)
+
+	setter := &models.TaskReminderSetter{
+		Status:    omit.From(string(entity.ReminderDeliveryStatusSent)),
+		LastError: omitnull.FromNull(null.Val[string]{}),
+		SentAt:    omitnull.From(sentAt),
+		UpdatedAt: omit.From(time.Now()),
+	}
+
+	_, err := models.TaskReminders.Update(
+		setter.UpdateMod(),
+		um.Where(models.TaskReminders.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to mark task reminder sent",
+			slog.String("task_reminder_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to mark task reminder sent: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: MarkDeliverySent completed",
+		slog.String("task_reminder_id", id),
+	)
+	return nil
+}
+
+// MarkDeliveryFailed はリマインダーを配信失敗にしてエラーを記録します
+func (r *taskReminderRepository) MarkDeliveryFailed(ctx context.Context, id string, lastError string) error {
+	r.logger.InfoContext(ctx, "Repository: MarkDeliveryFailed started",
+		slog.String("task_reminder_id", id),
+	)
+
+	if runes := []rune(lastError); len(runes) > maxReminderErrorLength {
+		lastError = string(runes[:maxReminderErrorLength])
+	}
+
+	setter := &models.TaskReminderSetter{
+		Status:    omit.From(string(entity.ReminderDeliveryStatusFailed)),
+		LastError: omitnull.From(lastError),
+		UpdatedAt: omit.From(time.Now()),
+	}
+
+	_, err := models.TaskReminders.Update(
+		setter.UpdateMod(),
+		um.Where(models.TaskReminders.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to mark task reminder failed",
+			slog.String("task_reminder_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to mark task reminder failed: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: MarkDeliveryFailed completed",
+		slog.String("task_reminder_id", id),
+	)
+	return nil
+}
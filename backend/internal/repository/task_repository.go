@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/aarondl/opt/null"
@@ -13,10 +14,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/dialect"
 	"github.com/stephenafamo/bob/dialect/mysql/dm"
 	"github.com/stephenafamo/bob/dialect/mysql/sm"
 	"github.com/stephenafamo/bob/dialect/mysql/um"
 	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
 	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
 )
 
@@ -38,7 +41,12 @@ func NewTaskRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) inter
 	}
 }
 
-// GetTaskByID はIDでタスクを取得します
+// incrementVersion はタスクの楽観的排他制御用のバージョンを加算する更新句を返します
+func incrementVersion() bob.Mod[*dialect.UpdateQuery] {
+	return um.SetCol("version").To(mysql.Raw("version + 1"))
+}
+
+// GetTaskByID はIDでタスクを取得します（削除済みのタスクは含まない）
 func (r *taskRepository) GetTaskByID(ctx context.Context, id string) (*models.Task, error) {
 	r.logger.InfoContext(ctx, "Repository: GetTaskByID started",
 		slog.String("task_id", id),
@@ -46,6 +54,7 @@ func (r *taskRepository) GetTaskByID(ctx context.Context, id string) (*models.Ta
 
 	task, err := models.Tasks.Query(
 		sm.Where(models.Tasks.Columns.ID.EQ(mysql.Arg(id))),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
 	).One(ctx, r.db)
 
 	if err != nil {
@@ -73,6 +82,7 @@ func (r *taskRepository) GetAllTasks(ctx context.Context) (models.TaskSlice, err
 	r.logger.InfoContext(ctx, "Repository: GetAllTasks started")
 
 	tasks, err := models.Tasks.Query(
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
 		sm.OrderBy(mysql.Raw("created_at DESC")),
 	).All(ctx, r.db)
 
@@ -90,16 +100,27 @@ func (r *taskRepository) GetAllTasks(ctx context.Context) (models.TaskSlice, err
 }
 
 // GetTasksByUserID はユーザーごとのタスク一覧を取得します
-func (r *taskRepository) GetTasksByUserID(ctx context.Context, userID string) (models.TaskSlice, error) {
+// includeSnoozed・includeArchivedがfalseの場合はスヌーズ中・アーカイブしたタスクを含みません
+func (r *taskRepository) GetTasksByUserID(ctx context.Context, userID string, includeSnoozed bool, includeArchived bool) (models.TaskSlice, error) {
 	r.logger.InfoContext(ctx, "Repository: GetTasksByUserID started",
 		slog.String("user_id", userID),
+		slog.Bool("include_snoozed", includeSnoozed),
+		slog.Bool("include_archived", includeArchived),
 	)
 
-	tasks, err := models.Tasks.Query(
+	mods := []bob.Mod[*dialect.SelectQuery]{
 		sm.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
-		sm.OrderBy(mysql.Raw("created_at DESC")),
-	).All(ctx, r.db)
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(mysql.Raw("rank_key ASC, created_at DESC")),
+	}
+	if !includeSnoozed {
+		mods = append(mods, sm.Where(notSnoozed(time.Now())))
+	}
+	if !includeArchived {
+		mods = append(mods, sm.Where(models.Tasks.Columns.ArchivedAt.IsNull()))
+	}
 
+	tasks, err := models.Tasks.Query(mods...).All(ctx, r.db)
 	if err != nil {
 		r.logger.ErrorContext(ctx, "Repository: Failed to query tasks by user",
 			slog.String("user_id", userID),
@@ -115,6 +136,313 @@ func (r *taskRepository) GetTasksByUserID(ctx context.Context, userID string) (m
 	return tasks, nil
 }
 
+// GetTasksByProjectID はユーザーのタスクのうち指定プロジェクトに属するものを取得します
+// includeSnoozed・includeArchivedがfalseの場合はスヌーズ中・アーカイブしたタスクを含みません
+func (r *taskRepository) GetTasksByProjectID(ctx context.Context, userID string, projectID string, includeSnoozed bool, includeArchived bool) (models.TaskSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetTasksByProjectID started",
+		slog.String("user_id", userID),
+		slog.String("project_id", projectID),
+		slog.Bool("include_snoozed", includeSnoozed),
+		slog.Bool("include_archived", includeArchived),
+	)
+
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.Tasks.Columns.ProjectID.EQ(mysql.Arg(projectID))),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(mysql.Raw("rank_key ASC, created_at DESC")),
+	}
+	if !includeSnoozed {
+		mods = append(mods, sm.Where(notSnoozed(time.Now())))
+	}
+	if !includeArchived {
+		mods = append(mods, sm.Where(models.Tasks.Columns.ArchivedAt.IsNull()))
+	}
+
+	tasks, err := models.Tasks.Query(mods...).All(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query tasks by project",
+			slog.String("user_id", userID),
+			slog.String("project_id", projectID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetTasksByProjectID completed",
+		slog.String("project_id", projectID),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// GetTasksByQuery はユーザーのタスクのうち検索条件に一致するものを取得します（ゴミ箱内を除く）
+func (r *taskRepository) GetTasksByQuery(ctx context.Context, userID string, query entity.TaskQuery) (models.TaskSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetTasksByQuery started",
+		slog.String("user_id", userID),
+	)
+
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+	}
+	if len(query.Statuses) > 0 {
+		mods = append(mods, sm.Where(models.Tasks.Columns.Status.In(stringArgs(query.Statuses)...)))
+	}
+	if len(query.StatusCategories) > 0 {
+		mods = append(mods, sm.Where(models.Tasks.Columns.StatusCategory.In(stringArgs(query.StatusCategories)...)))
+	}
+	if len(query.ProjectIDs) > 0 {
+		mods = append(mods, sm.Where(models.Tasks.Columns.ProjectID.In(stringArgs(query.ProjectIDs)...)))
+	}
+	if len(query.Sources) > 0 {
+		mods = append(mods, sm.Where(models.Tasks.Columns.Source.In(stringArgs(query.Sources)...)))
+	}
+	if query.DueFrom != nil {
+		mods = append(mods, sm.Where(models.Tasks.Columns.DueAt.GTE(mysql.Arg(*query.DueFrom))))
+	}
+	if query.DueTo != nil {
+		mods = append(mods, sm.Where(models.Tasks.Columns.DueAt.LT(mysql.Arg(*query.DueTo))))
+	}
+	if query.HasDueDate != nil {
+		if *query.HasDueDate {
+			mods = append(mods, sm.Where(models.Tasks.Columns.DueAt.IsNotNull()))
+		} else {
+			mods = append(mods, sm.Where(models.Tasks.Columns.DueAt.IsNull()))
+		}
+	}
+	if !query.IncludeSnoozed {
+		mods = append(mods, sm.Where(notSnoozed(time.Now())))
+	}
+	if !query.IncludeArchived {
+		mods = append(mods, sm.Where(models.Tasks.Columns.ArchivedAt.IsNull()))
+	}
+	if query.TitleContains != nil {
+		// タイトルの照合順序（utf8mb4_unicode_ci）により大文字・小文字は区別しない
+		mods = append(mods, sm.Where(mysql.Raw("title LIKE ?", "%"+escapeLike(*query.TitleContains)+"%")))
+	}
+
+	switch query.Sort {
+	case entity.TaskSortDueAt:
+		mods = append(mods, sm.OrderBy(mysql.Raw("due_at IS NULL, due_at ASC, rank_key ASC")))
+	case entity.TaskSortCreatedAt:
+		mods = append(mods, sm.OrderBy(mysql.Raw("created_at DESC, id ASC")))
+	default:
+		mods = append(mods, sm.OrderBy(mysql.Raw("rank_key ASC, created_at DESC")))
+	}
+
+	tasks, err := models.Tasks.Query(mods...).All(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query tasks by filter",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetTasksByQuery completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// GetColumnTasksForUpdate はステータス列（プロジェクトがある場合はプロジェクト内）のタスクを表示順で取得し、行ロックします
+// 並べ替えの競合を避けるためトランザクション内で使用します（アーカイブしたタスクは列に表示しないため含みません）
+func (r *taskRepository) GetColumnTasksForUpdate(ctx context.Context, userID string, projectID *string, status string) (models.TaskSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetColumnTasksForUpdate started",
+		slog.String("user_id", userID),
+		slog.String("status", status),
+	)
+
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.Tasks.Columns.Status.EQ(mysql.Arg(status))),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+		sm.Where(models.Tasks.Columns.ArchivedAt.IsNull()),
+		sm.OrderBy(mysql.Raw("rank_key ASC, created_at DESC")),
+		sm.ForUpdate(),
+	}
+	if projectID != nil {
+		mods = append(mods, sm.Where(models.Tasks.Columns.ProjectID.EQ(mysql.Arg(*projectID))))
+	} else {
+		mods = append(mods, sm.Where(models.Tasks.Columns.ProjectID.IsNull()))
+	}
+
+	tasks, err := models.Tasks.Query(mods...).All(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query column tasks",
+			slog.String("user_id", userID),
+			slog.String("status", status),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get column tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetColumnTasksForUpdate completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// UpdateRankKey はタスクの表示順キーのみを更新します
+func (r *taskRepository) UpdateRankKey(ctx context.Context, id string, rankKey string) error {
+	r.logger.InfoContext(ctx, "Repository: UpdateRankKey started",
+		slog.String("task_id", id),
+	)
+
+	setter := &models.TaskSetter{
+		RankKey: omit.From(rankKey),
+	}
+
+	_, err := models.Tasks.Update(
+		setter.UpdateMod(),
+		incrementVersion(),
+		um.Where(models.Tasks.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to update rank key",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to update rank key: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: UpdateRankKey completed",
+		slog.String("task_id", id),
+	)
+	return nil
+}
+
+// CountTasksByStatus はユーザーのタスクのうち指定したステータスのものの件数を取得します
+// includeDeletedがtrueの場合はゴミ箱内のタスクも数えます
+func (r *taskRepository) CountTasksByStatus(ctx context.Context, userID string, status string, includeDeleted bool) (int64, error) {
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.Tasks.Columns.Status.EQ(mysql.Arg(status))),
+	}
+	if !includeDeleted {
+		mods = append(mods, sm.Where(models.Tasks.Columns.DeletedAt.IsNull()))
+	}
+
+	count, err := models.Tasks.Query(mods...).Count(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to count tasks by status",
+			slog.String("user_id", userID),
+			slog.String("status", status),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("failed to count tasks: %w", err)
+	}
+	return count, nil
+}
+
+// UpdateTasksStatusCategory はステータスのカテゴリの変更を、そのステータスのタスク（ゴミ箱内を含む）に反映します
+// 着手・完了日時とアーカイブはステータスを個別に変更した場合と同じ規則で記録・解除します
+func (r *taskRepository) UpdateTasksStatusCategory(ctx context.Context, userID string, status string, category string, now time.Time) (int64, error) {
+	r.logger.InfoContext(ctx, "Repository: UpdateTasksStatusCategory started",
+		slog.String("user_id", userID),
+		slog.String("status", status),
+		slog.String("category", category),
+	)
+
+	setter := &models.TaskSetter{
+		StatusCategory: omit.From(category),
+		UpdatedAt:      omit.From(now),
+	}
+	mods := []bob.Mod[*dialect.UpdateQuery]{
+		incrementVersion(),
+		um.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
+		um.Where(models.Tasks.Columns.Status.EQ(mysql.Arg(status))),
+	}
+	switch category {
+	case entity.TaskStatusCategoryTodo:
+		setter.StartedAt = omitnull.FromNull(null.Val[time.Time]{})
+		setter.CompletedAt = omitnull.FromNull(null.Val[time.Time]{})
+		setter.ArchivedAt = omitnull.FromNull(null.Val[time.Time]{})
+	case entity.TaskStatusCategoryDoing:
+		setter.CompletedAt = omitnull.FromNull(null.Val[time.Time]{})
+		setter.ArchivedAt = omitnull.FromNull(null.Val[time.Time]{})
+		mods = append(mods, um.SetCol("started_at").To(mysql.Raw("COALESCE(started_at, ?)", now)))
+	case entity.TaskStatusCategoryDone:
+		mods = append(mods, um.SetCol("completed_at").To(mysql.Raw("COALESCE(completed_at, ?)", now)))
+	}
+
+	rowsAffected, err := models.Tasks.Update(append([]bob.Mod[*dialect.UpdateQuery]{setter.UpdateMod()}, mods...)...).Exec(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to update task status categories",
+			slog.String("user_id", userID),
+			slog.String("status", status),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("failed to update task status categories: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: UpdateTasksStatusCategory completed",
+		slog.String("status", status),
+		slog.Int64("count", rowsAffected),
+	)
+	return rowsAffected, nil
+}
+
+// GetRecurringTasksByUserID はユーザーの未完了の繰り返しタスク（各シリーズの現在の回）を取得します
+func (r *taskRepository) GetRecurringTasksByUserID(ctx context.Context, userID string) (models.TaskSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetRecurringTasksByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	tasks, err := models.Tasks.Query(
+		sm.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.Tasks.Columns.RecurrenceRule.IsNotNull()),
+		sm.Where(models.Tasks.Columns.StatusCategory.NE(mysql.Arg(entity.TaskStatusCategoryDone))),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(mysql.Raw("due_at ASC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query recurring tasks",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get recurring tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetRecurringTasksByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// ExistsRecurrenceOccurrence はシリーズ内に指定日時以降の回が既に存在するかを確認します
+func (r *taskRepository) ExistsRecurrenceOccurrence(ctx context.Context, seriesID string, dueAt time.Time) (bool, error) {
+	r.logger.InfoContext(ctx, "Repository: ExistsRecurrenceOccurrence started",
+		slog.String("series_id", seriesID),
+	)
+
+	exists, err := models.Tasks.Query(
+		sm.Where(models.Tasks.Columns.RecurrenceSeriesID.EQ(mysql.Arg(seriesID))),
+		sm.Where(models.Tasks.Columns.DueAt.GTE(mysql.Arg(dueAt))),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+	).Exists(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to check recurrence occurrence",
+			slog.String("series_id", seriesID),
+			slog.String("error", err.Error()),
+		)
+		return false, fmt.Errorf("failed to check recurrence occurrence: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: ExistsRecurrenceOccurrence completed",
+		slog.String("series_id", seriesID),
+		slog.Bool("exists", exists),
+	)
+	return exists, nil
+}
+
 // CreateTask は新しいタスクを作成します
 func (r *taskRepository) CreateTask(ctx context.Context, task *models.Task) error {
 	r.logger.InfoContext(ctx, "Repository: CreateTask started",
@@ -136,17 +464,39 @@ func (r *taskRepository) CreateTask(ctx context.Context, task *models.Task) erro
 	if task.Status == "" {
 		task.Status = "todo"
 	}
+	if task.StatusCategory == "" {
+		task.StatusCategory = entity.TaskStatusCategoryTodo
+	}
+	task.Version = 1
+
+	// 着手・完了済みで作成したタスクは作成日時を着手・完了日時とする
+	if task.StatusCategory == entity.TaskStatusCategoryDoing && !task.StartedAt.IsValue() {
+		task.StartedAt = null.From(now)
+	}
+	if task.StatusCategory == entity.TaskStatusCategoryDone && !task.CompletedAt.IsValue() {
+		task.CompletedAt = null.From(now)
+	}
 
 	_, err := models.Tasks.Insert(
 		&models.TaskSetter{
 			ID:                 omit.From(task.ID),
 			UserID:             omit.From(task.UserID),
+			ProjectID:          omitnull.FromNull(task.ProjectID),
 			Title:              omit.From(task.Title),
 			Description:        omitnull.FromNull(task.Description),
 			DueAt:              omitnull.FromNull(task.DueAt),
+			EstimateMinutes:    omitnull.FromNull(task.EstimateMinutes),
 			Status:             omit.From(task.Status),
+			StatusCategory:     omit.From(task.StatusCategory),
+			RankKey:            omit.From(task.RankKey),
+			StartedAt:          omitnull.FromNull(task.StartedAt),
+			CompletedAt:        omitnull.FromNull(task.CompletedAt),
 			Source:             omit.From(task.Source),
 			AiInterpretationID: omitnull.FromNull(task.AiInterpretationID),
+			RecurrenceRule:     omitnull.FromNull(task.RecurrenceRule),
+			RecurrenceAnchorAt: omitnull.FromNull(task.RecurrenceAnchorAt),
+			RecurrenceSeriesID: omitnull.FromNull(task.RecurrenceSeriesID),
+			Version:            omit.From(task.Version),
 			CreatedAt:          omit.From(task.CreatedAt),
 			UpdatedAt:          omit.From(task.UpdatedAt),
 		},
@@ -167,6 +517,7 @@ func (r *taskRepository) CreateTask(ctx context.Context, task *models.Task) erro
 }
 
 // UpdateTask はタスクを完全更新します
+// task.Versionが取得時から変わっていない場合のみ更新し、成功するとtask.Versionを加算します
 func (r *taskRepository) UpdateTask(ctx context.Context, task *models.Task) error {
 	r.logger.InfoContext(ctx, "Repository: UpdateTask started",
 		slog.String("task_id", task.ID),
@@ -176,16 +527,29 @@ func (r *taskRepository) UpdateTask(ctx context.Context, task *models.Task) erro
 	task.UpdatedAt = time.Now()
 
 	setter := &models.TaskSetter{
-		Title:       omit.From(task.Title),
-		Description: omitnull.FromNull(task.Description),
-		DueAt:       omitnull.FromNull(task.DueAt),
-		Status:      omit.From(task.Status),
-		UpdatedAt:   omit.From(task.UpdatedAt),
+		Title:              omit.From(task.Title),
+		Description:        omitnull.FromNull(task.Description),
+		DueAt:              omitnull.FromNull(task.DueAt),
+		EstimateMinutes:    omitnull.FromNull(task.EstimateMinutes),
+		Status:             omit.From(task.Status),
+		StatusCategory:     omit.From(task.StatusCategory),
+		RankKey:            omit.From(task.RankKey),
+		StartedAt:          omitnull.FromNull(task.StartedAt),
+		CompletedAt:        omitnull.FromNull(task.CompletedAt),
+		ArchivedAt:         omitnull.FromNull(task.ArchivedAt),
+		ProjectID:          omitnull.FromNull(task.ProjectID),
+		RecurrenceRule:     omitnull.FromNull(task.RecurrenceRule),
+		RecurrenceAnchorAt: omitnull.FromNull(task.RecurrenceAnchorAt),
+		RecurrenceSeriesID: omitnull.FromNull(task.RecurrenceSeriesID),
+		UpdatedAt:          omit.From(task.UpdatedAt),
 	}
 
-	_, err := models.Tasks.Update(
+	rowsAffected, err := models.Tasks.Update(
 		setter.UpdateMod(),
+		incrementVersion(),
 		um.Where(models.Tasks.Columns.ID.EQ(mysql.Arg(task.ID))),
+		um.Where(models.Tasks.Columns.Version.EQ(mysql.Arg(task.Version))),
+		um.Where(models.Tasks.Columns.DeletedAt.IsNull()),
 	).Exec(ctx, r.db)
 
 	if err != nil {
@@ -196,6 +560,15 @@ func (r *taskRepository) UpdateTask(ctx context.Context, task *models.Task) erro
 		return fmt.Errorf("failed to update task: %w", err)
 	}
 
+	if rowsAffected == 0 {
+		r.logger.WarnContext(ctx, "Repository: Task version conflict",
+			slog.String("task_id", task.ID),
+			slog.Int("version", int(task.Version)),
+		)
+		return fmt.Errorf("task version conflict: %s", task.ID)
+	}
+	task.Version++
+
 	r.logger.InfoContext(ctx, "Repository: UpdateTask completed",
 		slog.String("task_id", task.ID),
 	)
@@ -203,7 +576,8 @@ func (r *taskRepository) UpdateTask(ctx context.Context, task *models.Task) erro
 }
 
 // EditTask はタスクを部分更新します
-func (r *taskRepository) EditTask(ctx context.Context, id string, updates map[string]interface{}) (*models.Task, error) {
+// versionは取得時のバージョンで、他の更新により変わっていた場合は更新しません
+func (r *taskRepository) EditTask(ctx context.Context, id string, version int32, updates map[string]interface{}) (*models.Task, error) {
 	r.logger.InfoContext(ctx, "Repository: EditTask started",
 		slog.String("task_id", id),
 	)
@@ -230,13 +604,75 @@ func (r *taskRepository) EditTask(ctx context.Context, id string, updates map[st
 			setter.DueAt = omitnull.FromNull(null.From(*dueAt))
 		}
 	}
+	if estimateMinutes, ok := updates["estimate_minutes"].(*int32); ok {
+		if estimateMinutes != nil {
+			// 0は見積もりの解除
+			if *estimateMinutes == 0 {
+				setter.EstimateMinutes = omitnull.FromNull(null.Val[int32]{})
+			} else {
+				setter.EstimateMinutes = omitnull.FromNull(null.From(*estimateMinutes))
+			}
+		}
+	}
 	if status, ok := updates["status"].(string); ok {
 		setter.Status = omit.From(status)
 	}
+	if statusCategory, ok := updates["status_category"].(string); ok {
+		setter.StatusCategory = omit.From(statusCategory)
+	}
+	if rankKey, ok := updates["rank_key"].(string); ok {
+		setter.RankKey = omit.From(rankKey)
+	}
+	if startedAt, ok := updates["started_at"].(null.Val[time.Time]); ok {
+		setter.StartedAt = omitnull.FromNull(startedAt)
+	}
+	if completedAt, ok := updates["completed_at"].(null.Val[time.Time]); ok {
+		setter.CompletedAt = omitnull.FromNull(completedAt)
+	}
+	if projectID, ok := updates["project_id"].(*string); ok {
+		if projectID != nil {
+			// 空文字列はプロジェクトからの除外
+			if *projectID == "" {
+				setter.ProjectID = omitnull.FromNull(null.Val[string]{})
+			} else {
+				setter.ProjectID = omitnull.FromNull(null.From(*projectID))
+			}
+		}
+	}
+	if recurrenceRule, ok := updates["recurrence_rule"].(*string); ok {
+		if recurrenceRule != nil {
+			// 空文字列は繰り返しの解除
+			if *recurrenceRule == "" {
+				setter.RecurrenceRule = omitnull.FromNull(null.Val[string]{})
+			} else {
+				setter.RecurrenceRule = omitnull.FromNull(null.From(*recurrenceRule))
+			}
+		}
+	}
+	if recurrenceAnchorAt, ok := updates["recurrence_anchor_at"].(*time.Time); ok {
+		if recurrenceAnchorAt != nil {
+			setter.RecurrenceAnchorAt = omitnull.FromNull(null.From(*recurrenceAnchorAt))
+		}
+	}
+	if recurrenceSeriesID, ok := updates["recurrence_series_id"].(string); ok {
+		setter.RecurrenceSeriesID = omitnull.FromNull(null.From(recurrenceSeriesID))
+	}
+	if snoozedUntil, ok := updates["snoozed_until"].(null.Val[time.Time]); ok {
+		setter.SnoozedUntil = omitnull.FromNull(snoozedUntil)
+	}
+	if snoozeNotify, ok := updates["snooze_notify"].(bool); ok {
+		setter.SnoozeNotify = omit.From(snoozeNotify)
+	}
+	if archivedAt, ok := updates["archived_at"].(null.Val[time.Time]); ok {
+		setter.ArchivedAt = omitnull.FromNull(archivedAt)
+	}
 
-	_, err := models.Tasks.Update(
+	rowsAffected, err := models.Tasks.Update(
 		setter.UpdateMod(),
+		incrementVersion(),
 		um.Where(models.Tasks.Columns.ID.EQ(mysql.Arg(id))),
+		um.Where(models.Tasks.Columns.Version.EQ(mysql.Arg(version))),
+		um.Where(models.Tasks.Columns.DeletedAt.IsNull()),
 	).Exec(ctx, r.db)
 
 	if err != nil {
@@ -247,6 +683,14 @@ func (r *taskRepository) EditTask(ctx context.Context, id string, updates map[st
 		return nil, fmt.Errorf("failed to edit task: %w", err)
 	}
 
+	if rowsAffected == 0 {
+		r.logger.WarnContext(ctx, "Repository: Task version conflict",
+			slog.String("task_id", id),
+			slog.Int("version", int(version)),
+		)
+		return nil, fmt.Errorf("task version conflict: %s", id)
+	}
+
 	// 更新されたタスクを取得
 	task, err := r.GetTaskByID(ctx, id)
 	if err != nil {
@@ -263,14 +707,25 @@ func (r *taskRepository) EditTask(ctx context.Context, id string, updates map[st
 	return task, nil
 }
 
-// DeleteTask はタスクを削除します
-func (r *taskRepository) DeleteTask(ctx context.Context, id string) error {
+// DeleteTask はタスクを論理削除します（ゴミ箱に移動し、保持期間の経過後にPurgeDeletedTasksで完全削除）
+// versionは取得時のバージョンで、他の更新により変わっていた場合は削除しません
+func (r *taskRepository) DeleteTask(ctx context.Context, id string, version int32) error {
 	r.logger.InfoContext(ctx, "Repository: DeleteTask started",
 		slog.String("task_id", id),
 	)
 
-	_, err := models.Tasks.Delete(
-		dm.Where(models.Tasks.Columns.ID.EQ(mysql.Arg(id))),
+	now := time.Now()
+	setter := &models.TaskSetter{
+		DeletedAt: omitnull.From(now),
+		UpdatedAt: omit.From(now),
+	}
+
+	rowsAffected, err := models.Tasks.Update(
+		setter.UpdateMod(),
+		incrementVersion(),
+		um.Where(models.Tasks.Columns.ID.EQ(mysql.Arg(id))),
+		um.Where(models.Tasks.Columns.Version.EQ(mysql.Arg(version))),
+		um.Where(models.Tasks.Columns.DeletedAt.IsNull()),
 	).Exec(ctx, r.db)
 
 	if err != nil {
@@ -281,8 +736,377 @@ func (r *taskRepository) DeleteTask(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to delete task: %w", err)
 	}
 
+	if rowsAffected == 0 {
+		r.logger.WarnContext(ctx, "Repository: Task version conflict",
+			slog.String("task_id", id),
+			slog.Int("version", int(version)),
+		)
+		return fmt.Errorf("task version conflict: %s", id)
+	}
+
 	r.logger.InfoContext(ctx, "Repository: DeleteTask completed",
 		slog.String("task_id", id),
 	)
 	return nil
 }
+
+// GetDeletedTaskByID はIDで削除済みのタスクを取得します
+func (r *taskRepository) GetDeletedTaskByID(ctx context.Context, id string) (*models.Task, error) {
+	r.logger.InfoContext(ctx, "Repository: GetDeletedTaskByID started",
+		slog.String("task_id", id),
+	)
+
+	task, err := models.Tasks.Query(
+		sm.Where(models.Tasks.Columns.ID.EQ(mysql.Arg(id))),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNotNull()),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "Repository: Deleted task not found",
+				slog.String("task_id", id),
+			)
+			return nil, fmt.Errorf("deleted task not found: %s", id)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query deleted task",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find deleted task: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetDeletedTaskByID completed",
+		slog.String("task_id", id),
+	)
+	return task, nil
+}
+
+// GetDeletedTasksByUserID はユーザーのゴミ箱内のタスクを削除日時の新しい順に取得します
+func (r *taskRepository) GetDeletedTasksByUserID(ctx context.Context, userID string) (models.TaskSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetDeletedTasksByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	tasks, err := models.Tasks.Query(
+		sm.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNotNull()),
+		sm.OrderBy(mysql.Raw("deleted_at DESC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query deleted tasks",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get deleted tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetDeletedTasksByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// RestoreTask は削除済みのタスクをゴミ箱から復元します（表示順は未配置に戻す）
+func (r *taskRepository) RestoreTask(ctx context.Context, id string) error {
+	r.logger.InfoContext(ctx, "Repository: RestoreTask started",
+		slog.String("task_id", id),
+	)
+
+	setter := &models.TaskSetter{
+		DeletedAt: omitnull.FromNull(null.Val[time.Time]{}),
+		RankKey:   omit.From(""),
+		UpdatedAt: omit.From(time.Now()),
+	}
+
+	_, err := models.Tasks.Update(
+		setter.UpdateMod(),
+		incrementVersion(),
+		um.Where(models.Tasks.Columns.ID.EQ(mysql.Arg(id))),
+		um.Where(models.Tasks.Columns.DeletedAt.IsNotNull()),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to restore task",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to restore task: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: RestoreTask completed",
+		slog.String("task_id", id),
+	)
+	return nil
+}
+
+// PurgeDeletedTasks は指定日時より前に削除されたタスクを最大limit件まで物理削除し、削除したタスクのIDを返します
+// 依存関係は外部キーにより削除され、変更履歴は残ります（アイテムの参照解除と同一トランザクション内で実行）
+func (r *taskRepository) PurgeDeletedTasks(ctx context.Context, deletedBefore time.Time, limit int) ([]string, error) {
+	r.logger.InfoContext(ctx, "Repository: PurgeDeletedTasks started",
+		slog.Time("deleted_before", deletedBefore),
+		slog.Int("limit", limit),
+	)
+
+	tasks, err := models.Tasks.Query(
+		sm.Columns(models.Tasks.Columns.ID),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNotNull()),
+		sm.Where(models.Tasks.Columns.DeletedAt.LT(mysql.Arg(deletedBefore))),
+		sm.OrderBy(mysql.Raw("deleted_at ASC")),
+		sm.Limit(int64(limit)),
+		sm.ForUpdate(),
+	).All(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query tasks to purge",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to purge deleted tasks: %w", err)
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+
+	if _, err := models.Tasks.Delete(
+		dm.Where(models.Tasks.Columns.ID.In(stringArgs(ids)...)),
+	).Exec(ctx, r.db); err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to purge deleted tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to purge deleted tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: PurgeDeletedTasks completed",
+		slog.Int("count", len(ids)),
+	)
+	return ids, nil
+}
+
+// GetTasksDueBetween は全ユーザーの未完了タスクのうち期限がfromより後かつto以前のものを期限の早い順に最大limit件取得します
+// afterを指定した場合はそのタスクより後（期限・IDの順）のものだけを取得します
+// ゴミ箱内・アーカイブ済み・nowの時点でスヌーズ中のタスクは含みません（リマインダーの配信対象の検索に使用）
+func (r *taskRepository) GetTasksDueBetween(ctx context.Context, now, from, to time.Time, after *models.Task, limit int) (models.TaskSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetTasksDueBetween started",
+		slog.Time("from", from),
+		slog.Time("to", to),
+	)
+
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Tasks.Columns.DueAt.GT(mysql.Arg(from))),
+		sm.Where(models.Tasks.Columns.DueAt.LTE(mysql.Arg(to))),
+		sm.Where(models.Tasks.Columns.StatusCategory.NE(mysql.Arg(entity.TaskStatusCategoryDone))),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+		sm.Where(models.Tasks.Columns.ArchivedAt.IsNull()),
+		sm.Where(notSnoozed(now)),
+	}
+	if after != nil {
+		afterDueAt := after.DueAt.GetOrZero()
+		mods = append(mods, sm.Where(mysql.Raw("(due_at > ? OR (due_at = ? AND id > ?))", afterDueAt, afterDueAt, after.ID)))
+	}
+	mods = append(mods,
+		sm.OrderBy(mysql.Raw("due_at ASC, id ASC")),
+		sm.Limit(int64(limit)),
+	)
+
+	tasks, err := models.Tasks.Query(mods...).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query tasks due between",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find tasks due between: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetTasksDueBetween completed",
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// GetTasksWithDueAtByUserID はユーザーの期限が設定されたタスク（ゴミ箱内を除く）のうち期限がdueFrom以降のものを期限順に取得します
+func (r *taskRepository) GetTasksWithDueAtByUserID(ctx context.Context, userID string, dueFrom time.Time) (models.TaskSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetTasksWithDueAtByUserID started",
+		slog.String("user_id", userID),
+		slog.Time("due_from", dueFrom),
+	)
+
+	tasks, err := models.Tasks.Query(
+		sm.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.Tasks.Columns.DueAt.GTE(mysql.Arg(dueFrom))),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(mysql.Raw("due_at ASC, id ASC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query tasks with due date",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find tasks with due date: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetTasksWithDueAtByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// GetSnoozeEndedTasks は全ユーザーのタスクのうちスヌーズの解除日時がnow以前になったものを解除日時の早い順に最大limit件取得します
+// ゴミ箱内のタスクも含みます（スヌーズ解除ワーカーの処理対象の検索に使用）
+func (r *taskRepository) GetSnoozeEndedTasks(ctx context.Context, now time.Time, limit int) (models.TaskSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetSnoozeEndedTasks started",
+		slog.Time("now", now),
+		slog.Int("limit", limit),
+	)
+
+	tasks, err := models.Tasks.Query(
+		sm.Where(models.Tasks.Columns.SnoozedUntil.IsNotNull()),
+		sm.Where(models.Tasks.Columns.SnoozedUntil.LTE(mysql.Arg(now))),
+		sm.OrderBy(mysql.Raw("snoozed_until ASC, id ASC")),
+		sm.Limit(int64(limit)),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query snooze ended tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find snooze ended tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetSnoozeEndedTasks completed",
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// ClearSnooze はタスクのスヌーズを解除し、解除できたかどうかを返します
+// snoozedUntilは取得時の解除日時で、その間にスヌーズが変更・解除されていた場合は何もしません
+// 利用者の編集と競合しないようバージョンは変更しません
+func (r *taskRepository) ClearSnooze(ctx context.Context, id string, snoozedUntil time.Time) (bool, error) {
+	r.logger.InfoContext(ctx, "Repository: ClearSnooze started",
+		slog.String("task_id", id),
+	)
+
+	setter := &models.TaskSetter{
+		SnoozedUntil: omitnull.FromNull(null.Val[time.Time]{}),
+		SnoozeNotify: omit.From(false),
+	}
+
+	rowsAffected, err := models.Tasks.Update(
+		setter.UpdateMod(),
+		um.Where(models.Tasks.Columns.ID.EQ(mysql.Arg(id))),
+		um.Where(models.Tasks.Columns.SnoozedUntil.EQ(mysql.Arg(snoozedUntil))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to clear snooze",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return false, fmt.Errorf("failed to clear snooze: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: ClearSnooze completed",
+		slog.String("task_id", id),
+		slog.Bool("cleared", rowsAffected > 0),
+	)
+	return rowsAffected > 0, nil
+}
+
+// GetArchivedTasksByUserID はユーザーのアーカイブしたタスク（ゴミ箱内を除く）をアーカイブ日時の新しい順に取得します
+func (r *taskRepository) GetArchivedTasksByUserID(ctx context.Context, userID string) (models.TaskSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetArchivedTasksByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	tasks, err := models.Tasks.Query(
+		sm.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.Tasks.Columns.ArchivedAt.IsNotNull()),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(mysql.Raw("archived_at DESC, id ASC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query archived tasks",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get archived tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetArchivedTasksByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// ArchiveDoneTasks はユーザーの完了したタスクのうち、完了日時と更新日時がどちらもbefore以前のものを最大limit件アーカイブし、件数を返します
+// アーカイブを解除したタスクは更新日時が変わるため、再びbeforeを過ぎるまではアーカイブしません
+func (r *taskRepository) ArchiveDoneTasks(ctx context.Context, userID string, before time.Time, now time.Time, limit int) (int64, error) {
+	r.logger.InfoContext(ctx, "Repository: ArchiveDoneTasks started",
+		slog.String("user_id", userID),
+		slog.Time("before", before),
+	)
+
+	setter := &models.TaskSetter{
+		ArchivedAt: omitnull.From(now),
+		UpdatedAt:  omit.From(now),
+	}
+
+	rowsAffected, err := models.Tasks.Update(
+		setter.UpdateMod(),
+		incrementVersion(),
+		um.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
+		um.Where(models.Tasks.Columns.StatusCategory.EQ(mysql.Arg(entity.TaskStatusCategoryDone))),
+		um.Where(models.Tasks.Columns.CompletedAt.LTE(mysql.Arg(before))),
+		um.Where(models.Tasks.Columns.UpdatedAt.LTE(mysql.Arg(before))),
+		um.Where(models.Tasks.Columns.ArchivedAt.IsNull()),
+		um.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+		um.OrderBy(mysql.Raw("completed_at ASC")),
+		um.Limit(int64(limit)),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to archive done tasks",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("failed to archive done tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: ArchiveDoneTasks completed",
+		slog.String("user_id", userID),
+		slog.Int64("count", rowsAffected),
+	)
+	return rowsAffected, nil
+}
+
+// notSnoozed はスヌーズされていない、またはnowの時点でスヌーズが解除日時を過ぎたタスクを表す条件です
+// 解除ワーカーの実行を待たずに解除日時を過ぎたタスクを一覧に戻すため、日時で比較します
+func notSnoozed(now time.Time) bob.Expression {
+	return mysql.Or(
+		models.Tasks.Columns.SnoozedUntil.IsNull(),
+		models.Tasks.Columns.SnoozedUntil.LTE(mysql.Arg(now)),
+	)
+}
+
+// stringArgs は文字列の一覧をIN句の引数に変換します
+func stringArgs(values []string) []bob.Expression {
+	args := make([]bob.Expression, len(values))
+	for i, value := range values {
+		args[i] = mysql.Arg(value)
+	}
+	return args
+}
+
+// escapeLike はLIKEのパターンで特別な意味を持つ文字（\ % _）をエスケープします
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
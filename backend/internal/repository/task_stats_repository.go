@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/stephenafamo/scan"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// taskStatsSlotRow は時間枠ごとの集計クエリの結果行
+type taskStatsSlotRow struct {
+	Slot         int64  `db:"slot"`
+	Source       string `db:"source"`
+	Count        int64  `db:"count"`
+	DoneCount    int64  `db:"done_count"`
+	LeadSeconds  int64  `db:"lead_seconds"`
+	CycleCount   int64  `db:"cycle_count"`
+	CycleSeconds int64  `db:"cycle_seconds"`
+}
+
+// taskFlowTotalsRow は累計件数の集計クエリの結果行
+type taskFlowTotalsRow struct {
+	Created   int64 `db:"created_count"`
+	Started   int64 `db:"started_count"`
+	Completed int64 `db:"completed_count"`
+}
+
+// countCreatedTasksBySlotQuery は作成日時の時間枠・作成元ごとに、作成件数とそのうち完了している件数を集計します
+const countCreatedTasksBySlotQuery = `
+SELECT
+  UNIX_TIMESTAMP(created_at) DIV ? AS slot,
+  source,
+  COUNT(*) AS count,
+  SUM(status_category = 'done') AS done_count,
+  0 AS lead_seconds,
+  0 AS cycle_count,
+  0 AS cycle_seconds
+FROM tasks
+WHERE user_id = ? AND deleted_at IS NULL AND created_at >= ? AND created_at < ?
+GROUP BY slot, source`
+
+// countStartedTasksBySlotQuery は着手日時（着手せずに完了したタスクは完了日時）の時間枠・作成元ごとに着手件数を集計します
+const countStartedTasksBySlotQuery = `
+SELECT
+  UNIX_TIMESTAMP(COALESCE(started_at, completed_at)) DIV ? AS slot,
+  source,
+  COUNT(*) AS count,
+  0 AS done_count,
+  0 AS lead_seconds,
+  0 AS cycle_count,
+  0 AS cycle_seconds
+FROM tasks
+WHERE user_id = ? AND deleted_at IS NULL AND COALESCE(started_at, completed_at) >= ? AND COALESCE(started_at, completed_at) < ?
+GROUP BY slot, source`
+
+// countCompletedTasksBySlotQuery は完了日時の時間枠・作成元ごとに、完了件数とリードタイム・サイクルタイムの合計を集計します
+const countCompletedTasksBySlotQuery = `
+SELECT
+  UNIX_TIMESTAMP(completed_at) DIV ? AS slot,
+  source,
+  COUNT(*) AS count,
+  COUNT(*) AS done_count,
+  COALESCE(SUM(GREATEST(TIMESTAMPDIFF(SECOND, created_at, completed_at), 0)), 0) AS lead_seconds,
+  COUNT(started_at) AS cycle_count,
+  COALESCE(SUM(GREATEST(TIMESTAMPDIFF(SECOND, started_at, completed_at), 0)), 0) AS cycle_seconds
+FROM tasks
+WHERE user_id = ? AND deleted_at IS NULL AND completed_at >= ? AND completed_at < ?
+GROUP BY slot, source`
+
+// countTasksBeforeQuery は指定日時より前に作成・着手・完了したタスクの累計件数を集計します
+const countTasksBeforeQuery = `
+SELECT
+  COUNT(*) AS created_count,
+  COALESCE(SUM(COALESCE(started_at, completed_at) < ?), 0) AS started_count,
+  COALESCE(SUM(completed_at < ?), 0) AS completed_count
+FROM tasks
+WHERE user_id = ? AND deleted_at IS NULL AND created_at < ?`
+
+type taskStatsRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewTaskStatsRepository は新しいTaskStatsRepositoryを生成します
+func NewTaskStatsRepository(db *sql.DB, logger *slog.Logger) interfaces.TaskStatsRepository {
+	return &taskStatsRepository{
+		db:     bob.NewDB(db),
+		logger: logger,
+	}
+}
+
+// CountCreatedTasksBySlot は期間内に作成したタスクの件数を時間枠・作成元ごとに集計します
+func (r *taskStatsRepository) CountCreatedTasksBySlot(ctx context.Context, userID string, from, to time.Time) ([]*entity.TaskStatsSlot, error) {
+	r.logger.InfoContext(ctx, "Repository: CountCreatedTasksBySlot started",
+		slog.String("user_id", userID),
+	)
+
+	rows, err := bob.All(ctx, r.db,
+		mysql.RawQuery(countCreatedTasksBySlotQuery, entity.TaskStatsSlotSeconds, userID, from, to),
+		scan.StructMapper[taskStatsSlotRow](),
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to count created tasks",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to count created tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CountCreatedTasksBySlot completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(rows)),
+	)
+	return toTaskStatsSlots(rows), nil
+}
+
+// CountStartedTasksBySlot は期間内に着手したタスクの件数を時間枠・作成元ごとに集計します
+func (r *taskStatsRepository) CountStartedTasksBySlot(ctx context.Context, userID string, from, to time.Time) ([]*entity.TaskStatsSlot, error) {
+	r.logger.InfoContext(ctx, "Repository: CountStartedTasksBySlot started",
+		slog.String("user_id", userID),
+	)
+
+	rows, err := bob.All(ctx, r.db,
+		mysql.RawQuery(countStartedTasksBySlotQuery, entity.TaskStatsSlotSeconds, userID, from, to),
+		scan.StructMapper[taskStatsSlotRow](),
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to count started tasks",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to count started tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CountStartedTasksBySlot completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(rows)),
+	)
+	return toTaskStatsSlots(rows), nil
+}
+
+// CountCompletedTasksBySlot は期間内に完了したタスクの件数とリードタイム・サイクルタイムを時間枠・作成元ごとに集計します
+func (r *taskStatsRepository) CountCompletedTasksBySlot(ctx context.Context, userID string, from, to time.Time) ([]*entity.TaskStatsSlot, error) {
+	r.logger.InfoContext(ctx, "Repository: CountCompletedTasksBySlot started",
+		slog.String("user_id", userID),
+	)
+
+	rows, err := bob.All(ctx, r.db,
+		mysql.RawQuery(countCompletedTasksBySlotQuery, entity.TaskStatsSlotSeconds, userID, from, to),
+		scan.StructMapper[taskStatsSlotRow](),
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to count completed tasks",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to count completed tasks: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CountCompletedTasksBySlot completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(rows)),
+	)
+	return toTaskStatsSlots(rows), nil
+}
+
+// CountTasksBefore は指定日時より前に作成・着手・完了したタスクの累計件数を取得します
+func (r *taskStatsRepository) CountTasksBefore(ctx context.Context, userID string, before time.Time) (*entity.TaskFlowTotals, error) {
+	row, err := bob.One(ctx, r.db,
+		mysql.RawQuery(countTasksBeforeQuery, before, before, userID, before),
+		scan.StructMapper[taskFlowTotalsRow](),
+	)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to count tasks before",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to count tasks: %w", err)
+	}
+
+	return &entity.TaskFlowTotals{
+		Created:   row.Created,
+		Started:   row.Started,
+		Completed: row.Completed,
+	}, nil
+}
+
+// CountOverdueTasks は指定日時の時点で期限を過ぎている未完了タスクの件数を取得します
+func (r *taskStatsRepository) CountOverdueTasks(ctx context.Context, userID string, now time.Time) (int64, error) {
+	count, err := models.Tasks.Query(
+		sm.Where(models.Tasks.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.Tasks.Columns.DeletedAt.IsNull()),
+		sm.Where(models.Tasks.Columns.StatusCategory.NE(mysql.Arg(entity.TaskStatusCategoryDone))),
+		sm.Where(models.Tasks.Columns.DueAt.LT(mysql.Arg(now))),
+	).Count(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to count overdue tasks",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("failed to count overdue tasks: %w", err)
+	}
+	return count, nil
+}
+
+// toTaskStatsSlots は集計クエリの結果行をエンティティに変換します
+func toTaskStatsSlots(rows []taskStatsSlotRow) []*entity.TaskStatsSlot {
+	slots := make([]*entity.TaskStatsSlot, len(rows))
+	for i, row := range rows {
+		slots[i] = &entity.TaskStatsSlot{
+			Slot:         row.Slot,
+			Source:       row.Source,
+			Count:        row.Count,
+			DoneCount:    row.DoneCount,
+			LeadSeconds:  row.LeadSeconds,
+			CycleCount:   row.CycleCount,
+			CycleSeconds: row.CycleSeconds,
+		}
+	}
+	return slots
+}
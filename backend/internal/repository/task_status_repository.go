@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/dm"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/stephenafamo/bob/dialect/mysql/um"
+	"github.com/yoshioka0101/ai_plan_chat/dberrors"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type taskStatusRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewTaskStatusRepository は新しいTaskStatusRepositoryを生成します
+func NewTaskStatusRepository(db *sql.DB, logger *slog.Logger) interfaces.TaskStatusRepository {
+	return NewTaskStatusRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewTaskStatusRepositoryWithExecutor は既存のexecutorを使ってTaskStatusRepositoryを生成します
+func NewTaskStatusRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.TaskStatusRepository {
+	return &taskStatusRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetStatusByID はIDでステータスを取得します
+func (r *taskStatusRepository) GetStatusByID(ctx context.Context, id string) (*models.TaskStatus, error) {
+	r.logger.InfoContext(ctx, "Repository: GetStatusByID started",
+		slog.String("status_id", id),
+	)
+
+	status, err := models.TaskStatuses.Query(
+		sm.Where(models.TaskStatuses.Columns.ID.EQ(mysql.Arg(id))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "Repository: Status not found",
+				slog.String("status_id", id),
+			)
+			return nil, fmt.Errorf("status not found: %s", id)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query status",
+			slog.String("status_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find status: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetStatusByID completed",
+		slog.String("status_id", id),
+	)
+	return status, nil
+}
+
+// GetStatusesByUserID はユーザーのステータス一覧を表示順で取得します
+func (r *taskStatusRepository) GetStatusesByUserID(ctx context.Context, userID string) (models.TaskStatusSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetStatusesByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	statuses, err := models.TaskStatuses.Query(
+		sm.Where(models.TaskStatuses.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.OrderBy(mysql.Raw("sort_order ASC, created_at ASC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query statuses by user",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get statuses: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetStatusesByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(statuses)),
+	)
+	return statuses, nil
+}
+
+// GetStatusByNameForUpdate はユーザーのステータスを名前で取得し、行ロックします（存在しない場合はnil）
+// WIP制限の確認を直列化するためトランザクション内で使用します
+func (r *taskStatusRepository) GetStatusByNameForUpdate(ctx context.Context, userID string, name string) (*models.TaskStatus, error) {
+	status, err := models.TaskStatuses.Query(
+		sm.Where(models.TaskStatuses.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.TaskStatuses.Columns.Name.EQ(mysql.Arg(name))),
+		sm.ForUpdate(),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query status for update",
+			slog.String("user_id", userID),
+			slog.String("name", name),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find status: %w", err)
+	}
+	return status, nil
+}
+
+// CreateStatus は新しいステータスを作成します
+func (r *taskStatusRepository) CreateStatus(ctx context.Context, status *models.TaskStatus) error {
+	r.logger.InfoContext(ctx, "Repository: CreateStatus started",
+		slog.String("name", status.Name),
+	)
+
+	// UUIDを生成
+	if status.ID == "" {
+		status.ID = uuid.New().String()
+	}
+
+	// 現在時刻を設定
+	now := time.Now()
+	status.CreatedAt = now
+	status.UpdatedAt = now
+
+	_, err := models.TaskStatuses.Insert(
+		&models.TaskStatusSetter{
+			ID:        omit.From(status.ID),
+			UserID:    omit.From(status.UserID),
+			Name:      omit.From(status.Name),
+			Category:  omit.From(status.Category),
+			SortOrder: omit.From(status.SortOrder),
+			WipLimit:  omitnull.FromNull(status.WipLimit),
+			CreatedAt: omit.From(status.CreatedAt),
+			UpdatedAt: omit.From(status.UpdatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		if errors.Is(dberrors.TaskStatusErrors.ErrUniqueUkTaskStatusesUserName, err) {
+			r.logger.WarnContext(ctx, "Repository: Status name already exists",
+				slog.String("name", status.Name),
+			)
+			return fmt.Errorf("status already exists: %s", status.Name)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to create status",
+			slog.String("status_id", status.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create status: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateStatus completed",
+		slog.String("status_id", status.ID),
+	)
+	return nil
+}
+
+// UpdateStatus はステータスのカテゴリ・表示順・WIP制限を更新します（名前は変更できません）
+func (r *taskStatusRepository) UpdateStatus(ctx context.Context, status *models.TaskStatus) error {
+	r.logger.InfoContext(ctx, "Repository: UpdateStatus started",
+		slog.String("status_id", status.ID),
+	)
+
+	// 更新時刻を設定
+	status.UpdatedAt = time.Now()
+
+	setter := &models.TaskStatusSetter{
+		Category:  omit.From(status.Category),
+		SortOrder: omit.From(status.SortOrder),
+		WipLimit:  omitnull.FromNull(status.WipLimit),
+		UpdatedAt: omit.From(status.UpdatedAt),
+	}
+
+	_, err := models.TaskStatuses.Update(
+		setter.UpdateMod(),
+		um.Where(models.TaskStatuses.Columns.ID.EQ(mysql.Arg(status.ID))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to update status",
+			slog.String("status_id", status.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: UpdateStatus completed",
+		slog.String("status_id", status.ID),
+	)
+	return nil
+}
+
+// DeleteStatus はステータスを削除します
+func (r *taskStatusRepository) DeleteStatus(ctx context.Context, id string) error {
+	r.logger.InfoContext(ctx, "Repository: DeleteStatus started",
+		slog.String("status_id", id),
+	)
+
+	_, err := models.TaskStatuses.Delete(
+		dm.Where(models.TaskStatuses.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to delete status",
+			slog.String("status_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to delete status: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: DeleteStatus completed",
+		slog.String("status_id", id),
+	)
+	return nil
+}
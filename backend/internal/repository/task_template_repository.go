@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/dm"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/stephenafamo/bob/dialect/mysql/um"
+	"github.com/stephenafamo/bob/types"
+	"github.com/yoshioka0101/ai_plan_chat/dberrors"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type taskTemplateRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewTaskTemplateRepository は新しいTaskTemplateRepositoryを生成します
+func NewTaskTemplateRepository(db *sql.DB, logger *slog.Logger) interfaces.TaskTemplateRepository {
+	return NewTaskTemplateRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewTaskTemplateRepositoryWithExecutor は既存のexecutorを使ってTaskTemplateRepositoryを生成します
+func NewTaskTemplateRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.TaskTemplateRepository {
+	return &taskTemplateRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetTemplateByID はIDでテンプレートを取得します
+func (r *taskTemplateRepository) GetTemplateByID(ctx context.Context, id string) (*entity.TaskTemplate, error) {
+	r.logger.InfoContext(ctx, "Repository: GetTemplateByID started",
+		slog.String("template_id", id),
+	)
+
+	dbTemplate, err := models.TaskTemplates.Query(
+		sm.Where(models.TaskTemplates.Columns.ID.EQ(mysql.Arg(id))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "Repository: Template not found",
+				slog.String("template_id", id),
+			)
+			return nil, fmt.Errorf("template not found: %s", id)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query template",
+			slog.String("template_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find template: %w", err)
+	}
+
+	template, err := r.toEntity(dbTemplate)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to convert template to entity",
+			slog.String("template_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetTemplateByID completed",
+		slog.String("template_id", id),
+	)
+	return template, nil
+}
+
+// GetTemplatesByUserID はユーザーのテンプレート一覧を名前順で取得します
+func (r *taskTemplateRepository) GetTemplatesByUserID(ctx context.Context, userID string) ([]*entity.TaskTemplate, error) {
+	r.logger.InfoContext(ctx, "Repository: GetTemplatesByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	dbTemplates, err := models.TaskTemplates.Query(
+		sm.Where(models.TaskTemplates.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.OrderBy(mysql.Raw("name ASC, created_at ASC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query templates by user",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get templates: %w", err)
+	}
+
+	templates := make([]*entity.TaskTemplate, 0, len(dbTemplates))
+	for _, dbTemplate := range dbTemplates {
+		template, err := r.toEntity(dbTemplate)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "Repository: Failed to convert template to entity",
+				slog.String("template_id", dbTemplate.ID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		templates = append(templates, template)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetTemplatesByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(templates)),
+	)
+	return templates, nil
+}
+
+// CreateTemplate は新しいテンプレートを作成します
+func (r *taskTemplateRepository) CreateTemplate(ctx context.Context, template *entity.TaskTemplate) error {
+	r.logger.InfoContext(ctx, "Repository: CreateTemplate started",
+		slog.String("name", template.Name),
+	)
+
+	// UUIDを生成
+	if template.ID == "" {
+		template.ID = uuid.New().String()
+	}
+
+	items, err := json.Marshal(template.Items)
+	if err != nil {
+		return fmt.Errorf("failed to marshal template items: %w", err)
+	}
+
+	// 現在時刻を設定
+	now := time.Now()
+	template.CreatedAt = now
+	template.UpdatedAt = now
+
+	_, err = models.TaskTemplates.Insert(
+		&models.TaskTemplateSetter{
+			ID:          omit.From(template.ID),
+			UserID:      omit.From(template.UserID),
+			Name:        omit.From(template.Name),
+			Description: omitnull.FromNull(null.FromPtr(template.Description)),
+			ProjectID:   omitnull.FromNull(null.FromPtr(template.ProjectID)),
+			Items:       omit.From(types.JSON[json.RawMessage]{Val: items}),
+			CreatedAt:   omit.From(template.CreatedAt),
+			UpdatedAt:   omit.From(template.UpdatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		if errors.Is(dberrors.TaskTemplateErrors.ErrUniqueUkTaskTemplatesUserName, err) {
+			r.logger.WarnContext(ctx, "Repository: Template name already exists",
+				slog.String("name", template.Name),
+			)
+			return fmt.Errorf("template already exists: %s", template.Name)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to create template",
+			slog.String("template_id", template.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create template: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateTemplate completed",
+		slog.String("template_id", template.ID),
+	)
+	return nil
+}
+
+// UpdateTemplate はテンプレートを更新します
+func (r *taskTemplateRepository) UpdateTemplate(ctx context.Context, template *entity.TaskTemplate) error {
+	r.logger.InfoContext(ctx, "Repository: UpdateTemplate started",
+		slog.String("template_id", template.ID),
+	)
+
+	items, err := json.Marshal(template.Items)
+	if err != nil {
+		return fmt.Errorf("failed to marshal template items: %w", err)
+	}
+
+	// 更新時刻を設定
+	template.UpdatedAt = time.Now()
+
+	setter := &models.TaskTemplateSetter{
+		Name:        omit.From(template.Name),
+		Description: omitnull.FromNull(null.FromPtr(template.Description)),
+		ProjectID:   omitnull.FromNull(null.FromPtr(template.ProjectID)),
+		Items:       omit.From(types.JSON[json.RawMessage]{Val: items}),
+		UpdatedAt:   omit.From(template.UpdatedAt),
+	}
+
+	_, err = models.TaskTemplates.Update(
+		setter.UpdateMod(),
+		um.Where(models.TaskTemplates.Columns.ID.EQ(mysql.Arg(template.ID))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		if errors.Is(dberrors.TaskTemplateErrors.ErrUniqueUkTaskTemplatesUserName, err) {
+			r.logger.WarnContext(ctx, "Repository: Template name already exists",
+				slog.String("name", template.Name),
+			)
+			return fmt.Errorf("template already exists: %s", template.Name)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to update template",
+			slog.String("template_id", template.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to update template: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: UpdateTemplate completed",
+		slog.String("template_id", template.ID),
+	)
+	return nil
+}
+
+// DeleteTemplate はテンプレートを削除します（展開済みのタスクは削除しない）
+func (r *taskTemplateRepository) DeleteTemplate(ctx context.Context, id string) error {
+	r.logger.InfoContext(ctx, "Repository: DeleteTemplate started",
+		slog.String("template_id", id),
+	)
+
+	_, err := models.TaskTemplates.Delete(
+		dm.Where(models.TaskTemplates.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to delete template",
+			slog.String("template_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: DeleteTemplate completed",
+		slog.String("template_id", id),
+	)
+	return nil
+}
+
+// toEntity はDBモデルをエンティティに変換します（itemsのJSONを展開）
+func (r *taskTemplateRepository) toEntity(dbTemplate *models.TaskTemplate) (*entity.TaskTemplate, error) {
+	template := &entity.TaskTemplate{
+		ID:          dbTemplate.ID,
+		UserID:      dbTemplate.UserID,
+		Name:        dbTemplate.Name,
+		Description: dbTemplate.Description.Ptr(),
+		ProjectID:   dbTemplate.ProjectID.Ptr(),
+		CreatedAt:   dbTemplate.CreatedAt,
+		UpdatedAt:   dbTemplate.UpdatedAt,
+	}
+
+	if err := json.Unmarshal(dbTemplate.Items.Val, &template.Items); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal template items: %w", err)
+	}
+	return template, nil
+}
@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/dm"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/stephenafamo/bob/dialect/mysql/um"
+	"github.com/stephenafamo/bob/types"
+	"github.com/yoshioka0101/ai_plan_chat/dberrors"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type taskViewRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewTaskViewRepository は新しいTaskViewRepositoryを生成します
+func NewTaskViewRepository(db *sql.DB, logger *slog.Logger) interfaces.TaskViewRepository {
+	return NewTaskViewRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewTaskViewRepositoryWithExecutor は既存のexecutorを使ってTaskViewRepositoryを生成します
+func NewTaskViewRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.TaskViewRepository {
+	return &taskViewRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetViewByID はIDでビューを取得します
+func (r *taskViewRepository) GetViewByID(ctx context.Context, id string) (*entity.TaskView, error) {
+	r.logger.InfoContext(ctx, "Repository: GetViewByID started",
+		slog.String("view_id", id),
+	)
+
+	dbView, err := models.TaskViews.Query(
+		sm.Where(models.TaskViews.Columns.ID.EQ(mysql.Arg(id))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "Repository: View not found",
+				slog.String("view_id", id),
+			)
+			return nil, fmt.Errorf("view not found: %s", id)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query view",
+			slog.String("view_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find view: %w", err)
+	}
+
+	view, err := r.toEntity(dbView)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to convert view to entity",
+			slog.String("view_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetViewByID completed",
+		slog.String("view_id", id),
+	)
+	return view, nil
+}
+
+// GetViewsByUserID はユーザーのビュー一覧を作成日時の古い順に取得します
+func (r *taskViewRepository) GetViewsByUserID(ctx context.Context, userID string) ([]*entity.TaskView, error) {
+	r.logger.InfoContext(ctx, "Repository: GetViewsByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	dbViews, err := models.TaskViews.Query(
+		sm.Where(models.TaskViews.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.OrderBy(mysql.Raw("created_at ASC, id ASC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query views by user",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get views: %w", err)
+	}
+
+	views := make([]*entity.TaskView, 0, len(dbViews))
+	for _, dbView := range dbViews {
+		view, err := r.toEntity(dbView)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "Repository: Failed to convert view to entity",
+				slog.String("view_id", dbView.ID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		views = append(views, view)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetViewsByUserID completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(views)),
+	)
+	return views, nil
+}
+
+// CreateView は新しいビューを作成します
+func (r *taskViewRepository) CreateView(ctx context.Context, view *entity.TaskView) error {
+	r.logger.InfoContext(ctx, "Repository: CreateView started",
+		slog.String("name", view.Name),
+	)
+
+	// UUIDを生成
+	if view.ID == "" {
+		view.ID = uuid.New().String()
+	}
+
+	filter, err := json.Marshal(view.Filter)
+	if err != nil {
+		return fmt.Errorf("failed to marshal view filter: %w", err)
+	}
+
+	// 現在時刻を設定
+	now := time.Now()
+	view.CreatedAt = now
+	view.UpdatedAt = now
+
+	_, err = models.TaskViews.Insert(
+		&models.TaskViewSetter{
+			ID:        omit.From(view.ID),
+			UserID:    omit.From(view.UserID),
+			Name:      omit.From(view.Name),
+			Filter:    omit.From(types.JSON[json.RawMessage]{Val: filter}),
+			CreatedAt: omit.From(view.CreatedAt),
+			UpdatedAt: omit.From(view.UpdatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		if errors.Is(dberrors.TaskViewErrors.ErrUniqueUkTaskViewsUserName, err) {
+			r.logger.WarnContext(ctx, "Repository: View name already exists",
+				slog.String("name", view.Name),
+			)
+			return fmt.Errorf("view already exists: %s", view.Name)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to create view",
+			slog.String("view_id", view.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create view: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateView completed",
+		slog.String("view_id", view.ID),
+	)
+	return nil
+}
+
+// UpdateView はビューを更新します
+func (r *taskViewRepository) UpdateView(ctx context.Context, view *entity.TaskView) error {
+	r.logger.InfoContext(ctx, "Repository: UpdateView started",
+		slog.String("view_id", view.ID),
+	)
+
+	filter, err := json.Marshal(view.Filter)
+	if err != nil {
+		return fmt.Errorf("failed to marshal view filter: %w", err)
+	}
+
+	// 更新時刻を設定
+	view.UpdatedAt = time.Now()
+
+	setter := &models.TaskViewSetter{
+		Name:      omit.From(view.Name),
+		Filter:    omit.From(types.JSON[json.RawMessage]{Val: filter}),
+		UpdatedAt: omit.From(view.UpdatedAt),
+	}
+
+	_, err = models.TaskViews.Update(
+		setter.UpdateMod(),
+		um.Where(models.TaskViews.Columns.ID.EQ(mysql.Arg(view.ID))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		if errors.Is(dberrors.TaskViewErrors.ErrUniqueUkTaskViewsUserName, err) {
+			r.logger.WarnContext(ctx, "Repository: View name already exists",
+				slog.String("name", view.Name),
+			)
+			return fmt.Errorf("view already exists: %s", view.Name)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to update view",
+			slog.String("view_id", view.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to update view: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: UpdateView completed",
+		slog.String("view_id", view.ID),
+	)
+	return nil
+}
+
+// DeleteView はビューを削除します
+func (r *taskViewRepository) DeleteView(ctx context.Context, id string) error {
+	r.logger.InfoContext(ctx, "Repository: DeleteView started",
+		slog.String("view_id", id),
+	)
+
+	_, err := models.TaskViews.Delete(
+		dm.Where(models.TaskViews.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to delete view",
+			slog.String("view_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to delete view: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: DeleteView completed",
+		slog.String("view_id", id),
+	)
+	return nil
+}
+
+// toEntity はDBモデルをエンティティに変換します（filterのJSONを展開）
+func (r *taskViewRepository) toEntity(dbView *models.TaskView) (*entity.TaskView, error) {
+	view := &entity.TaskView{
+		ID:        dbView.ID,
+		UserID:    dbView.UserID,
+		Name:      dbView.Name,
+		CreatedAt: dbView.CreatedAt,
+		UpdatedAt: dbView.UpdatedAt,
+	}
+
+	if err := json.Unmarshal(dbView.Filter.Val, &view.Filter); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal view filter: %w", err)
+	}
+	return view, nil
+}
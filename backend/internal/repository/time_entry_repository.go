@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/dialect"
+	"github.com/stephenafamo/bob/dialect/mysql/dm"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/stephenafamo/bob/dialect/mysql/um"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type timeEntryRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewTimeEntryRepository は新しいTimeEntryRepositoryを生成します
+func NewTimeEntryRepository(db *sql.DB, logger *slog.Logger) interfaces.TimeEntryRepository {
+	return NewTimeEntryRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewTimeEntryRepositoryWithExecutor は既存のexecutorを使ってTimeEntryRepositoryを生成します
+func NewTimeEntryRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.TimeEntryRepository {
+	return &timeEntryRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetEntryByID はIDで時間記録を取得します
+func (r *timeEntryRepository) GetEntryByID(ctx context.Context, id string) (*models.TimeEntry, error) {
+	r.logger.InfoContext(ctx, "Repository: GetEntryByID started",
+		slog.String("time_entry_id", id),
+	)
+
+	entry, err := models.TimeEntries.Query(
+		sm.Where(models.TimeEntries.Columns.ID.EQ(mysql.Arg(id))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.WarnContext(ctx, "Repository: Time entry not found",
+				slog.String("time_entry_id", id),
+			)
+			return nil, fmt.Errorf("time entry not found: %s", id)
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query time entry",
+			slog.String("time_entry_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find time entry: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetEntryByID completed",
+		slog.String("time_entry_id", id),
+	)
+	return entry, nil
+}
+
+// GetEntriesByTaskIDs は指定タスクの時間記録を開始日時の新しい順に取得します
+func (r *timeEntryRepository) GetEntriesByTaskIDs(ctx context.Context, taskIDs []string) (models.TimeEntrySlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetEntriesByTaskIDs started",
+		slog.Int("count", len(taskIDs)),
+	)
+
+	if len(taskIDs) == 0 {
+		return models.TimeEntrySlice{}, nil
+	}
+
+	args := make([]bob.Expression, len(taskIDs))
+	for i, id := range taskIDs {
+		args[i] = mysql.Arg(id)
+	}
+
+	entries, err := models.TimeEntries.Query(
+		sm.Where(models.TimeEntries.Columns.TaskID.In(args...)),
+		sm.OrderBy(mysql.Raw("started_at DESC, id ASC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query time entries by task",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get time entries: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetEntriesByTaskIDs completed",
+		slog.Int("count", len(entries)),
+	)
+	return entries, nil
+}
+
+// GetEntriesInRange はユーザーの時間記録のうち指定期間と重なるもの（計測中を含む）を開始日時順に取得します
+// ゴミ箱内のタスクの記録は含めません
+func (r *timeEntryRepository) GetEntriesInRange(ctx context.Context, userID string, from, to time.Time) (models.TimeEntrySlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetEntriesInRange started",
+		slog.String("user_id", userID),
+		slog.Time("from", from),
+		slog.Time("to", to),
+	)
+
+	entries, err := models.TimeEntries.Query(
+		sm.Where(models.TimeEntries.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.TimeEntries.Columns.StartedAt.LT(mysql.Arg(to))),
+		sm.Where(mysql.Raw("(ended_at IS NULL OR ended_at > ?)", from)),
+		sm.Where(mysql.Raw("task_id IN (SELECT id FROM tasks WHERE user_id = ? AND deleted_at IS NULL)", userID)),
+		sm.OrderBy(mysql.Raw("started_at ASC, id ASC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query time entries in range",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get time entries: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetEntriesInRange completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(entries)),
+	)
+	return entries, nil
+}
+
+// GetRunningEntry はユーザーの計測中の時間記録を取得します（計測中のものがない場合はnil）
+func (r *timeEntryRepository) GetRunningEntry(ctx context.Context, userID string) (*models.TimeEntry, error) {
+	return r.getRunningEntry(ctx, userID, false)
+}
+
+// GetRunningEntryForUpdate はユーザー行をロックした上で計測中の時間記録を取得します（計測中のものがない場合はnil）
+// 同時に開始されたタイマーを直列化するためトランザクション内で使用します
+func (r *timeEntryRepository) GetRunningEntryForUpdate(ctx context.Context, userID string) (*models.TimeEntry, error) {
+	_, err := models.Users.Query(
+		sm.Where(models.Users.Columns.ID.EQ(mysql.Arg(userID))),
+		sm.ForUpdate(),
+	).One(ctx, r.db)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to lock user for timer",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to lock user for timer: %w", err)
+	}
+
+	return r.getRunningEntry(ctx, userID, true)
+}
+
+// getRunningEntry はユーザーの計測中の時間記録を取得します
+func (r *timeEntryRepository) getRunningEntry(ctx context.Context, userID string, forUpdate bool) (*models.TimeEntry, error) {
+	r.logger.InfoContext(ctx, "Repository: GetRunningEntry started",
+		slog.String("user_id", userID),
+	)
+
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.TimeEntries.Columns.UserID.EQ(mysql.Arg(userID))),
+		sm.Where(models.TimeEntries.Columns.EndedAt.IsNull()),
+		sm.OrderBy(mysql.Raw("started_at DESC")),
+		sm.Limit(1),
+	}
+	if forUpdate {
+		mods = append(mods, sm.ForUpdate())
+	}
+
+	entry, err := models.TimeEntries.Query(mods...).One(ctx, r.db)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query running time entry",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get running time entry: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetRunningEntry completed",
+		slog.String("time_entry_id", entry.ID),
+	)
+	return entry, nil
+}
+
+// CreateEntry は新しい時間記録を作成します
+func (r *timeEntryRepository) CreateEntry(ctx context.Context, entry *models.TimeEntry) error {
+	r.logger.InfoContext(ctx, "Repository: CreateEntry started",
+		slog.String("task_id", entry.TaskID),
+	)
+
+	// UUIDを生成
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+
+	// 現在時刻を設定
+	now := time.Now()
+	entry.CreatedAt = now
+	entry.UpdatedAt = now
+
+	_, err := models.TimeEntries.Insert(
+		&models.TimeEntrySetter{
+			ID:              omit.From(entry.ID),
+			UserID:          omit.From(entry.UserID),
+			TaskID:          omit.From(entry.TaskID),
+			StartedAt:       omit.From(entry.StartedAt),
+			EndedAt:         omitnull.FromNull(entry.EndedAt),
+			DurationSeconds: omit.From(entry.DurationSeconds),
+			Note:            omitnull.FromNull(entry.Note),
+			CreatedAt:       omit.From(entry.CreatedAt),
+			UpdatedAt:       omit.From(entry.UpdatedAt),
+		},
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to create time entry",
+			slog.String("time_entry_id", entry.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to create time entry: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: CreateEntry completed",
+		slog.String("time_entry_id", entry.ID),
+	)
+	return nil
+}
+
+// UpdateEntry は時間記録の開始・終了日時、記録時間、メモを更新します
+func (r *timeEntryRepository) UpdateEntry(ctx context.Context, entry *models.TimeEntry) error {
+	r.logger.InfoContext(ctx, "Repository: UpdateEntry started",
+		slog.String("time_entry_id", entry.ID),
+	)
+
+	// 更新時刻を設定
+	entry.UpdatedAt = time.Now()
+
+	setter := &models.TimeEntrySetter{
+		StartedAt:       omit.From(entry.StartedAt),
+		EndedAt:         omitnull.FromNull(entry.EndedAt),
+		DurationSeconds: omit.From(entry.DurationSeconds),
+		Note:            omitnull.FromNull(entry.Note),
+		UpdatedAt:       omit.From(entry.UpdatedAt),
+	}
+
+	_, err := models.TimeEntries.Update(
+		setter.UpdateMod(),
+		um.Where(models.TimeEntries.Columns.ID.EQ(mysql.Arg(entry.ID))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to update time entry",
+			slog.String("time_entry_id", entry.ID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to update time entry: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: UpdateEntry completed",
+		slog.String("time_entry_id", entry.ID),
+	)
+	return nil
+}
+
+// StopRunningEntriesByTaskID はタスクの計測中の時間記録を指定日時で終了します（タスクをゴミ箱へ移動する際に使用）
+func (r *timeEntryRepository) StopRunningEntriesByTaskID(ctx context.Context, taskID string, endedAt time.Time) (int64, error) {
+	r.logger.InfoContext(ctx, "Repository: StopRunningEntriesByTaskID started",
+		slog.String("task_id", taskID),
+	)
+
+	rowsAffected, err := models.TimeEntries.Update(
+		um.SetCol("ended_at").To(mysql.Raw("GREATEST(started_at, ?)", endedAt)),
+		um.SetCol("duration_seconds").To(mysql.Raw("GREATEST(TIMESTAMPDIFF(SECOND, started_at, ?), 0)", endedAt)),
+		um.Where(models.TimeEntries.Columns.TaskID.EQ(mysql.Arg(taskID))),
+		um.Where(models.TimeEntries.Columns.EndedAt.IsNull()),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to stop running time entries",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("failed to stop running time entries: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: StopRunningEntriesByTaskID completed",
+		slog.String("task_id", taskID),
+		slog.Int64("count", rowsAffected),
+	)
+	return rowsAffected, nil
+}
+
+// DeleteEntry は時間記録を削除します
+func (r *timeEntryRepository) DeleteEntry(ctx context.Context, id string) error {
+	r.logger.InfoContext(ctx, "Repository: DeleteEntry started",
+		slog.String("time_entry_id", id),
+	)
+
+	_, err := models.TimeEntries.Delete(
+		dm.Where(models.TimeEntries.Columns.ID.EQ(mysql.Arg(id))),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to delete time entry",
+			slog.String("time_entry_id", id),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to delete time entry: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: DeleteEntry completed",
+		slog.String("time_entry_id", id),
+	)
+	return nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/mysql"
+	"github.com/stephenafamo/bob/dialect/mysql/im"
+	"github.com/stephenafamo/bob/dialect/mysql/sm"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+type userSettingRepository struct {
+	db     bob.Executor
+	logger *slog.Logger
+}
+
+// NewUserSettingRepository は新しいUserSettingRepositoryを生成します
+func NewUserSettingRepository(db *sql.DB, logger *slog.Logger) interfaces.UserSettingRepository {
+	return NewUserSettingRepositoryWithExecutor(bob.NewDB(db), logger)
+}
+
+// NewUserSettingRepositoryWithExecutor は既存のexecutorを使ってUserSettingRepositoryを生成します
+func NewUserSettingRepositoryWithExecutor(exec bob.Executor, logger *slog.Logger) interfaces.UserSettingRepository {
+	return &userSettingRepository{
+		db:     exec,
+		logger: logger,
+	}
+}
+
+// GetSettingByUserID はユーザーの設定を取得します（保存されていない場合はnil）
+func (r *userSettingRepository) GetSettingByUserID(ctx context.Context, userID string) (*models.UserSetting, error) {
+	r.logger.InfoContext(ctx, "Repository: GetSettingByUserID started",
+		slog.String("user_id", userID),
+	)
+
+	setting, err := models.UserSettings.Query(
+		sm.Where(models.UserSettings.Columns.UserID.EQ(mysql.Arg(userID))),
+	).One(ctx, r.db)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.ErrorContext(ctx, "Repository: Failed to query user setting",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to find user setting: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetSettingByUserID completed",
+		slog.String("user_id", userID),
+	)
+	return setting, nil
+}
+
+// SaveSetting はユーザーの設定を保存します（保存されていない場合は作成し、保存済みの場合は上書き）
+func (r *userSettingRepository) SaveSetting(ctx context.Context, setting *models.UserSetting) error {
+	r.logger.InfoContext(ctx, "Repository: SaveSetting started",
+		slog.String("user_id", setting.UserID),
+	)
+
+	// 現在時刻を設定
+	now := time.Now()
+	if setting.CreatedAt.IsZero() {
+		setting.CreatedAt = now
+	}
+	setting.UpdatedAt = now
+
+	_, err := models.UserSettings.Insert(
+		&models.UserSettingSetter{
+			UserID:          omit.From(setting.UserID),
+			AutoArchiveDays: omitnull.FromNull(setting.AutoArchiveDays),
+			Timezone:        omitnull.FromNull(setting.Timezone),
+			CreatedAt:       omit.From(setting.CreatedAt),
+			UpdatedAt:       omit.From(setting.UpdatedAt),
+		},
+		im.OnDuplicateKeyUpdate(im.UpdateWithValues("auto_archive_days", "timezone", "updated_at")),
+	).Exec(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to save user setting",
+			slog.String("user_id", setting.UserID),
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("failed to save user setting: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: SaveSetting completed",
+		slog.String("user_id", setting.UserID),
+	)
+	return nil
+}
+
+// GetAutoArchiveSettings は完了したタスクの自動アーカイブを有効にしている全ユーザーの設定を取得します
+func (r *userSettingRepository) GetAutoArchiveSettings(ctx context.Context) (models.UserSettingSlice, error) {
+	r.logger.InfoContext(ctx, "Repository: GetAutoArchiveSettings started")
+
+	settings, err := models.UserSettings.Query(
+		sm.Where(models.UserSettings.Columns.AutoArchiveDays.IsNotNull()),
+		sm.OrderBy(mysql.Raw("user_id ASC")),
+	).All(ctx, r.db)
+
+	if err != nil {
+		r.logger.ErrorContext(ctx, "Repository: Failed to query auto archive settings",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to get auto archive settings: %w", err)
+	}
+
+	r.logger.InfoContext(ctx, "Repository: GetAutoArchiveSettings completed",
+		slog.Int("count", len(settings)),
+	)
+	return settings, nil
+}
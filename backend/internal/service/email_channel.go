@@ -0,0 +1,120 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+// smtpTimeout はSMTPサーバーとの1回のやり取り全体のタイムアウト
+const smtpTimeout = 30 * time.Second
+
+// EmailNotificationChannel はリマインダーをSMTPでメール送信するチャネル
+type EmailNotificationChannel struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+}
+
+// NewEmailNotificationChannel は新しいEmailNotificationChannelを生成します
+// usernameが空の場合はSMTP認証を行いません
+func NewEmailNotificationChannel(host, port, username, password, from string) *EmailNotificationChannel {
+	return &EmailNotificationChannel{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// Name はチャネル名を返します
+func (c *EmailNotificationChannel) Name() string {
+	return NotificationChannelEmail
+}
+
+// Send はリマインダーをユーザーのメールアドレスに送信します
+// サーバーがSTARTTLSに対応している場合は暗号化してから認証・送信します
+func (c *EmailNotificationChannel) Send(ctx context.Context, reminder *entity.Reminder) error {
+	if reminder.UserEmail == "" {
+		return fmt.Errorf("user email is empty: %s", reminder.UserID)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(c.host, c.port))
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to create SMTP client: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: c.host}); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+	if c.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.username, c.password, c.host)); err != nil {
+			return fmt.Errorf("failed to authenticate with SMTP server: %w", err)
+		}
+	}
+
+	if err := client.Mail(c.from); err != nil {
+		return fmt.Errorf("failed to set sender: %w", err)
+	}
+	if err := client.Rcpt(reminder.UserEmail); err != nil {
+		return fmt.Errorf("failed to set recipient: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start message: %w", err)
+	}
+	if _, err := writer.Write(c.buildMessage(reminder)); err != nil {
+		writer.Close()
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// buildMessage はリマインダーのメール（ヘッダーと本文）を組み立てます
+func (c *EmailNotificationChannel) buildMessage(reminder *entity.Reminder) []byte {
+	title, body := reminderMessage(reminder)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", c.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", reminder.UserEmail)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", title))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(title+"\n\n"+body+"\n", "\n", "\r\n"))
+	return buf.Bytes()
+}
@@ -6,11 +6,14 @@ import (
 	"embed"
 	"encoding/json"
 	"fmt"
+	"math"
 	"text/template"
 	"time"
 
 	"github.com/google/generative-ai-go/genai"
 	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/recurrence"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
 	"google.golang.org/api/option"
 )
 
@@ -70,8 +73,10 @@ type InterpretInputResult struct {
 }
 
 // InterpretInput はユーザーの入力を解析します
-func (s *GeminiService) InterpretInput(ctx context.Context, inputText string) (*InterpretInputResult, error) {
-	prompt := buildPrompt(inputText)
+// projectNamesはタスクの割り当て先候補としてプロンプトに含めるユーザーのプロジェクト名です
+// estimateRatioは過去の見積もりに対する実績の比率で、見積もり工数の提案を補正するためにプロンプトに含めます（nilの場合は含めない）
+func (s *GeminiService) InterpretInput(ctx context.Context, inputText string, projectNames []string, estimateRatio *float64) (*InterpretInputResult, error) {
+	prompt := buildPrompt(inputText, projectNames, estimateRatio)
 
 	resp, err := s.model.GenerateContent(ctx, genai.Text(prompt))
 	if err != nil {
@@ -163,10 +168,31 @@ func convertToInterpretationMetadata(raw map[string]interface{}) entity.Interpre
 		}
 	}
 
+	// 繰り返しルールの変換（RRULEとして解釈できないものは破棄）
+	if rule, ok := raw["recurrence"].(string); ok && rule != "" {
+		if err := recurrence.Validate(rule); err == nil {
+			normalized := recurrence.Normalize(rule)
+			metadata.Recurrence = &normalized
+		}
+	}
+
+	// 見積もり工数の変換（JSONの数値はfloat64、範囲外の値は破棄）
+	if estimate, ok := raw["estimate_minutes"].(float64); ok {
+		minutes := int32(math.Round(estimate))
+		if minutes > 0 && minutes <= validation.MaxTaskEstimateMinutes {
+			metadata.EstimateMinutes = &minutes
+		}
+	}
+
+	// プロジェクト名の変換（既存プロジェクトとの照合はハンドラーで行う）
+	if project, ok := raw["project"].(string); ok && project != "" {
+		metadata.Project = &project
+	}
+
 	// その他のフィールドはExtraに格納
 	for key, value := range raw {
 		switch key {
-		case "tags", "priority", "deadline":
+		case "tags", "priority", "deadline", "recurrence", "estimate_minutes", "project":
 			// 既に処理済みまたは別途処理
 		default:
 			metadata.Extra[key] = value
@@ -177,10 +203,14 @@ func convertToInterpretationMetadata(raw map[string]interface{}) entity.Interpre
 }
 
 // buildPrompt は解析用のプロンプトを構築します
-func buildPrompt(inputText string) string {
+func buildPrompt(inputText string, projectNames []string, estimateRatio *float64) string {
 	var buf bytes.Buffer
 	data := map[string]interface{}{
-		"Input": inputText,
+		"Input":    inputText,
+		"Projects": projectNames,
+	}
+	if estimateRatio != nil {
+		data["EstimateRatio"] = fmt.Sprintf("%.1f", *estimateRatio)
 	}
 
 	err := promptTemplate.ExecuteTemplate(&buf, "interpretation.tmpl", data)
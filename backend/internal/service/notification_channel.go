@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// 配信状態の記録に使うチャネル名
+const (
+	NotificationChannelInApp   = "in_app"
+	NotificationChannelEmail   = "email"
+	NotificationChannelWebhook = "webhook"
+)
+
+// reminderDueAtLayout はリマインダーの本文に表示する期限日時の書式（ユーザーのタイムゾーンは不明なためUTCで表示）
+const reminderDueAtLayout = "2006-01-02 15:04 MST"
+
+// reminderMessage は各チャネル共通のリマインダーのタイトルと本文を組み立てます
+func reminderMessage(reminder *entity.Reminder) (string, string) {
+	title := fmt.Sprintf("「%s」の期限が近づいています", reminder.TaskTitle)
+	if reminder.Offset <= 0 {
+		title = fmt.Sprintf("「%s」の期限になりました", reminder.TaskTitle)
+	}
+	body := fmt.Sprintf("期限: %s", reminder.DueAt.UTC().Format(reminderDueAtLayout))
+	return title, body
+}
+
+// InAppNotificationChannel はリマインダーをアプリ内通知として発行するチャネル
+type InAppNotificationChannel struct {
+	producer interfaces.NotificationProducer
+}
+
+// NewInAppNotificationChannel は新しいInAppNotificationChannelを生成します
+func NewInAppNotificationChannel(producer interfaces.NotificationProducer) *InAppNotificationChannel {
+	return &InAppNotificationChannel{producer: producer}
+}
+
+// Name はチャネル名を返します
+func (c *InAppNotificationChannel) Name() string {
+	return NotificationChannelInApp
+}
+
+// Send はリマインダーをユーザーの未読通知として追加します
+func (c *InAppNotificationChannel) Send(ctx context.Context, reminder *entity.Reminder) error {
+	title, body := reminderMessage(reminder)
+	_, err := c.producer.Notify(ctx, &entity.NewNotification{
+		UserID: reminder.UserID,
+		Type:   entity.NotificationTypeTaskReminder,
+		Title:  title,
+		Body:   &body,
+		TaskID: &reminder.TaskID,
+	})
+	return err
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+const (
+	// webhookTimeout はWebhookの1回のリクエストのタイムアウト
+	webhookTimeout = 10 * time.Second
+	// WebhookSignatureHeader は署名（sha256=<HMAC-SHA256の16進数>）を送るヘッダー
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// webhookReminderPayload はWebhookで送信するリマインダーのJSON
+type webhookReminderPayload struct {
+	Type          string    `json:"type"`
+	UserID        string    `json:"user_id"`
+	TaskID        string    `json:"task_id"`
+	TaskTitle     string    `json:"task_title"`
+	DueAt         time.Time `json:"due_at"`
+	OffsetMinutes int       `json:"offset_minutes"`
+	Title         string    `json:"title"`
+	Body          string    `json:"body"`
+	SentAt        time.Time `json:"sent_at"`
+}
+
+// WebhookNotificationChannel はリマインダーを設定されたURLにJSONでPOSTするチャネル
+type WebhookNotificationChannel struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewWebhookNotificationChannel は新しいWebhookNotificationChannelを生成します
+// secretが空でない場合はボディのHMAC-SHA256署名をヘッダーに付与します
+func NewWebhookNotificationChannel(url, secret string) *WebhookNotificationChannel {
+	return &WebhookNotificationChannel{
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Name はチャネル名を返します
+func (c *WebhookNotificationChannel) Name() string {
+	return NotificationChannelWebhook
+}
+
+// Send はリマインダーをWebhookに送信します（2xx以外のレスポンスはエラー）
+func (c *WebhookNotificationChannel) Send(ctx context.Context, reminder *entity.Reminder) error {
+	title, body := reminderMessage(reminder)
+	payload, err := json.Marshal(webhookReminderPayload{
+		Type:          string(entity.NotificationTypeTaskReminder),
+		UserID:        reminder.UserID,
+		TaskID:        reminder.TaskID,
+		TaskTitle:     reminder.TaskTitle,
+		DueAt:         reminder.DueAt,
+		OffsetMinutes: int(reminder.Offset / time.Minute),
+		Title:         title,
+		Body:          body,
+		SentAt:        time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.secret != "" {
+		mac := hmac.New(sha256.New, []byte(c.secret))
+		mac.Write(payload)
+		req.Header.Set(WebhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
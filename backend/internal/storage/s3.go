@@ -0,0 +1,221 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+const (
+	// s3Service はAWS Signature Version 4の署名に使うサービス名
+	s3Service = "s3"
+	// s3UnsignedPayload はボディのハッシュを署名に含めないことを示す値（アップロード時にボディを2回読まないため）
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	// s3EmptyPayloadHash はボディのないリクエストのSHA-256
+	s3EmptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	// s3ErrorBodyLimit はエラーレスポンスからメッセージとして読み込む最大バイト数
+	s3ErrorBodyLimit = 1024
+)
+
+// S3Options はS3互換ストレージの接続設定
+type S3Options struct {
+	// Endpoint はAPIのURL（例: https://s3.ap-northeast-1.amazonaws.com、http://localhost:9000）
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle はバケット名をホスト名ではなくパスに含めるか（MinIO等で使用）
+	UsePathStyle bool
+}
+
+// S3Storage はS3互換ストレージ（AWS S3、MinIO等）のバケットにファイルを保存します
+// SDKに依存せず、REST APIをAWS Signature Version 4で署名して呼び出します
+type S3Storage struct {
+	endpoint *url.URL
+	options  S3Options
+	client   *http.Client
+}
+
+// NewS3Storage は新しいS3Storageを生成します
+func NewS3Storage(options S3Options) (interfaces.AttachmentStorage, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(options.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("invalid S3 endpoint: %s", options.Endpoint)
+	}
+	if options.Bucket == "" || options.Region == "" || options.AccessKeyID == "" || options.SecretAccessKey == "" {
+		return nil, fmt.Errorf("S3 bucket, region and credentials are required")
+	}
+	return &S3Storage{
+		endpoint: endpoint,
+		options:  options,
+		client:   &http.Client{Timeout: 5 * time.Minute},
+	}, nil
+}
+
+// Put はファイルをアップロードします（PutObject）
+func (s *S3Storage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	s.sign(req, s3UnsignedPayload, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to upload object: %w", s3ResponseError(resp))
+	}
+	return nil
+}
+
+// Get はファイルをダウンロードします（GetObject、存在しない場合はErrObjectNotFound）
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, s3EmptyPayloadHash, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download object: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrObjectNotFound
+	default:
+		defer resp.Body.Close()
+		return nil, fmt.Errorf("failed to download object: %w", s3ResponseError(resp))
+	}
+}
+
+// Delete はファイルを削除します（DeleteObject、存在しない場合も成功）
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	s.sign(req, s3EmptyPayloadHash, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("failed to delete object: %w", s3ResponseError(resp))
+	}
+}
+
+// newRequest はオブジェクトのURLへのリクエストを生成します
+func (s *S3Storage) newRequest(ctx context.Context, method string, key string, body io.Reader) (*http.Request, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	objectURL := *s.endpoint
+	path := "/" + key
+	if s.options.UsePathStyle {
+		path = "/" + s.options.Bucket + path
+	} else {
+		objectURL.Host = s.options.Bucket + "." + s.endpoint.Host
+	}
+	objectURL.Path = s.endpoint.Path + path
+	objectURL.RawPath = s3EscapePath(objectURL.Path)
+
+	req, err := http.NewRequestWithContext(ctx, method, objectURL.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	return req, nil
+}
+
+// sign はリクエストにAWS Signature Version 4のAuthorizationヘッダーを付与します
+// 署名対象のヘッダーはhost・x-amz-content-sha256・x-amz-dateのみです
+func (s *S3Storage) sign(req *http.Request, payloadHash string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.options.Region + "/" + s3Service + "/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.options.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.options.Region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.options.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// hmacSHA256 はkeyでdataのHMAC-SHA256を計算します
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3EscapePath はキーをS3の署名の仕様に沿ってURLエンコードします（"/" はそのまま）
+// 英数字と "-" "_" "." "~" 以外のバイトをすべて %XX に変換します
+func s3EscapePath(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+// s3ResponseError はエラーレスポンスのステータスとボディの先頭をエラーにします
+func s3ResponseError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, s3ErrorBodyLimit))
+	return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,27 @@
+// Package storage は添付ファイルの本体を保存するストレージの実装を提供します
+//
+// ローカルファイルシステムとS3互換ストレージ（AWS S3、MinIO等）に対応し、
+// どちらもinterfaces.AttachmentStorageとして使用します
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrObjectNotFound は指定したキーのファイルがストレージに存在しない場合のエラー
+var ErrObjectNotFound = errors.New("object not found")
+
+// validateKey はキーがストレージのルートの外を指さない相対パスであることを検証します
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("invalid storage key: %q", key)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("invalid storage key: %q", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,484 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/types"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/ranking"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+type accountArchiveUsecase struct {
+	db       *sql.DB
+	userRepo repository.UserRepository
+	logger   *slog.Logger
+}
+
+// NewAccountArchiveUsecase は新しいAccountArchiveUsecaseを生成します
+// dbはエクスポートで一貫したデータを読み出すため、インポートですべてのデータを同一トランザクションで作成するために使用します
+func NewAccountArchiveUsecase(db *sql.DB, userRepo repository.UserRepository, logger *slog.Logger) interfaces.AccountArchiveUsecase {
+	return &accountArchiveUsecase{
+		db:       db,
+		userRepo: userRepo,
+		logger:   logger,
+	}
+}
+
+// ExportAccount はユーザーのプロフィール・プロジェクト・タスク・AI解釈とそのアイテムを取得します
+// ゴミ箱のタスクは含みません
+func (u *accountArchiveUsecase) ExportAccount(ctx context.Context) (*entity.AccountArchive, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: ExportAccount started",
+		slog.String("user_id", userID),
+	)
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+	user, err := u.userRepo.GetUserByID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	archive := &entity.AccountArchive{
+		Manifest: entity.AccountArchiveManifest{
+			Format:     entity.AccountArchiveFormat,
+			Version:    entity.AccountArchiveVersion,
+			ExportedAt: time.Now().UTC(),
+		},
+		Profile: entity.AccountProfileRecord{
+			ID:        user.ID,
+			Email:     user.Email,
+			Nickname:  user.Nickname,
+			Avatar:    nullStringPtr(user.Avatar),
+			CreatedAt: user.CreatedAt,
+		},
+	}
+
+	// 読み出し中の変更でデータ間の参照がずれないよう、同一トランザクション（スナップショット）で読み出す
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		projectRepo := repository.NewProjectRepositoryWithExecutor(tx, u.logger)
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		archiveRepo := repository.NewAccountArchiveRepositoryWithExecutor(tx, u.logger)
+
+		projects, err := projectRepo.GetProjectsByUserID(ctx, userID, true)
+		if err != nil {
+			return err
+		}
+		tasks, err := taskRepo.GetTasksByUserID(ctx, userID, true, true)
+		if err != nil {
+			return err
+		}
+		interpretations, err := archiveRepo.GetInterpretationsByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		items, err := archiveRepo.GetInterpretationItemsByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		archive.Projects = make([]entity.AccountProjectRecord, len(projects))
+		for i, project := range projects {
+			archive.Projects[i] = toAccountProjectRecord(project)
+		}
+		archive.Tasks = make([]entity.AccountTaskRecord, len(tasks))
+		for i, task := range tasks {
+			archive.Tasks[i] = toAccountTaskRecord(task)
+		}
+		archive.Interpretations = make([]entity.AccountInterpretationRecord, len(interpretations))
+		for i, interpretation := range interpretations {
+			archive.Interpretations[i] = toAccountInterpretationRecord(interpretation)
+		}
+		archive.InterpretationItems = make([]entity.AccountInterpretationItemRecord, len(items))
+		for i, item := range items {
+			archive.InterpretationItems[i] = toAccountInterpretationItemRecord(item)
+		}
+		return nil
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to export account",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: ExportAccount completed",
+		slog.String("user_id", userID),
+		slog.Int("projects", len(archive.Projects)),
+		slog.Int("tasks", len(archive.Tasks)),
+		slog.Int("interpretations", len(archive.Interpretations)),
+		slog.Int("interpretation_items", len(archive.InterpretationItems)),
+	)
+	return archive, nil
+}
+
+// ImportAccount はアーカイブのデータをユーザーのアカウントに復元します
+// すべてのデータに新しいIDを振り直し、タスク・AI解釈・アイテム・プロジェクト間の参照は新しいIDで張り直します
+// プロフィールはログイン中のアカウントのものを維持し、同名のプロジェクトが既にある場合はそれを使います
+// 復元はすべて同一トランザクションで行います（途中で失敗した場合は何も作成されません）
+func (u *accountArchiveUsecase) ImportAccount(ctx context.Context, archive *entity.AccountArchive) (*entity.AccountImportResult, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: ImportAccount started",
+		slog.String("user_id", userID),
+		slog.Int("projects", len(archive.Projects)),
+		slog.Int("tasks", len(archive.Tasks)),
+		slog.Int("interpretations", len(archive.Interpretations)),
+		slog.Int("interpretation_items", len(archive.InterpretationItems)),
+	)
+
+	if err := validation.ValidateAccountArchive(archive); err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Invalid account archive",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	result := &entity.AccountImportResult{}
+	now := time.Now()
+
+	err := database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		projectRepo := repository.NewProjectRepositoryWithExecutor(tx, u.logger)
+		archiveRepo := repository.NewAccountArchiveRepositoryWithExecutor(tx, u.logger)
+		eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+
+		// プロジェクト: 同名のプロジェクトがあればそれを使い、なければ作成する
+		existing, err := projectRepo.GetProjectsByUserID(ctx, userID, true)
+		if err != nil {
+			return err
+		}
+		// プロジェクト名の一意制約は大文字小文字を区別しないため、小文字で照合する
+		projectsByName := make(map[string]string, len(existing))
+		for _, project := range existing {
+			projectsByName[strings.ToLower(project.Name)] = project.ID
+		}
+
+		projectIDs := make(map[string]string, len(archive.Projects))
+		for _, record := range archive.Projects {
+			if id, ok := projectsByName[strings.ToLower(record.Name)]; ok {
+				projectIDs[record.ID] = id
+				result.ProjectsMerged++
+				continue
+			}
+
+			project := &models.Project{
+				ID:        uuid.New().String(),
+				UserID:    userID,
+				Name:      record.Name,
+				Archived:  record.Archived,
+				SortOrder: record.SortOrder,
+			}
+			if record.Color != nil {
+				project.Color = null.From(*record.Color)
+			}
+			if err := projectRepo.CreateProject(ctx, project); err != nil {
+				return err
+			}
+			projectsByName[strings.ToLower(project.Name)] = project.ID
+			projectIDs[record.ID] = project.ID
+			result.ProjectsCreated++
+		}
+
+		// AI解釈
+		interpretationIDs := make(map[string]string, len(archive.Interpretations))
+		for _, record := range archive.Interpretations {
+			interpretation := &models.AiInterpretation{
+				ID:               uuid.New().String(),
+				UserID:           userID,
+				InputText:        record.InputText,
+				StructuredResult: types.NewJSON(record.StructuredResult),
+				AiModel:          record.AIModel,
+				CreatedAt:        timeOrNow(record.CreatedAt, now),
+			}
+			if len(record.OriginalResult) > 0 && string(record.OriginalResult) != "null" {
+				interpretation.OriginalResult = null.From(types.NewJSON(record.OriginalResult))
+			}
+			if record.AIPromptTokens != nil {
+				interpretation.AiPromptTokens = null.From(*record.AIPromptTokens)
+			}
+			if record.AICompletionTokens != nil {
+				interpretation.AiCompletionTokens = null.From(*record.AICompletionTokens)
+			}
+			if err := archiveRepo.RestoreInterpretation(ctx, interpretation); err != nil {
+				return err
+			}
+			interpretationIDs[record.ID] = interpretation.ID
+			result.Interpretations++
+		}
+
+		// タスク: 繰り返しシリーズIDは最初のタスクのIDであることが多いため、先にすべてのタスクのIDを決める
+		taskIDs := make(map[string]string, len(archive.Tasks))
+		for _, record := range archive.Tasks {
+			taskIDs[record.ID] = uuid.New().String()
+		}
+		seriesIDs := make(map[string]string)
+
+		// ステータスは同名のステータスがあればそれを使い、なければカテゴリの近いステータスに対応付ける
+		statusRepo := repository.NewTaskStatusRepositoryWithExecutor(tx, u.logger)
+		statuses, err := loadTaskStatuses(ctx, statusRepo, userID)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range archive.Tasks {
+			task := &models.Task{
+				ID:        taskIDs[record.ID],
+				UserID:    userID,
+				Title:     record.Title,
+				RankKey:   record.RankKey,
+				Source:    record.Source,
+				CreatedAt: timeOrNow(record.CreatedAt, now),
+				UpdatedAt: timeOrNow(record.UpdatedAt, now),
+			}
+			setTaskStatus(task, matchTaskStatus(statuses, record.Status))
+			if ranking.Validate(task.RankKey) != nil {
+				// 不正な表示順キーは未配置として扱う
+				task.RankKey = ""
+			}
+			if record.Description != nil {
+				task.Description = null.From(*record.Description)
+			}
+			if record.DueAt != nil {
+				task.DueAt = null.From(*record.DueAt)
+			}
+			// 着手・完了日時はステータスと矛盾しない場合のみ引き継ぐ（記録のない古いアーカイブは更新日時で代用）
+			if record.StartedAt != nil && task.StatusCategory != entity.TaskStatusCategoryTodo {
+				task.StartedAt = null.From(*record.StartedAt)
+			} else if task.StatusCategory == entity.TaskStatusCategoryDoing {
+				task.StartedAt = null.From(task.UpdatedAt)
+			}
+			if record.CompletedAt != nil && task.StatusCategory == entity.TaskStatusCategoryDone {
+				task.CompletedAt = null.From(*record.CompletedAt)
+			} else if task.StatusCategory == entity.TaskStatusCategoryDone {
+				task.CompletedAt = null.From(task.UpdatedAt)
+			}
+			// アーカイブは完了したタスクのみ引き継ぐ
+			if record.ArchivedAt != nil && task.StatusCategory == entity.TaskStatusCategoryDone {
+				task.ArchivedAt = null.From(*record.ArchivedAt)
+			}
+			task.EstimateMinutes = taskEstimateMinutes(record.EstimateMinutes)
+			if record.ProjectID != nil {
+				if id, ok := projectIDs[*record.ProjectID]; ok {
+					task.ProjectID = null.From(id)
+				}
+			}
+			if record.AIInterpretationID != nil {
+				if id, ok := interpretationIDs[*record.AIInterpretationID]; ok {
+					task.AiInterpretationID = null.From(id)
+				}
+			}
+			if record.RecurrenceSeriesID != nil {
+				task.RecurrenceSeriesID = null.From(remapSeriesID(*record.RecurrenceSeriesID, taskIDs, seriesIDs))
+			}
+			setTaskRecurrence(task, record.RecurrenceRule, record.RecurrenceAnchorAt)
+
+			if err := archiveRepo.RestoreTask(ctx, task); err != nil {
+				return err
+			}
+			if err := recordTaskEvent(ctx, eventRepo, entity.TaskEventTypeCreated, entity.TaskEventSourceManual, nil, task); err != nil {
+				return err
+			}
+			result.Tasks++
+		}
+
+		// AI解釈アイテム: 作成済みリソースがアーカイブ内のタスクであれば新しいタスクIDを参照する
+		for _, record := range archive.InterpretationItems {
+			item := &models.InterpretationItem{
+				ID:               uuid.New().String(),
+				InterpretationID: interpretationIDs[record.InterpretationID],
+				ItemIndex:        record.ItemIndex,
+				ResourceType:     record.ResourceType,
+				Status:           record.Status,
+				Data:             types.NewJSON(record.Data),
+				OriginalData:     types.NewJSON(record.OriginalData),
+				CreatedAt:        timeOrNow(record.CreatedAt, now),
+				UpdatedAt:        timeOrNow(record.UpdatedAt, now),
+			}
+			if record.ResourceID != nil && record.ResourceType == "task" {
+				if id, ok := taskIDs[*record.ResourceID]; ok {
+					item.ResourceID = null.From(id)
+				}
+			}
+			if record.ReviewedAt != nil {
+				item.ReviewedAt = null.From(*record.ReviewedAt)
+			}
+			if err := archiveRepo.RestoreInterpretationItem(ctx, item); err != nil {
+				return err
+			}
+			result.InterpretationItems++
+		}
+		return nil
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to import account",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: ImportAccount completed",
+		slog.String("user_id", userID),
+		slog.Int("projects_created", result.ProjectsCreated),
+		slog.Int("projects_merged", result.ProjectsMerged),
+		slog.Int("tasks", result.Tasks),
+		slog.Int("interpretations", result.Interpretations),
+		slog.Int("interpretation_items", result.InterpretationItems),
+	)
+	return result, nil
+}
+
+// remapSeriesID は繰り返しシリーズIDを新しいIDに置き換えます
+// シリーズIDがアーカイブ内のタスクのIDであればそのタスクの新しいIDを使い、それ以外は同じシリーズに同じ新しいIDを割り当てます
+func remapSeriesID(seriesID string, taskIDs map[string]string, seriesIDs map[string]string) string {
+	if id, ok := taskIDs[seriesID]; ok {
+		return id
+	}
+	if id, ok := seriesIDs[seriesID]; ok {
+		return id
+	}
+	id := uuid.New().String()
+	seriesIDs[seriesID] = id
+	return id
+}
+
+// timeOrNow はアーカイブに日時がない場合に現在時刻を返します
+func timeOrNow(t time.Time, now time.Time) time.Time {
+	if t.IsZero() {
+		return now
+	}
+	return t
+}
+
+func toAccountProjectRecord(project *models.Project) entity.AccountProjectRecord {
+	return entity.AccountProjectRecord{
+		ID:        project.ID,
+		Name:      project.Name,
+		Color:     nullStringPtr(project.Color),
+		Archived:  project.Archived,
+		SortOrder: project.SortOrder,
+		CreatedAt: project.CreatedAt,
+		UpdatedAt: project.UpdatedAt,
+	}
+}
+
+func toAccountTaskRecord(task *models.Task) entity.AccountTaskRecord {
+	record := entity.AccountTaskRecord{
+		ID:                 task.ID,
+		ProjectID:          nullStringPtr(task.ProjectID),
+		Title:              task.Title,
+		Description:        nullStringPtr(task.Description),
+		Status:             task.Status,
+		RankKey:            task.RankKey,
+		Source:             task.Source,
+		AIInterpretationID: nullStringPtr(task.AiInterpretationID),
+		RecurrenceRule:     nullStringPtr(task.RecurrenceRule),
+		RecurrenceSeriesID: nullStringPtr(task.RecurrenceSeriesID),
+		CreatedAt:          task.CreatedAt,
+		UpdatedAt:          task.UpdatedAt,
+	}
+	if task.DueAt.IsValue() {
+		dueAt := task.DueAt.MustGet()
+		record.DueAt = &dueAt
+	}
+	if task.EstimateMinutes.IsValue() {
+		estimate := task.EstimateMinutes.MustGet()
+		record.EstimateMinutes = &estimate
+	}
+	if task.RecurrenceAnchorAt.IsValue() {
+		anchorAt := task.RecurrenceAnchorAt.MustGet()
+		record.RecurrenceAnchorAt = &anchorAt
+	}
+	if task.StartedAt.IsValue() {
+		startedAt := task.StartedAt.MustGet()
+		record.StartedAt = &startedAt
+	}
+	if task.CompletedAt.IsValue() {
+		completedAt := task.CompletedAt.MustGet()
+		record.CompletedAt = &completedAt
+	}
+	if task.ArchivedAt.IsValue() {
+		archivedAt := task.ArchivedAt.MustGet()
+		record.ArchivedAt = &archivedAt
+	}
+	return record
+}
+
+func toAccountInterpretationRecord(interpretation *models.AiInterpretation) entity.AccountInterpretationRecord {
+	record := entity.AccountInterpretationRecord{
+		ID:               interpretation.ID,
+		InputText:        interpretation.InputText,
+		StructuredResult: interpretation.StructuredResult.Val,
+		AIModel:          interpretation.AiModel,
+		CreatedAt:        interpretation.CreatedAt,
+	}
+	if interpretation.OriginalResult.IsValue() {
+		record.OriginalResult = interpretation.OriginalResult.MustGet().Val
+	}
+	if interpretation.AiPromptTokens.IsValue() {
+		tokens := interpretation.AiPromptTokens.MustGet()
+		record.AIPromptTokens = &tokens
+	}
+	if interpretation.AiCompletionTokens.IsValue() {
+		tokens := interpretation.AiCompletionTokens.MustGet()
+		record.AICompletionTokens = &tokens
+	}
+	return record
+}
+
+func toAccountInterpretationItemRecord(item *models.InterpretationItem) entity.AccountInterpretationItemRecord {
+	record := entity.AccountInterpretationItemRecord{
+		ID:               item.ID,
+		InterpretationID: item.InterpretationID,
+		ItemIndex:        item.ItemIndex,
+		ResourceType:     item.ResourceType,
+		ResourceID:       nullStringPtr(item.ResourceID),
+		Status:           item.Status,
+		Data:             item.Data.Val,
+		OriginalData:     item.OriginalData.Val,
+		CreatedAt:        item.CreatedAt,
+		UpdatedAt:        item.UpdatedAt,
+	}
+	if item.ReviewedAt.IsValue() {
+		reviewedAt := item.ReviewedAt.MustGet()
+		record.ReviewedAt = &reviewedAt
+	}
+	return record
+}
+
+// nullStringPtr はnull許容の文字列をポインタに変換します（nullはnil）
+func nullStringPtr(v null.Val[string]) *string {
+	if !v.IsValue() {
+		return nil
+	}
+	s := v.MustGet()
+	return &s
+}
@@ -0,0 +1,313 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/storage"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// attachmentPurgeBatchSize はゴミ箱のタスクの添付ファイルを1回に削除する最大件数
+const attachmentPurgeBatchSize = 100
+
+type attachmentUsecase struct {
+	repo     interfaces.TaskAttachmentRepository
+	taskRepo interfaces.TaskRepository
+	storage  interfaces.AttachmentStorage
+	maxSize  int64
+	logger   *slog.Logger
+}
+
+// NewAttachmentUsecase は新しいAttachmentUsecaseを生成します
+// メタデータはrepoに、ファイル本体はstorageに保存し、maxSizeを超えるファイルは受け付けません
+func NewAttachmentUsecase(repo interfaces.TaskAttachmentRepository, taskRepo interfaces.TaskRepository, storage interfaces.AttachmentStorage, maxSize int64, logger *slog.Logger) interfaces.AttachmentUsecase {
+	return &attachmentUsecase{
+		repo:     repo,
+		taskRepo: taskRepo,
+		storage:  storage,
+		maxSize:  maxSize,
+		logger:   logger,
+	}
+}
+
+// GetTaskAttachments はタスクの添付ファイル一覧をアップロード日時の古い順に取得します
+func (u *attachmentUsecase) GetTaskAttachments(ctx context.Context, taskID string) (models.TaskAttachmentSlice, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetTaskAttachments started",
+		slog.String("task_id", taskID),
+	)
+
+	if _, err := u.getOwnedTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := u.repo.GetAttachmentsByTaskID(ctx, taskID)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get attachments",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetTaskAttachments completed",
+		slog.String("task_id", taskID),
+		slog.Int("count", len(attachments)),
+	)
+	return attachments, nil
+}
+
+// UploadAttachment はファイルをタスクに添付します
+// MIMEタイプはファイルの内容から判定し、許可されていない形式は検証エラーにします
+// ストレージへの保存後にメタデータの作成に失敗した場合は、保存したファイルを削除します
+func (u *attachmentUsecase) UploadAttachment(ctx context.Context, taskID string, filename string, data []byte) (*models.TaskAttachment, error) {
+	u.logger.InfoContext(ctx, "UseCase: UploadAttachment started",
+		slog.String("task_id", taskID),
+		slog.Int("size", len(data)),
+	)
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("validation error: file is empty")
+	}
+	if int64(len(data)) > u.maxSize {
+		return nil, fmt.Errorf("attachment is too large: %d bytes", len(data))
+	}
+	name, err := validation.NormalizeAttachmentFilename(filename)
+	if err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	contentType, err := validation.DetectAttachmentContentType(name, data)
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Unsupported attachment type",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	task, err := u.getOwnedTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := u.repo.CountAttachmentsByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= validation.MaxAttachmentsPerTask {
+		return nil, fmt.Errorf("validation error: task can have %d attachments or less", validation.MaxAttachmentsPerTask)
+	}
+
+	checksum := sha256.Sum256(data)
+	attachment := &models.TaskAttachment{
+		ID:             uuid.New().String(),
+		UserID:         task.UserID,
+		TaskID:         task.ID,
+		Filename:       name,
+		ContentType:    contentType,
+		SizeBytes:      int64(len(data)),
+		ChecksumSha256: hex.EncodeToString(checksum[:]),
+	}
+	attachment.StorageKey = attachmentStorageKey(attachment)
+
+	if err := u.storage.Put(ctx, attachment.StorageKey, bytes.NewReader(data), attachment.SizeBytes, contentType); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to store attachment",
+			slog.String("task_id", taskID),
+			slog.String("storage_key", attachment.StorageKey),
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	if err := u.repo.CreateAttachment(ctx, attachment); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to create attachment",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		if deleteErr := u.storage.Delete(context.WithoutCancel(ctx), attachment.StorageKey); deleteErr != nil {
+			u.logger.ErrorContext(ctx, "UseCase: Failed to delete stored attachment after error",
+				slog.String("storage_key", attachment.StorageKey),
+				slog.String("error", deleteErr.Error()),
+			)
+		}
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: UploadAttachment completed",
+		slog.String("task_id", taskID),
+		slog.String("attachment_id", attachment.ID),
+		slog.String("content_type", contentType),
+	)
+	return attachment, nil
+}
+
+// DownloadAttachment は添付ファイルのメタデータと本体を取得します（本体は呼び出し側で閉じます）
+func (u *attachmentUsecase) DownloadAttachment(ctx context.Context, taskID string, attachmentID string) (*models.TaskAttachment, io.ReadCloser, error) {
+	u.logger.InfoContext(ctx, "UseCase: DownloadAttachment started",
+		slog.String("task_id", taskID),
+		slog.String("attachment_id", attachmentID),
+	)
+
+	attachment, err := u.getOwnedAttachment(ctx, taskID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, err := u.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			u.logger.ErrorContext(ctx, "UseCase: Attachment file is missing in storage",
+				slog.String("attachment_id", attachmentID),
+				slog.String("storage_key", attachment.StorageKey),
+			)
+			return nil, nil, fmt.Errorf("attachment not found: %s", attachmentID)
+		}
+		u.logger.ErrorContext(ctx, "UseCase: Failed to read attachment from storage",
+			slog.String("attachment_id", attachmentID),
+			slog.String("error", err.Error()),
+		)
+		return nil, nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: DownloadAttachment completed",
+		slog.String("attachment_id", attachmentID),
+	)
+	return attachment, body, nil
+}
+
+// DeleteAttachment はタスクの添付ファイルを削除します
+// メタデータを先に削除するため、ストレージからの削除に失敗してもダウンロードはできなくなります
+func (u *attachmentUsecase) DeleteAttachment(ctx context.Context, taskID string, attachmentID string) error {
+	u.logger.InfoContext(ctx, "UseCase: DeleteAttachment started",
+		slog.String("task_id", taskID),
+		slog.String("attachment_id", attachmentID),
+	)
+
+	attachment, err := u.getOwnedAttachment(ctx, taskID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteAttachment(ctx, attachmentID); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to delete attachment",
+			slog.String("attachment_id", attachmentID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	if err := u.storage.Delete(ctx, attachment.StorageKey); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to delete attachment file from storage",
+			slog.String("attachment_id", attachmentID),
+			slog.String("storage_key", attachment.StorageKey),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: DeleteAttachment completed",
+		slog.String("attachment_id", attachmentID),
+	)
+	return nil
+}
+
+// PurgeDeletedTaskAttachments は指定日時より前にゴミ箱へ移動したタスクの添付ファイルを全ユーザー分削除します（バックグラウンド処理用）
+// タスクの完全削除ではメタデータが外部キーで削除されるだけのため、その前にストレージ上のファイルを削除します
+func (u *attachmentUsecase) PurgeDeletedTaskAttachments(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	u.logger.InfoContext(ctx, "UseCase: PurgeDeletedTaskAttachments started",
+		slog.Time("deleted_before", deletedBefore),
+	)
+
+	var total int64
+	for {
+		attachments, err := u.repo.GetAttachmentsOfDeletedTasks(ctx, deletedBefore, attachmentPurgeBatchSize)
+		if err != nil {
+			return total, err
+		}
+
+		for _, attachment := range attachments {
+			if err := u.storage.Delete(ctx, attachment.StorageKey); err != nil {
+				u.logger.ErrorContext(ctx, "UseCase: Failed to delete attachment file from storage",
+					slog.String("attachment_id", attachment.ID),
+					slog.String("storage_key", attachment.StorageKey),
+					slog.String("error", err.Error()),
+				)
+				return total, fmt.Errorf("failed to delete attachment file: %w", err)
+			}
+			if err := u.repo.DeleteAttachment(ctx, attachment.ID); err != nil {
+				return total, err
+			}
+			total++
+		}
+
+		if len(attachments) < attachmentPurgeBatchSize {
+			break
+		}
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: PurgeDeletedTaskAttachments completed",
+		slog.Int64("count", total),
+	)
+	return total, nil
+}
+
+// getOwnedTask はログインユーザーが所有するタスク（ゴミ箱内を除く）を取得します
+// 他ユーザーのタスクは存在を明かさないため見つからない場合と同じエラーを返します
+func (u *attachmentUsecase) getOwnedTask(ctx context.Context, taskID string) (*models.Task, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	task, err := u.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized task access",
+			slog.String("task_id", taskID),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("task not found: %s", taskID)
+	}
+	return task, nil
+}
+
+// getOwnedAttachment はログインユーザーのタスクに添付されたファイルを取得します
+// 別のタスクの添付ファイルは見つからない場合と同じエラーを返します
+func (u *attachmentUsecase) getOwnedAttachment(ctx context.Context, taskID string, attachmentID string) (*models.TaskAttachment, error) {
+	task, err := u.getOwnedTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := u.repo.GetAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if attachment.TaskID != task.ID || attachment.UserID != task.UserID {
+		u.logger.WarnContext(ctx, "UseCase: Attachment does not belong to task",
+			slog.String("task_id", taskID),
+			slog.String("attachment_id", attachmentID),
+		)
+		return nil, fmt.Errorf("attachment not found: %s", attachmentID)
+	}
+	return attachment, nil
+}
+
+// attachmentStorageKey はストレージ上のキーを返します（ユーザー・タスクごとにまとめ、ファイル名は含めません）
+func attachmentStorageKey(attachment *models.TaskAttachment) string {
+	return fmt.Sprintf("attachments/%s/%s/%s", attachment.UserID, attachment.TaskID, attachment.ID)
+}
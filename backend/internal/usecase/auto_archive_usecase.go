@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// autoArchiveBatchSize は1回の更新でアーカイブする最大タスク数
+const autoArchiveBatchSize = 500
+
+type autoArchiveUsecase struct {
+	taskRepo    interfaces.TaskRepository
+	settingRepo interfaces.UserSettingRepository
+	logger      *slog.Logger
+}
+
+// NewAutoArchiveUsecase は新しいAutoArchiveUsecaseを生成します
+func NewAutoArchiveUsecase(taskRepo interfaces.TaskRepository, settingRepo interfaces.UserSettingRepository, logger *slog.Logger) interfaces.AutoArchiveUsecase {
+	return &autoArchiveUsecase{
+		taskRepo:    taskRepo,
+		settingRepo: settingRepo,
+		logger:      logger,
+	}
+}
+
+// ArchiveDoneTasks は自動アーカイブを有効にしているユーザーごとに、設定した日数より前に完了し
+// その後更新されていないタスクをアーカイブし、アーカイブした件数を返します
+// 一括の更新のため変更履歴には記録しません
+func (u *autoArchiveUsecase) ArchiveDoneTasks(ctx context.Context, now time.Time) (int64, error) {
+	settings, err := u.settingRepo.GetAutoArchiveSettings(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, setting := range settings {
+		days, ok := setting.AutoArchiveDays.Get()
+		if !ok {
+			continue
+		}
+		before := now.AddDate(0, 0, -int(days))
+
+		for {
+			count, err := u.taskRepo.ArchiveDoneTasks(ctx, setting.UserID, before, now, autoArchiveBatchSize)
+			if err != nil {
+				return total, err
+			}
+			total += count
+			if count < autoArchiveBatchSize {
+				break
+			}
+		}
+	}
+	return total, nil
+}
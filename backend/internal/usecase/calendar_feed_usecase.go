@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+)
+
+const (
+	// calendarFeedTokenBytes はフィードのトークンの乱数のバイト数
+	calendarFeedTokenBytes = 32
+	// maxCalendarFeedTokenLength は受け付けるトークンの最大長（base64urlで32バイトは43文字）
+	maxCalendarFeedTokenLength = 64
+	// calendarFeedPastWindow はフィードに含める過去の期限の範囲（古いタスクでフィードが肥大化しないようにする）
+	calendarFeedPastWindow = 180 * 24 * time.Hour
+)
+
+type calendarFeedUsecase struct {
+	db       *sql.DB
+	repo     interfaces.CalendarFeedRepository
+	taskRepo interfaces.TaskRepository
+	logger   *slog.Logger
+}
+
+// NewCalendarFeedUsecase は新しいCalendarFeedUsecaseを生成します
+// dbはトークンの再発行時に古いトークンの削除と新しいトークンの作成をトランザクションで行うために使用します
+func NewCalendarFeedUsecase(db *sql.DB, repo interfaces.CalendarFeedRepository, taskRepo interfaces.TaskRepository, logger *slog.Logger) interfaces.CalendarFeedUsecase {
+	return &calendarFeedUsecase{
+		db:       db,
+		repo:     repo,
+		taskRepo: taskRepo,
+		logger:   logger,
+	}
+}
+
+// GetFeed はログインユーザーのフィードを取得します（発行されていない場合はnil）
+func (u *calendarFeedUsecase) GetFeed(ctx context.Context) (*models.CalendarFeed, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	return u.repo.GetFeedByUserID(ctx, userID)
+}
+
+// RotateToken はログインユーザーのフィードのトークンを新しく発行します
+// 以前のトークンは無効になります。トークンはハッシュのみ保存するため、平文を返すのはこの時だけです
+func (u *calendarFeedUsecase) RotateToken(ctx context.Context) (*models.CalendarFeed, string, error) {
+	u.logger.InfoContext(ctx, "UseCase: RotateToken started")
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, "", fmt.Errorf("unauthorized")
+	}
+
+	raw := make([]byte, calendarFeedTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("failed to generate calendar feed token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	feed := &models.CalendarFeed{
+		UserID:    userID,
+		TokenHash: hashCalendarFeedToken(token),
+	}
+
+	err := database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		feedRepo := repository.NewCalendarFeedRepositoryWithExecutor(tx, u.logger)
+
+		if _, err := feedRepo.DeleteFeedByUserID(ctx, userID); err != nil {
+			return err
+		}
+		return feedRepo.CreateFeed(ctx, feed)
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to rotate calendar feed token",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, "", err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: RotateToken completed",
+		slog.String("calendar_feed_id", feed.ID),
+	)
+	return feed, token, nil
+}
+
+// RevokeToken はログインユーザーのフィードを無効にします（発行されていない場合はエラー）
+func (u *calendarFeedUsecase) RevokeToken(ctx context.Context) error {
+	u.logger.InfoContext(ctx, "UseCase: RevokeToken started")
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return fmt.Errorf("unauthorized")
+	}
+
+	deleted, err := u.repo.DeleteFeedByUserID(ctx, userID)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to revoke calendar feed token",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("calendar feed not found")
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: RevokeToken completed")
+	return nil
+}
+
+// GetFeedTasks はトークンに対応するユーザーの期限付きタスクを取得します
+// 認証ヘッダーを送れないカレンダーアプリから呼ばれるため、トークンのみで所有者を特定します
+func (u *calendarFeedUsecase) GetFeedTasks(ctx context.Context, token string) (models.TaskSlice, error) {
+	if token == "" || len(token) > maxCalendarFeedTokenLength {
+		return nil, fmt.Errorf("calendar feed not found")
+	}
+
+	feed, err := u.repo.GetFeedByTokenHash(ctx, hashCalendarFeedToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := u.taskRepo.GetTasksWithDueAtByUserID(ctx, feed.UserID, time.Now().Add(-calendarFeedPastWindow))
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get calendar feed tasks",
+			slog.String("calendar_feed_id", feed.ID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetFeedTasks completed",
+		slog.String("calendar_feed_id", feed.ID),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// hashCalendarFeedToken はトークンを保存・照合用のSHA-256（16進数）に変換します
+func hashCalendarFeedToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
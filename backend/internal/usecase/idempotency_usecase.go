@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// idempotencyPurgeBatchSize は期限切れの冪等性キーを1回のDELETEで処理する最大件数
+const idempotencyPurgeBatchSize = 500
+
+type idempotencyUsecase struct {
+	repo   interfaces.IdempotencyKeyRepository
+	ttl    time.Duration
+	logger *slog.Logger
+}
+
+// NewIdempotencyUsecase は新しいIdempotencyUsecaseを生成します
+// ttlは冪等性キーの有効期間で、期限切れのキーは同じ値で再利用できます
+func NewIdempotencyUsecase(repo interfaces.IdempotencyKeyRepository, ttl time.Duration, logger *slog.Logger) interfaces.IdempotencyUsecase {
+	return &idempotencyUsecase{
+		repo:   repo,
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+// BeginRequest は冪等性キーを処理中として登録します
+// 同じキーで処理済みのリクエストがあればreplay=trueで保存済みのレスポンスを返します
+// 異なる内容のリクエストでキーが再利用された場合や、同じキーのリクエストが処理中の場合はエラーを返します
+func (u *idempotencyUsecase) BeginRequest(ctx context.Context, key string, method string, path string, requestHash string) (*models.IdempotencyKey, bool, error) {
+	u.logger.InfoContext(ctx, "UseCase: BeginRequest started",
+		slog.String("method", method),
+		slog.String("path", path),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for BeginRequest")
+		return nil, false, fmt.Errorf("unauthorized")
+	}
+
+	record := &models.IdempotencyKey{
+		UserID:         userID,
+		IdempotencyKey: key,
+		RequestMethod:  method,
+		RequestPath:    path,
+		RequestHash:    requestHash,
+	}
+
+	// 期限切れのキーを削除した直後に他のリクエストが登録した場合に備えて1回だけ再試行する
+	for attempt := 0; attempt < 2; attempt++ {
+		record.ExpiresAt = time.Now().Add(u.ttl)
+		err := u.repo.CreateKey(ctx, record)
+		if err == nil {
+			u.logger.InfoContext(ctx, "UseCase: BeginRequest completed",
+				slog.String("idempotency_key_id", record.ID),
+			)
+			return record, false, nil
+		}
+		if !strings.Contains(err.Error(), "already exists") {
+			return nil, false, err
+		}
+
+		existing, err := u.repo.GetKey(ctx, userID, key)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				continue
+			}
+			return nil, false, err
+		}
+
+		if !existing.ExpiresAt.After(time.Now()) {
+			if err := u.repo.DeleteKey(ctx, existing.ID); err != nil {
+				return nil, false, err
+			}
+			continue
+		}
+
+		if existing.RequestHash != requestHash {
+			u.logger.WarnContext(ctx, "UseCase: Idempotency key reused with different payload",
+				slog.String("idempotency_key_id", existing.ID),
+			)
+			return nil, false, fmt.Errorf("idempotency key reused with different payload")
+		}
+
+		if _, ok := existing.StatusCode.Get(); !ok {
+			u.logger.WarnContext(ctx, "UseCase: Idempotent request is still in progress",
+				slog.String("idempotency_key_id", existing.ID),
+			)
+			return nil, false, fmt.Errorf("idempotent request is still in progress")
+		}
+
+		u.logger.InfoContext(ctx, "UseCase: BeginRequest completed with stored response",
+			slog.String("idempotency_key_id", existing.ID),
+		)
+		return existing, true, nil
+	}
+
+	return nil, false, fmt.Errorf("idempotent request is still in progress")
+}
+
+// CompleteRequest は処理中の冪等性キーにレスポンスを保存し、以降の再送で同じレスポンスを返せるようにします
+func (u *idempotencyUsecase) CompleteRequest(ctx context.Context, record *models.IdempotencyKey, statusCode int, headers map[string]string, body []byte) error {
+	data, err := json.Marshal(headers)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response headers: %w", err)
+	}
+	if body == nil {
+		body = []byte{}
+	}
+
+	if err := u.repo.CompleteKey(ctx, record.ID, int32(statusCode), data, body); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to complete idempotent request",
+			slog.String("idempotency_key_id", record.ID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
+
+// ReleaseRequest は処理中の冪等性キーを削除し、同じキーでの再試行を受け付けられるようにします（サーバーエラー時）
+func (u *idempotencyUsecase) ReleaseRequest(ctx context.Context, record *models.IdempotencyKey) error {
+	if err := u.repo.DeleteKey(ctx, record.ID); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to release idempotent request",
+			slog.String("idempotency_key_id", record.ID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	return nil
+}
+
+// PurgeExpiredKeys は有効期限が指定日時より前の冪等性キーを全ユーザー分削除します（バックグラウンド処理用）
+func (u *idempotencyUsecase) PurgeExpiredKeys(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	u.logger.InfoContext(ctx, "UseCase: PurgeExpiredKeys started",
+		slog.Time("expired_before", expiredBefore),
+	)
+
+	var total int64
+	for {
+		count, err := u.repo.DeleteExpiredKeys(ctx, expiredBefore, idempotencyPurgeBatchSize)
+		if err != nil {
+			u.logger.ErrorContext(ctx, "UseCase: Failed to purge expired idempotency keys",
+				slog.Int64("purged", total),
+				slog.String("error", err.Error()),
+			)
+			return total, err
+		}
+		total += count
+		if count < idempotencyPurgeBatchSize {
+			break
+		}
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: PurgeExpiredKeys completed",
+		slog.Int64("count", total),
+	)
+	return total, nil
+}
@@ -0,0 +1,431 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/importer"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// importAIModelPrefix はインポートしたタスクのレビュー用に作成するAI解釈のモデル名の接頭辞（後ろに取り込み元が続く）
+// このAI解釈のアイテムから作成したタスクはAIの出力ではないため手動作成として扱います
+const importAIModelPrefix = "import:"
+
+type importUsecase struct {
+	db          *sql.DB
+	importRepo  interfaces.TaskImportRepository
+	projectRepo interfaces.ProjectRepository
+	logger      *slog.Logger
+}
+
+// NewImportUsecase は新しいImportUsecaseを生成します
+// dbは取り込むタスクの作成をすべて同一トランザクションで行うために使用します
+func NewImportUsecase(db *sql.DB, importRepo interfaces.TaskImportRepository, projectRepo interfaces.ProjectRepository, logger *slog.Logger) interfaces.ImportUsecase {
+	return &importUsecase{
+		db:          db,
+		importRepo:  importRepo,
+		projectRepo: projectRepo,
+		logger:      logger,
+	}
+}
+
+// ImportICal はiCalendarデータのVTODO・VEVENTをタスクとして取り込みます
+func (u *importUsecase) ImportICal(ctx context.Context, data []byte, options entity.ImportOptions) (*entity.ImportResult, error) {
+	u.logger.InfoContext(ctx, "UseCase: ImportICal started",
+		slog.Int("size", len(data)),
+		slog.Bool("dry_run", options.DryRun),
+	)
+
+	tasks, err := importer.ParseICal(data, options.Location, time.Now())
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Failed to parse iCalendar data",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	return u.importTasks(ctx, entity.ImportSourceICal, tasks, options)
+}
+
+// ImportTodoist はTodoistのCSVエクスポートのタスクをレビュー待ちのAI解釈アイテムとして登録します
+func (u *importUsecase) ImportTodoist(ctx context.Context, data []byte, options entity.ImportOptions) (*entity.ImportResult, error) {
+	u.logger.InfoContext(ctx, "UseCase: ImportTodoist started",
+		slog.Int("size", len(data)),
+		slog.Bool("dry_run", options.DryRun),
+	)
+
+	tasks, err := importer.ParseTodoistCSV(data, options.Location)
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Failed to parse Todoist CSV",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	return u.stageTasksForReview(ctx, entity.ImportSourceTodoist, tasks, options)
+}
+
+// ImportTrello はTrelloのボードのJSONエクスポートのカードをレビュー待ちのAI解釈アイテムとして登録します
+func (u *importUsecase) ImportTrello(ctx context.Context, data []byte, options entity.ImportOptions) (*entity.ImportResult, error) {
+	u.logger.InfoContext(ctx, "UseCase: ImportTrello started",
+		slog.Int("size", len(data)),
+		slog.Bool("dry_run", options.DryRun),
+	)
+
+	tasks, err := importer.ParseTrelloJSON(data)
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Failed to parse Trello JSON",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	return u.stageTasksForReview(ctx, entity.ImportSourceTrello, tasks, options)
+}
+
+// ImportMarkdown はMarkdownのチェックリストの各行をレビュー待ちのAI解釈アイテムとして登録します
+func (u *importUsecase) ImportMarkdown(ctx context.Context, data []byte, options entity.ImportOptions) (*entity.ImportResult, error) {
+	u.logger.InfoContext(ctx, "UseCase: ImportMarkdown started",
+		slog.Int("size", len(data)),
+		slog.Bool("dry_run", options.DryRun),
+	)
+
+	tasks, err := importer.ParseMarkdown(data, options.Location)
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Failed to parse markdown",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	return u.stageTasksForReview(ctx, entity.ImportSourceMarkdown, tasks, options)
+}
+
+// importTasks は取り込み元から変換したタスクの候補を取り込みます
+// 取り込み済みの識別子と同じファイル内で重複する識別子は作成せず、
+// 作成するタスクはすべて同一トランザクションで作成します（途中で失敗した場合は何も作成されません）
+func (u *importUsecase) importTasks(ctx context.Context, source entity.ImportSource, candidates []entity.ImportTask, options entity.ImportOptions) (*entity.ImportResult, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	if err := validation.ValidateImportTaskCount(len(candidates)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	// 割り当て先プロジェクトの所有者確認
+	if options.ProjectID != nil {
+		if err := checkTaskProject(ctx, u.projectRepo, *options.ProjectID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	externalIDs := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		externalIDs = append(externalIDs, candidate.ExternalID)
+	}
+	imported, err := u.importRepo.GetImportedTaskIDs(ctx, userID, string(source), externalIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity.ImportResult{
+		DryRun: options.DryRun,
+		Items:  make([]entity.ImportItem, len(candidates)),
+	}
+	seen := make(map[string]bool, len(candidates))
+	var pending []int
+
+	for i, candidate := range candidates {
+		item := entity.ImportItem{ImportTask: candidate}
+
+		switch {
+		case candidate.SkipReason != "":
+			item.Result = entity.ImportItemResultSkipped
+			item.Reason = candidate.SkipReason
+		case imported[candidate.ExternalID] != "":
+			item.Result = entity.ImportItemResultDuplicate
+			item.Reason = "already imported"
+			item.TaskID = imported[candidate.ExternalID]
+		case seen[candidate.ExternalID]:
+			item.Result = entity.ImportItemResultDuplicate
+			item.Reason = "duplicate entry in the same file"
+		default:
+			if err := validation.ValidateImportTask(candidate.Title, candidate.Status, candidate.RecurrenceRule, candidate.EstimateMinutes); err != nil {
+				item.Result = entity.ImportItemResultSkipped
+				item.Reason = err.Error()
+				break
+			}
+			seen[candidate.ExternalID] = true
+			item.Result = entity.ImportItemResultWouldCreate
+			pending = append(pending, i)
+		}
+
+		result.Items[i] = item
+	}
+
+	if !options.DryRun && len(pending) > 0 {
+		err := database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+			taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+			eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+			importRepo := repository.NewTaskImportRepositoryWithExecutor(tx, u.logger)
+			statusRepo := repository.NewTaskStatusRepositoryWithExecutor(tx, u.logger)
+
+			// 取り込み元のステータス（todo/in_progress/done）をユーザーのステータスに対応付ける
+			statuses, err := loadTaskStatuses(ctx, statusRepo, userID)
+			if err != nil {
+				return err
+			}
+
+			for _, i := range pending {
+				task := newImportedTask(userID, &result.Items[i].ImportTask, statuses, options.ProjectID)
+				if err := taskRepo.CreateTask(ctx, task); err != nil {
+					return err
+				}
+				if err := recordTaskEvent(ctx, eventRepo, entity.TaskEventTypeCreated, entity.TaskEventSourceManual, nil, task); err != nil {
+					return err
+				}
+				if err := importRepo.CreateImport(ctx, &models.TaskImport{
+					UserID:     userID,
+					Source:     string(source),
+					ExternalID: result.Items[i].ExternalID,
+					TaskID:     task.ID,
+				}); err != nil {
+					return err
+				}
+
+				result.Items[i].Result = entity.ImportItemResultCreated
+				result.Items[i].TaskID = task.ID
+			}
+			return nil
+		})
+		if err != nil {
+			u.logger.ErrorContext(ctx, "UseCase: Failed to import tasks",
+				slog.String("source", string(source)),
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+	}
+
+	countImportResults(result)
+
+	u.logger.InfoContext(ctx, "UseCase: Import completed",
+		slog.String("source", string(source)),
+		slog.Bool("dry_run", options.DryRun),
+		slog.Int("created", result.Created),
+		slog.Int("duplicates", result.Duplicates),
+		slog.Int("skipped", result.Skipped),
+	)
+	return result, nil
+}
+
+// importSourceNames はレビュー用のAI解釈に表示する取り込み元の名前
+var importSourceNames = map[entity.ImportSource]string{
+	entity.ImportSourceTodoist:  "Todoist",
+	entity.ImportSourceTrello:   "Trello",
+	entity.ImportSourceMarkdown: "Markdown",
+}
+
+// stageTasksForReview は取り込み元から変換したタスクの候補を、タスクを直接作成せずに
+// 1件のAI解釈にまとめたレビュー待ち（pending）のアイテムとして登録します
+// ユーザーは既存のAI解釈アイテムのレビュー（編集・承認）を通して作成するタスクを確定します
+func (u *importUsecase) stageTasksForReview(ctx context.Context, source entity.ImportSource, candidates []entity.ImportTask, options entity.ImportOptions) (*entity.ImportResult, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	if err := validation.ValidateImportTaskCount(len(candidates)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	// 割り当て先プロジェクトの所有者確認
+	if options.ProjectID != nil {
+		if err := checkTaskProject(ctx, u.projectRepo, *options.ProjectID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &entity.ImportResult{
+		DryRun: options.DryRun,
+		Items:  make([]entity.ImportItem, len(candidates)),
+	}
+	seen := make(map[string]bool, len(candidates))
+	var pending []int
+
+	for i, candidate := range candidates {
+		item := entity.ImportItem{ImportTask: candidate}
+
+		switch {
+		case candidate.SkipReason != "":
+			item.Result = entity.ImportItemResultSkipped
+			item.Reason = candidate.SkipReason
+		case seen[candidate.ExternalID]:
+			item.Result = entity.ImportItemResultDuplicate
+			item.Reason = "duplicate entry in the same file"
+		default:
+			if err := validation.ValidateImportTask(candidate.Title, candidate.Status, candidate.RecurrenceRule, candidate.EstimateMinutes); err != nil {
+				item.Result = entity.ImportItemResultSkipped
+				item.Reason = err.Error()
+				break
+			}
+			seen[candidate.ExternalID] = true
+			item.Result = entity.ImportItemResultWouldCreate
+			pending = append(pending, i)
+		}
+
+		result.Items[i] = item
+	}
+
+	if !options.DryRun && len(pending) > 0 {
+		title := fmt.Sprintf("%sからの取り込み（%d件）", importSourceNames[source], len(pending))
+		interpretation := &entity.AIInterpretation{
+			ID:        uuid.New().String(),
+			UserID:    userID,
+			InputText: title,
+			Result: entity.InterpretationResult{
+				Type:  entity.InterpretationTypeTodo,
+				Title: title,
+			},
+			AIModel: importAIModelPrefix + string(source),
+		}
+
+		items := make([]*entity.InterpretationItem, 0, len(pending))
+		for index, i := range pending {
+			item, err := newImportReviewItem(interpretation.ID, index, &result.Items[i].ImportTask, options.ProjectID)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+
+		err := database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+			interpretationRepo := repository.NewInterpretationRepositoryWithExecutor(tx, u.logger)
+			itemRepo := repository.NewInterpretationItemRepository(tx, u.logger)
+
+			if err := interpretationRepo.CreateInterpretation(ctx, interpretation); err != nil {
+				return err
+			}
+			return itemRepo.CreateItems(ctx, items)
+		})
+		if err != nil {
+			u.logger.ErrorContext(ctx, "UseCase: Failed to stage imported tasks for review",
+				slog.String("source", string(source)),
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+
+		result.InterpretationID = interpretation.ID
+		for index, i := range pending {
+			result.Items[i].Result = entity.ImportItemResultPending
+			result.Items[i].InterpretationItemID = items[index].ID
+		}
+	}
+
+	countImportResults(result)
+
+	u.logger.InfoContext(ctx, "UseCase: Import staged for review",
+		slog.String("source", string(source)),
+		slog.Bool("dry_run", options.DryRun),
+		slog.String("interpretation_id", result.InterpretationID),
+		slog.Int("pending", result.Pending),
+		slog.Int("duplicates", result.Duplicates),
+		slog.Int("skipped", result.Skipped),
+	)
+	return result, nil
+}
+
+// newImportReviewItem は取り込むタスクの候補からレビュー用のAI解釈アイテムを生成します
+// 原本（original_data）には取り込み元のエントリを保存します
+func newImportReviewItem(interpretationID string, index int, candidate *entity.ImportTask, projectID *string) (*entity.InterpretationItem, error) {
+	status := candidate.Status
+	taskData := entity.TaskData{
+		Title:           candidate.Title,
+		Description:     candidate.Description,
+		DueAt:           candidate.DueAt,
+		EstimateMinutes: candidate.EstimateMinutes,
+		Priority:        candidate.Priority,
+		Status:          &status,
+		Tags:            candidate.Labels,
+		RecurrenceRule:  candidate.RecurrenceRule,
+		ProjectID:       projectID,
+	}
+
+	data, err := json.Marshal(taskData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task data: %w", err)
+	}
+
+	original := candidate.Raw
+	if len(original) == 0 {
+		original = data
+	}
+
+	return &entity.InterpretationItem{
+		ID:               uuid.New().String(),
+		InterpretationID: interpretationID,
+		ItemIndex:        index,
+		ResourceType:     entity.ResourceTypeTask,
+		Status:           entity.ItemStatusPending,
+		Data:             data,
+		OriginalData:     original,
+	}, nil
+}
+
+// countImportResults は取り込み候補ごとの処理結果から件数を集計します
+func countImportResults(result *entity.ImportResult) {
+	for _, item := range result.Items {
+		switch item.Result {
+		case entity.ImportItemResultCreated, entity.ImportItemResultWouldCreate:
+			result.Created++
+		case entity.ImportItemResultPending:
+			result.Pending++
+		case entity.ImportItemResultDuplicate:
+			result.Duplicates++
+		case entity.ImportItemResultSkipped:
+			result.Skipped++
+		}
+	}
+}
+
+// newImportedTask は取り込むタスクの候補からタスクのモデルを生成します
+func newImportedTask(userID string, candidate *entity.ImportTask, statuses models.TaskStatusSlice, projectID *string) *models.Task {
+	task := &models.Task{
+		ID:     uuid.New().String(),
+		UserID: userID,
+		Title:  candidate.Title,
+		Source: "manual",
+	}
+	setTaskStatus(task, matchTaskStatus(statuses, candidate.Status))
+
+	if candidate.Description != nil {
+		task.Description = null.From(*candidate.Description)
+	}
+	if candidate.DueAt != nil {
+		task.DueAt = null.From(*candidate.DueAt)
+	}
+	if projectID != nil {
+		task.ProjectID = null.From(*projectID)
+	}
+	task.EstimateMinutes = taskEstimateMinutes(candidate.EstimateMinutes)
+	setTaskRecurrence(task, candidate.RecurrenceRule, candidate.RecurrenceAnchorAt)
+	return task
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/aarondl/opt/null"
 	"github.com/google/uuid"
@@ -14,7 +15,9 @@ import (
 	"github.com/yoshioka0101/ai_plan_chat/internal/database"
 	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
 	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/recurrence"
 	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
 )
 
 type interpretationItemUseCase struct {
@@ -239,6 +242,7 @@ func (uc *interpretationItemUseCase) ApproveMultipleItems(ctx context.Context, i
 }
 
 // createTaskFromItem はアイテムからタスクを作成します（トランザクション内で実行）
+// インポートのレビュー用のAI解釈のアイテムは、AIの出力と区別するため手動作成として作成・記録します
 func (uc *interpretationItemUseCase) createTaskFromItem(ctx context.Context, tx bob.Executor, item *entity.InterpretationItem) (string, error) {
 	// トランザクション内で動作するRepositoryを作成
 	taskRepo := repository.NewTaskRepositoryWithExecutor(tx, uc.logger)
@@ -255,6 +259,16 @@ func (uc *interpretationItemUseCase) createTaskFromItem(ctx context.Context, tx
 		return "", fmt.Errorf("user_id not found in context")
 	}
 
+	interpretationRepo := repository.NewInterpretationRepositoryWithExecutor(tx, uc.logger)
+	interpretation, err := interpretationRepo.GetInterpretationByID(ctx, item.InterpretationID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get interpretation: %w", err)
+	}
+	source, eventSource := "ai", entity.TaskEventSourceAI
+	if strings.HasPrefix(interpretation.AIModel, importAIModelPrefix) {
+		source, eventSource = "manual", entity.TaskEventSourceManual
+	}
+
 	// タスク作成
 	task := &models.Task{
 		ID:                 uuid.New().String(),
@@ -262,28 +276,54 @@ func (uc *interpretationItemUseCase) createTaskFromItem(ctx context.Context, tx
 		Title:              taskData.Title,
 		Description:        null.FromPtr(taskData.Description),
 		DueAt:              null.FromPtr(taskData.DueAt),
-		Status:             resolveTaskStatus(taskData.Status),
-		Source:             "ai",
+		Source:             source,
 		AiInterpretationID: null.From(item.InterpretationID),
 	}
 
-	if err := taskRepo.CreateTask(ctx, task); err != nil {
-		return "", fmt.Errorf("failed to create task: %w", err)
+	// AIが提案したステータス名をユーザーのステータスに対応付ける（該当がなければ未着手）
+	statusRepo := repository.NewTaskStatusRepositoryWithExecutor(tx, uc.logger)
+	statuses, err := loadTaskStatuses(ctx, statusRepo, userID)
+	if err != nil {
+		return "", err
+	}
+	var statusName string
+	if taskData.Status != nil {
+		statusName = *taskData.Status
 	}
+	setTaskStatus(task, matchTaskStatus(statuses, statusName))
 
-	return task.ID, nil
-}
+	// 繰り返しルール（レビューで編集された値も含めて検証する）
+	if taskData.RecurrenceRule != nil && *taskData.RecurrenceRule != "" {
+		if err := recurrence.Validate(*taskData.RecurrenceRule); err != nil {
+			return "", fmt.Errorf("validation error: %w", err)
+		}
+		setTaskRecurrence(task, taskData.RecurrenceRule, nil)
+	}
 
-// resolveTaskStatus validates and returns a task status, defaulting to "todo" for invalid or empty values.
-func resolveTaskStatus(statusPtr *string) string {
-	if statusPtr == nil || *statusPtr == "" {
-		return "todo"
+	// 見積もり工数（レビューで編集された値も含めて検証する）
+	if err := validation.ValidateTaskEstimateMinutes(taskData.EstimateMinutes); err != nil {
+		return "", fmt.Errorf("validation error: %w", err)
 	}
+	task.EstimateMinutes = taskEstimateMinutes(taskData.EstimateMinutes)
 
-	switch *statusPtr {
-	case "todo", "in_progress", "done":
-		return *statusPtr
-	default:
-		return "todo"
+	// 割り当て先プロジェクト（レビューで編集された値も含めて所有者を確認する）
+	if taskData.ProjectID != nil && *taskData.ProjectID != "" {
+		projectRepo := repository.NewProjectRepositoryWithExecutor(tx, uc.logger)
+		if err := checkTaskProject(ctx, projectRepo, *taskData.ProjectID, userID); err != nil {
+			return "", err
+		}
+		task.ProjectID = null.From(*taskData.ProjectID)
 	}
+
+	if err := taskRepo.CreateTask(ctx, task); err != nil {
+		return "", fmt.Errorf("failed to create task: %w", err)
+	}
+
+	// AI解釈アイテム（インポートの場合は手動）の承認による作成として変更履歴を記録
+	eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, uc.logger)
+	if err := recordTaskEvent(ctx, eventRepo, entity.TaskEventTypeCreated, eventSource, nil, task); err != nil {
+		return "", fmt.Errorf("failed to record task event: %w", err)
+	}
+
+	return task.ID, nil
 }
@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// maxNotificationTitleLength は通知のタイトルの最大長（notifications.titleのカラム長）
+const maxNotificationTitleLength = 500
+
+type notificationUsecase struct {
+	repo   interfaces.NotificationRepository
+	logger *slog.Logger
+}
+
+// NewNotificationUsecase は新しいNotificationUsecaseを生成します
+func NewNotificationUsecase(repo interfaces.NotificationRepository, logger *slog.Logger) interfaces.NotificationUsecase {
+	return &notificationUsecase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Notify は指定ユーザーに未読のアプリ内通知を発行します
+// タイトルが長すぎる場合は切り詰めて保存します
+func (u *notificationUsecase) Notify(ctx context.Context, input *entity.NewNotification) (*models.Notification, error) {
+	u.logger.InfoContext(ctx, "UseCase: Notify started",
+		slog.String("user_id", input.UserID),
+		slog.String("type", string(input.Type)),
+	)
+
+	if err := validation.ValidateNewNotification(input); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	title := input.Title
+	if runes := []rune(title); len(runes) > maxNotificationTitleLength {
+		title = string(runes[:maxNotificationTitleLength])
+	}
+
+	notification := &models.Notification{
+		UserID: input.UserID,
+		Type:   string(input.Type),
+		Title:  title,
+	}
+	if input.Body != nil {
+		notification.Body = null.From(*input.Body)
+	}
+	if input.TaskID != nil {
+		notification.TaskID = null.From(*input.TaskID)
+	}
+
+	if err := u.repo.CreateNotification(ctx, notification); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to create notification",
+			slog.String("user_id", input.UserID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: Notify completed",
+		slog.String("notification_id", notification.ID),
+	)
+	return notification, nil
+}
+
+// ListNotifications はログインユーザーの通知を作成日時の新しい順に取得します
+// 続きがある場合は次のページのカーソルを返します
+func (u *notificationUsecase) ListNotifications(ctx context.Context, unreadOnly bool, cursor *entity.NotificationCursor, limit int) (models.NotificationSlice, *entity.NotificationCursor, error) {
+	u.logger.InfoContext(ctx, "UseCase: ListNotifications started",
+		slog.Bool("unread_only", unreadOnly),
+		slog.Int("limit", limit),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, nil, fmt.Errorf("unauthorized")
+	}
+
+	if err := validation.ValidateNotificationListLimit(limit); err != nil {
+		return nil, nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	// 次のページの有無を判定するため1件多く取得
+	notifications, err := u.repo.ListNotifications(ctx, userID, unreadOnly, cursor, limit+1)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to list notifications",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, nil, err
+	}
+
+	var next *entity.NotificationCursor
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		last := notifications[len(notifications)-1]
+		next = &entity.NotificationCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: ListNotifications completed",
+		slog.Int("count", len(notifications)),
+		slog.Bool("has_next", next != nil),
+	)
+	return notifications, next, nil
+}
+
+// GetUnreadCount はログインユーザーの未読の通知数を取得します
+func (u *notificationUsecase) GetUnreadCount(ctx context.Context) (int64, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return 0, fmt.Errorf("unauthorized")
+	}
+
+	return u.repo.CountUnreadNotifications(ctx, userID)
+}
+
+// MarkNotificationRead は通知を既読にして更新後の通知を返します
+func (u *notificationUsecase) MarkNotificationRead(ctx context.Context, id string) (*models.Notification, error) {
+	u.logger.InfoContext(ctx, "UseCase: MarkNotificationRead started",
+		slog.String("notification_id", id),
+	)
+
+	notification, err := u.getOwnedNotification(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 既読の通知は既読日時を変えない
+	if notification.ReadAt.IsNull() {
+		readAt := time.Now()
+		if err := u.repo.MarkNotificationRead(ctx, id, readAt); err != nil {
+			u.logger.ErrorContext(ctx, "UseCase: Failed to mark notification read",
+				slog.String("notification_id", id),
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+		notification.ReadAt = null.From(readAt)
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: MarkNotificationRead completed",
+		slog.String("notification_id", id),
+	)
+	return notification, nil
+}
+
+// MarkAllNotificationsRead はログインユーザーの未読の通知をすべて既読にし、既読にした件数を返します
+func (u *notificationUsecase) MarkAllNotificationsRead(ctx context.Context) (int64, error) {
+	u.logger.InfoContext(ctx, "UseCase: MarkAllNotificationsRead started")
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return 0, fmt.Errorf("unauthorized")
+	}
+
+	count, err := u.repo.MarkAllNotificationsRead(ctx, userID, time.Now())
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to mark all notifications read",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return 0, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: MarkAllNotificationsRead completed",
+		slog.Int64("count", count),
+	)
+	return count, nil
+}
+
+// DeleteNotification は通知を削除します
+func (u *notificationUsecase) DeleteNotification(ctx context.Context, id string) error {
+	u.logger.InfoContext(ctx, "UseCase: DeleteNotification started",
+		slog.String("notification_id", id),
+	)
+
+	if _, err := u.getOwnedNotification(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteNotification(ctx, id); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to delete notification",
+			slog.String("notification_id", id),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: DeleteNotification completed",
+		slog.String("notification_id", id),
+	)
+	return nil
+}
+
+// getOwnedNotification はログインユーザーの通知を取得します（他ユーザーの通知は存在しないものとして扱う）
+func (u *notificationUsecase) getOwnedNotification(ctx context.Context, id string) (*models.Notification, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	notification, err := u.repo.GetNotificationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if notification.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized notification access",
+			slog.String("notification_id", id),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("notification not found: %s", id)
+	}
+	return notification, nil
+}
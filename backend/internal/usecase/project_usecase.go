@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/aarondl/opt/null"
+	"github.com/google/uuid"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+type projectUsecase struct {
+	repo   interfaces.ProjectRepository
+	logger *slog.Logger
+}
+
+// NewProjectUsecase は新しいProjectUsecaseを生成します
+func NewProjectUsecase(repo interfaces.ProjectRepository, logger *slog.Logger) interfaces.ProjectUsecase {
+	return &projectUsecase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetProject はIDでプロジェクトを取得します
+func (u *projectUsecase) GetProject(ctx context.Context, id string) (*models.Project, error) {
+	return u.getOwnedProject(ctx, id)
+}
+
+// GetProjectList はログインユーザーのプロジェクト一覧を取得します
+func (u *projectUsecase) GetProjectList(ctx context.Context, includeArchived bool) (models.ProjectSlice, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetProjectList started",
+		slog.Bool("include_archived", includeArchived),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetProjectList")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	projects, err := u.repo.GetProjectsByUserID(ctx, userID, includeArchived)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get project list",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetProjectList completed",
+		slog.Int("count", len(projects)),
+	)
+	return projects, nil
+}
+
+// CreateProject は新しいプロジェクトを作成します
+func (u *projectUsecase) CreateProject(ctx context.Context, name string, color *string, sortOrder *int) (*models.Project, error) {
+	u.logger.InfoContext(ctx, "UseCase: CreateProject started",
+		slog.String("name", name),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for CreateProject")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	// バリデーション
+	if err := validation.ValidateCreateProjectRequest(name, color); err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Validation failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	project := &models.Project{
+		ID:     uuid.New().String(),
+		UserID: userID,
+		Name:   strings.TrimSpace(name),
+		Color:  null.FromPtr(color),
+	}
+	if sortOrder != nil {
+		project.SortOrder = int32(*sortOrder)
+	}
+
+	if err := u.repo.CreateProject(ctx, project); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to create project",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: CreateProject completed",
+		slog.String("project_id", project.ID),
+	)
+	return project, nil
+}
+
+// EditProject はプロジェクトを部分更新します（名前・色・アーカイブ・表示順）
+func (u *projectUsecase) EditProject(ctx context.Context, id string, name *string, color *string, archived *bool, sortOrder *int) (*models.Project, error) {
+	u.logger.InfoContext(ctx, "UseCase: EditProject started",
+		slog.String("project_id", id),
+	)
+
+	// バリデーション
+	if err := validation.ValidateEditProjectRequest(name, color); err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Validation failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	project, err := u.getOwnedProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		project.Name = strings.TrimSpace(*name)
+	}
+	if color != nil {
+		project.Color = null.From(*color)
+	}
+	if archived != nil {
+		project.Archived = *archived
+	}
+	if sortOrder != nil {
+		project.SortOrder = int32(*sortOrder)
+	}
+
+	if err := u.repo.UpdateProject(ctx, project); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to edit project",
+			slog.String("project_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: EditProject completed",
+		slog.String("project_id", id),
+	)
+	return project, nil
+}
+
+// DeleteProject はプロジェクトを削除します（所属タスクはプロジェクト未設定になる）
+func (u *projectUsecase) DeleteProject(ctx context.Context, id string) error {
+	u.logger.InfoContext(ctx, "UseCase: DeleteProject started",
+		slog.String("project_id", id),
+	)
+
+	if _, err := u.getOwnedProject(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteProject(ctx, id); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to delete project",
+			slog.String("project_id", id),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: DeleteProject completed",
+		slog.String("project_id", id),
+	)
+	return nil
+}
+
+// getOwnedProject はログインユーザーが所有するプロジェクトを取得します
+func (u *projectUsecase) getOwnedProject(ctx context.Context, id string) (*models.Project, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	project, err := u.repo.GetProjectByID(ctx, id)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get project",
+			slog.String("project_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	if project.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized project access",
+			slog.String("project_id", id),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	return project, nil
+}
+
+// checkTaskProject はタスクの割り当て先プロジェクトがユーザーの所有するものかを確認します
+// 存在しない・他ユーザーのプロジェクトはタスクの入力値の誤りとして扱います
+func checkTaskProject(ctx context.Context, projectRepo interfaces.ProjectRepository, projectID string, userID string) error {
+	project, err := projectRepo.GetProjectByID(ctx, projectID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return fmt.Errorf("validation error: invalid project: %s", projectID)
+		}
+		return err
+	}
+	if project.UserID != userID {
+		return fmt.Errorf("validation error: invalid project: %s", projectID)
+	}
+	return nil
+}
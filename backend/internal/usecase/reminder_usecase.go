@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+)
+
+const (
+	// reminderScanBatchSize はリマインダーのタイミングごとの範囲を走査する際に1回で取得するタスク数
+	reminderScanBatchSize = 500
+	// reminderMaxAttempts はチャネルごとの配信の最大試行回数
+	reminderMaxAttempts = 3
+	// reminderStaleTimeout は配信中のまま停止したリマインダーを再試行するまでの時間
+	reminderStaleTimeout = 10 * time.Minute
+	// reminderOverdueGrace は期限到来時のリマインダーを期限を過ぎてから配信できる猶予（停止中の取りこぼし対策）
+	reminderOverdueGrace = time.Hour
+)
+
+type reminderUsecase struct {
+	taskRepo     interfaces.TaskRepository
+	reminderRepo interfaces.TaskReminderRepository
+	userRepo     repository.UserRepository
+	channels     []interfaces.NotificationChannel
+	offsets      []time.Duration
+	logger       *slog.Logger
+}
+
+// NewReminderUsecase は新しいReminderUsecaseを生成します
+// offsetsは期限のどれだけ前にリマインダーを配信するかで、重複を除いて長い順に扱います（0は期限到来時）
+func NewReminderUsecase(taskRepo interfaces.TaskRepository, reminderRepo interfaces.TaskReminderRepository, userRepo repository.UserRepository, channels []interfaces.NotificationChannel, offsets []time.Duration, logger *slog.Logger) interfaces.ReminderUsecase {
+	return &reminderUsecase{
+		taskRepo:     taskRepo,
+		reminderRepo: reminderRepo,
+		userRepo:     userRepo,
+		channels:     channels,
+		offsets:      normalizeReminderOffsets(offsets),
+		logger:       logger,
+	}
+}
+
+// DeliverDueReminders は期限がリマインダーの範囲に入ったタスクのリマインダーを各チャネルに配信し、配信数を返します
+// 各タイミングの範囲は「次に短いタイミング」から「そのタイミング」までで、期限が範囲に入った時点で1回だけ配信します
+// 配信状態はタスク・期限・タイミング・チャネルの組で記録するため、再起動しても重複して配信しません
+func (u *reminderUsecase) DeliverDueReminders(ctx context.Context, now time.Time) (int, error) {
+	if len(u.channels) == 0 || len(u.offsets) == 0 {
+		return 0, nil
+	}
+
+	users := make(map[string]*models.User)
+	delivered := 0
+	for i, offset := range u.offsets {
+		lower := -reminderOverdueGrace
+		if i+1 < len(u.offsets) {
+			lower = u.offsets[i+1]
+		} else if offset > 0 {
+			lower = 0
+		}
+
+		// 配信済みのタスクも範囲に残るため、範囲の末尾まで期限・IDの順にページングして走査する
+		var after *models.Task
+		for {
+			tasks, err := u.taskRepo.GetTasksDueBetween(ctx, now, now.Add(lower), now.Add(offset), after, reminderScanBatchSize)
+			if err != nil {
+				return delivered, err
+			}
+
+			for _, task := range tasks {
+				sent, err := u.deliverTask(ctx, users, task, offset)
+				delivered += sent
+				if err != nil {
+					return delivered, err
+				}
+			}
+
+			if len(tasks) < reminderScanBatchSize {
+				break
+			}
+			after = tasks[len(tasks)-1]
+		}
+	}
+	return delivered, nil
+}
+
+// deliverTask は1つのタスクのリマインダーを各チャネルに配信し、配信数を返します
+func (u *reminderUsecase) deliverTask(ctx context.Context, users map[string]*models.User, task *models.Task, offset time.Duration) (int, error) {
+	user, err := u.getUser(ctx, users, task.UserID)
+	if err != nil || user == nil {
+		return 0, err
+	}
+
+	reminder := &entity.Reminder{
+		TaskID:    task.ID,
+		TaskTitle: task.Title,
+		DueAt:     task.DueAt.GetOrZero(),
+		Offset:    offset,
+		UserID:    user.ID,
+		UserEmail: user.Email,
+		UserName:  user.Nickname,
+	}
+	delivered := 0
+	for _, channel := range u.channels {
+		sent, err := u.deliver(ctx, channel, reminder)
+		if err != nil {
+			return delivered, err
+		}
+		if sent {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+// deliver は1つのチャネルにリマインダーを配信します
+// 配信済みや他の処理が配信中の場合は何もせず、チャネルの送信失敗は記録して次回の走査で再試行します
+func (u *reminderUsecase) deliver(ctx context.Context, channel interfaces.NotificationChannel, reminder *entity.Reminder) (bool, error) {
+	delivery, err := u.claimDelivery(ctx, channel.Name(), reminder)
+	if err != nil || delivery == nil {
+		return false, err
+	}
+
+	if err := channel.Send(ctx, reminder); err != nil {
+		if ctx.Err() != nil {
+			// 停止による中断は配信中のまま残し、一定時間後に再試行する
+			return false, ctx.Err()
+		}
+		u.logger.WarnContext(ctx, "UseCase: Failed to send reminder",
+			slog.String("task_id", reminder.TaskID),
+			slog.String("channel", channel.Name()),
+			slog.Int("attempts", int(delivery.Attempts)),
+			slog.String("error", err.Error()),
+		)
+		return false, u.reminderRepo.MarkDeliveryFailed(ctx, delivery.ID, err.Error())
+	}
+
+	if err := u.reminderRepo.MarkDeliverySent(ctx, delivery.ID, time.Now()); err != nil {
+		return false, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: Reminder sent",
+		slog.String("task_id", reminder.TaskID),
+		slog.String("channel", channel.Name()),
+		slog.Duration("offset", reminder.Offset),
+	)
+	return true, nil
+}
+
+// claimDelivery はリマインダーの配信権を得ます（配信済みや再試行できない場合はnil）
+func (u *reminderUsecase) claimDelivery(ctx context.Context, channelName string, reminder *entity.Reminder) (*models.TaskReminder, error) {
+	delivery := &models.TaskReminder{
+		ID:            uuid.New().String(),
+		TaskID:        reminder.TaskID,
+		DueAt:         reminder.DueAt,
+		OffsetMinutes: int32(reminder.Offset / time.Minute),
+		Channel:       channelName,
+	}
+
+	err := u.reminderRepo.CreateDelivery(ctx, delivery)
+	if err == nil {
+		return delivery, nil
+	}
+	if !strings.Contains(err.Error(), "already exists") {
+		return nil, err
+	}
+
+	return u.reminderRepo.RetryDelivery(ctx, delivery.TaskID, delivery.DueAt, delivery.OffsetMinutes, channelName, time.Now().Add(-reminderStaleTimeout), reminderMaxAttempts)
+}
+
+// getUser はリマインダーの宛先ユーザーを取得します（走査中はキャッシュし、存在しない場合はnil）
+func (u *reminderUsecase) getUser(ctx context.Context, users map[string]*models.User, userID string) (*models.User, error) {
+	if user, ok := users[userID]; ok {
+		return user, nil
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %s", userID)
+	}
+	user, err := u.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	users[userID] = user
+	return user, nil
+}
+
+// normalizeReminderOffsets はリマインダーのタイミングを分単位に丸め、重複と負の値を除いて長い順に並べます
+func normalizeReminderOffsets(offsets []time.Duration) []time.Duration {
+	seen := make(map[time.Duration]bool, len(offsets))
+	normalized := make([]time.Duration, 0, len(offsets))
+	for _, offset := range offsets {
+		offset = offset.Truncate(time.Minute)
+		if offset < 0 || seen[offset] {
+			continue
+		}
+		seen[offset] = true
+		normalized = append(normalized, offset)
+	}
+	sort.Slice(normalized, func(i, j int) bool {
+		return normalized[i] > normalized[j]
+	})
+	return normalized
+}
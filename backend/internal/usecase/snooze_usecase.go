@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// snoozeWakeBatchSize は1回の検索で処理するスヌーズ解除対象のタスク数
+const snoozeWakeBatchSize = 500
+
+type snoozeUsecase struct {
+	taskRepo      interfaces.TaskRepository
+	notifications interfaces.NotificationProducer
+	logger        *slog.Logger
+}
+
+// NewSnoozeUsecase は新しいSnoozeUsecaseを生成します
+func NewSnoozeUsecase(taskRepo interfaces.TaskRepository, notifications interfaces.NotificationProducer, logger *slog.Logger) interfaces.SnoozeUsecase {
+	return &snoozeUsecase{
+		taskRepo:      taskRepo,
+		notifications: notifications,
+		logger:        logger,
+	}
+}
+
+// WakeSnoozedTasks は解除日時がnow以前になったタスクのスヌーズを全ユーザー分解除し、解除数を返します
+// 解除時の通知を指定されたタスクはアプリ内通知を発行します（ゴミ箱内のタスクは解除のみ）
+// 解除は変更履歴に記録せず、利用者の編集と競合しないようバージョンも変更しません
+func (u *snoozeUsecase) WakeSnoozedTasks(ctx context.Context, now time.Time) (int, error) {
+	woken := 0
+	for {
+		tasks, err := u.taskRepo.GetSnoozeEndedTasks(ctx, now, snoozeWakeBatchSize)
+		if err != nil {
+			return woken, err
+		}
+
+		cleared := 0
+		for _, task := range tasks {
+			snoozedUntil := task.SnoozedUntil.GetOrZero()
+			ok, err := u.taskRepo.ClearSnooze(ctx, task.ID, snoozedUntil)
+			if err != nil {
+				return woken, err
+			}
+			// 取得後にスヌーズが変更・解除されていた場合は何もしない
+			if !ok {
+				continue
+			}
+			cleared++
+			woken++
+
+			if task.SnoozeNotify && task.DeletedAt.IsNull() {
+				if err := u.notify(ctx, task); err != nil {
+					// 通知に失敗してもスヌーズの解除は取り消さない
+					u.logger.WarnContext(ctx, "UseCase: Failed to notify snooze ended",
+						slog.String("task_id", task.ID),
+						slog.String("error", err.Error()),
+					)
+				}
+			}
+		}
+
+		if len(tasks) < snoozeWakeBatchSize || cleared == 0 {
+			break
+		}
+	}
+	return woken, nil
+}
+
+// notify はスヌーズが解除されたことをタスクの所有者に通知します
+func (u *snoozeUsecase) notify(ctx context.Context, task *models.Task) error {
+	body := fmt.Sprintf("スヌーズ解除: %s", task.SnoozedUntil.GetOrZero().UTC().Format(time.RFC3339))
+	_, err := u.notifications.Notify(ctx, &entity.NewNotification{
+		UserID: task.UserID,
+		Type:   entity.NotificationTypeTaskSnoozeEnded,
+		Title:  fmt.Sprintf("「%s」のスヌーズが終了しました", task.Title),
+		Body:   &body,
+		TaskID: &task.ID,
+	})
+	return err
+}
@@ -0,0 +1,282 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// statsSources は作成元ごとの集計で常に返す作成元（件数0でも含める）
+var statsSources = []string{"ai", "manual"}
+
+type statsUsecase struct {
+	repo   interfaces.TaskStatsRepository
+	logger *slog.Logger
+}
+
+// NewStatsUsecase は新しいStatsUsecaseを生成します
+func NewStatsUsecase(repo interfaces.TaskStatsRepository, logger *slog.Logger) interfaces.StatsUsecase {
+	return &statsUsecase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetTaskStats は期間内のタスクの生産性統計を集計します
+// 件数はDBで15分単位の時間枠ごとに集計し、指定したタイムゾーンの日・週にまとめ直します
+func (u *statsUsecase) GetTaskStats(ctx context.Context, from, to time.Time, groupBy entity.StatsGroupBy, loc *time.Location) (*entity.TaskStats, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetTaskStats started",
+		slog.Time("from", from),
+		slog.Time("to", to),
+		slog.String("group_by", string(groupBy)),
+	)
+
+	if err := validation.ValidateStatsRange(from, to); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := validation.ValidateStatsGroupBy(string(groupBy)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetTaskStats")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	created, err := u.repo.CountCreatedTasksBySlot(ctx, userID, from, to)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to count created tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	completed, err := u.repo.CountCompletedTasksBySlot(ctx, userID, from, to)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to count completed tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	overdue, err := u.repo.CountOverdueTasks(ctx, userID, time.Now())
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to count overdue tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	stats := &entity.TaskStats{
+		From:         from,
+		To:           to,
+		GroupBy:      groupBy,
+		OverdueCount: overdue,
+	}
+
+	// 件数0の期間もグラフで途切れないように含める
+	periods := make(map[string]*entity.TaskStatsPeriod)
+	for _, start := range statsPeriodStarts(from, to, groupBy, loc) {
+		period := &entity.TaskStatsPeriod{Start: start}
+		periods[statsPeriodKey(start)] = period
+		stats.Periods = append(stats.Periods, period)
+	}
+
+	sources := make(map[string]*entity.TaskStatsSource)
+	for _, source := range statsSources {
+		sources[source] = &entity.TaskStatsSource{Source: source}
+		stats.Sources = append(stats.Sources, sources[source])
+	}
+	statsSource := func(source string) *entity.TaskStatsSource {
+		if _, ok := sources[source]; !ok {
+			sources[source] = &entity.TaskStatsSource{Source: source}
+			stats.Sources = append(stats.Sources, sources[source])
+		}
+		return sources[source]
+	}
+
+	var createdDone int64
+	for _, slot := range created {
+		stats.CreatedCount += slot.Count
+		createdDone += slot.DoneCount
+		statsSource(slot.Source).CreatedCount += slot.Count
+		if period, ok := periods[statsPeriodKey(statsPeriodStart(slot.Time(), groupBy, loc))]; ok {
+			period.CreatedCount += slot.Count
+		}
+	}
+
+	var leadSeconds int64
+	for _, slot := range completed {
+		stats.CompletedCount += slot.Count
+		leadSeconds += slot.LeadSeconds
+		statsSource(slot.Source).CompletedCount += slot.Count
+		if period, ok := periods[statsPeriodKey(statsPeriodStart(slot.Time(), groupBy, loc))]; ok {
+			period.CompletedCount += slot.Count
+		}
+	}
+
+	if stats.CreatedCount > 0 {
+		rate := float64(createdDone) / float64(stats.CreatedCount)
+		stats.CompletionRate = &rate
+	}
+	if stats.CompletedCount > 0 {
+		average := float64(leadSeconds) / float64(stats.CompletedCount)
+		stats.AverageLeadTimeSeconds = &average
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetTaskStats completed",
+		slog.Int64("created_count", stats.CreatedCount),
+		slog.Int64("completed_count", stats.CompletedCount),
+	)
+	return stats, nil
+}
+
+// GetTaskFlowReport は期間内のサイクルタイム・スループットと、累積フロー図・バーンダウンチャート用の累計件数を集計します
+func (u *statsUsecase) GetTaskFlowReport(ctx context.Context, from, to time.Time, groupBy entity.StatsGroupBy, loc *time.Location) (*entity.TaskFlowReport, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetTaskFlowReport started",
+		slog.Time("from", from),
+		slog.Time("to", to),
+		slog.String("group_by", string(groupBy)),
+	)
+
+	if err := validation.ValidateStatsRange(from, to); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := validation.ValidateStatsGroupBy(string(groupBy)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetTaskFlowReport")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	totals, err := u.repo.CountTasksBefore(ctx, userID, from)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to count tasks before range",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	created, err := u.repo.CountCreatedTasksBySlot(ctx, userID, from, to)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to count created tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	started, err := u.repo.CountStartedTasksBySlot(ctx, userID, from, to)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to count started tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	completed, err := u.repo.CountCompletedTasksBySlot(ctx, userID, from, to)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to count completed tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	report := &entity.TaskFlowReport{
+		From:    from,
+		To:      to,
+		GroupBy: groupBy,
+	}
+
+	// 期間ごとの件数（作成件数は累計の算出にのみ使用）
+	periods := make(map[string]*entity.TaskFlowPeriod)
+	createdCounts := make(map[string]int64)
+	cycleCounts := make(map[string]int64)
+	cycleSeconds := make(map[string]int64)
+	for _, start := range statsPeriodStarts(from, to, groupBy, loc) {
+		period := &entity.TaskFlowPeriod{Start: start}
+		periods[statsPeriodKey(start)] = period
+		report.Periods = append(report.Periods, period)
+	}
+
+	for _, slot := range created {
+		createdCounts[statsPeriodKey(statsPeriodStart(slot.Time(), groupBy, loc))] += slot.Count
+	}
+	for _, slot := range started {
+		if period, ok := periods[statsPeriodKey(statsPeriodStart(slot.Time(), groupBy, loc))]; ok {
+			period.StartedCount += slot.Count
+		}
+	}
+	var totalCycleCount, totalCycleSeconds int64
+	for _, slot := range completed {
+		report.Throughput += slot.Count
+		totalCycleCount += slot.CycleCount
+		totalCycleSeconds += slot.CycleSeconds
+		key := statsPeriodKey(statsPeriodStart(slot.Time(), groupBy, loc))
+		if period, ok := periods[key]; ok {
+			period.CompletedCount += slot.Count
+			cycleCounts[key] += slot.CycleCount
+			cycleSeconds[key] += slot.CycleSeconds
+		}
+	}
+
+	// 期間の順に累計を積み上げる
+	cumulative := *totals
+	for _, period := range report.Periods {
+		key := statsPeriodKey(period.Start)
+		cumulative.Created += createdCounts[key]
+		cumulative.Started += period.StartedCount
+		cumulative.Completed += period.CompletedCount
+		period.Cumulative = cumulative
+		if cycleCounts[key] > 0 {
+			average := float64(cycleSeconds[key]) / float64(cycleCounts[key])
+			period.AverageCycleTimeSeconds = &average
+		}
+	}
+
+	if totalCycleCount > 0 {
+		average := float64(totalCycleSeconds) / float64(totalCycleCount)
+		report.AverageCycleTimeSeconds = &average
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetTaskFlowReport completed",
+		slog.Int64("throughput", report.Throughput),
+	)
+	return report, nil
+}
+
+// statsPeriodStarts は集計期間に含まれる期間（locでの日、または月曜日始まりの週）の開始日時を順に返します
+func statsPeriodStarts(from, to time.Time, groupBy entity.StatsGroupBy, loc *time.Location) []time.Time {
+	var starts []time.Time
+	for start := statsPeriodStart(from, groupBy, loc); start.Before(to); start = nextStatsPeriod(start, groupBy) {
+		starts = append(starts, start)
+	}
+	return starts
+}
+
+// statsPeriodKey は期間の開始日時から集計キー（YYYY-MM-DD）を返します
+func statsPeriodKey(start time.Time) string {
+	return start.Format("2006-01-02")
+}
+
+// statsPeriodStart は日時を含む期間（locでの日、または月曜日始まりの週）の開始日時を返します
+func statsPeriodStart(at time.Time, groupBy entity.StatsGroupBy, loc *time.Location) time.Time {
+	at = at.In(loc)
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, loc)
+	if groupBy == entity.StatsGroupByWeek {
+		// time.Weekdayは日曜日が0のため、月曜日からの日数に変換する
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	}
+	return day
+}
+
+// nextStatsPeriod は次の期間の開始日時を返します
+func nextStatsPeriod(start time.Time, groupBy entity.StatsGroupBy) time.Time {
+	if groupBy == entity.StatsGroupByWeek {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
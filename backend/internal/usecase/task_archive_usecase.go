@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/stephenafamo/bob"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+)
+
+// GetArchivedTasks はログインユーザーのアーカイブしたタスクをアーカイブ日時の新しい順に取得します
+func (u *taskUsecase) GetArchivedTasks(ctx context.Context) (models.TaskSlice, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetArchivedTasks started")
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetArchivedTasks")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	tasks, err := u.repo.GetArchivedTasksByUserID(ctx, userID)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get archived tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetArchivedTasks completed",
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// UnarchiveTask はタスクのアーカイブを解除します（アーカイブされていない場合はそのまま返します）
+// 解除により更新日時が変わるため、自動アーカイブの日数が再び経過するまではアーカイブされません
+func (u *taskUsecase) UnarchiveTask(ctx context.Context, id string) (*models.Task, error) {
+	u.logger.InfoContext(ctx, "UseCase: UnarchiveTask started",
+		slog.String("task_id", id),
+	)
+
+	existingTask, err := u.getOwnedTask(ctx, u.repo, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingTask.ArchivedAt.IsNull() {
+		return existingTask, nil
+	}
+
+	updates := map[string]interface{}{
+		"archived_at": null.Val[time.Time]{},
+	}
+
+	var task *models.Task
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+
+		var err error
+		task, err = u.applyTaskEdit(ctx, taskRepo, eventRepo, existingTask, updates)
+		return err
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to unarchive task",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: UnarchiveTask completed",
+		slog.String("task_id", id),
+	)
+	return task, nil
+}
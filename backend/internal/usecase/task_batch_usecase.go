@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// errTaskBatchAborted は全件ロールバック指定時に失敗した操作があったことを表します（トランザクションのロールバック用）
+var errTaskBatchAborted = errors.New("task batch aborted")
+
+// taskBatchRepositories は一括操作のトランザクション内で使用するリポジトリ
+type taskBatchRepositories struct {
+	task       interfaces.TaskRepository
+	event      interfaces.TaskEventRepository
+	project    interfaces.ProjectRepository
+	dependency interfaces.TaskDependencyRepository
+	timeEntry  interfaces.TimeEntryRepository
+	status     interfaces.TaskStatusRepository
+}
+
+// BatchTasks は複数のタスク操作を1つのトランザクションで実行し、操作ごとの結果を返します
+// allOrNothingがtrueの場合は1件でも失敗すると全ての操作を取り消し、falseの場合は失敗した操作のみを取り消します
+func (u *taskUsecase) BatchTasks(ctx context.Context, operations []entity.TaskBatchOperation, allOrNothing bool) ([]*entity.TaskBatchResult, error) {
+	u.logger.InfoContext(ctx, "UseCase: BatchTasks started",
+		slog.Int("count", len(operations)),
+		slog.Bool("all_or_nothing", allOrNothing),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for BatchTasks")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	if err := validation.ValidateTaskBatchSize(len(operations)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	results := make([]*entity.TaskBatchResult, len(operations))
+	for i, op := range operations {
+		results[i] = &entity.TaskBatchResult{
+			Index:  i,
+			TaskID: op.TaskID,
+			Type:   op.Type,
+			Status: entity.TaskBatchResultSkipped,
+		}
+	}
+
+	err := database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		repos := &taskBatchRepositories{
+			task:       repository.NewTaskRepositoryWithExecutor(tx, u.logger),
+			event:      repository.NewTaskEventRepositoryWithExecutor(tx, u.logger),
+			project:    repository.NewProjectRepositoryWithExecutor(tx, u.logger),
+			dependency: repository.NewTaskDependencyRepositoryWithExecutor(tx, u.logger),
+			timeEntry:  repository.NewTimeEntryRepositoryWithExecutor(tx, u.logger),
+			status:     repository.NewTaskStatusRepositoryWithExecutor(tx, u.logger),
+		}
+
+		for i, op := range operations {
+			// 失敗した操作の途中までの変更だけを取り消せるようにセーブポイントを置く
+			if !allOrNothing {
+				if _, err := tx.ExecContext(ctx, "SAVEPOINT task_batch_operation"); err != nil {
+					return fmt.Errorf("failed to create savepoint: %w", err)
+				}
+			}
+
+			opErr := u.applyBatchOperation(ctx, repos, userID, op)
+			if opErr == nil {
+				results[i].Status = entity.TaskBatchResultSucceeded
+				continue
+			}
+
+			u.logger.WarnContext(ctx, "UseCase: Batch operation failed",
+				slog.Int("index", i),
+				slog.String("task_id", op.TaskID),
+				slog.String("type", string(op.Type)),
+				slog.String("error", opErr.Error()),
+			)
+			results[i].Status = entity.TaskBatchResultFailed
+			results[i].Err = opErr
+
+			if allOrNothing {
+				return errTaskBatchAborted
+			}
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT task_batch_operation"); err != nil {
+				return fmt.Errorf("failed to rollback to savepoint: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errTaskBatchAborted) {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to execute task batch",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	// 全件ロールバックした場合は成功していた操作も取り消し扱いにする
+	if errors.Is(err, errTaskBatchAborted) {
+		for _, result := range results {
+			if result.Status == entity.TaskBatchResultSucceeded {
+				result.Status = entity.TaskBatchResultRolledBack
+			}
+		}
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: BatchTasks completed",
+		slog.Int("count", len(operations)),
+		slog.Bool("aborted", err != nil),
+	)
+	return results, nil
+}
+
+// applyBatchOperation は一括操作の1件を実行します（トランザクション内で実行）
+// 所有者の確認やバリデーションは単体のAPIと同じ規則で行います
+func (u *taskUsecase) applyBatchOperation(ctx context.Context, repos *taskBatchRepositories, userID string, op entity.TaskBatchOperation) error {
+	if err := validation.ValidationTaskID(op.TaskID); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+
+	existingTask, err := u.getOwnedTask(ctx, repos.task, op.TaskID)
+	if err != nil {
+		// 他ユーザーのタスクの存在を明かさない
+		if err.Error() == "unauthorized" {
+			return fmt.Errorf("task not found: %s", op.TaskID)
+		}
+		return err
+	}
+
+	if err := u.checkTaskVersion(ctx, existingTask, op.Version); err != nil {
+		return err
+	}
+
+	var (
+		title       *string
+		description *string
+		dueAt       *time.Time
+		status      *string
+		projectID   *string
+	)
+
+	switch op.Type {
+	case entity.TaskBatchOperationDelete:
+		if err := repos.task.DeleteTask(ctx, op.TaskID, existingTask.Version); err != nil {
+			return err
+		}
+		if _, err := repos.timeEntry.StopRunningEntriesByTaskID(ctx, op.TaskID, time.Now()); err != nil {
+			return err
+		}
+		return recordTaskEvent(ctx, repos.event, entity.TaskEventTypeDeleted, entity.TaskEventSourceManual, existingTask, nil)
+
+	case entity.TaskBatchOperationUpdate:
+		if op.Title == nil && op.Description == nil && op.DueAt == nil && op.Status == nil && op.ProjectID == nil {
+			return fmt.Errorf("validation error: no fields to update")
+		}
+		if err := validation.ValidateEditTaskRequest(op.Title, op.Description, op.DueAt, op.Status, nil, nil); err != nil {
+			return fmt.Errorf("validation error: %w", err)
+		}
+		title, description, dueAt, status, projectID = op.Title, op.Description, op.DueAt, op.Status, op.ProjectID
+
+	case entity.TaskBatchOperationSetStatus:
+		if op.Status == nil {
+			return fmt.Errorf("validation error: status is required")
+		}
+		if err := validation.ValidateTaskStatus(*op.Status); err != nil {
+			return fmt.Errorf("validation error: %w", err)
+		}
+		status = op.Status
+
+	case entity.TaskBatchOperationMoveProject:
+		// プロジェクトの指定がない場合はプロジェクトから除外する
+		cleared := ""
+		projectID = &cleared
+		if op.ProjectID != nil {
+			projectID = op.ProjectID
+		}
+
+	default:
+		return fmt.Errorf("validation error: unknown operation: %s", op.Type)
+	}
+
+	if projectID != nil && *projectID != "" {
+		if err := checkTaskProject(ctx, repos.project, *projectID, userID); err != nil {
+			return err
+		}
+	}
+
+	var taskStatus *models.TaskStatus
+	if status != nil {
+		taskStatus, err = resolveTaskStatus(ctx, repos.status, userID, *status)
+		if err != nil {
+			return err
+		}
+	}
+
+	// ブロック中のタスクは明示的に強制しない限り着手できない（同じ一括操作内で先行タスクを完了した場合も考慮）
+	if taskStatus != nil && taskStatus.Category == entity.TaskStatusCategoryDoing && existingTask.StatusCategory != entity.TaskStatusCategoryDoing && !op.Force {
+		if err := u.checkTaskNotBlocked(ctx, repos.dependency, op.TaskID); err != nil {
+			return err
+		}
+	}
+
+	// WIP制限は同じ一括操作内で先に移動したタスクも含めて数える
+	if taskStatus != nil && taskStatus.Name != existingTask.Status {
+		if err := checkTaskStatusWIPLimit(ctx, repos.status, repos.task, userID, taskStatus.Name); err != nil {
+			return err
+		}
+	}
+
+	updates := taskEditUpdates(existingTask, title, description, dueAt, taskStatus, nil, nil, projectID, nil)
+	_, err = u.applyTaskEdit(ctx, repos.task, repos.event, existingTask, updates)
+	return err
+}
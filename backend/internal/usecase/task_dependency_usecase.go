@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/stephenafamo/bob"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+)
+
+// GetTaskDependencies はタスクの先行タスクと後続タスクを取得します
+func (u *taskUsecase) GetTaskDependencies(ctx context.Context, id string) (models.TaskSlice, models.TaskSlice, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetTaskDependencies started",
+		slog.String("task_id", id),
+	)
+
+	if _, err := u.getOwnedTask(ctx, u.repo, id); err != nil {
+		return nil, nil, err
+	}
+
+	blockedBy, err := u.dependencyRepo.GetBlockingTasks(ctx, id)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get blocking tasks",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, nil, err
+	}
+
+	blocks, err := u.dependencyRepo.GetDependentTasks(ctx, id)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get dependent tasks",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetTaskDependencies completed",
+		slog.String("task_id", id),
+		slog.Int("blocked_by_count", len(blockedBy)),
+		slog.Int("blocks_count", len(blocks)),
+	)
+	return blockedBy, blocks, nil
+}
+
+// AddTaskDependency はタスクに先行タスクを追加します（循環する依存関係は拒否）
+func (u *taskUsecase) AddTaskDependency(ctx context.Context, id string, dependsOnTaskID string) error {
+	u.logger.InfoContext(ctx, "UseCase: AddTaskDependency started",
+		slog.String("task_id", id),
+		slog.String("depends_on_task_id", dependsOnTaskID),
+	)
+
+	if id == dependsOnTaskID {
+		u.logger.WarnContext(ctx, "UseCase: Task cannot depend on itself",
+			slog.String("task_id", id),
+		)
+		return fmt.Errorf("validation error: task cannot depend on itself")
+	}
+
+	err := database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		dependencyRepo := repository.NewTaskDependencyRepositoryWithExecutor(tx, u.logger)
+
+		task, err := u.getOwnedTask(ctx, taskRepo, id)
+		if err != nil {
+			return err
+		}
+		if _, err := u.getOwnedTask(ctx, taskRepo, dependsOnTaskID); err != nil {
+			return err
+		}
+
+		// 逆向きの依存関係が同時に追加されて循環しないよう、ユーザー単位で直列化してから検出する
+		edges, err := dependencyRepo.GetDependenciesByUserIDForUpdate(ctx, task.UserID)
+		if err != nil {
+			return err
+		}
+
+		graph := make(map[string][]string)
+		for _, edge := range edges {
+			if edge.TaskID == id && edge.DependsOnTaskID == dependsOnTaskID {
+				return fmt.Errorf("task dependency already exists")
+			}
+			graph[edge.TaskID] = append(graph[edge.TaskID], edge.DependsOnTaskID)
+		}
+
+		// 先行タスクから辿って対象タスクに到達できる場合は循環になる
+		if hasDependencyPath(graph, dependsOnTaskID, id) {
+			return fmt.Errorf("task dependency cycle detected")
+		}
+
+		return dependencyRepo.CreateDependency(ctx, &models.TaskDependency{
+			TaskID:          id,
+			DependsOnTaskID: dependsOnTaskID,
+		})
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to add task dependency",
+			slog.String("task_id", id),
+			slog.String("depends_on_task_id", dependsOnTaskID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: AddTaskDependency completed",
+		slog.String("task_id", id),
+		slog.String("depends_on_task_id", dependsOnTaskID),
+	)
+	return nil
+}
+
+// RemoveTaskDependency はタスクから先行タスクの依存関係を削除します
+func (u *taskUsecase) RemoveTaskDependency(ctx context.Context, id string, dependsOnTaskID string) error {
+	u.logger.InfoContext(ctx, "UseCase: RemoveTaskDependency started",
+		slog.String("task_id", id),
+		slog.String("depends_on_task_id", dependsOnTaskID),
+	)
+
+	if _, err := u.getOwnedTask(ctx, u.repo, id); err != nil {
+		return err
+	}
+
+	if err := u.dependencyRepo.DeleteDependency(ctx, id, dependsOnTaskID); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to remove task dependency",
+			slog.String("task_id", id),
+			slog.String("depends_on_task_id", dependsOnTaskID),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: RemoveTaskDependency completed",
+		slog.String("task_id", id),
+		slog.String("depends_on_task_id", dependsOnTaskID),
+	)
+	return nil
+}
+
+// checkTaskNotBlocked はタスクが未完了の先行タスクによりブロックされていないかを確認します
+func (u *taskUsecase) checkTaskNotBlocked(ctx context.Context, dependencyRepo interfaces.TaskDependencyRepository, id string) error {
+	blocked, err := dependencyRepo.GetBlockedTaskIDs(ctx, []string{id})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to check blocked status",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+	if blocked[id] {
+		u.logger.WarnContext(ctx, "UseCase: Task is blocked by unfinished dependencies",
+			slog.String("task_id", id),
+		)
+		return fmt.Errorf("task is blocked by unfinished dependencies")
+	}
+	return nil
+}
+
+// getOwnedTask はログインユーザーが所有するタスクを取得します
+func (u *taskUsecase) getOwnedTask(ctx context.Context, taskRepo interfaces.TaskRepository, id string) (*models.Task, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	task, err := taskRepo.GetTaskByID(ctx, id)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get task",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	if task.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized task access",
+			slog.String("task_id", id),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	return task, nil
+}
+
+// hasDependencyPath は依存関係グラフ上でfromからtoへ到達できるかを幅優先探索で判定します
+func hasDependencyPath(graph map[string][]string, from, to string) bool {
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == to {
+			return true
+		}
+		for _, next := range graph[current] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/stephenafamo/bob/types"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// trackedTaskFields は変更履歴に記録するタスクのフィールド（表示順キーなどの内部的な値は含まない）
+var trackedTaskFields = []string{
+	"title",
+	"description",
+	"due_at",
+	"estimate_minutes",
+	"status",
+	"project_id",
+	"recurrence_rule",
+	"recurrence_anchor_at",
+	"snoozed_until",
+	"archived_at",
+}
+
+// GetTaskHistory はタスクの変更履歴を記録順に取得します
+// 削除済みのタスクでも所有者であれば履歴を取得できます
+func (u *taskUsecase) GetTaskHistory(ctx context.Context, id string) (models.TaskEventSlice, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetTaskHistory started",
+		slog.String("task_id", id),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetTaskHistory")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	events, err := u.eventRepo.GetEventsByTaskID(ctx, userID, id)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get task history",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	// 履歴がない場合はタスクの存在と所有者を確認する
+	if len(events) == 0 {
+		if _, err := u.getOwnedTask(ctx, u.repo, id); err != nil {
+			return nil, err
+		}
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetTaskHistory completed",
+		slog.String("task_id", id),
+		slog.Int("count", len(events)),
+	)
+	return events, nil
+}
+
+// recordTaskEvent はタスクの変更前後を比較して変更履歴を追記します（変更と同じトランザクション内で実行）
+// beforeは作成時、afterは削除時にnilを渡します。更新で記録対象の変更がない場合は何もしません
+func recordTaskEvent(ctx context.Context, eventRepo interfaces.TaskEventRepository, eventType entity.TaskEventType, source entity.TaskEventSource, before, after *models.Task) error {
+	changes := diffTask(before, after)
+	if eventType == entity.TaskEventTypeUpdated && len(changes) == 0 {
+		return nil
+	}
+
+	task := after
+	if task == nil {
+		task = before
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task changes: %w", err)
+	}
+
+	event := &models.TaskEvent{
+		TaskID:    task.ID,
+		UserID:    task.UserID,
+		EventType: string(eventType),
+		Source:    string(source),
+		Changes:   types.NewJSON(json.RawMessage(data)),
+	}
+	if actorID, ok := ctx.Value("user_id").(string); ok && actorID != "" {
+		event.ActorID = null.From(actorID)
+	}
+
+	return eventRepo.CreateEvent(ctx, event)
+}
+
+// diffTask は記録対象のフィールドについて変更前後の値を抽出します
+func diffTask(before, after *models.Task) map[string]entity.TaskFieldChange {
+	beforeValues := taskFieldValues(before)
+	afterValues := taskFieldValues(after)
+
+	changes := make(map[string]entity.TaskFieldChange)
+	for _, field := range trackedTaskFields {
+		if beforeValues[field] != afterValues[field] {
+			changes[field] = entity.TaskFieldChange{
+				Before: beforeValues[field],
+				After:  afterValues[field],
+			}
+		}
+	}
+	return changes
+}
+
+// taskFieldValues は記録対象のフィールドの値を比較可能な形で取り出します（日時はRFC 3339の文字列）
+func taskFieldValues(task *models.Task) map[string]interface{} {
+	values := make(map[string]interface{}, len(trackedTaskFields))
+	if task == nil {
+		return values
+	}
+
+	values["title"] = task.Title
+	values["status"] = task.Status
+	if val, ok := task.Description.Get(); ok {
+		values["description"] = val
+	}
+	if val, ok := task.DueAt.Get(); ok {
+		values["due_at"] = val.UTC().Format(time.RFC3339)
+	}
+	if val, ok := task.EstimateMinutes.Get(); ok {
+		values["estimate_minutes"] = val
+	}
+	if val, ok := task.ProjectID.Get(); ok {
+		values["project_id"] = val
+	}
+	if val, ok := task.RecurrenceRule.Get(); ok {
+		values["recurrence_rule"] = val
+	}
+	if val, ok := task.RecurrenceAnchorAt.Get(); ok {
+		values["recurrence_anchor_at"] = val.UTC().Format(time.RFC3339)
+	}
+	if val, ok := task.SnoozedUntil.Get(); ok {
+		values["snoozed_until"] = val.UTC().Format(time.RFC3339)
+	}
+	if val, ok := task.ArchivedAt.Get(); ok {
+		values["archived_at"] = val.UTC().Format(time.RFC3339)
+	}
+	return values
+}
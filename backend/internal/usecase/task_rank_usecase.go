@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/ranking"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// MoveTask はタスクのステータスと列内の表示順を同一トランザクションで更新します
+// positionは移動するタスク自身を除いた移動先の列での挿入位置です
+func (u *taskUsecase) MoveTask(ctx context.Context, id string, status string, position int, force bool) (*models.Task, error) {
+	u.logger.InfoContext(ctx, "UseCase: MoveTask started",
+		slog.String("task_id", id),
+		slog.String("status", status),
+		slog.Int("position", position),
+	)
+
+	// バリデーション
+	if status == "" {
+		return nil, fmt.Errorf("validation error: status is required")
+	}
+	if err := validation.ValidateTaskStatus(status); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if position < 0 {
+		return nil, fmt.Errorf("validation error: position must be 0 or greater")
+	}
+
+	existingTask, err := u.getOwnedTask(ctx, u.repo, id)
+	if err != nil {
+		return nil, err
+	}
+
+	taskStatus, err := resolveTaskStatus(ctx, u.statusRepo, existingTask.UserID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	// ブロック中のタスクは明示的に強制しない限り着手できない
+	if taskStatus.Category == entity.TaskStatusCategoryDoing && existingTask.StatusCategory != entity.TaskStatusCategoryDoing && !force {
+		if err := u.checkTaskNotBlocked(ctx, u.dependencyRepo, id); err != nil {
+			return nil, err
+		}
+	}
+
+	var task *models.Task
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+		statusRepo := repository.NewTaskStatusRepositoryWithExecutor(tx, u.logger)
+
+		// 列内の並べ替えだけの場合はWIP制限を確認しない
+		if status != existingTask.Status {
+			if err := checkTaskStatusWIPLimit(ctx, statusRepo, taskRepo, existingTask.UserID, status); err != nil {
+				return err
+			}
+		}
+
+		var projectID *string
+		if value, ok := existingTask.ProjectID.Get(); ok {
+			projectID = &value
+		}
+
+		column, err := taskRepo.GetColumnTasksForUpdate(ctx, existingTask.UserID, projectID, status)
+		if err != nil {
+			return err
+		}
+
+		// 移動するタスク自身を除いた並びに挿入する
+		others := make(models.TaskSlice, 0, len(column))
+		for _, t := range column {
+			if t.ID != id {
+				others = append(others, t)
+			}
+		}
+		if position > len(others) {
+			position = len(others)
+		}
+
+		rankKey, err := u.resolveRankKey(ctx, taskRepo, others, position)
+		if err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{
+			"status":          taskStatus.Name,
+			"status_category": taskStatus.Category,
+			"rank_key":        rankKey,
+		}
+		if status != existingTask.Status {
+			updates["started_at"], updates["completed_at"] = taskStatusTimestamps(existingTask, taskStatus.Category, time.Now())
+		}
+		if existingTask.ArchivedAt.IsValue() {
+			updates["archived_at"] = taskArchivedAt(existingTask, taskStatus.Category)
+		}
+
+		edited, err := taskRepo.EditTask(ctx, id, existingTask.Version, updates)
+		if err != nil {
+			return err
+		}
+		task = edited
+
+		if err := recordTaskEvent(ctx, eventRepo, entity.TaskEventTypeUpdated, entity.TaskEventSourceManual, existingTask, task); err != nil {
+			return err
+		}
+
+		if existingTask.StatusCategory != entity.TaskStatusCategoryDone && task.StatusCategory == entity.TaskStatusCategoryDone {
+			return u.createNextOccurrence(ctx, taskRepo, eventRepo, task)
+		}
+		return nil
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to move task",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: MoveTask completed",
+		slog.String("task_id", id),
+		slog.String("rank_key", task.RankKey),
+	)
+	return task, nil
+}
+
+// resolveRankKey は列のposition番目に挿入するタスクの表示順キーを決定します（トランザクション内で実行）
+// 前後に未配置のタスクがある場合やキーが長くなりすぎた場合は、列全体のキーを振り直します
+func (u *taskUsecase) resolveRankKey(ctx context.Context, taskRepo interfaces.TaskRepository, column models.TaskSlice, position int) (string, error) {
+	before, after := "", ""
+	if position > 0 {
+		before = column[position-1].RankKey
+	}
+	if position < len(column) {
+		after = column[position].RankKey
+	}
+
+	unranked := (position > 0 && before == "") || (position < len(column) && after == "")
+	if !unranked {
+		key, err := ranking.Between(before, after)
+		if err == nil && !ranking.NeedsRebalance(key) {
+			return key, nil
+		}
+	}
+
+	// 挿入後の並びに等間隔のキーを割り当てる（挿入位置の分を空けておく）
+	keys := ranking.Spread(len(column) + 1)
+	for i, t := range column {
+		key := keys[i]
+		if i >= position {
+			key = keys[i+1]
+		}
+		if t.RankKey == key {
+			continue
+		}
+		if err := taskRepo.UpdateRankKey(ctx, t.ID, key); err != nil {
+			return "", err
+		}
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: Column rank keys rebalanced",
+		slog.Int("count", len(column)),
+	)
+	return keys[position], nil
+}
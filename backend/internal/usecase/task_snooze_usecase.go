@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/stephenafamo/bob"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// SnoozeTask はタスクを解除日時までスヌーズします（既定の一覧に表示しない）
+// untilとdurationはどちらか一方を指定し、durationは現在からの期間（例: "3 days"）として解除日時に変換します
+// スヌーズ中のタスクに実行した場合は解除日時と通知の有無を置き換えます
+func (u *taskUsecase) SnoozeTask(ctx context.Context, id string, until *time.Time, duration *string, notify bool) (*models.Task, error) {
+	u.logger.InfoContext(ctx, "UseCase: SnoozeTask started",
+		slog.String("task_id", id),
+	)
+
+	snoozedUntil, err := validation.ResolveSnoozeUntil(until, duration, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	existingTask, err := u.getOwnedTask(ctx, u.repo, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 完了したタスクは一覧に戻す必要がないためスヌーズできない
+	if existingTask.StatusCategory == entity.TaskStatusCategoryDone {
+		return nil, fmt.Errorf("validation error: done tasks cannot be snoozed")
+	}
+
+	updates := map[string]interface{}{
+		"snoozed_until": null.From(snoozedUntil),
+		"snooze_notify": notify,
+	}
+
+	var task *models.Task
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+
+		var err error
+		task, err = u.applyTaskEdit(ctx, taskRepo, eventRepo, existingTask, updates)
+		return err
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to snooze task",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: SnoozeTask completed",
+		slog.String("task_id", id),
+		slog.Time("snoozed_until", snoozedUntil),
+	)
+	return task, nil
+}
+
+// UnsnoozeTask はタスクのスヌーズを解除します（スヌーズされていない場合はそのまま返します）
+func (u *taskUsecase) UnsnoozeTask(ctx context.Context, id string) (*models.Task, error) {
+	u.logger.InfoContext(ctx, "UseCase: UnsnoozeTask started",
+		slog.String("task_id", id),
+	)
+
+	existingTask, err := u.getOwnedTask(ctx, u.repo, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingTask.SnoozedUntil.IsNull() {
+		return existingTask, nil
+	}
+
+	updates := map[string]interface{}{
+		"snoozed_until": null.Val[time.Time]{},
+		"snooze_notify": false,
+	}
+
+	var task *models.Task
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+
+		var err error
+		task, err = u.applyTaskEdit(ctx, taskRepo, eventRepo, existingTask, updates)
+		return err
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to unsnooze task",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: UnsnoozeTask completed",
+		slog.String("task_id", id),
+	)
+	return task, nil
+}
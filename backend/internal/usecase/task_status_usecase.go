@@ -0,0 +1,415 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+type taskStatusUsecase struct {
+	db       *sql.DB
+	repo     interfaces.TaskStatusRepository
+	taskRepo interfaces.TaskRepository
+	logger   *slog.Logger
+}
+
+// NewTaskStatusUsecase は新しいTaskStatusUsecaseを生成します
+// dbはカテゴリの変更とタスクへの反映、削除時の使用状況の確認をトランザクションで行うために使用します
+func NewTaskStatusUsecase(db *sql.DB, repo interfaces.TaskStatusRepository, taskRepo interfaces.TaskRepository, logger *slog.Logger) interfaces.TaskStatusUsecase {
+	return &taskStatusUsecase{
+		db:       db,
+		repo:     repo,
+		taskRepo: taskRepo,
+		logger:   logger,
+	}
+}
+
+// GetStatusList はログインユーザーのステータス一覧を表示順で取得します
+func (u *taskStatusUsecase) GetStatusList(ctx context.Context) (models.TaskStatusSlice, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetStatusList started")
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetStatusList")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	statuses, err := loadTaskStatuses(ctx, u.repo, userID)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get status list",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetStatusList completed",
+		slog.Int("count", len(statuses)),
+	)
+	return statuses, nil
+}
+
+// CreateStatus は新しいステータスを追加します（表示順の指定がない場合は最後に追加します）
+func (u *taskStatusUsecase) CreateStatus(ctx context.Context, name string, category string, sortOrder *int32, wipLimit *int32) (*models.TaskStatus, error) {
+	u.logger.InfoContext(ctx, "UseCase: CreateStatus started",
+		slog.String("name", name),
+		slog.String("category", category),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for CreateStatus")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	// バリデーション
+	if err := validation.ValidateTaskStatusName(name); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := validation.ValidateTaskStatusCategory(category); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := validation.ValidateTaskStatusWIPLimit(wipLimit); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	statuses, err := loadTaskStatuses(ctx, u.repo, userID)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get statuses",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	if err := validation.ValidateTaskStatusCount(len(statuses) + 1); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	status := &models.TaskStatus{
+		ID:       uuid.New().String(),
+		UserID:   userID,
+		Name:     name,
+		Category: category,
+		WipLimit: taskStatusWIPLimit(wipLimit),
+	}
+	if sortOrder != nil {
+		status.SortOrder = *sortOrder
+	} else if len(statuses) > 0 {
+		status.SortOrder = statuses[len(statuses)-1].SortOrder + 1
+	}
+
+	if err := u.repo.CreateStatus(ctx, status); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to create status",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: CreateStatus completed",
+		slog.String("status_id", status.ID),
+	)
+	return status, nil
+}
+
+// EditStatus はステータスのカテゴリ・表示順・WIP制限を部分更新します（wipLimitの0は制限の解除）
+// カテゴリを変更した場合は、そのステータスのタスクのカテゴリと着手・完了日時も同一トランザクションで更新します
+func (u *taskStatusUsecase) EditStatus(ctx context.Context, id string, category *string, sortOrder *int32, wipLimit *int32) (*models.TaskStatus, error) {
+	u.logger.InfoContext(ctx, "UseCase: EditStatus started",
+		slog.String("status_id", id),
+	)
+
+	// バリデーション
+	if category != nil {
+		if err := validation.ValidateTaskStatusCategory(*category); err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+	}
+	if err := validation.ValidateTaskStatusWIPLimit(wipLimit); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	status, err := u.getOwnedStatus(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryChanged := category != nil && *category != status.Category
+	if categoryChanged && status.Category == entity.TaskStatusCategoryTodo {
+		if err := u.checkOtherTodoStatusExists(ctx, status); err != nil {
+			return nil, err
+		}
+	}
+
+	if category != nil {
+		status.Category = *category
+	}
+	if sortOrder != nil {
+		status.SortOrder = *sortOrder
+	}
+	if wipLimit != nil {
+		status.WipLimit = taskStatusWIPLimit(wipLimit)
+	}
+
+	// 定義の更新とタスクへのカテゴリの反映を同一トランザクションで実行
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		statusRepo := repository.NewTaskStatusRepositoryWithExecutor(tx, u.logger)
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+
+		if err := statusRepo.UpdateStatus(ctx, status); err != nil {
+			return err
+		}
+		if !categoryChanged {
+			return nil
+		}
+		count, err := taskRepo.UpdateTasksStatusCategory(ctx, status.UserID, status.Name, status.Category, time.Now())
+		if err != nil {
+			return err
+		}
+		u.logger.InfoContext(ctx, "UseCase: Status category applied to tasks",
+			slog.String("status_id", id),
+			slog.Int64("count", count),
+		)
+		return nil
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to edit status",
+			slog.String("status_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: EditStatus completed",
+		slog.String("status_id", id),
+	)
+	return status, nil
+}
+
+// DeleteStatus はステータスを削除します
+// タスク（ゴミ箱内を含む）が使用しているステータスと、未着手カテゴリの最後のステータスは削除できません
+func (u *taskStatusUsecase) DeleteStatus(ctx context.Context, id string) error {
+	u.logger.InfoContext(ctx, "UseCase: DeleteStatus started",
+		slog.String("status_id", id),
+	)
+
+	status, err := u.getOwnedStatus(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if status.Category == entity.TaskStatusCategoryTodo {
+		if err := u.checkOtherTodoStatusExists(ctx, status); err != nil {
+			return err
+		}
+	}
+
+	// 使用状況の確認と削除を同一トランザクションで実行（確認後に割り当てられるのを防ぐため定義を行ロックする）
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		statusRepo := repository.NewTaskStatusRepositoryWithExecutor(tx, u.logger)
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+
+		if _, err := statusRepo.GetStatusByNameForUpdate(ctx, status.UserID, status.Name); err != nil {
+			return err
+		}
+		count, err := taskRepo.CountTasksByStatus(ctx, status.UserID, status.Name, true)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("status is in use: %s is used by %d tasks", status.Name, count)
+		}
+		return statusRepo.DeleteStatus(ctx, id)
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to delete status",
+			slog.String("status_id", id),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: DeleteStatus completed",
+		slog.String("status_id", id),
+	)
+	return nil
+}
+
+// getOwnedStatus はログインユーザーのステータスを取得します
+func (u *taskStatusUsecase) getOwnedStatus(ctx context.Context, id string) (*models.TaskStatus, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	status, err := u.repo.GetStatusByID(ctx, id)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get status",
+			slog.String("status_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	if status.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized status access",
+			slog.String("status_id", id),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	return status, nil
+}
+
+// checkOtherTodoStatusExists は指定したステータス以外に未着手カテゴリのステータスがあるかを確認します
+// 新しいタスクの既定のステータスになるため、未着手カテゴリのステータスは常に1つ以上必要です
+func (u *taskStatusUsecase) checkOtherTodoStatusExists(ctx context.Context, status *models.TaskStatus) error {
+	statuses, err := u.repo.GetStatusesByUserID(ctx, status.UserID)
+	if err != nil {
+		return err
+	}
+	for _, s := range statuses {
+		if s.ID != status.ID && s.Category == entity.TaskStatusCategoryTodo {
+			return nil
+		}
+	}
+	return fmt.Errorf("validation error: at least one status in the todo category is required")
+}
+
+// loadTaskStatuses はユーザーのステータスを表示順で取得します
+// まだ定義がないユーザーには、デフォルトのステータスを作成して返します
+func loadTaskStatuses(ctx context.Context, repo interfaces.TaskStatusRepository, userID string) (models.TaskStatusSlice, error) {
+	statuses, err := repo.GetStatusesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses) > 0 {
+		return statuses, nil
+	}
+
+	for i, def := range entity.DefaultTaskStatuses {
+		status := &models.TaskStatus{
+			ID:        uuid.New().String(),
+			UserID:    userID,
+			Name:      def.Name,
+			Category:  def.Category,
+			SortOrder: int32(i),
+		}
+		if err := repo.CreateStatus(ctx, status); err != nil {
+			// 同時に作成された場合は作成済みの定義を使う
+			if strings.Contains(err.Error(), "already exists") {
+				return repo.GetStatusesByUserID(ctx, userID)
+			}
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// findTaskStatus は名前が一致するステータスを返します（ない場合はnil）
+func findTaskStatus(statuses models.TaskStatusSlice, name string) *models.TaskStatus {
+	for _, status := range statuses {
+		if status.Name == name {
+			return status
+		}
+	}
+	return nil
+}
+
+// firstTaskStatus はカテゴリのうち表示順が最初のステータスを返します（ない場合はnil）
+func firstTaskStatus(statuses models.TaskStatusSlice, category string) *models.TaskStatus {
+	for _, status := range statuses {
+		if status.Category == category {
+			return status
+		}
+	}
+	return nil
+}
+
+// matchTaskStatus は取り込んだデータやAIが提案したステータス名を、ユーザーのステータスに対応付けます
+// 同じ名前のステータスがなければデフォルトのステータス名（todo/in_progress/done）のカテゴリの最初のステータス、
+// それ以外は未着手カテゴリの最初のステータスを返します
+func matchTaskStatus(statuses models.TaskStatusSlice, name string) *models.TaskStatus {
+	if status := findTaskStatus(statuses, name); status != nil {
+		return status
+	}
+	for _, def := range entity.DefaultTaskStatuses {
+		if def.Name != name {
+			continue
+		}
+		if status := firstTaskStatus(statuses, def.Category); status != nil {
+			return status
+		}
+	}
+	return firstTaskStatus(statuses, entity.TaskStatusCategoryTodo)
+}
+
+// resolveTaskStatus はステータス名をユーザーのステータスに解決します（空文字列は未着手カテゴリの最初のステータス）
+func resolveTaskStatus(ctx context.Context, repo interfaces.TaskStatusRepository, userID string, name string) (*models.TaskStatus, error) {
+	statuses, err := loadTaskStatuses(ctx, repo, userID)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		if status := firstTaskStatus(statuses, entity.TaskStatusCategoryTodo); status != nil {
+			return status, nil
+		}
+	}
+	status := findTaskStatus(statuses, name)
+	if status == nil {
+		return nil, fmt.Errorf("validation error: unknown status: %s", name)
+	}
+	return status, nil
+}
+
+// setTaskStatus はタスクにステータスとそのカテゴリを設定します
+func setTaskStatus(task *models.Task, status *models.TaskStatus) {
+	task.Status = status.Name
+	task.StatusCategory = status.Category
+}
+
+// checkTaskStatusWIPLimit は移動先のステータスにWIP制限の空きがあるかを確認します（トランザクション内で実行）
+// 同時に移動した場合に制限を超えないよう、ステータスの定義を行ロックしてから件数を数えます
+func checkTaskStatusWIPLimit(ctx context.Context, statusRepo interfaces.TaskStatusRepository, taskRepo interfaces.TaskRepository, userID string, name string) error {
+	status, err := statusRepo.GetStatusByNameForUpdate(ctx, userID, name)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		return fmt.Errorf("validation error: unknown status: %s", name)
+	}
+	limit, ok := status.WipLimit.Get()
+	if !ok {
+		return nil
+	}
+
+	count, err := taskRepo.CountTasksByStatus(ctx, userID, name, false)
+	if err != nil {
+		return err
+	}
+	if count >= int64(limit) {
+		return fmt.Errorf("wip limit reached: %s allows %d tasks", name, limit)
+	}
+	return nil
+}
+
+// taskStatusWIPLimit は指定されたWIP制限をモデルの値に変換します（nilや0は制限なし）
+func taskStatusWIPLimit(wipLimit *int32) null.Val[int32] {
+	if wipLimit == nil || *wipLimit == 0 {
+		return null.Val[int32]{}
+	}
+	return null.From(*wipLimit)
+}
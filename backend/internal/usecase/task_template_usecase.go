@@ -0,0 +1,530 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+type taskTemplateUsecase struct {
+	db             *sql.DB
+	repo           interfaces.TaskTemplateRepository
+	taskRepo       interfaces.TaskRepository
+	dependencyRepo interfaces.TaskDependencyRepository
+	projectRepo    interfaces.ProjectRepository
+	logger         *slog.Logger
+}
+
+// NewTaskTemplateUsecase は新しいTaskTemplateUsecaseを生成します
+// dbはテンプレートの展開で作成するタスクと依存関係をすべて同一トランザクションで作成するために使用します
+func NewTaskTemplateUsecase(db *sql.DB, repo interfaces.TaskTemplateRepository, taskRepo interfaces.TaskRepository, dependencyRepo interfaces.TaskDependencyRepository, projectRepo interfaces.ProjectRepository, logger *slog.Logger) interfaces.TaskTemplateUsecase {
+	return &taskTemplateUsecase{
+		db:             db,
+		repo:           repo,
+		taskRepo:       taskRepo,
+		dependencyRepo: dependencyRepo,
+		projectRepo:    projectRepo,
+		logger:         logger,
+	}
+}
+
+// GetTemplate はIDでテンプレートを取得します
+func (u *taskTemplateUsecase) GetTemplate(ctx context.Context, id string) (*entity.TaskTemplate, error) {
+	return u.getOwnedTemplate(ctx, id)
+}
+
+// GetTemplateList はログインユーザーのテンプレート一覧を取得します
+func (u *taskTemplateUsecase) GetTemplateList(ctx context.Context) ([]*entity.TaskTemplate, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetTemplateList started")
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetTemplateList")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	templates, err := u.repo.GetTemplatesByUserID(ctx, userID)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get template list",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetTemplateList completed",
+		slog.Int("count", len(templates)),
+	)
+	return templates, nil
+}
+
+// CreateTemplate は新しいテンプレートを作成します
+func (u *taskTemplateUsecase) CreateTemplate(ctx context.Context, name string, description *string, projectID *string, items []entity.TaskTemplateItem) (*entity.TaskTemplate, error) {
+	u.logger.InfoContext(ctx, "UseCase: CreateTemplate started",
+		slog.String("name", name),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for CreateTemplate")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	// バリデーション
+	if err := validation.ValidateCreateTaskTemplateRequest(name, projectID); err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Validation failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	normalizedItems, err := validation.NormalizeTaskTemplateItems(items)
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Validation failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	// 既定のプロジェクトの所有者確認
+	if projectID != nil {
+		if err := checkTaskProject(ctx, u.projectRepo, *projectID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	template := &entity.TaskTemplate{
+		ID:          uuid.New().String(),
+		UserID:      userID,
+		Name:        strings.TrimSpace(name),
+		Description: templateDescription(description),
+		ProjectID:   projectID,
+		Items:       normalizedItems,
+	}
+
+	if err := u.repo.CreateTemplate(ctx, template); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to create template",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: CreateTemplate completed",
+		slog.String("template_id", template.ID),
+	)
+	return template, nil
+}
+
+// EditTemplate はテンプレートを部分更新します（itemsを指定した場合はタスク定義をすべて置き換えます）
+func (u *taskTemplateUsecase) EditTemplate(ctx context.Context, id string, name *string, description *string, projectID *string, items []entity.TaskTemplateItem) (*entity.TaskTemplate, error) {
+	u.logger.InfoContext(ctx, "UseCase: EditTemplate started",
+		slog.String("template_id", id),
+	)
+
+	// バリデーション
+	if name != nil {
+		if err := validation.ValidateTaskTemplateName(*name); err != nil {
+			u.logger.WarnContext(ctx, "UseCase: Validation failed",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+	}
+	var normalizedItems []entity.TaskTemplateItem
+	if items != nil {
+		var err error
+		normalizedItems, err = validation.NormalizeTaskTemplateItems(items)
+		if err != nil {
+			u.logger.WarnContext(ctx, "UseCase: Validation failed",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+	}
+
+	template, err := u.getOwnedTemplate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if projectID != nil {
+		if err := checkTaskProject(ctx, u.projectRepo, *projectID, template.UserID); err != nil {
+			return nil, err
+		}
+		template.ProjectID = projectID
+	}
+	if name != nil {
+		template.Name = strings.TrimSpace(*name)
+	}
+	if description != nil {
+		template.Description = templateDescription(description)
+	}
+	if normalizedItems != nil {
+		template.Items = normalizedItems
+	}
+
+	if err := u.repo.UpdateTemplate(ctx, template); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to edit template",
+			slog.String("template_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: EditTemplate completed",
+		slog.String("template_id", id),
+	)
+	return template, nil
+}
+
+// DeleteTemplate はテンプレートを削除します（展開済みのタスクは残ります）
+func (u *taskTemplateUsecase) DeleteTemplate(ctx context.Context, id string) error {
+	u.logger.InfoContext(ctx, "UseCase: DeleteTemplate started",
+		slog.String("template_id", id),
+	)
+
+	if _, err := u.getOwnedTemplate(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteTemplate(ctx, id); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to delete template",
+			slog.String("template_id", id),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: DeleteTemplate completed",
+		slog.String("template_id", id),
+	)
+	return nil
+}
+
+// InstantiateTemplate はテンプレートのタスク定義からタスクをまとめて作成します
+// 期限は基準日時（nilの場合は現在時刻）に相対期限を加算して設定し、サブタスクは親タスクが依存する先行タスクとして作成します
+// プロジェクトの指定がない場合はテンプレートの既定のプロジェクトに割り当てます
+// タスク・依存関係・変更履歴はすべて同一トランザクションで作成するため、途中で失敗した場合は何も作成されません
+// 作成したタスクとあわせて、サブタスクを持つタスクをブロック中とした算出値を返します
+func (u *taskTemplateUsecase) InstantiateTemplate(ctx context.Context, id string, anchorAt *time.Time, projectID *string) (models.TaskSlice, map[string]*entity.TaskSummary, error) {
+	u.logger.InfoContext(ctx, "UseCase: InstantiateTemplate started",
+		slog.String("template_id", id),
+	)
+
+	template, err := u.getOwnedTemplate(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if projectID == nil {
+		projectID = template.ProjectID
+	}
+	if projectID != nil {
+		if err := checkTaskProject(ctx, u.projectRepo, *projectID, template.UserID); err != nil {
+			u.logger.WarnContext(ctx, "UseCase: Invalid project for template tasks",
+				slog.String("project_id", *projectID),
+				slog.String("error", err.Error()),
+			)
+			return nil, nil, err
+		}
+	}
+
+	anchor := time.Now()
+	if anchorAt != nil {
+		anchor = *anchorAt
+	}
+
+	tasks := make(models.TaskSlice, 0, entity.CountTaskTemplateItems(template.Items))
+	summaries := make(map[string]*entity.TaskSummary, cap(tasks))
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		dependencyRepo := repository.NewTaskDependencyRepositoryWithExecutor(tx, u.logger)
+		eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+		statusRepo := repository.NewTaskStatusRepositoryWithExecutor(tx, u.logger)
+
+		// テンプレートから作成するタスクは未着手カテゴリの最初のステータスにする
+		status, err := resolveTaskStatus(ctx, statusRepo, template.UserID, "")
+		if err != nil {
+			return err
+		}
+
+		// createItem はタスク定義のタスクを作成し、続けてサブタスクを作成して依存関係を登録します
+		var createItem func(item entity.TaskTemplateItem) (*models.Task, error)
+		createItem = func(item entity.TaskTemplateItem) (*models.Task, error) {
+			task, err := newTemplateTask(template.UserID, item, status, anchor, projectID)
+			if err != nil {
+				return nil, err
+			}
+			if err := taskRepo.CreateTask(ctx, task); err != nil {
+				return nil, err
+			}
+			if err := recordTaskEvent(ctx, eventRepo, entity.TaskEventTypeCreated, entity.TaskEventSourceManual, nil, task); err != nil {
+				return nil, err
+			}
+			tasks = append(tasks, task)
+
+			for _, subtaskItem := range item.Subtasks {
+				subtask, err := createItem(subtaskItem)
+				if err != nil {
+					return nil, err
+				}
+				if err := dependencyRepo.CreateDependency(ctx, &models.TaskDependency{
+					TaskID:          task.ID,
+					DependsOnTaskID: subtask.ID,
+				}); err != nil {
+					return nil, err
+				}
+			}
+			summaries[task.ID] = &entity.TaskSummary{Blocked: len(item.Subtasks) > 0}
+			return task, nil
+		}
+
+		for _, item := range template.Items {
+			if _, err := createItem(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to instantiate template",
+			slog.String("template_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: InstantiateTemplate completed",
+		slog.String("template_id", id),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, summaries, nil
+}
+
+// SaveTaskAsTemplate は既存のタスクと、そのタスクが依存する先行タスクを再帰的にたどったツリーをテンプレートとして保存します
+// 先行タスクはサブタスクとして保存し、複数のタスクから依存されている先行タスクは最初に現れた位置にのみ含めます
+// 相対期限は保存元のタスクの期限（なければツリー内で最も早い期限）を基準に算出します
+func (u *taskTemplateUsecase) SaveTaskAsTemplate(ctx context.Context, taskID string, name string, description *string) (*entity.TaskTemplate, error) {
+	u.logger.InfoContext(ctx, "UseCase: SaveTaskAsTemplate started",
+		slog.String("task_id", taskID),
+		slog.String("name", name),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for SaveTaskAsTemplate")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	if err := validation.ValidateTaskTemplateName(name); err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Validation failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	root, err := u.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if root.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized task access",
+			slog.String("task_id", taskID),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("task not found: %s", taskID)
+	}
+
+	tasks, err := u.taskRepo.GetTasksByUserID(ctx, userID, true, true)
+	if err != nil {
+		return nil, err
+	}
+	dependencies, err := u.dependencyRepo.GetDependenciesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先行タスクの並び順はタスク一覧の表示順に揃える
+	taskByID := make(map[string]*models.Task, len(tasks))
+	order := make(map[string]int, len(tasks))
+	for i, task := range tasks {
+		taskByID[task.ID] = task
+		order[task.ID] = i
+	}
+	blocking := make(map[string][]string)
+	for _, dependency := range dependencies {
+		if _, ok := taskByID[dependency.DependsOnTaskID]; ok {
+			blocking[dependency.TaskID] = append(blocking[dependency.TaskID], dependency.DependsOnTaskID)
+		}
+	}
+	for _, ids := range blocking {
+		sort.Slice(ids, func(i, j int) bool {
+			return order[ids[i]] < order[ids[j]]
+		})
+	}
+
+	tree := collectTemplateTree(root, taskByID, blocking)
+	if count := len(tree); count > validation.MaxTaskTemplateItems {
+		return nil, fmt.Errorf("validation error: task tree has %d tasks, must be %d or less", count, validation.MaxTaskTemplateItems)
+	}
+
+	var anchor *time.Time
+	if root.DueAt.IsValue() {
+		anchor = root.DueAt.Ptr()
+	} else {
+		for _, task := range tree {
+			if task.DueAt.IsValue() && (anchor == nil || task.DueAt.MustGet().Before(*anchor)) {
+				anchor = task.DueAt.Ptr()
+			}
+		}
+	}
+
+	visited := make(map[string]bool, len(tree))
+	var buildItem func(task *models.Task, depth int) (entity.TaskTemplateItem, error)
+	buildItem = func(task *models.Task, depth int) (entity.TaskTemplateItem, error) {
+		if depth > validation.MaxTaskTemplateDepth {
+			return entity.TaskTemplateItem{}, fmt.Errorf("validation error: task tree is nested more than %d levels", validation.MaxTaskTemplateDepth)
+		}
+		visited[task.ID] = true
+
+		item := entity.TaskTemplateItem{
+			Title:       task.Title,
+			Description: task.Description.Ptr(),
+		}
+		if task.EstimateMinutes.IsValue() {
+			item.EstimateMinutes = task.EstimateMinutes.Ptr()
+		}
+		if anchor != nil && task.DueAt.IsValue() {
+			dueOffset := entity.DueOffsetBetween(*anchor, task.DueAt.MustGet()).String()
+			item.DueOffset = &dueOffset
+		}
+		for _, blockingID := range blocking[task.ID] {
+			if visited[blockingID] {
+				continue
+			}
+			subtask, err := buildItem(taskByID[blockingID], depth+1)
+			if err != nil {
+				return entity.TaskTemplateItem{}, err
+			}
+			item.Subtasks = append(item.Subtasks, subtask)
+		}
+		return item, nil
+	}
+
+	item, err := buildItem(root, 1)
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Task tree cannot be saved as template",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	var projectID *string
+	if root.ProjectID.IsValue() {
+		projectID = root.ProjectID.Ptr()
+	}
+	template, err := u.CreateTemplate(ctx, name, description, projectID, []entity.TaskTemplateItem{item})
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: SaveTaskAsTemplate completed",
+		slog.String("task_id", taskID),
+		slog.String("template_id", template.ID),
+		slog.Int("count", len(tree)),
+	)
+	return template, nil
+}
+
+// getOwnedTemplate はログインユーザーが所有するテンプレートを取得します
+func (u *taskTemplateUsecase) getOwnedTemplate(ctx context.Context, id string) (*entity.TaskTemplate, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	template, err := u.repo.GetTemplateByID(ctx, id)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get template",
+			slog.String("template_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	if template.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized template access",
+			slog.String("template_id", id),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	return template, nil
+}
+
+// newTemplateTask はテンプレートのタスク定義から作成するタスクを生成します（サブタスクは含みません）
+func newTemplateTask(userID string, item entity.TaskTemplateItem, status *models.TaskStatus, anchor time.Time, projectID *string) (*models.Task, error) {
+	task := &models.Task{
+		ID:     uuid.New().String(),
+		UserID: userID,
+		Title:  item.Title,
+		Source: "manual",
+	}
+	setTaskStatus(task, status)
+
+	if item.Description != nil {
+		task.Description = null.From(*item.Description)
+	}
+	if item.DueOffset != nil {
+		offset, err := validation.ParseDueOffset(*item.DueOffset)
+		if err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+		task.DueAt = null.From(offset.Apply(anchor))
+	}
+	if projectID != nil {
+		task.ProjectID = null.From(*projectID)
+	}
+	task.EstimateMinutes = taskEstimateMinutes(item.EstimateMinutes)
+	return task, nil
+}
+
+// collectTemplateTree は指定タスクと、依存する先行タスクを再帰的にたどったタスクの一覧を返します（各タスクは1回のみ）
+func collectTemplateTree(root *models.Task, taskByID map[string]*models.Task, blocking map[string][]string) []*models.Task {
+	visited := map[string]bool{root.ID: true}
+	tree := []*models.Task{root}
+	for i := 0; i < len(tree); i++ {
+		for _, blockingID := range blocking[tree[i].ID] {
+			if visited[blockingID] {
+				continue
+			}
+			visited[blockingID] = true
+			tree = append(tree, taskByID[blockingID])
+		}
+	}
+	return tree
+}
+
+// templateDescription はテンプレートの説明を整えます（空文字列の場合は説明なし）
+func templateDescription(description *string) *string {
+	if description == nil || strings.TrimSpace(*description) == "" {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*description)
+	return &trimmed
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+)
+
+// purgeBatchSize は完全削除を1回のトランザクションで処理する最大件数（ロックの長時間化を避ける）
+const purgeBatchSize = 500
+
+// GetTrash はログインユーザーのゴミ箱内のタスクを取得します
+func (u *taskUsecase) GetTrash(ctx context.Context) (models.TaskSlice, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetTrash started")
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetTrash")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	tasks, err := u.repo.GetDeletedTasksByUserID(ctx, userID)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get trash",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetTrash completed",
+		slog.String("user_id", userID),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// RestoreTask はゴミ箱内のタスクを復元します（復元したタスクは列の先頭に表示）
+func (u *taskUsecase) RestoreTask(ctx context.Context, id string) (*models.Task, error) {
+	u.logger.InfoContext(ctx, "UseCase: RestoreTask started",
+		slog.String("task_id", id),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for RestoreTask")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	var task *models.Task
+	err := database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+
+		deletedTask, err := taskRepo.GetDeletedTaskByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if deletedTask.UserID != userID {
+			u.logger.WarnContext(ctx, "UseCase: Unauthorized restore attempt",
+				slog.String("task_id", id),
+				slog.String("user_id", userID),
+			)
+			return fmt.Errorf("unauthorized")
+		}
+
+		if err := taskRepo.RestoreTask(ctx, id); err != nil {
+			return err
+		}
+
+		restored, err := taskRepo.GetTaskByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		task = restored
+
+		return recordTaskEvent(ctx, eventRepo, entity.TaskEventTypeRestored, entity.TaskEventSourceManual, nil, task)
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to restore task",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: RestoreTask completed",
+		slog.String("task_id", id),
+	)
+	return task, nil
+}
+
+// PurgeDeletedTasks は指定日時より前にゴミ箱へ移動したタスクを全ユーザー分完全削除します（バックグラウンド処理用）
+func (u *taskUsecase) PurgeDeletedTasks(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	u.logger.InfoContext(ctx, "UseCase: PurgeDeletedTasks started",
+		slog.Time("deleted_before", deletedBefore),
+	)
+
+	var total int64
+	for {
+		// 物理削除とアイテムの参照解除を同一トランザクションで実行（存在しないタスクを指すアイテムを残さない）
+		var count int64
+		err := database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+			taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+			itemRepo := repository.NewInterpretationItemRepository(tx, u.logger)
+
+			ids, err := taskRepo.PurgeDeletedTasks(ctx, deletedBefore, purgeBatchSize)
+			if err != nil {
+				return err
+			}
+			count = int64(len(ids))
+			return itemRepo.ClearTaskResourceIDs(ctx, ids)
+		})
+		if err != nil {
+			u.logger.ErrorContext(ctx, "UseCase: Failed to purge deleted tasks",
+				slog.Int64("purged", total),
+				slog.String("error", err.Error()),
+			)
+			return total, err
+		}
+		total += count
+		if count < purgeBatchSize {
+			break
+		}
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: PurgeDeletedTasks completed",
+		slog.Int64("count", total),
+	)
+	return total, nil
+}
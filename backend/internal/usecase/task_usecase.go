@@ -2,26 +2,51 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"log/slog"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aarondl/opt/null"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
 	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
 	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/recurrence"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
 	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
 )
 
 type taskUsecase struct {
-	repo   interfaces.TaskRepository
-	logger *slog.Logger
+	db             *sql.DB
+	repo           interfaces.TaskRepository
+	dependencyRepo interfaces.TaskDependencyRepository
+	projectRepo    interfaces.ProjectRepository
+	eventRepo      interfaces.TaskEventRepository
+	timeEntryRepo  interfaces.TimeEntryRepository
+	statusRepo     interfaces.TaskStatusRepository
+	settingRepo    interfaces.UserSettingRepository
+	logger         *slog.Logger
 }
 
 // NewTaskUsecase は新しいTaskUsecaseを生成します
-func NewTaskUsecase(repo interfaces.TaskRepository, logger *slog.Logger) interfaces.TaskUsecase {
+// dbはタスク更新と変更履歴の記録、次回の繰り返しタスク生成をトランザクションで行うために使用します
+// settingRepoは繰り返しルールを展開するユーザーのタイムゾーンの取得に使用します
+func NewTaskUsecase(db *sql.DB, repo interfaces.TaskRepository, dependencyRepo interfaces.TaskDependencyRepository, projectRepo interfaces.ProjectRepository, eventRepo interfaces.TaskEventRepository, timeEntryRepo interfaces.TimeEntryRepository, statusRepo interfaces.TaskStatusRepository, settingRepo interfaces.UserSettingRepository, logger *slog.Logger) interfaces.TaskUsecase {
 	return &taskUsecase{
-		repo:   repo,
-		logger: logger,
+		db:             db,
+		repo:           repo,
+		dependencyRepo: dependencyRepo,
+		projectRepo:    projectRepo,
+		eventRepo:      eventRepo,
+		timeEntryRepo:  timeEntryRepo,
+		statusRepo:     statusRepo,
+		settingRepo:    settingRepo,
+		logger:         logger,
 	}
 }
 
@@ -40,7 +65,9 @@ func (u *taskUsecase) GetTask(ctx context.Context, id string) (*models.Task, err
 }
 
 // GetTaskList は全タスクを取得します
-func (u *taskUsecase) GetTaskList(ctx context.Context) (models.TaskSlice, error) {
+// projectIDが指定された場合はそのプロジェクトのタスクのみ取得します
+// includeSnoozedがfalseの場合はスヌーズ中のタスクを含みません（アーカイブしたタスクは常に含みません）
+func (u *taskUsecase) GetTaskList(ctx context.Context, projectID *string, includeSnoozed bool) (models.TaskSlice, error) {
 	u.logger.InfoContext(ctx, "UseCase: GetTaskList started")
 
 	userID, ok := ctx.Value("user_id").(string)
@@ -49,7 +76,13 @@ func (u *taskUsecase) GetTaskList(ctx context.Context) (models.TaskSlice, error)
 		return nil, fmt.Errorf("unauthorized")
 	}
 
-	tasks, err := u.repo.GetTasksByUserID(ctx, userID)
+	var tasks models.TaskSlice
+	var err error
+	if projectID != nil {
+		tasks, err = u.repo.GetTasksByProjectID(ctx, userID, *projectID, includeSnoozed, false)
+	} else {
+		tasks, err = u.repo.GetTasksByUserID(ctx, userID, includeSnoozed, false)
+	}
 	if err != nil {
 		u.logger.ErrorContext(ctx, "UseCase: Failed to get task list",
 			slog.String("error", err.Error()),
@@ -63,8 +96,48 @@ func (u *taskUsecase) GetTaskList(ctx context.Context) (models.TaskSlice, error)
 	return tasks, nil
 }
 
+// GetTaskSummaries は指定タスクのブロック状態と作業時間の合計を算出します
+func (u *taskUsecase) GetTaskSummaries(ctx context.Context, taskIDs []string) (map[string]*entity.TaskSummary, error) {
+	blocked, err := u.dependencyRepo.GetBlockedTaskIDs(ctx, taskIDs)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get blocked task IDs",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	entries, err := u.timeEntryRepo.GetEntriesByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get time entries",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	summaries := make(map[string]*entity.TaskSummary, len(taskIDs))
+	for _, id := range taskIDs {
+		summaries[id] = &entity.TaskSummary{Blocked: blocked[id]}
+	}
+
+	now := time.Now()
+	for _, entry := range entries {
+		summary, ok := summaries[entry.TaskID]
+		if !ok {
+			continue
+		}
+		if _, ended := entry.EndedAt.Get(); ended {
+			summary.TrackedSeconds += int64(entry.DurationSeconds)
+			continue
+		}
+		// 計測中の記録は現在までの経過時間を含める
+		summary.TimerRunning = true
+		summary.TrackedSeconds += int64(timeEntryDuration(entry.StartedAt, now))
+	}
+	return summaries, nil
+}
+
 // CreateTask は新しいタスクを作成します
-func (u *taskUsecase) CreateTask(ctx context.Context, title string, description *string, dueAt *time.Time, status string) (*models.Task, error) {
+func (u *taskUsecase) CreateTask(ctx context.Context, title string, description *string, dueAt *time.Time, status string, recurrenceRule *string, recurrenceAnchorAt *time.Time, projectID *string, estimateMinutes *int32) (*models.Task, error) {
 	u.logger.InfoContext(ctx, "UseCase: CreateTask started",
 		slog.String("title", title),
 		slog.String("status", status),
@@ -77,25 +150,42 @@ func (u *taskUsecase) CreateTask(ctx context.Context, title string, description
 	}
 
 	// バリデーション
-	if err := validation.ValidateCreateTaskRequest(title, description, dueAt, status); err != nil {
+	if err := validation.ValidateCreateTaskRequest(title, description, dueAt, status, recurrenceRule, estimateMinutes); err != nil {
 		u.logger.WarnContext(ctx, "UseCase: Validation failed",
 			slog.String("error", err.Error()),
 		)
 		return nil, err
 	}
 
-	// デフォルトステータスを設定
-	if status == "" {
-		status = "todo"
+	// 割り当て先プロジェクトの所有者確認
+	if projectID != nil {
+		if err := checkTaskProject(ctx, u.projectRepo, *projectID, userID); err != nil {
+			u.logger.WarnContext(ctx, "UseCase: Invalid project for task",
+				slog.String("project_id", *projectID),
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+	}
+
+	// ステータスの定義を取得（未指定の場合は未着手カテゴリの最初のステータス）
+	taskStatus, err := resolveTaskStatus(ctx, u.statusRepo, userID, status)
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Invalid status for task",
+			slog.String("status", status),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
 	}
 
 	// モデルを作成
 	task := &models.Task{
+		ID:     uuid.New().String(),
 		UserID: userID,
 		Title:  title,
-		Status: status,
 		Source: "manual",
 	}
+	setTaskStatus(task, taskStatus)
 
 	if description != nil {
 		task.Description = null.From(*description)
@@ -103,9 +193,27 @@ func (u *taskUsecase) CreateTask(ctx context.Context, title string, description
 	if dueAt != nil {
 		task.DueAt = null.From(*dueAt)
 	}
-
-	// リポジトリで作成
-	if err := u.repo.CreateTask(ctx, task); err != nil {
+	if projectID != nil {
+		task.ProjectID = null.From(*projectID)
+	}
+	task.EstimateMinutes = taskEstimateMinutes(estimateMinutes)
+	setTaskRecurrence(task, recurrenceRule, recurrenceAnchorAt)
+
+	// WIP制限の確認・作成・変更履歴の記録を同一トランザクションで実行
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+		statusRepo := repository.NewTaskStatusRepositoryWithExecutor(tx, u.logger)
+
+		if err := checkTaskStatusWIPLimit(ctx, statusRepo, taskRepo, userID, task.Status); err != nil {
+			return err
+		}
+		if err := taskRepo.CreateTask(ctx, task); err != nil {
+			return err
+		}
+		return recordTaskEvent(ctx, eventRepo, entity.TaskEventTypeCreated, entity.TaskEventSourceManual, nil, task)
+	})
+	if err != nil {
 		u.logger.ErrorContext(ctx, "UseCase: Failed to create task",
 			slog.String("error", err.Error()),
 		)
@@ -119,7 +227,8 @@ func (u *taskUsecase) CreateTask(ctx context.Context, title string, description
 }
 
 // UpdateTask はタスクを完全更新します
-func (u *taskUsecase) UpdateTask(ctx context.Context, id string, title string, description *string, dueAt *time.Time, status string) (*models.Task, error) {
+// forceがfalseの場合、未完了の先行タスクを持つタスクを作業中カテゴリのステータスに変更することはできません
+func (u *taskUsecase) UpdateTask(ctx context.Context, id string, title string, description *string, dueAt *time.Time, status string, recurrenceRule *string, recurrenceAnchorAt *time.Time, projectID *string, estimateMinutes *int32, force bool, expectedVersion *int32) (*models.Task, error) {
 	u.logger.InfoContext(ctx, "UseCase: UpdateTask started",
 		slog.String("task_id", id),
 		slog.String("title", title),
@@ -133,7 +242,7 @@ func (u *taskUsecase) UpdateTask(ctx context.Context, id string, title string, d
 	}
 
 	// バリデーション
-	if err := validation.ValidateUpdateTaskRequest(title, description, dueAt, status); err != nil {
+	if err := validation.ValidateUpdateTaskRequest(title, description, dueAt, status, recurrenceRule, estimateMinutes); err != nil {
 		u.logger.WarnContext(ctx, "UseCase: Validation failed",
 			slog.String("error", err.Error()),
 		)
@@ -158,9 +267,44 @@ func (u *taskUsecase) UpdateTask(ctx context.Context, id string, title string, d
 		return nil, fmt.Errorf("unauthorized")
 	}
 
+	if err := u.checkTaskVersion(ctx, existingTask, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	// 割り当て先プロジェクトの所有者確認
+	if projectID != nil {
+		if err := checkTaskProject(ctx, u.projectRepo, *projectID, userID); err != nil {
+			u.logger.WarnContext(ctx, "UseCase: Invalid project for task",
+				slog.String("project_id", *projectID),
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+	}
+
+	taskStatus, err := resolveTaskStatus(ctx, u.statusRepo, userID, status)
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Invalid status for task",
+			slog.String("status", status),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	// ブロック中のタスクは明示的に強制しない限り着手できない
+	if taskStatus.Category == entity.TaskStatusCategoryDoing && existingTask.StatusCategory != entity.TaskStatusCategoryDoing && !force {
+		if err := u.checkTaskNotBlocked(ctx, u.dependencyRepo, id); err != nil {
+			return nil, err
+		}
+	}
+
+	previousTask := *existingTask
+	previousStatus := existingTask.Status
+	previousProjectID := existingTask.ProjectID.GetOr("")
+
 	// フィールドを更新
 	existingTask.Title = title
-	existingTask.Status = status
+	setTaskStatus(existingTask, taskStatus)
 
 	if description != nil {
 		existingTask.Description = null.From(*description)
@@ -174,8 +318,56 @@ func (u *taskUsecase) UpdateTask(ctx context.Context, id string, title string, d
 		existingTask.DueAt = null.Val[time.Time]{}
 	}
 
-	// リポジトリで更新
-	if err := u.repo.UpdateTask(ctx, existingTask); err != nil {
+	if projectID != nil {
+		existingTask.ProjectID = null.From(*projectID)
+	} else {
+		existingTask.ProjectID = null.Val[string]{}
+	}
+
+	existingTask.EstimateMinutes = taskEstimateMinutes(estimateMinutes)
+
+	// 別の列に移ったタスクは未配置に戻し、移動先の列の先頭に表示する
+	if existingTask.Status != previousStatus || existingTask.ProjectID.GetOr("") != previousProjectID {
+		existingTask.RankKey = ""
+	}
+
+	if existingTask.Status != previousStatus {
+		existingTask.StartedAt, existingTask.CompletedAt = taskStatusTimestamps(&previousTask, existingTask.StatusCategory, time.Now())
+	}
+	existingTask.ArchivedAt = taskArchivedAt(&previousTask, existingTask.StatusCategory)
+
+	if recurrenceRule != nil && strings.TrimSpace(*recurrenceRule) != "" {
+		setTaskRecurrence(existingTask, recurrenceRule, recurrenceAnchorAt)
+	} else {
+		existingTask.RecurrenceRule = null.Val[string]{}
+		existingTask.RecurrenceAnchorAt = null.Val[time.Time]{}
+	}
+
+	// WIP制限の確認・更新・変更履歴の記録・次回の繰り返しタスク生成を同一トランザクションで実行
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+		statusRepo := repository.NewTaskStatusRepositoryWithExecutor(tx, u.logger)
+
+		if existingTask.Status != previousStatus {
+			if err := checkTaskStatusWIPLimit(ctx, statusRepo, taskRepo, userID, existingTask.Status); err != nil {
+				return err
+			}
+		}
+		if err := taskRepo.UpdateTask(ctx, existingTask); err != nil {
+			return err
+		}
+
+		if err := recordTaskEvent(ctx, eventRepo, entity.TaskEventTypeUpdated, entity.TaskEventSourceManual, &previousTask, existingTask); err != nil {
+			return err
+		}
+
+		if previousTask.StatusCategory != entity.TaskStatusCategoryDone && existingTask.StatusCategory == entity.TaskStatusCategoryDone {
+			return u.createNextOccurrence(ctx, taskRepo, eventRepo, existingTask)
+		}
+		return nil
+	})
+	if err != nil {
 		u.logger.ErrorContext(ctx, "UseCase: Failed to update task",
 			slog.String("task_id", id),
 			slog.String("error", err.Error()),
@@ -190,7 +382,9 @@ func (u *taskUsecase) UpdateTask(ctx context.Context, id string, title string, d
 }
 
 // EditTask はタスクを部分更新します
-func (u *taskUsecase) EditTask(ctx context.Context, id string, title *string, description *string, dueAt *time.Time, status *string) (*models.Task, error) {
+// forceがfalseの場合、未完了の先行タスクを持つタスクを作業中カテゴリのステータスに変更することはできません
+// 移動先のステータスにWIP制限がある場合、上限に達していると変更できません（forceによらない）
+func (u *taskUsecase) EditTask(ctx context.Context, id string, title *string, description *string, dueAt *time.Time, status *string, recurrenceRule *string, recurrenceAnchorAt *time.Time, projectID *string, estimateMinutes *int32, force bool, expectedVersion *int32) (*models.Task, error) {
 	u.logger.InfoContext(ctx, "UseCase: EditTask started",
 		slog.String("task_id", id),
 	)
@@ -202,30 +396,86 @@ func (u *taskUsecase) EditTask(ctx context.Context, id string, title *string, de
 	}
 
 	// バリデーション
-	if err := validation.ValidateEditTaskRequest(title, description, dueAt, status); err != nil {
+	if err := validation.ValidateEditTaskRequest(title, description, dueAt, status, recurrenceRule, estimateMinutes); err != nil {
 		u.logger.WarnContext(ctx, "UseCase: Validation failed",
 			slog.String("error", err.Error()),
 		)
 		return nil, err
 	}
 
-	// 更新用のマップを作成
-	updates := make(map[string]interface{})
-	if title != nil {
-		updates["title"] = *title
+	// 既存のタスクを取得
+	existingTask, err := u.repo.GetTaskByID(ctx, id)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get existing task",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
 	}
-	if description != nil {
-		updates["description"] = description
+
+	if existingTask.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized task edit attempt",
+			slog.String("task_id", id),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("unauthorized")
 	}
-	if dueAt != nil {
-		updates["due_at"] = dueAt
+
+	if err := u.checkTaskVersion(ctx, existingTask, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	// 割り当て先プロジェクトの所有者確認
+	if projectID != nil {
+		if err := checkTaskProject(ctx, u.projectRepo, *projectID, userID); err != nil {
+			u.logger.WarnContext(ctx, "UseCase: Invalid project for task",
+				slog.String("project_id", *projectID),
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
 	}
+
+	var taskStatus *models.TaskStatus
 	if status != nil {
-		updates["status"] = *status
+		taskStatus, err = resolveTaskStatus(ctx, u.statusRepo, userID, *status)
+		if err != nil {
+			u.logger.WarnContext(ctx, "UseCase: Invalid status for task",
+				slog.String("status", *status),
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+	}
+
+	// ブロック中のタスクは明示的に強制しない限り着手できない
+	if taskStatus != nil && taskStatus.Category == entity.TaskStatusCategoryDoing && existingTask.StatusCategory != entity.TaskStatusCategoryDoing && !force {
+		if err := u.checkTaskNotBlocked(ctx, u.dependencyRepo, id); err != nil {
+			return nil, err
+		}
 	}
 
-	// リポジトリで部分更新
-	task, err := u.repo.EditTask(ctx, id, updates)
+	updates := taskEditUpdates(existingTask, title, description, dueAt, taskStatus, recurrenceRule, recurrenceAnchorAt, projectID, estimateMinutes)
+
+	// WIP制限の確認・部分更新・変更履歴の記録・次回の繰り返しタスク生成を同一トランザクションで実行
+	var task *models.Task
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+		statusRepo := repository.NewTaskStatusRepositoryWithExecutor(tx, u.logger)
+
+		if taskStatus != nil && taskStatus.Name != existingTask.Status {
+			if err := checkTaskStatusWIPLimit(ctx, statusRepo, taskRepo, userID, taskStatus.Name); err != nil {
+				return err
+			}
+		}
+		edited, err := u.applyTaskEdit(ctx, taskRepo, eventRepo, existingTask, updates)
+		if err != nil {
+			return err
+		}
+		task = edited
+		return nil
+	})
 	if err != nil {
 		u.logger.ErrorContext(ctx, "UseCase: Failed to edit task",
 			slog.String("task_id", id),
@@ -240,8 +490,112 @@ func (u *taskUsecase) EditTask(ctx context.Context, id string, title *string, de
 	return task, nil
 }
 
-// DeleteTask はタスクを削除します
-func (u *taskUsecase) DeleteTask(ctx context.Context, id string) error {
+// taskEditUpdates は部分更新で指定されたフィールドからリポジトリに渡す更新内容を作成します
+func taskEditUpdates(existingTask *models.Task, title *string, description *string, dueAt *time.Time, status *models.TaskStatus, recurrenceRule *string, recurrenceAnchorAt *time.Time, projectID *string, estimateMinutes *int32) map[string]interface{} {
+	updates := make(map[string]interface{})
+	if title != nil {
+		updates["title"] = *title
+	}
+	if description != nil {
+		updates["description"] = description
+	}
+	if dueAt != nil {
+		updates["due_at"] = dueAt
+	}
+	if status != nil {
+		updates["status"] = status.Name
+		updates["status_category"] = status.Category
+		if status.Name != existingTask.Status {
+			updates["started_at"], updates["completed_at"] = taskStatusTimestamps(existingTask, status.Category, time.Now())
+		}
+		if existingTask.ArchivedAt.IsValue() {
+			updates["archived_at"] = taskArchivedAt(existingTask, status.Category)
+		}
+	}
+	if projectID != nil {
+		updates["project_id"] = projectID
+	}
+	if estimateMinutes != nil {
+		updates["estimate_minutes"] = estimateMinutes
+	}
+	// 別の列に移ったタスクは未配置に戻し、移動先の列の先頭に表示する
+	if (status != nil && status.Name != existingTask.Status) || (projectID != nil && *projectID != existingTask.ProjectID.GetOr("")) {
+		updates["rank_key"] = ""
+	}
+	if recurrenceRule != nil {
+		normalized := recurrence.Normalize(*recurrenceRule)
+		updates["recurrence_rule"] = &normalized
+		// 新たに繰り返しを設定した場合は自身をシリーズの起点にする
+		if normalized != "" && !existingTask.RecurrenceSeriesID.IsValue() {
+			updates["recurrence_series_id"] = existingTask.ID
+		}
+		if normalized != "" && recurrenceAnchorAt == nil && !existingTask.RecurrenceAnchorAt.IsValue() {
+			anchor := time.Now()
+			if dueAt != nil {
+				anchor = *dueAt
+			} else if existingDueAt, ok := existingTask.DueAt.Get(); ok {
+				anchor = existingDueAt
+			}
+			updates["recurrence_anchor_at"] = &anchor
+		}
+	}
+	if recurrenceAnchorAt != nil {
+		updates["recurrence_anchor_at"] = recurrenceAnchorAt
+	}
+	return updates
+}
+
+// taskStatusTimestamps はステータスの変更後の着手日時・完了日時を、変更後のステータスのカテゴリから返します
+// 作業中カテゴリへの変更で着手日時を、完了カテゴリへの変更で完了日時を記録し、
+// 未着手カテゴリに戻すと両方、完了カテゴリから戻すと完了日時を解除します
+func taskStatusTimestamps(existingTask *models.Task, category string, now time.Time) (startedAt, completedAt null.Val[time.Time]) {
+	switch category {
+	case entity.TaskStatusCategoryDoing:
+		startedAt = existingTask.StartedAt
+		if !startedAt.IsValue() {
+			startedAt = null.From(now)
+		}
+	case entity.TaskStatusCategoryDone:
+		// 着手せずに完了したタスクは着手日時を持たない（サイクルタイムの集計対象外）
+		startedAt = existingTask.StartedAt
+		completedAt = existingTask.CompletedAt
+		if !completedAt.IsValue() {
+			completedAt = null.From(now)
+		}
+	}
+	return startedAt, completedAt
+}
+
+// taskArchivedAt はステータスの変更後のアーカイブ日時を、変更後のステータスのカテゴリから返します
+// 完了カテゴリ以外に戻したタスクはアーカイブを解除し、既定の一覧に再び表示します
+func taskArchivedAt(existingTask *models.Task, category string) null.Val[time.Time] {
+	if category != entity.TaskStatusCategoryDone {
+		return null.Val[time.Time]{}
+	}
+	return existingTask.ArchivedAt
+}
+
+// applyTaskEdit は部分更新・変更履歴の記録・次回の繰り返しタスク生成を行います（トランザクション内で実行）
+func (u *taskUsecase) applyTaskEdit(ctx context.Context, taskRepo interfaces.TaskRepository, eventRepo interfaces.TaskEventRepository, existingTask *models.Task, updates map[string]interface{}) (*models.Task, error) {
+	task, err := taskRepo.EditTask(ctx, existingTask.ID, existingTask.Version, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recordTaskEvent(ctx, eventRepo, entity.TaskEventTypeUpdated, entity.TaskEventSourceManual, existingTask, task); err != nil {
+		return nil, err
+	}
+
+	if existingTask.StatusCategory != entity.TaskStatusCategoryDone && task.StatusCategory == entity.TaskStatusCategoryDone {
+		if err := u.createNextOccurrence(ctx, taskRepo, eventRepo, task); err != nil {
+			return nil, err
+		}
+	}
+	return task, nil
+}
+
+// DeleteTask はタスクをゴミ箱に移動します（論理削除）
+func (u *taskUsecase) DeleteTask(ctx context.Context, id string, expectedVersion *int32) error {
 	u.logger.InfoContext(ctx, "UseCase: DeleteTask started",
 		slog.String("task_id", id),
 	)
@@ -270,8 +624,26 @@ func (u *taskUsecase) DeleteTask(ctx context.Context, id string) error {
 		return fmt.Errorf("unauthorized")
 	}
 
-	// リポジトリで削除
-	if err := u.repo.DeleteTask(ctx, id); err != nil {
+	if err := u.checkTaskVersion(ctx, task, expectedVersion); err != nil {
+		return err
+	}
+
+	// 削除とタイマーの停止、変更履歴の記録を同一トランザクションで実行
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		taskRepo := repository.NewTaskRepositoryWithExecutor(tx, u.logger)
+		eventRepo := repository.NewTaskEventRepositoryWithExecutor(tx, u.logger)
+		timeEntryRepo := repository.NewTimeEntryRepositoryWithExecutor(tx, u.logger)
+
+		if err := taskRepo.DeleteTask(ctx, id, task.Version); err != nil {
+			return err
+		}
+		// ゴミ箱内のタスクのタイマーは停止できなくなるため、削除時点で止める
+		if _, err := timeEntryRepo.StopRunningEntriesByTaskID(ctx, id, time.Now()); err != nil {
+			return err
+		}
+		return recordTaskEvent(ctx, eventRepo, entity.TaskEventTypeDeleted, entity.TaskEventSourceManual, task, nil)
+	})
+	if err != nil {
 		u.logger.ErrorContext(ctx, "UseCase: Failed to delete task",
 			slog.String("task_id", id),
 			slog.String("error", err.Error()),
@@ -284,3 +656,297 @@ func (u *taskUsecase) DeleteTask(ctx context.Context, id string) error {
 	)
 	return nil
 }
+
+// checkTaskVersion はクライアントが取得したバージョン（If-Match）と現在のバージョンが一致するかを確認します
+// expectedVersionがnilの場合は確認しません（取得時点からの競合はリポジトリの条件付き更新で検出）
+func (u *taskUsecase) checkTaskVersion(ctx context.Context, task *models.Task, expectedVersion *int32) error {
+	if expectedVersion == nil || *expectedVersion == task.Version {
+		return nil
+	}
+	u.logger.WarnContext(ctx, "UseCase: Task version mismatch",
+		slog.String("task_id", task.ID),
+		slog.Int("expected_version", int(*expectedVersion)),
+		slog.Int("current_version", int(task.Version)),
+	)
+	return fmt.Errorf("task version conflict: %s", task.ID)
+}
+
+// GetOccurrences は期間内の繰り返しタスクの発生予定を展開します
+// 既にタスクとして存在する回（現在の期限）は含みません
+func (u *taskUsecase) GetOccurrences(ctx context.Context, from, to time.Time) ([]*entity.TaskOccurrence, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetOccurrences started",
+		slog.Time("from", from),
+		slog.Time("to", to),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetOccurrences")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	tasks, err := u.repo.GetRecurringTasksByUserID(ctx, userID)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get recurring tasks",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	loc := u.recurrenceLocation(ctx, userID)
+	occurrences := make([]*entity.TaskOccurrence, 0)
+	for _, task := range tasks {
+		rule, _ := task.RecurrenceRule.Get()
+		times, err := recurrence.Between(rule, recurrenceAnchor(task), from, to, loc)
+		if err != nil {
+			// 不正なルールが保存されていても他のタスクの展開は継続する
+			u.logger.WarnContext(ctx, "UseCase: Failed to expand recurrence rule",
+				slog.String("task_id", task.ID),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+
+		dueAt, hasDueAt := task.DueAt.Get()
+		for _, t := range times {
+			if hasDueAt && !t.After(dueAt) {
+				continue
+			}
+			occurrences = append(occurrences, newTaskOccurrence(task, t))
+		}
+	}
+
+	sort.Slice(occurrences, func(i, j int) bool {
+		return occurrences[i].OccursAt.Before(occurrences[j].OccursAt)
+	})
+
+	u.logger.InfoContext(ctx, "UseCase: GetOccurrences completed",
+		slog.Int("count", len(occurrences)),
+	)
+	return occurrences, nil
+}
+
+// GetTaskOccurrences は繰り返しタスクの今後の発生予定を取得します
+func (u *taskUsecase) GetTaskOccurrences(ctx context.Context, id string, limit int) ([]*entity.TaskOccurrence, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetTaskOccurrences started",
+		slog.String("task_id", id),
+		slog.Int("limit", limit),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetTaskOccurrences")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	task, err := u.repo.GetTaskByID(ctx, id)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get task",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	if task.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized task occurrences access",
+			slog.String("task_id", id),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	occurrences := make([]*entity.TaskOccurrence, 0)
+	rule, ok := task.RecurrenceRule.Get()
+	if !ok {
+		return occurrences, nil
+	}
+
+	after := time.Now()
+	if dueAt, ok := task.DueAt.Get(); ok {
+		after = dueAt
+	}
+
+	times, err := recurrence.Upcoming(rule, recurrenceAnchor(task), after, limit, u.recurrenceLocation(ctx, userID))
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to expand recurrence rule",
+			slog.String("task_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	for _, t := range times {
+		occurrences = append(occurrences, newTaskOccurrence(task, t))
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetTaskOccurrences completed",
+		slog.String("task_id", id),
+		slog.Int("count", len(occurrences)),
+	)
+	return occurrences, nil
+}
+
+// createNextOccurrence は完了した繰り返しタスクの次の回を生成します（トランザクション内で実行）
+// 期限前に完了した場合は期限の次の回、期限を過ぎて完了した場合は現在以降の最初の回を生成します
+func (u *taskUsecase) createNextOccurrence(ctx context.Context, taskRepo interfaces.TaskRepository, eventRepo interfaces.TaskEventRepository, task *models.Task) error {
+	rule, ok := task.RecurrenceRule.Get()
+	if !ok {
+		return nil
+	}
+
+	after := time.Now()
+	if dueAt, ok := task.DueAt.Get(); ok && dueAt.After(after) {
+		after = dueAt
+	}
+
+	next, ok, err := recurrence.Next(rule, recurrenceAnchor(task), after, u.recurrenceLocation(ctx, task.UserID))
+	if err != nil {
+		return fmt.Errorf("failed to compute next occurrence: %w", err)
+	}
+	if !ok {
+		// COUNT/UNTILによりシリーズが終了している
+		u.logger.InfoContext(ctx, "UseCase: Recurrence series finished",
+			slog.String("task_id", task.ID),
+		)
+		return nil
+	}
+
+	seriesID := task.ID
+	if id, ok := task.RecurrenceSeriesID.Get(); ok {
+		seriesID = id
+	}
+
+	// 既に次の回が生成済みの場合は重複して作成しない
+	exists, err := taskRepo.ExistsRecurrenceOccurrence(ctx, seriesID, next)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	// 次の回は未着手カテゴリの最初のステータスで作成する
+	taskStatus, err := resolveTaskStatus(ctx, u.statusRepo, task.UserID, "")
+	if err != nil {
+		return err
+	}
+
+	nextTask := &models.Task{
+		ID:                 uuid.New().String(),
+		UserID:             task.UserID,
+		ProjectID:          task.ProjectID,
+		Title:              task.Title,
+		Description:        task.Description,
+		DueAt:              null.From(next),
+		EstimateMinutes:    task.EstimateMinutes,
+		Status:             taskStatus.Name,
+		StatusCategory:     taskStatus.Category,
+		Source:             task.Source,
+		AiInterpretationID: task.AiInterpretationID,
+		RecurrenceRule:     task.RecurrenceRule,
+		RecurrenceAnchorAt: null.From(recurrenceAnchor(task)),
+		RecurrenceSeriesID: null.From(seriesID),
+	}
+
+	if err := taskRepo.CreateTask(ctx, nextTask); err != nil {
+		return fmt.Errorf("failed to create next occurrence: %w", err)
+	}
+	if err := recordTaskEvent(ctx, eventRepo, entity.TaskEventTypeCreated, entity.TaskEventSourceManual, nil, nextTask); err != nil {
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: Next occurrence created",
+		slog.String("task_id", task.ID),
+		slog.String("next_task_id", nextTask.ID),
+		slog.Time("due_at", next),
+	)
+	return nil
+}
+
+// taskEstimateMinutes は指定された見積もり工数をモデルの値に変換します（nilや0は見積もりなし）
+func taskEstimateMinutes(estimateMinutes *int32) null.Val[int32] {
+	if estimateMinutes == nil || *estimateMinutes == 0 {
+		return null.Val[int32]{}
+	}
+	return null.From(*estimateMinutes)
+}
+
+// setTaskRecurrence はタスクに繰り返しルールを設定します
+// 起点日時が指定されない場合は既存の起点、期限、現在時刻の順で起点とします
+func setTaskRecurrence(task *models.Task, recurrenceRule *string, recurrenceAnchorAt *time.Time) {
+	if recurrenceRule == nil || strings.TrimSpace(*recurrenceRule) == "" {
+		return
+	}
+
+	task.RecurrenceRule = null.From(recurrence.Normalize(*recurrenceRule))
+
+	switch {
+	case recurrenceAnchorAt != nil:
+		task.RecurrenceAnchorAt = null.From(*recurrenceAnchorAt)
+	case task.RecurrenceAnchorAt.IsValue():
+		// 既存の起点を維持
+	case task.DueAt.IsValue():
+		task.RecurrenceAnchorAt = task.DueAt
+	default:
+		task.RecurrenceAnchorAt = null.From(time.Now())
+	}
+
+	if !task.RecurrenceSeriesID.IsValue() {
+		task.RecurrenceSeriesID = null.From(task.ID)
+	}
+}
+
+// recurrenceAnchor は繰り返しの起点日時を返します（未設定の場合は期限、作成日時の順で代用）
+func recurrenceAnchor(task *models.Task) time.Time {
+	if anchor, ok := task.RecurrenceAnchorAt.Get(); ok {
+		return anchor
+	}
+	if dueAt, ok := task.DueAt.Get(); ok {
+		return dueAt
+	}
+	return task.CreatedAt
+}
+
+// recurrenceLocation は繰り返しルールを展開するユーザーのタイムゾーンを返します
+// 設定されていない場合や取得に失敗した場合はUTCで展開します
+func (u *taskUsecase) recurrenceLocation(ctx context.Context, userID string) *time.Location {
+	setting, err := u.settingRepo.GetSettingByUserID(ctx, userID)
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Failed to get user timezone, using UTC",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		return time.UTC
+	}
+	if setting == nil {
+		return time.UTC
+	}
+	name, ok := setting.Timezone.Get()
+	if !ok {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Invalid user timezone, using UTC",
+			slog.String("user_id", userID),
+			slog.String("timezone", name),
+		)
+		return time.UTC
+	}
+	return loc
+}
+
+// newTaskOccurrence はタスクと発生日時から発生予定を生成します
+func newTaskOccurrence(task *models.Task, occursAt time.Time) *entity.TaskOccurrence {
+	seriesID := task.ID
+	if id, ok := task.RecurrenceSeriesID.Get(); ok {
+		seriesID = id
+	}
+	return &entity.TaskOccurrence{
+		TaskID:   task.ID,
+		SeriesID: seriesID,
+		Title:    task.Title,
+		OccursAt: occursAt,
+	}
+}
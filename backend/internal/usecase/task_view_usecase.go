@@ -0,0 +1,366 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// openTaskStatusCategories は未完了のタスクのステータスのカテゴリ
+var openTaskStatusCategories = []string{entity.TaskStatusCategoryTodo, entity.TaskStatusCategoryDoing}
+
+// systemTaskViews はシステムビューの定義（一覧での表示順）
+var systemTaskViews = []entity.TaskView{
+	{
+		ID:   entity.SystemTaskViewToday,
+		Name: "Today",
+		Filter: entity.TaskFilter{
+			StatusCategories: openTaskStatusCategories,
+			DueFrom:          stringPtr("+0d"),
+			DueTo:            stringPtr("+1d"),
+			Sort:             entity.TaskSortDueAt,
+		},
+	},
+	{
+		ID:   entity.SystemTaskViewOverdue,
+		Name: "Overdue",
+		Filter: entity.TaskFilter{
+			StatusCategories: openTaskStatusCategories,
+			DueTo:            stringPtr(entity.TaskDueNow),
+			Sort:             entity.TaskSortDueAt,
+		},
+	},
+	{
+		ID:   entity.SystemTaskViewUpcoming,
+		Name: "Upcoming",
+		Filter: entity.TaskFilter{
+			StatusCategories: openTaskStatusCategories,
+			DueFrom:          stringPtr("+1d"),
+			DueTo:            stringPtr("+8d"),
+			Sort:             entity.TaskSortDueAt,
+		},
+	},
+	{
+		ID:   entity.SystemTaskViewAICreated,
+		Name: "AI-created",
+		Filter: entity.TaskFilter{
+			Sources: []string{"ai"},
+			Sort:    entity.TaskSortCreatedAt,
+		},
+	},
+}
+
+type taskViewUsecase struct {
+	repo     interfaces.TaskViewRepository
+	taskRepo interfaces.TaskRepository
+	logger   *slog.Logger
+}
+
+// NewTaskViewUsecase は新しいTaskViewUsecaseを生成します
+func NewTaskViewUsecase(repo interfaces.TaskViewRepository, taskRepo interfaces.TaskRepository, logger *slog.Logger) interfaces.TaskViewUsecase {
+	return &taskViewUsecase{
+		repo:     repo,
+		taskRepo: taskRepo,
+		logger:   logger,
+	}
+}
+
+// GetView はIDでビュー（保存したビューまたはシステムビュー）を取得します
+func (u *taskViewUsecase) GetView(ctx context.Context, id string) (*entity.TaskView, error) {
+	return u.getOwnedView(ctx, id)
+}
+
+// GetViewList はシステムビューとログインユーザーが保存したビューの一覧を取得します（システムビューが先頭）
+func (u *taskViewUsecase) GetViewList(ctx context.Context) ([]*entity.TaskView, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetViewList started")
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetViewList")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	views, err := u.repo.GetViewsByUserID(ctx, userID)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get view list",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	result := make([]*entity.TaskView, 0, len(systemTaskViews)+len(views))
+	for i := range systemTaskViews {
+		view := systemTaskViews[i]
+		view.System = true
+		result = append(result, &view)
+	}
+	result = append(result, views...)
+
+	u.logger.InfoContext(ctx, "UseCase: GetViewList completed",
+		slog.Int("count", len(result)),
+	)
+	return result, nil
+}
+
+// CreateView は絞り込み条件を新しいビューとして保存します
+func (u *taskViewUsecase) CreateView(ctx context.Context, name string, filter entity.TaskFilter) (*entity.TaskView, error) {
+	u.logger.InfoContext(ctx, "UseCase: CreateView started",
+		slog.String("name", name),
+	)
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for CreateView")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	// バリデーション
+	if err := validation.ValidateTaskViewName(name); err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Validation failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	normalizedFilter, err := validation.NormalizeTaskFilter(filter)
+	if err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Validation failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	view := &entity.TaskView{
+		ID:     uuid.New().String(),
+		UserID: userID,
+		Name:   strings.TrimSpace(name),
+		Filter: normalizedFilter,
+	}
+
+	if err := u.repo.CreateView(ctx, view); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to create view",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: CreateView completed",
+		slog.String("view_id", view.ID),
+	)
+	return view, nil
+}
+
+// EditView は保存したビューを部分更新します（filterを指定した場合は絞り込み条件をすべて置き換えます）
+func (u *taskViewUsecase) EditView(ctx context.Context, id string, name *string, filter *entity.TaskFilter) (*entity.TaskView, error) {
+	u.logger.InfoContext(ctx, "UseCase: EditView started",
+		slog.String("view_id", id),
+	)
+
+	if validation.IsSystemTaskViewID(id) {
+		return nil, fmt.Errorf("validation error: system views cannot be modified")
+	}
+
+	// バリデーション
+	if name != nil {
+		if err := validation.ValidateTaskViewName(*name); err != nil {
+			u.logger.WarnContext(ctx, "UseCase: Validation failed",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+	}
+	var normalizedFilter *entity.TaskFilter
+	if filter != nil {
+		normalized, err := validation.NormalizeTaskFilter(*filter)
+		if err != nil {
+			u.logger.WarnContext(ctx, "UseCase: Validation failed",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+		normalizedFilter = &normalized
+	}
+
+	view, err := u.getOwnedView(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		view.Name = strings.TrimSpace(*name)
+	}
+	if normalizedFilter != nil {
+		view.Filter = *normalizedFilter
+	}
+
+	if err := u.repo.UpdateView(ctx, view); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to edit view",
+			slog.String("view_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: EditView completed",
+		slog.String("view_id", id),
+	)
+	return view, nil
+}
+
+// DeleteView は保存したビューを削除します
+func (u *taskViewUsecase) DeleteView(ctx context.Context, id string) error {
+	u.logger.InfoContext(ctx, "UseCase: DeleteView started",
+		slog.String("view_id", id),
+	)
+
+	if validation.IsSystemTaskViewID(id) {
+		return fmt.Errorf("validation error: system views cannot be deleted")
+	}
+
+	if _, err := u.getOwnedView(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteView(ctx, id); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to delete view",
+			slog.String("view_id", id),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: DeleteView completed",
+		slog.String("view_id", id),
+	)
+	return nil
+}
+
+// GetViewTasks はビューの絞り込み条件に一致するタスクを取得します
+// 期限の相対指定はlocのタイムゾーン（nilの場合はUTC）での当日0時を基準に解決します
+func (u *taskViewUsecase) GetViewTasks(ctx context.Context, id string, loc *time.Location) (models.TaskSlice, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetViewTasks started",
+		slog.String("view_id", id),
+	)
+
+	view, err := u.getOwnedView(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	userID := ctx.Value("user_id").(string)
+
+	if loc == nil {
+		loc = time.UTC
+	}
+	query, err := resolveTaskFilter(view.Filter, time.Now(), loc)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to resolve view filter",
+			slog.String("view_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	tasks, err := u.taskRepo.GetTasksByQuery(ctx, userID, query)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get view tasks",
+			slog.String("view_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetViewTasks completed",
+		slog.String("view_id", id),
+		slog.Int("count", len(tasks)),
+	)
+	return tasks, nil
+}
+
+// getOwnedView はシステムビュー、またはログインユーザーが保存したビューを取得します
+func (u *taskViewUsecase) getOwnedView(ctx context.Context, id string) (*entity.TaskView, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	for i := range systemTaskViews {
+		if systemTaskViews[i].ID == id {
+			view := systemTaskViews[i]
+			view.System = true
+			return &view, nil
+		}
+	}
+
+	view, err := u.repo.GetViewByID(ctx, id)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get view",
+			slog.String("view_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	if view.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized view access",
+			slog.String("view_id", id),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	return view, nil
+}
+
+// resolveTaskFilter はビューの絞り込み条件の相対期限を、locでの当日0時（"now" は現在時刻）を基準に日時へ解決します
+func resolveTaskFilter(filter entity.TaskFilter, now time.Time, loc *time.Location) (entity.TaskQuery, error) {
+	query := entity.TaskQuery{
+		Statuses:         filter.Statuses,
+		StatusCategories: filter.StatusCategories,
+		ProjectIDs:       filter.ProjectIDs,
+		Sources:          filter.Sources,
+		HasDueDate:       filter.HasDueDate,
+		TitleContains:    filter.TitleContains,
+		IncludeSnoozed:   filter.IncludeSnoozed,
+		IncludeArchived:  filter.IncludeArchived,
+		Sort:             filter.Sort,
+	}
+
+	now = now.In(loc)
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	resolve := func(value *string) (*time.Time, error) {
+		if value == nil {
+			return nil, nil
+		}
+		if *value == entity.TaskDueNow {
+			return &now, nil
+		}
+		offset, err := validation.ParseDueOffset(*value)
+		if err != nil {
+			return nil, err
+		}
+		at := offset.Apply(startOfDay)
+		return &at, nil
+	}
+
+	var err error
+	if query.DueFrom, err = resolve(filter.DueFrom); err != nil {
+		return entity.TaskQuery{}, err
+	}
+	if query.DueTo, err = resolve(filter.DueTo); err != nil {
+		return entity.TaskQuery{}, err
+	}
+	return query, nil
+}
+
+// stringPtr は文字列のポインタを返します
+func stringPtr(value string) *string {
+	return &value
+}
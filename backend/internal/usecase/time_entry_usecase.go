@@ -0,0 +1,676 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/stephenafamo/bob"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/database"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/repository"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+// maxEstimateReportTasks は見積もりレポートで集計する直近の完了タスクの最大件数
+const maxEstimateReportTasks = 100
+
+type timeEntryUsecase struct {
+	db          *sql.DB
+	repo        interfaces.TimeEntryRepository
+	taskRepo    interfaces.TaskRepository
+	projectRepo interfaces.ProjectRepository
+	itemRepo    interfaces.InterpretationItemRepository
+	logger      *slog.Logger
+}
+
+// NewTimeEntryUsecase は新しいTimeEntryUsecaseを生成します
+// dbはタイマーの開始時に計測中の記録の確認と作成をトランザクションで行うために使用します
+// itemRepoはタグごとの集計で、タスクを作成したAI解釈・インポートのアイテムからタグを取得するために使用します
+func NewTimeEntryUsecase(db *sql.DB, repo interfaces.TimeEntryRepository, taskRepo interfaces.TaskRepository, projectRepo interfaces.ProjectRepository, itemRepo interfaces.InterpretationItemRepository, logger *slog.Logger) interfaces.TimeEntryUsecase {
+	return &timeEntryUsecase{
+		db:          db,
+		repo:        repo,
+		taskRepo:    taskRepo,
+		projectRepo: projectRepo,
+		itemRepo:    itemRepo,
+		logger:      logger,
+	}
+}
+
+// StartTimer はタスクのタイマーを開始します
+// タイマーはユーザーごとに1つまでで、計測中のタイマーがある場合はエラーを返します
+func (u *timeEntryUsecase) StartTimer(ctx context.Context, taskID string, note *string) (*models.TimeEntry, error) {
+	u.logger.InfoContext(ctx, "UseCase: StartTimer started",
+		slog.String("task_id", taskID),
+	)
+
+	if err := validation.ValidateTimeEntryNote(note); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	task, err := u.getOwnedTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &models.TimeEntry{
+		UserID:    task.UserID,
+		TaskID:    task.ID,
+		StartedAt: time.Now(),
+	}
+	if note != nil {
+		entry.Note = null.From(*note)
+	}
+
+	err = database.WithTransaction(ctx, u.db, func(tx bob.Executor) error {
+		timeEntryRepo := repository.NewTimeEntryRepositoryWithExecutor(tx, u.logger)
+
+		running, err := timeEntryRepo.GetRunningEntryForUpdate(ctx, task.UserID)
+		if err != nil {
+			return err
+		}
+		if running != nil {
+			u.logger.WarnContext(ctx, "UseCase: Timer already running",
+				slog.String("time_entry_id", running.ID),
+				slog.String("task_id", running.TaskID),
+			)
+			return fmt.Errorf("timer already running: %s", running.TaskID)
+		}
+
+		return timeEntryRepo.CreateEntry(ctx, entry)
+	})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to start timer",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: StartTimer completed",
+		slog.String("time_entry_id", entry.ID),
+	)
+	return entry, nil
+}
+
+// StopTimer はタスクの計測中のタイマーを停止し、記録時間を確定します
+func (u *timeEntryUsecase) StopTimer(ctx context.Context, taskID string) (*models.TimeEntry, error) {
+	u.logger.InfoContext(ctx, "UseCase: StopTimer started",
+		slog.String("task_id", taskID),
+	)
+
+	task, err := u.getOwnedTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	running, err := u.repo.GetRunningEntry(ctx, task.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if running == nil || running.TaskID != task.ID {
+		u.logger.WarnContext(ctx, "UseCase: No running timer for task",
+			slog.String("task_id", taskID),
+		)
+		return nil, fmt.Errorf("running timer not found: %s", taskID)
+	}
+
+	endedAt := time.Now()
+	if endedAt.Before(running.StartedAt) {
+		endedAt = running.StartedAt
+	}
+	running.EndedAt = null.From(endedAt)
+	running.DurationSeconds = timeEntryDuration(running.StartedAt, endedAt)
+
+	if err := u.repo.UpdateEntry(ctx, running); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to stop timer",
+			slog.String("time_entry_id", running.ID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: StopTimer completed",
+		slog.String("time_entry_id", running.ID),
+		slog.Int("duration_seconds", int(running.DurationSeconds)),
+	)
+	return running, nil
+}
+
+// GetRunningTimer はログインユーザーの計測中の時間記録を取得します（計測中のものがない場合はnil）
+func (u *timeEntryUsecase) GetRunningTimer(ctx context.Context) (*models.TimeEntry, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetRunningTimer")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	return u.repo.GetRunningEntry(ctx, userID)
+}
+
+// GetTaskTimeEntries はタスクの時間記録を開始日時の新しい順に取得します
+func (u *timeEntryUsecase) GetTaskTimeEntries(ctx context.Context, taskID string) (models.TimeEntrySlice, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetTaskTimeEntries started",
+		slog.String("task_id", taskID),
+	)
+
+	if _, err := u.getOwnedTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+
+	entries, err := u.repo.GetEntriesByTaskIDs(ctx, []string{taskID})
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get time entries",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetTaskTimeEntries completed",
+		slog.String("task_id", taskID),
+		slog.Int("count", len(entries)),
+	)
+	return entries, nil
+}
+
+// CreateTimeEntry はタイマーを使わずに終了済みの時間記録を手動で追加します
+func (u *timeEntryUsecase) CreateTimeEntry(ctx context.Context, taskID string, startedAt, endedAt time.Time, note *string) (*models.TimeEntry, error) {
+	u.logger.InfoContext(ctx, "UseCase: CreateTimeEntry started",
+		slog.String("task_id", taskID),
+	)
+
+	if err := validation.ValidateTimeEntryRange(startedAt, endedAt); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := validation.ValidateTimeEntryNote(note); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	task, err := u.getOwnedTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &models.TimeEntry{
+		UserID:          task.UserID,
+		TaskID:          task.ID,
+		StartedAt:       startedAt,
+		EndedAt:         null.From(endedAt),
+		DurationSeconds: timeEntryDuration(startedAt, endedAt),
+	}
+	if note != nil {
+		entry.Note = null.From(*note)
+	}
+
+	if err := u.repo.CreateEntry(ctx, entry); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to create time entry",
+			slog.String("task_id", taskID),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: CreateTimeEntry completed",
+		slog.String("time_entry_id", entry.ID),
+	)
+	return entry, nil
+}
+
+// EditTimeEntry は時間記録の開始・終了日時とメモを部分更新します（nilのフィールドは変更しない）
+// 計測中の記録に終了日時を指定した場合はタイマーの停止として扱います
+func (u *timeEntryUsecase) EditTimeEntry(ctx context.Context, id string, startedAt, endedAt *time.Time, note *string) (*models.TimeEntry, error) {
+	u.logger.InfoContext(ctx, "UseCase: EditTimeEntry started",
+		slog.String("time_entry_id", id),
+	)
+
+	if err := validation.ValidateTimeEntryNote(note); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	entry, err := u.getOwnedEntry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if startedAt != nil {
+		entry.StartedAt = *startedAt
+	}
+	if endedAt != nil {
+		entry.EndedAt = null.From(*endedAt)
+	}
+	if note != nil {
+		entry.Note = null.From(*note)
+	}
+
+	if end, ok := entry.EndedAt.Get(); ok {
+		if err := validation.ValidateTimeEntryRange(entry.StartedAt, end); err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+		entry.DurationSeconds = timeEntryDuration(entry.StartedAt, end)
+	} else if entry.StartedAt.After(time.Now()) {
+		return nil, fmt.Errorf("validation error: started_at of a running timer cannot be in the future")
+	}
+
+	if err := u.repo.UpdateEntry(ctx, entry); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to edit time entry",
+			slog.String("time_entry_id", id),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: EditTimeEntry completed",
+		slog.String("time_entry_id", id),
+	)
+	return entry, nil
+}
+
+// DeleteTimeEntry は時間記録を削除します（計測中の記録を削除した場合はタイマーの取り消しになる）
+func (u *timeEntryUsecase) DeleteTimeEntry(ctx context.Context, id string) error {
+	u.logger.InfoContext(ctx, "UseCase: DeleteTimeEntry started",
+		slog.String("time_entry_id", id),
+	)
+
+	if _, err := u.getOwnedEntry(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteEntry(ctx, id); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to delete time entry",
+			slog.String("time_entry_id", id),
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: DeleteTimeEntry completed",
+		slog.String("time_entry_id", id),
+	)
+	return nil
+}
+
+// GetTimeReport は期間内の作業時間を日ごと・プロジェクトごと・タグごとに集計します
+// 日ごとの集計ではlocの日付で区切り、日をまたぐ記録は日ごとに分割して集計します
+// タグごとの集計では複数のタグを持つタスクの時間を各タグに計上するため、グループの合計は全体の合計を超えることがあります
+func (u *timeEntryUsecase) GetTimeReport(ctx context.Context, from, to time.Time, groupBy entity.TimeReportGroupBy, loc *time.Location) (*entity.TimeReport, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetTimeReport started",
+		slog.Time("from", from),
+		slog.Time("to", to),
+		slog.String("group_by", string(groupBy)),
+	)
+
+	if err := validation.ValidateTimeReportRange(from, to); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := validation.ValidateTimeReportGroupBy(string(groupBy)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetTimeReport")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	entries, err := u.repo.GetEntriesInRange(ctx, userID, from, to)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get time entries for report",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	report := &entity.TimeReport{
+		From:    from,
+		To:      to,
+		GroupBy: groupBy,
+		Groups:  []*entity.TimeReportGroup{},
+	}
+
+	var groups map[string]*entity.TimeReportGroup
+	switch groupBy {
+	case entity.TimeReportGroupByDay:
+		groups = groupTimeEntriesByDay(entries, from, to, loc)
+	case entity.TimeReportGroupByProject:
+		groups, err = u.groupTimeEntriesByProject(ctx, userID, entries, from, to)
+		if err != nil {
+			return nil, err
+		}
+	case entity.TimeReportGroupByTag:
+		groups, err = u.groupTimeEntriesByTag(ctx, userID, entries, from, to)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, group := range groups {
+		report.TotalSeconds += group.TotalSeconds
+		report.Groups = append(report.Groups, group)
+	}
+	// タグごとの集計は同じ記録を複数のグループに計上するため、全体の合計は記録から求め直す
+	if groupBy == entity.TimeReportGroupByTag {
+		report.TotalSeconds = 0
+		now := time.Now()
+		for _, entry := range entries {
+			start, end := clipTimeEntry(entry, from, to, now)
+			if start.Before(end) {
+				report.TotalSeconds += int64(end.Sub(start) / time.Second)
+			}
+		}
+	}
+	sort.Slice(report.Groups, func(i, j int) bool {
+		if groupBy != entity.TimeReportGroupByDay && report.Groups[i].TotalSeconds != report.Groups[j].TotalSeconds {
+			return report.Groups[i].TotalSeconds > report.Groups[j].TotalSeconds
+		}
+		return report.Groups[i].Key < report.Groups[j].Key
+	})
+
+	u.logger.InfoContext(ctx, "UseCase: GetTimeReport completed",
+		slog.Int("group_count", len(report.Groups)),
+		slog.Int64("total_seconds", report.TotalSeconds),
+	)
+	return report, nil
+}
+
+// GetEstimateReport は見積もり工数のある完了タスクについて見積もりと実績時間を比較します
+// 時間記録のないタスクは実績が不明なため除外し、直近に更新された完了タスクから最大maxEstimateReportTasks件を集計します
+func (u *timeEntryUsecase) GetEstimateReport(ctx context.Context) (*entity.EstimateReport, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetEstimateReport started")
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetEstimateReport")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	tasks, err := u.taskRepo.GetTasksByUserID(ctx, userID, true, true)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get tasks for estimate report",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	estimated := make(models.TaskSlice, 0, len(tasks))
+	for _, task := range tasks {
+		if task.StatusCategory == entity.TaskStatusCategoryDone && task.EstimateMinutes.IsValue() {
+			estimated = append(estimated, task)
+		}
+	}
+	sort.SliceStable(estimated, func(i, j int) bool {
+		return estimated[i].UpdatedAt.After(estimated[j].UpdatedAt)
+	})
+
+	taskIDs := make([]string, len(estimated))
+	for i, task := range estimated {
+		taskIDs[i] = task.ID
+	}
+	actuals := make(map[string]int64, len(taskIDs))
+	if len(taskIDs) > 0 {
+		entries, err := u.repo.GetEntriesByTaskIDs(ctx, taskIDs)
+		if err != nil {
+			u.logger.ErrorContext(ctx, "UseCase: Failed to get time entries for estimate report",
+				slog.String("error", err.Error()),
+			)
+			return nil, err
+		}
+		// 計測中の記録は実績が確定していないため含めない
+		for _, entry := range entries {
+			if entry.EndedAt.IsValue() {
+				actuals[entry.TaskID] += int64(entry.DurationSeconds)
+			}
+		}
+	}
+
+	report := &entity.EstimateReport{
+		Tasks: []*entity.EstimateReportTask{},
+	}
+	for _, task := range estimated {
+		actual := actuals[task.ID]
+		if actual == 0 {
+			continue
+		}
+		estimate := task.EstimateMinutes.GetOrZero()
+		report.Tasks = append(report.Tasks, &entity.EstimateReportTask{
+			TaskID:          task.ID,
+			Title:           task.Title,
+			EstimateMinutes: estimate,
+			ActualSeconds:   actual,
+		})
+		report.TotalEstimateSeconds += int64(estimate) * 60
+		report.TotalActualSeconds += actual
+		if len(report.Tasks) == maxEstimateReportTasks {
+			break
+		}
+	}
+	report.TaskCount = len(report.Tasks)
+	if report.TotalEstimateSeconds > 0 {
+		ratio := float64(report.TotalActualSeconds) / float64(report.TotalEstimateSeconds)
+		report.ActualToEstimateRatio = &ratio
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetEstimateReport completed",
+		slog.Int("task_count", report.TaskCount),
+	)
+	return report, nil
+}
+
+// groupTimeEntriesByProject は期間内の作業時間をタスクのプロジェクトごとに集計します
+func (u *timeEntryUsecase) groupTimeEntriesByProject(ctx context.Context, userID string, entries models.TimeEntrySlice, from, to time.Time) (map[string]*entity.TimeReportGroup, error) {
+	tasks, err := u.taskRepo.GetTasksByUserID(ctx, userID, true, true)
+	if err != nil {
+		return nil, err
+	}
+	taskProjects := make(map[string]string, len(tasks))
+	for _, task := range tasks {
+		taskProjects[task.ID] = entity.TimeReportNoProjectKey
+		if projectID, ok := task.ProjectID.Get(); ok {
+			taskProjects[task.ID] = projectID
+		}
+	}
+
+	// アーカイブ済みのプロジェクトも名前を表示する
+	projects, err := u.projectRepo.GetProjectsByUserID(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	projectNames := make(map[string]string, len(projects))
+	for _, project := range projects {
+		projectNames[project.ID] = project.Name
+	}
+
+	now := time.Now()
+	groups := make(map[string]*entity.TimeReportGroup)
+	for _, entry := range entries {
+		key, ok := taskProjects[entry.TaskID]
+		if !ok {
+			continue
+		}
+		start, end := clipTimeEntry(entry, from, to, now)
+		if !start.Before(end) {
+			continue
+		}
+
+		group, ok := groups[key]
+		if !ok {
+			group = &entity.TimeReportGroup{Key: key}
+			if name, ok := projectNames[key]; ok {
+				group.Label = &name
+			}
+			groups[key] = group
+		}
+		group.TotalSeconds += int64(end.Sub(start) / time.Second)
+		group.EntryCount++
+	}
+	return groups, nil
+}
+
+// groupTimeEntriesByTag は時間記録をタスクのタグごとに集計します
+// タグはタスクを作成したAI解釈・インポートのアイテムから取得し、繰り返しタスクの次の回はシリーズ最初のタスクのタグを引き継ぎます
+func (u *timeEntryUsecase) groupTimeEntriesByTag(ctx context.Context, userID string, entries models.TimeEntrySlice, from, to time.Time) (map[string]*entity.TimeReportGroup, error) {
+	tasks, err := u.taskRepo.GetTasksByUserID(ctx, userID, true, true)
+	if err != nil {
+		return nil, err
+	}
+	taskSources := make(map[string]string, len(tasks))
+	sourceIDs := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		sourceID := task.RecurrenceSeriesID.GetOr(task.ID)
+		taskSources[task.ID] = sourceID
+		sourceIDs = append(sourceIDs, sourceID)
+	}
+
+	tags, err := u.itemRepo.GetTaskTagsByTaskIDs(ctx, sourceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	groups := make(map[string]*entity.TimeReportGroup)
+	for _, entry := range entries {
+		sourceID, ok := taskSources[entry.TaskID]
+		if !ok {
+			continue
+		}
+		start, end := clipTimeEntry(entry, from, to, now)
+		if !start.Before(end) {
+			continue
+		}
+
+		// タグ"none"とタグなしを区別するため、タグなしのグループは別のキーで保持する
+		keys := make([]string, 0, len(tags[sourceID]))
+		for _, tag := range tags[sourceID] {
+			keys = append(keys, "tag:"+tag)
+		}
+		if len(keys) == 0 {
+			keys = append(keys, entity.TimeReportNoTagKey)
+		}
+
+		for _, key := range keys {
+			group, ok := groups[key]
+			if !ok {
+				group = &entity.TimeReportGroup{Key: entity.TimeReportNoTagKey}
+				if tag, ok := strings.CutPrefix(key, "tag:"); ok {
+					group.Key = tag
+					group.Label = &tag
+				}
+				groups[key] = group
+			}
+			group.TotalSeconds += int64(end.Sub(start) / time.Second)
+			group.EntryCount++
+		}
+	}
+	return groups, nil
+}
+
+// getOwnedTask はログインユーザーが所有するタスク（ゴミ箱内を除く）を取得します
+// 他ユーザーのタスクは存在を明かさないため見つからない場合と同じエラーを返します
+func (u *timeEntryUsecase) getOwnedTask(ctx context.Context, taskID string) (*models.Task, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	task, err := u.taskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized task access",
+			slog.String("task_id", taskID),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("task not found: %s", taskID)
+	}
+	return task, nil
+}
+
+// getOwnedEntry はログインユーザーの時間記録を取得します
+// 他ユーザーの時間記録は存在を明かさないため見つからない場合と同じエラーを返します
+func (u *timeEntryUsecase) getOwnedEntry(ctx context.Context, id string) (*models.TimeEntry, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	entry, err := u.repo.GetEntryByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.UserID != userID {
+		u.logger.WarnContext(ctx, "UseCase: Unauthorized time entry access",
+			slog.String("time_entry_id", id),
+			slog.String("user_id", userID),
+		)
+		return nil, fmt.Errorf("time entry not found: %s", id)
+	}
+	return entry, nil
+}
+
+// groupTimeEntriesByDay は期間内の作業時間をlocの日付ごとに集計します
+func groupTimeEntriesByDay(entries models.TimeEntrySlice, from, to time.Time, loc *time.Location) map[string]*entity.TimeReportGroup {
+	now := time.Now()
+	groups := make(map[string]*entity.TimeReportGroup)
+	for _, entry := range entries {
+		start, end := clipTimeEntry(entry, from, to, now)
+
+		// 日の境界で分割して集計する
+		for current := start.In(loc); current.Before(end); {
+			nextDay := time.Date(current.Year(), current.Month(), current.Day()+1, 0, 0, 0, 0, loc)
+			segmentEnd := end
+			if nextDay.Before(segmentEnd) {
+				segmentEnd = nextDay
+			}
+
+			key := current.Format("2006-01-02")
+			group, ok := groups[key]
+			if !ok {
+				group = &entity.TimeReportGroup{Key: key}
+				groups[key] = group
+			}
+			group.TotalSeconds += int64(segmentEnd.Sub(current) / time.Second)
+			group.EntryCount++
+
+			current = segmentEnd
+		}
+	}
+	return groups
+}
+
+// clipTimeEntry は時間記録の期間を集計期間内に切り詰めます（計測中の記録はnowまでとして扱う）
+func clipTimeEntry(entry *models.TimeEntry, from, to, now time.Time) (time.Time, time.Time) {
+	start := entry.StartedAt
+	end, ok := entry.EndedAt.Get()
+	if !ok {
+		end = now
+	}
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	return start, end
+}
+
+// timeEntryDuration は開始から終了までの記録時間（秒）を算出します
+func timeEntryDuration(startedAt, endedAt time.Time) int32 {
+	seconds := int64(endedAt.Sub(startedAt) / time.Second)
+	if seconds < 0 {
+		return 0
+	}
+	return int32(seconds)
+}
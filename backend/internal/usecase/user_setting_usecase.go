@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/aarondl/opt/null"
+	"github.com/yoshioka0101/ai_plan_chat/gen/models"
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+	"github.com/yoshioka0101/ai_plan_chat/internal/validation"
+)
+
+type userSettingUsecase struct {
+	repo   interfaces.UserSettingRepository
+	logger *slog.Logger
+}
+
+// NewUserSettingUsecase は新しいUserSettingUsecaseを生成します
+func NewUserSettingUsecase(repo interfaces.UserSettingRepository, logger *slog.Logger) interfaces.UserSettingUsecase {
+	return &userSettingUsecase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetSettings はログインユーザーの設定を取得します（保存されていない場合は既定値）
+func (u *userSettingUsecase) GetSettings(ctx context.Context) (*models.UserSetting, error) {
+	u.logger.InfoContext(ctx, "UseCase: GetSettings started")
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for GetSettings")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	setting, err := u.getSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: GetSettings completed")
+	return setting, nil
+}
+
+// EditSettings はログインユーザーの設定を部分更新します（nilの項目は変更しない）
+// autoArchiveDaysの0は完了したタスクを自動でアーカイブしないこと、timezoneの空文字は未設定（UTC）を表します
+func (u *userSettingUsecase) EditSettings(ctx context.Context, autoArchiveDays *int32, timezone *string) (*models.UserSetting, error) {
+	u.logger.InfoContext(ctx, "UseCase: EditSettings started")
+
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok || userID == "" {
+		u.logger.WarnContext(ctx, "UseCase: Missing user_id in context for EditSettings")
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	// バリデーション
+	if err := validation.ValidateAutoArchiveDays(autoArchiveDays); err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Validation failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := validation.ValidateTimezone(timezone); err != nil {
+		u.logger.WarnContext(ctx, "UseCase: Validation failed",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	setting, err := u.getSetting(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if autoArchiveDays != nil {
+		if *autoArchiveDays == 0 {
+			setting.AutoArchiveDays = null.Val[int32]{}
+		} else {
+			setting.AutoArchiveDays = null.From(*autoArchiveDays)
+		}
+	}
+	if timezone != nil {
+		if name := strings.TrimSpace(*timezone); name == "" {
+			setting.Timezone = null.Val[string]{}
+		} else {
+			setting.Timezone = null.From(name)
+		}
+	}
+
+	if err := u.repo.SaveSetting(ctx, setting); err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to save settings",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	u.logger.InfoContext(ctx, "UseCase: EditSettings completed")
+	return setting, nil
+}
+
+// getSetting はユーザーの設定を取得し、保存されていない場合は既定値の設定を返します
+func (u *userSettingUsecase) getSetting(ctx context.Context, userID string) (*models.UserSetting, error) {
+	setting, err := u.repo.GetSettingByUserID(ctx, userID)
+	if err != nil {
+		u.logger.ErrorContext(ctx, "UseCase: Failed to get settings",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	if setting == nil {
+		setting = &models.UserSetting{UserID: userID}
+	}
+	return setting, nil
+}
@@ -0,0 +1,116 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+const (
+	// MaxAccountArchiveSize はインポートするアーカイブ（zip）の最大サイズ（バイト）
+	MaxAccountArchiveSize = 50 << 20
+	// maxAccountArchiveRecords はアーカイブに含められるデータの種類ごとの最大件数
+	maxAccountArchiveRecords = 10000
+	// maxArchiveTaskTitleLength はタスクタイトルの最大文字数（tasks.titleのカラム長）
+	maxArchiveTaskTitleLength = 500
+)
+
+// ValidateAccountArchive はインポートするアーカイブの内容の検証を行います
+// データ間の参照はアーカイブ内のIDで行うため、各データのIDの重複とAI解釈アイテムの参照先も検証します
+func ValidateAccountArchive(archive *entity.AccountArchive) error {
+	counts := map[string]int{
+		"projects":             len(archive.Projects),
+		"tasks":                len(archive.Tasks),
+		"interpretations":      len(archive.Interpretations),
+		"interpretation items": len(archive.InterpretationItems),
+	}
+	for name, count := range counts {
+		if count > maxAccountArchiveRecords {
+			return fmt.Errorf("archive must contain %d %s or less", maxAccountArchiveRecords, name)
+		}
+	}
+
+	projectIDs := make(map[string]bool, len(archive.Projects))
+	for _, project := range archive.Projects {
+		if err := validateArchiveID("project", project.ID, projectIDs); err != nil {
+			return err
+		}
+		if err := ValidateCreateProjectRequest(project.Name, project.Color); err != nil {
+			return fmt.Errorf("project %s: %w", project.ID, err)
+		}
+	}
+
+	taskIDs := make(map[string]bool, len(archive.Tasks))
+	for _, task := range archive.Tasks {
+		if err := validateArchiveID("task", task.ID, taskIDs); err != nil {
+			return err
+		}
+		if utf8.RuneCountInString(task.Title) > maxArchiveTaskTitleLength {
+			return fmt.Errorf("task %s: title must be %d characters or less", task.ID, maxArchiveTaskTitleLength)
+		}
+		if err := ValidateImportTask(task.Title, task.Status, task.RecurrenceRule, task.EstimateMinutes); err != nil {
+			return fmt.Errorf("task %s: %w", task.ID, err)
+		}
+		if task.Source != "ai" && task.Source != "manual" {
+			return fmt.Errorf("task %s: invalid source: %s, must be one of: ai, manual", task.ID, task.Source)
+		}
+	}
+
+	interpretationIDs := make(map[string]bool, len(archive.Interpretations))
+	for _, interpretation := range archive.Interpretations {
+		if err := validateArchiveID("interpretation", interpretation.ID, interpretationIDs); err != nil {
+			return err
+		}
+		if strings.TrimSpace(interpretation.InputText) == "" {
+			return fmt.Errorf("interpretation %s: input_text is required", interpretation.ID)
+		}
+		if !isJSONValue(interpretation.StructuredResult) {
+			return fmt.Errorf("interpretation %s: structured_result is required", interpretation.ID)
+		}
+	}
+
+	itemIDs := make(map[string]bool, len(archive.InterpretationItems))
+	for _, item := range archive.InterpretationItems {
+		if err := validateArchiveID("interpretation item", item.ID, itemIDs); err != nil {
+			return err
+		}
+		if !interpretationIDs[item.InterpretationID] {
+			return fmt.Errorf("interpretation item %s: interpretation %s is not in the archive", item.ID, item.InterpretationID)
+		}
+		switch item.ResourceType {
+		case "task", "event", "wallet":
+		default:
+			return fmt.Errorf("interpretation item %s: invalid resource_type: %s", item.ID, item.ResourceType)
+		}
+		switch item.Status {
+		case "pending", "created":
+		default:
+			return fmt.Errorf("interpretation item %s: invalid status: %s", item.ID, item.Status)
+		}
+		if !isJSONValue(item.Data) || !isJSONValue(item.OriginalData) {
+			return fmt.Errorf("interpretation item %s: data and original_data are required", item.ID)
+		}
+	}
+
+	return nil
+}
+
+// validateArchiveID はアーカイブ内のIDが空でなく、同じ種類のデータで重複していないことを検証します
+func validateArchiveID(kind string, id string, seen map[string]bool) error {
+	if id == "" {
+		return fmt.Errorf("%s id is required", kind)
+	}
+	if seen[id] {
+		return fmt.Errorf("duplicate %s id: %s", kind, id)
+	}
+	seen[id] = true
+	return nil
+}
+
+// isJSONValue はnull以外のJSONの値であるかを判定します
+func isJSONValue(value json.RawMessage) bool {
+	return len(value) > 0 && string(value) != "null" && json.Valid(value)
+}
@@ -0,0 +1,91 @@
+package validation
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxAttachmentFilenameLength は添付ファイル名の最大長（task_attachments.filenameのカラム長）
+	maxAttachmentFilenameLength = 255
+	// MaxAttachmentsPerTask は1つのタスクに添付できるファイルの最大件数
+	MaxAttachmentsPerTask = 20
+)
+
+// allowedAttachmentTypes はファイルの内容から判定したMIMEタイプのうち、添付を許可するもの
+// ブラウザでスクリプトとして実行されうるHTML・SVG等は許可しません
+var allowedAttachmentTypes = map[string]bool{
+	"application/pdf": true,
+	"application/zip": true,
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"image/bmp":       true,
+	"text/plain":      true,
+}
+
+// attachmentTypesByExtension は内容からは区別できない形式を拡張子で詳細なMIMEタイプに絞り込むための対応表
+// キーは内容から判定したMIMEタイプ、値は拡張子ごとのMIMEタイプ
+var attachmentTypesByExtension = map[string]map[string]string{
+	"text/plain": {
+		".csv":  "text/csv",
+		".md":   "text/markdown",
+		".json": "application/json",
+	},
+	"application/zip": {
+		".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	},
+}
+
+// ValidateAttachmentID は添付ファイルIDの検証を行います
+func ValidateAttachmentID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("invalid attachment ID format: %w", err)
+	}
+	return nil
+}
+
+// NormalizeAttachmentFilename はアップロードされたファイル名からディレクトリ部分を除き、検証します
+func NormalizeAttachmentFilename(filename string) (string, error) {
+	name := strings.TrimSpace(path.Base(strings.ReplaceAll(filename, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		return "", fmt.Errorf("filename is required")
+	}
+	if !utf8.ValidString(name) {
+		return "", fmt.Errorf("filename must be valid UTF-8")
+	}
+	if utf8.RuneCountInString(name) > maxAttachmentFilenameLength {
+		return "", fmt.Errorf("filename must be %d characters or less", maxAttachmentFilenameLength)
+	}
+	for _, r := range name {
+		if unicode.IsControl(r) {
+			return "", fmt.Errorf("filename must not contain control characters")
+		}
+	}
+	return name, nil
+}
+
+// DetectAttachmentContentType はファイルの内容からMIMEタイプを判定し、添付を許可する形式か検証します
+// クライアントが申告したContent-Typeは信用せず、内容で判定できない形式のみ拡張子で絞り込みます
+func DetectAttachmentContentType(filename string, data []byte) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(data))
+	if err != nil || !allowedAttachmentTypes[mediaType] {
+		return "", fmt.Errorf("unsupported file type: %s", http.DetectContentType(data))
+	}
+
+	extension := strings.ToLower(path.Ext(filename))
+	if refined, ok := attachmentTypesByExtension[mediaType][extension]; ok {
+		return refined, nil
+	}
+	return mediaType, nil
+}
@@ -0,0 +1,42 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxImportFileSize は取り込むファイルの最大サイズ（バイト）
+	MaxImportFileSize = 5 << 20
+	// maxImportTasks は1回の取り込みで扱う最大件数
+	maxImportTasks = 1000
+)
+
+// ValidateImportTaskCount は取り込むタスクの件数の検証を行います
+func ValidateImportTaskCount(count int) error {
+	if count == 0 {
+		return fmt.Errorf("no tasks found to import")
+	}
+	if count > maxImportTasks {
+		return fmt.Errorf("import must contain %d tasks or less", maxImportTasks)
+	}
+	return nil
+}
+
+// ValidateImportTask は取り込むタスクの検証を行います
+// 過去の予定や完了済みのToDoも取り込めるよう、期限が過去であることは許容します
+func ValidateImportTask(title string, status string, recurrenceRule *string, estimateMinutes *int32) error {
+	if strings.TrimSpace(title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if err := ValidateTaskStatus(status); err != nil {
+		return err
+	}
+	if err := ValidateTaskRecurrenceRule(recurrenceRule); err != nil {
+		return err
+	}
+	if err := ValidateTaskEstimateMinutes(estimateMinutes); err != nil {
+		return err
+	}
+	return nil
+}
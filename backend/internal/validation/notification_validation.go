@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+const (
+	// maxNotificationListLimit は通知一覧の最大取得件数
+	maxNotificationListLimit = 100
+	// maxNotificationTypeLength は通知の種類の最大長（notifications.typeのカラム長）
+	maxNotificationTypeLength = 50
+)
+
+// ValidateNotificationID は通知IDの検証を行います
+func ValidateNotificationID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("invalid notification ID format: %w", err)
+	}
+	return nil
+}
+
+// ValidateNotificationListLimit は通知一覧の取得件数の検証を行います
+func ValidateNotificationListLimit(limit int) error {
+	if limit < 1 || limit > maxNotificationListLimit {
+		return fmt.Errorf("limit must be between 1 and %d", maxNotificationListLimit)
+	}
+	return nil
+}
+
+// ValidateNewNotification は発行する通知の検証を行います（タイトルの長さは発行時に切り詰めるため検証しません）
+func ValidateNewNotification(notification *entity.NewNotification) error {
+	if _, err := uuid.Parse(notification.UserID); err != nil {
+		return fmt.Errorf("invalid user ID format: %w", err)
+	}
+	if notification.Type == "" {
+		return fmt.Errorf("type is required")
+	}
+	if utf8.RuneCountInString(string(notification.Type)) > maxNotificationTypeLength {
+		return fmt.Errorf("type must be %d characters or less", maxNotificationTypeLength)
+	}
+	if strings.TrimSpace(notification.Title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if notification.TaskID != nil {
+		if _, err := uuid.Parse(*notification.TaskID); err != nil {
+			return fmt.Errorf("invalid task ID format: %w", err)
+		}
+	}
+	return nil
+}
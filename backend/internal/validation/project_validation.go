@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxProjectNameLength はプロジェクト名の最大文字数（projects.nameのカラム長）
+	maxProjectNameLength = 100
+)
+
+// projectColorPattern はプロジェクトの表示色（#RRGGBB）の形式
+var projectColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// ValidateProjectID はプロジェクトIDの形式の検証を行います
+func ValidateProjectID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("invalid project ID format: %w", err)
+	}
+	return nil
+}
+
+// ValidateProjectName はプロジェクト名の検証を行います
+func ValidateProjectName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if utf8.RuneCountInString(name) > maxProjectNameLength {
+		return fmt.Errorf("name must be %d characters or less", maxProjectNameLength)
+	}
+	return nil
+}
+
+// ValidateProjectColor はプロジェクトの表示色の検証を行います
+func ValidateProjectColor(color *string) error {
+	if color == nil {
+		return nil
+	}
+	if !projectColorPattern.MatchString(*color) {
+		return fmt.Errorf("invalid color: %s, must be in #RRGGBB format", *color)
+	}
+	return nil
+}
+
+// ValidateCreateProjectRequest はプロジェクト作成リクエストの検証を行います
+func ValidateCreateProjectRequest(name string, color *string) error {
+	if err := ValidateProjectName(name); err != nil {
+		return err
+	}
+	if err := ValidateProjectColor(color); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateEditProjectRequest はプロジェクト部分更新リクエストの検証を行います
+func ValidateEditProjectRequest(name *string, color *string) error {
+	if name != nil {
+		if err := ValidateProjectName(*name); err != nil {
+			return err
+		}
+	}
+	if err := ValidateProjectColor(color); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package validation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+// maxStatsRange は生産性統計を集計できる最大期間
+const maxStatsRange = 366 * 24 * time.Hour
+
+// ValidateStatsRange は生産性統計の集計期間の検証を行います
+func ValidateStatsRange(from, to time.Time) error {
+	if from.IsZero() || to.IsZero() {
+		return fmt.Errorf("from and to are required")
+	}
+	if !from.Before(to) {
+		return fmt.Errorf("from must be before to")
+	}
+	if to.Sub(from) > maxStatsRange {
+		return fmt.Errorf("range must be %d days or less", int(maxStatsRange.Hours()/24))
+	}
+	return nil
+}
+
+// ValidateStatsGroupBy は生産性統計の集計単位の検証を行います
+func ValidateStatsGroupBy(groupBy string) error {
+	switch entity.StatsGroupBy(groupBy) {
+	case entity.StatsGroupByDay, entity.StatsGroupByWeek:
+		return nil
+	}
+	return fmt.Errorf("invalid group_by: %s, must be one of: day, week", groupBy)
+}
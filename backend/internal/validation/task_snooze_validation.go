@@ -0,0 +1,122 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+const (
+	// maxSnoozeDays はスヌーズできる最大日数
+	maxSnoozeDays = 365
+	// maxSnoozeDurationLength はスヌーズ期間の文字列の最大文字数
+	maxSnoozeDurationLength = 50
+)
+
+// snoozeDurationPartPattern はスヌーズ期間の数値と単位の組（例: "3 days"、"2h"、"3日"）
+var snoozeDurationPartPattern = regexp.MustCompile(`(\d+)\s*([a-z]+|分|時間|日|週間|週)`)
+
+// snoozeDurationSeparatorPattern はスヌーズ期間の数値と単位の組の区切り（空白・カンマ・and）
+var snoozeDurationSeparatorPattern = regexp.MustCompile(`^[\s,]*(and)?[\s,]*$`)
+
+// snoozeDurationUnits はスヌーズ期間の単位の表記と、対応する相対期限の単位（w/d/h/m）
+var snoozeDurationUnits = map[string]string{
+	"w": "w", "wk": "w", "wks": "w", "week": "w", "weeks": "w", "週": "w", "週間": "w",
+	"d": "d", "day": "d", "days": "d", "日": "d",
+	"h": "h", "hr": "h", "hrs": "h", "hour": "h", "hours": "h", "時間": "h",
+	"m": "m", "min": "m", "mins": "m", "minute": "m", "minutes": "m", "分": "m",
+}
+
+// ParseSnoozeDuration は自然言語のスヌーズ期間を解析します
+// 数値と単位（週・日・時間・分）の組を空白・カンマ・andで並べて指定します（例: "3 days"、"1 week 2 days"、"2h30m"、"3日"）
+func ParseSnoozeDuration(value string) (entity.DueOffset, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return entity.DueOffset{}, fmt.Errorf("duration is required")
+	}
+	if utf8.RuneCountInString(value) > maxSnoozeDurationLength {
+		return entity.DueOffset{}, fmt.Errorf("duration must be %d characters or less", maxSnoozeDurationLength)
+	}
+
+	invalid := fmt.Errorf("invalid duration: %q, must be like \"3 days\", \"1 week 2 days\" or \"2h30m\"", value)
+	matches := snoozeDurationPartPattern.FindAllStringSubmatchIndex(value, -1)
+	if len(matches) == 0 {
+		return entity.DueOffset{}, invalid
+	}
+
+	var offset entity.DueOffset
+	seen := make(map[string]bool)
+	end := 0
+	for i, match := range matches {
+		// 数値と単位の組の間には区切り以外を含めない（先頭と末尾は空白のみ）
+		gap := value[end:match[0]]
+		if (i == 0 && strings.TrimSpace(gap) != "") || !snoozeDurationSeparatorPattern.MatchString(gap) {
+			return entity.DueOffset{}, invalid
+		}
+		end = match[1]
+
+		unit, ok := snoozeDurationUnits[value[match[4]:match[5]]]
+		if !ok {
+			return entity.DueOffset{}, invalid
+		}
+		if seen[unit] {
+			return entity.DueOffset{}, fmt.Errorf("invalid duration: %q, each unit can be specified only once", value)
+		}
+		seen[unit] = true
+
+		amount, err := strconv.Atoi(value[match[2]:match[3]])
+		if err != nil || amount > maxSnoozeDays*24*60 {
+			return entity.DueOffset{}, fmt.Errorf("invalid duration: %q, must be within %d days", value, maxSnoozeDays)
+		}
+		switch unit {
+		case "w":
+			offset.Days += amount * 7
+		case "d":
+			offset.Days += amount
+		case "h":
+			offset.Duration += time.Duration(amount) * time.Hour
+		case "m":
+			offset.Duration += time.Duration(amount) * time.Minute
+		}
+	}
+	if strings.TrimSpace(value[end:]) != "" {
+		return entity.DueOffset{}, invalid
+	}
+
+	if offset.Days == 0 && offset.Duration == 0 {
+		return entity.DueOffset{}, fmt.Errorf("invalid duration: %q, must be greater than zero", value)
+	}
+	if offset.Days+int(offset.Duration/(24*time.Hour)) > maxSnoozeDays {
+		return entity.DueOffset{}, fmt.Errorf("invalid duration: %q, must be within %d days", value, maxSnoozeDays)
+	}
+	return offset, nil
+}
+
+// ResolveSnoozeUntil はスヌーズの解除日時を検証・算出します
+// untilとdurationはどちらか一方のみ指定でき、durationはnowからの期間として解除日時に変換します
+func ResolveSnoozeUntil(until *time.Time, duration *string, now time.Time) (time.Time, error) {
+	if (until == nil) == (duration == nil) {
+		return time.Time{}, fmt.Errorf("exactly one of until or duration is required")
+	}
+
+	if duration != nil {
+		offset, err := ParseSnoozeDuration(*duration)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return offset.Apply(now), nil
+	}
+
+	if !until.After(now) {
+		return time.Time{}, fmt.Errorf("until must be in the future")
+	}
+	if until.After(now.AddDate(0, 0, maxSnoozeDays)) {
+		return time.Time{}, fmt.Errorf("until must be within %d days", maxSnoozeDays)
+	}
+	return *until, nil
+}
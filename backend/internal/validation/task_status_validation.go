@@ -0,0 +1,62 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+const (
+	// maxTaskStatusNameLength はステータス名の最大文字数（task_statuses.name・tasks.statusのカラム長）
+	maxTaskStatusNameLength = 20
+	// maxTaskStatusesPerUser はユーザーごとに定義できるステータスの最大件数
+	maxTaskStatusesPerUser = 20
+	// MaxTaskStatusWIPLimit はWIP制限に指定できる最大値
+	MaxTaskStatusWIPLimit = 1000
+)
+
+// ValidateTaskStatusID はステータスIDの検証を行います
+func ValidateTaskStatusID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("invalid status ID format: %w", err)
+	}
+	return nil
+}
+
+// ValidateTaskStatusName は新しく定義するステータス名の検証を行います
+func ValidateTaskStatusName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	return ValidateTaskStatus(name)
+}
+
+// ValidateTaskStatusCategory はステータスのカテゴリの検証を行います
+func ValidateTaskStatusCategory(category string) error {
+	switch category {
+	case entity.TaskStatusCategoryTodo, entity.TaskStatusCategoryDoing, entity.TaskStatusCategoryDone:
+		return nil
+	}
+	return fmt.Errorf("invalid category: %s, must be one of: todo, doing, done", category)
+}
+
+// ValidateTaskStatusWIPLimit はWIP制限の検証を行います（0は制限なし）
+func ValidateTaskStatusWIPLimit(wipLimit *int32) error {
+	if wipLimit == nil {
+		return nil
+	}
+	if *wipLimit < 0 || *wipLimit > MaxTaskStatusWIPLimit {
+		return fmt.Errorf("wip_limit must be between 0 and %d", MaxTaskStatusWIPLimit)
+	}
+	return nil
+}
+
+// ValidateTaskStatusCount は追加後のステータスの件数の検証を行います
+func ValidateTaskStatusCount(count int) error {
+	if count > maxTaskStatusesPerUser {
+		return fmt.Errorf("statuses must be %d or less", maxTaskStatusesPerUser)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+const (
+	// maxTaskTemplateNameLength はテンプレート名の最大文字数（task_templates.nameのカラム長）
+	maxTaskTemplateNameLength = 100
+	// MaxTaskTemplateItems は1つのテンプレートに含められるタスク定義の最大件数（サブタスクを含む）
+	MaxTaskTemplateItems = 100
+	// MaxTaskTemplateDepth はサブタスクの最大階層数（最上位のタスクを1とする）
+	MaxTaskTemplateDepth = 5
+	// maxDueOffsetDays は相対期限の最大日数（前後10年）
+	maxDueOffsetDays = 3650
+)
+
+// dueOffsetPattern は相対期限（符号 + 数値と単位の組、例: "+3d"、"-1d12h"）の形式
+var dueOffsetPattern = regexp.MustCompile(`^[+-]?(\d+[wdhm])+$`)
+
+// dueOffsetPartPattern は相対期限の数値と単位の組
+var dueOffsetPartPattern = regexp.MustCompile(`(\d+)([wdhm])`)
+
+// ValidateTaskTemplateID はテンプレートIDの形式の検証を行います
+func ValidateTaskTemplateID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("invalid template ID format: %w", err)
+	}
+	return nil
+}
+
+// ValidateTaskTemplateName はテンプレート名の検証を行います
+func ValidateTaskTemplateName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if utf8.RuneCountInString(name) > maxTaskTemplateNameLength {
+		return fmt.Errorf("name must be %d characters or less", maxTaskTemplateNameLength)
+	}
+	return nil
+}
+
+// ParseDueOffset は相対期限の文字列を解析します
+// 単位はw（週）・d（日）・h（時間）・m（分）で、同じ単位は1回のみ指定できます（例: "+1w2d"、"-12h"）
+func ParseDueOffset(value string) (entity.DueOffset, error) {
+	value = strings.TrimSpace(value)
+	if !dueOffsetPattern.MatchString(value) {
+		return entity.DueOffset{}, fmt.Errorf("invalid due offset: %q, must be like +3d, -1d12h or +2w", value)
+	}
+
+	var offset entity.DueOffset
+	seen := make(map[string]bool)
+	for _, part := range dueOffsetPartPattern.FindAllStringSubmatch(value, -1) {
+		unit := part[2]
+		if seen[unit] {
+			return entity.DueOffset{}, fmt.Errorf("invalid due offset: %q, unit %s is specified more than once", value, unit)
+		}
+		seen[unit] = true
+
+		amount, err := strconv.Atoi(part[1])
+		if err != nil || amount > maxDueOffsetDays*24*60 {
+			return entity.DueOffset{}, fmt.Errorf("invalid due offset: %q, must be within %d days", value, maxDueOffsetDays)
+		}
+		switch unit {
+		case "w":
+			offset.Days += amount * 7
+		case "d":
+			offset.Days += amount
+		case "h":
+			offset.Duration += time.Duration(amount) * time.Hour
+		case "m":
+			offset.Duration += time.Duration(amount) * time.Minute
+		}
+	}
+
+	if offset.Days+int(offset.Duration/(24*time.Hour)) > maxDueOffsetDays {
+		return entity.DueOffset{}, fmt.Errorf("invalid due offset: %q, must be within %d days", value, maxDueOffsetDays)
+	}
+	if strings.HasPrefix(value, "-") {
+		offset.Days, offset.Duration = -offset.Days, -offset.Duration
+	}
+	return offset, nil
+}
+
+// NormalizeTaskTemplateItems はテンプレートのタスク定義を検証し、保存する形に整えたコピーを返します
+// タイトルの前後の空白を除き、相対期限は "+1w2d" 形式に揃え、見積もり工数の0は未設定として扱います
+func NormalizeTaskTemplateItems(items []entity.TaskTemplateItem) ([]entity.TaskTemplateItem, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("items must contain at least one task")
+	}
+	if count := entity.CountTaskTemplateItems(items); count > MaxTaskTemplateItems {
+		return nil, fmt.Errorf("items must contain %d tasks or less including subtasks", MaxTaskTemplateItems)
+	}
+	return normalizeTaskTemplateItems(items, 1)
+}
+
+// normalizeTaskTemplateItems は指定した階層のタスク定義を再帰的に検証・整形します
+func normalizeTaskTemplateItems(items []entity.TaskTemplateItem, depth int) ([]entity.TaskTemplateItem, error) {
+	if depth > MaxTaskTemplateDepth {
+		return nil, fmt.Errorf("subtasks can be nested up to %d levels", MaxTaskTemplateDepth)
+	}
+
+	normalized := make([]entity.TaskTemplateItem, 0, len(items))
+	for _, item := range items {
+		title := strings.TrimSpace(item.Title)
+		if err := ValidateTaskTitle(title); err != nil {
+			return nil, err
+		}
+		if err := ValidateTaskEstimateMinutes(item.EstimateMinutes); err != nil {
+			return nil, err
+		}
+
+		result := entity.TaskTemplateItem{
+			Title:       title,
+			Description: item.Description,
+		}
+		if item.EstimateMinutes != nil && *item.EstimateMinutes != 0 {
+			estimateMinutes := *item.EstimateMinutes
+			result.EstimateMinutes = &estimateMinutes
+		}
+		if item.DueOffset != nil {
+			offset, err := ParseDueOffset(*item.DueOffset)
+			if err != nil {
+				return nil, err
+			}
+			dueOffset := offset.String()
+			result.DueOffset = &dueOffset
+		}
+		if len(item.Subtasks) > 0 {
+			subtasks, err := normalizeTaskTemplateItems(item.Subtasks, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			result.Subtasks = subtasks
+		}
+		normalized = append(normalized, result)
+	}
+	return normalized, nil
+}
+
+// ValidateCreateTaskTemplateRequest はテンプレート作成リクエストの検証を行います
+func ValidateCreateTaskTemplateRequest(name string, projectID *string) error {
+	if err := ValidateTaskTemplateName(name); err != nil {
+		return err
+	}
+	if projectID != nil {
+		if err := ValidateProjectID(*projectID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,8 +4,21 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/yoshioka0101/ai_plan_chat/internal/recurrence"
+)
+
+const (
+	// maxOccurrenceRange は発生予定を展開できる最大期間
+	maxOccurrenceRange = 366 * 24 * time.Hour
+	// maxOccurrenceLimit は発生予定の最大取得件数
+	maxOccurrenceLimit = 100
+	// maxTaskBatchOperations は一括操作で1回に指定できる最大件数
+	maxTaskBatchOperations = 100
+	// MaxTaskEstimateMinutes は見積もり工数の上限（分、30日分）
+	MaxTaskEstimateMinutes = 30 * 24 * 60
 )
 
 func ValidationTaskID(id string) error {
@@ -26,15 +39,19 @@ func ValidateTaskTitle(title string) error {
 	return nil
 }
 
-// ValidateTaskStatus はタスクステータスの検証を行います
+// ValidateTaskStatus はタスクステータス名の形式の検証を行います（空文字列は未指定として扱います）
+// ステータスはユーザーごとに定義するため、定義されているかどうかはユースケースで確認します
 func ValidateTaskStatus(status string) error {
-	validStatuses := []string{"todo", "in_progress", "done", ""}
-	for _, validStatus := range validStatuses {
-		if status == validStatus {
-			return nil
-		}
+	if status == "" {
+		return nil
+	}
+	if strings.TrimSpace(status) != status {
+		return fmt.Errorf("invalid status: %q, must not start or end with spaces", status)
 	}
-	return fmt.Errorf("invalid status: %s, must be one of: todo, in_progress, done", status)
+	if utf8.RuneCountInString(status) > maxTaskStatusNameLength {
+		return fmt.Errorf("invalid status: %s, must be %d characters or less", status, maxTaskStatusNameLength)
+	}
+	return nil
 }
 
 // ValidateTaskDueAt は期限日の検証を行います
@@ -49,8 +66,29 @@ func ValidateTaskDueAt(dueAt *time.Time) error {
 	return nil
 }
 
+// ValidateTaskRecurrenceRule は繰り返しルール（RRULE）の検証を行います
+// 空文字列は繰り返しなし（解除）として扱います
+func ValidateTaskRecurrenceRule(rule *string) error {
+	if rule == nil || strings.TrimSpace(*rule) == "" {
+		return nil
+	}
+	return recurrence.Validate(*rule)
+}
+
+// ValidateTaskEstimateMinutes は見積もり工数（分）の検証を行います
+// 0は見積もりなし（解除）として扱います
+func ValidateTaskEstimateMinutes(estimateMinutes *int32) error {
+	if estimateMinutes == nil {
+		return nil
+	}
+	if *estimateMinutes < 0 || *estimateMinutes > MaxTaskEstimateMinutes {
+		return fmt.Errorf("estimate_minutes must be between 0 and %d", MaxTaskEstimateMinutes)
+	}
+	return nil
+}
+
 // ValidateCreateTaskRequest はタスク作成リクエストの検証を行います
-func ValidateCreateTaskRequest(title string, description *string, dueAt *time.Time, status string) error {
+func ValidateCreateTaskRequest(title string, description *string, dueAt *time.Time, status string, recurrenceRule *string, estimateMinutes *int32) error {
 	if err := ValidateTaskTitle(title); err != nil {
 		return err
 	}
@@ -60,16 +98,22 @@ func ValidateCreateTaskRequest(title string, description *string, dueAt *time.Ti
 	if err := ValidateTaskDueAt(dueAt); err != nil {
 		return err
 	}
+	if err := ValidateTaskRecurrenceRule(recurrenceRule); err != nil {
+		return err
+	}
+	if err := ValidateTaskEstimateMinutes(estimateMinutes); err != nil {
+		return err
+	}
 	return nil
 }
 
 // ValidateUpdateTaskRequest はタスク更新リクエストの検証を行います
-func ValidateUpdateTaskRequest(title string, description *string, dueAt *time.Time, status string) error {
-	return ValidateCreateTaskRequest(title, description, dueAt, status)
+func ValidateUpdateTaskRequest(title string, description *string, dueAt *time.Time, status string, recurrenceRule *string, estimateMinutes *int32) error {
+	return ValidateCreateTaskRequest(title, description, dueAt, status, recurrenceRule, estimateMinutes)
 }
 
 // ValidateEditTaskRequest はタスク部分更新リクエストの検証を行います
-func ValidateEditTaskRequest(title *string, description *string, dueAt *time.Time, status *string) error {
+func ValidateEditTaskRequest(title *string, description *string, dueAt *time.Time, status *string, recurrenceRule *string, estimateMinutes *int32) error {
 	if title != nil {
 		if err := ValidateTaskTitle(*title); err != nil {
 			return err
@@ -83,5 +127,41 @@ func ValidateEditTaskRequest(title *string, description *string, dueAt *time.Tim
 	if err := ValidateTaskDueAt(dueAt); err != nil {
 		return err
 	}
+	if err := ValidateTaskRecurrenceRule(recurrenceRule); err != nil {
+		return err
+	}
+	if err := ValidateTaskEstimateMinutes(estimateMinutes); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateOccurrenceRange は発生予定を展開する期間の検証を行います
+func ValidateOccurrenceRange(from, to time.Time) error {
+	if from.IsZero() || to.IsZero() {
+		return fmt.Errorf("from and to are required")
+	}
+	if !from.Before(to) {
+		return fmt.Errorf("from must be before to")
+	}
+	if to.Sub(from) > maxOccurrenceRange {
+		return fmt.Errorf("range must be %d days or less", int(maxOccurrenceRange.Hours()/24))
+	}
+	return nil
+}
+
+// ValidateOccurrenceLimit は発生予定の取得件数の検証を行います
+func ValidateOccurrenceLimit(limit int) error {
+	if limit < 1 || limit > maxOccurrenceLimit {
+		return fmt.Errorf("limit must be between 1 and %d", maxOccurrenceLimit)
+	}
+	return nil
+}
+
+// ValidateTaskBatchSize は一括操作の件数の検証を行います
+func ValidateTaskBatchSize(count int) error {
+	if count < 1 || count > maxTaskBatchOperations {
+		return fmt.Errorf("operations must contain between 1 and %d items", maxTaskBatchOperations)
+	}
 	return nil
 }
@@ -0,0 +1,161 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+const (
+	// maxTaskViewNameLength はビュー名の最大文字数（task_views.nameのカラム長）
+	maxTaskViewNameLength = 100
+	// maxTaskFilterProjects はビューの絞り込み条件に指定できるプロジェクトの最大件数
+	maxTaskFilterProjects = 50
+	// maxTaskFilterTitleLength はタイトルの絞り込みに指定できる最大文字数
+	maxTaskFilterTitleLength = 500
+)
+
+// systemTaskViewIDs はシステムビューのID
+var systemTaskViewIDs = map[string]bool{
+	entity.SystemTaskViewToday:     true,
+	entity.SystemTaskViewOverdue:   true,
+	entity.SystemTaskViewUpcoming:  true,
+	entity.SystemTaskViewAICreated: true,
+}
+
+// IsSystemTaskViewID はシステムビューのIDかどうかを返します
+func IsSystemTaskViewID(id string) bool {
+	return systemTaskViewIDs[id]
+}
+
+// ValidateTaskViewID はビューIDの検証を行います（保存したビューのUUID、またはシステムビューのID）
+func ValidateTaskViewID(id string) error {
+	if IsSystemTaskViewID(id) {
+		return nil
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("invalid view ID format: %w", err)
+	}
+	return nil
+}
+
+// ValidateTaskViewName はビュー名の検証を行います
+func ValidateTaskViewName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if utf8.RuneCountInString(name) > maxTaskViewNameLength {
+		return fmt.Errorf("name must be %d characters or less", maxTaskViewNameLength)
+	}
+	return nil
+}
+
+// NormalizeTaskFilter はビューの絞り込み条件を検証し、保存する形に整えたコピーを返します
+// 一覧の重複を除き、相対期限は "+1w2d" 形式に揃え、空のタイトル条件は指定なしとして扱います
+func NormalizeTaskFilter(filter entity.TaskFilter) (entity.TaskFilter, error) {
+	normalized := entity.TaskFilter{
+		HasDueDate:      filter.HasDueDate,
+		IncludeSnoozed:  filter.IncludeSnoozed,
+		IncludeArchived: filter.IncludeArchived,
+		Sort:            filter.Sort,
+	}
+
+	for _, status := range uniqueStrings(filter.Statuses) {
+		if status == "" {
+			return entity.TaskFilter{}, fmt.Errorf("invalid status: empty")
+		}
+		if err := ValidateTaskStatus(status); err != nil {
+			return entity.TaskFilter{}, err
+		}
+		normalized.Statuses = append(normalized.Statuses, status)
+	}
+
+	for _, category := range uniqueStrings(filter.StatusCategories) {
+		if err := ValidateTaskStatusCategory(category); err != nil {
+			return entity.TaskFilter{}, err
+		}
+		normalized.StatusCategories = append(normalized.StatusCategories, category)
+	}
+
+	projectIDs := uniqueStrings(filter.ProjectIDs)
+	if len(projectIDs) > maxTaskFilterProjects {
+		return entity.TaskFilter{}, fmt.Errorf("project_ids must contain %d projects or less", maxTaskFilterProjects)
+	}
+	for _, projectID := range projectIDs {
+		if err := ValidateProjectID(projectID); err != nil {
+			return entity.TaskFilter{}, err
+		}
+		normalized.ProjectIDs = append(normalized.ProjectIDs, projectID)
+	}
+
+	for _, source := range uniqueStrings(filter.Sources) {
+		if source != "ai" && source != "manual" {
+			return entity.TaskFilter{}, fmt.Errorf("invalid source: %s, must be one of: ai, manual", source)
+		}
+		normalized.Sources = append(normalized.Sources, source)
+	}
+
+	var err error
+	if normalized.DueFrom, err = normalizeTaskFilterDue(filter.DueFrom); err != nil {
+		return entity.TaskFilter{}, err
+	}
+	if normalized.DueTo, err = normalizeTaskFilterDue(filter.DueTo); err != nil {
+		return entity.TaskFilter{}, err
+	}
+	if (normalized.DueFrom != nil || normalized.DueTo != nil) && filter.HasDueDate != nil && !*filter.HasDueDate {
+		return entity.TaskFilter{}, fmt.Errorf("due_from and due_to cannot be combined with has_due_date: false")
+	}
+
+	if filter.TitleContains != nil {
+		title := strings.TrimSpace(*filter.TitleContains)
+		if utf8.RuneCountInString(title) > maxTaskFilterTitleLength {
+			return entity.TaskFilter{}, fmt.Errorf("title_contains must be %d characters or less", maxTaskFilterTitleLength)
+		}
+		if title != "" {
+			normalized.TitleContains = &title
+		}
+	}
+
+	switch filter.Sort {
+	case "", entity.TaskSortRank, entity.TaskSortDueAt, entity.TaskSortCreatedAt:
+	default:
+		return entity.TaskFilter{}, fmt.Errorf("invalid sort: %s, must be one of: rank, due_at, created_at", filter.Sort)
+	}
+
+	return normalized, nil
+}
+
+// normalizeTaskFilterDue は期限の絞り込み（"now" または相対期限）を検証し、相対期限を "+1w2d" 形式に揃えます
+func normalizeTaskFilterDue(value *string) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == entity.TaskDueNow {
+		return &trimmed, nil
+	}
+	offset, err := ParseDueOffset(trimmed)
+	if err != nil {
+		return nil, err
+	}
+	normalized := offset.String()
+	return &normalized, nil
+}
+
+// uniqueStrings は前後の空白を除いた値の重複を除き、最初に現れた順で返します
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/yoshioka0101/ai_plan_chat/internal/entity"
+)
+
+const (
+	// maxTimeEntryNoteLength は時間記録のメモの最大長（time_entries.noteのカラム長）
+	maxTimeEntryNoteLength = 1000
+	// maxTimeEntryDuration は1件の時間記録の最大長（duration_secondsの桁あふれを防ぐ）
+	maxTimeEntryDuration = 366 * 24 * time.Hour
+	// maxTimeReportRange は作業時間レポートを集計できる最大期間
+	maxTimeReportRange = 366 * 24 * time.Hour
+)
+
+// ValidateTimeEntryID は時間記録IDの検証を行います
+func ValidateTimeEntryID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("invalid time entry ID format: %w", err)
+	}
+	return nil
+}
+
+// ValidateTimeEntryRange は時間記録の開始・終了日時の検証を行います
+func ValidateTimeEntryRange(startedAt, endedAt time.Time) error {
+	if startedAt.IsZero() || endedAt.IsZero() {
+		return fmt.Errorf("started_at and ended_at are required")
+	}
+	if !startedAt.Before(endedAt) {
+		return fmt.Errorf("started_at must be before ended_at")
+	}
+	if endedAt.Sub(startedAt) > maxTimeEntryDuration {
+		return fmt.Errorf("time entry must be %d days or less", int(maxTimeEntryDuration.Hours()/24))
+	}
+	return nil
+}
+
+// ValidateTimeEntryNote は時間記録のメモの検証を行います
+func ValidateTimeEntryNote(note *string) error {
+	if note != nil && utf8.RuneCountInString(*note) > maxTimeEntryNoteLength {
+		return fmt.Errorf("note must be %d characters or less", maxTimeEntryNoteLength)
+	}
+	return nil
+}
+
+// ValidateTimeReportRange は作業時間レポートの集計期間の検証を行います
+func ValidateTimeReportRange(from, to time.Time) error {
+	if from.IsZero() || to.IsZero() {
+		return fmt.Errorf("from and to are required")
+	}
+	if !from.Before(to) {
+		return fmt.Errorf("from must be before to")
+	}
+	if to.Sub(from) > maxTimeReportRange {
+		return fmt.Errorf("range must be %d days or less", int(maxTimeReportRange.Hours()/24))
+	}
+	return nil
+}
+
+// ValidateTimeReportGroupBy は作業時間レポートの集計単位の検証を行います
+func ValidateTimeReportGroupBy(groupBy string) error {
+	switch entity.TimeReportGroupBy(groupBy) {
+	case entity.TimeReportGroupByDay, entity.TimeReportGroupByProject, entity.TimeReportGroupByTag:
+		return nil
+	}
+	return fmt.Errorf("invalid group_by: %s, must be one of: day, project, tag", groupBy)
+}
@@ -0,0 +1,46 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxTimezoneLength はタイムゾーン名の最大文字数（user_settings.timezoneのカラム長）
+const maxTimezoneLength = 64
+
+// MaxAutoArchiveDays は完了したタスクの自動アーカイブまでの日数に指定できる最大値
+const MaxAutoArchiveDays = 3650
+
+// ValidateAutoArchiveDays は自動アーカイブまでの日数の検証を行います（0は自動アーカイブしない）
+func ValidateAutoArchiveDays(days *int32) error {
+	if days == nil {
+		return nil
+	}
+	if *days < 0 || *days > MaxAutoArchiveDays {
+		return fmt.Errorf("auto_archive_days must be between 0 and %d", MaxAutoArchiveDays)
+	}
+	return nil
+}
+
+// ValidateTimezone はタイムゾーン名（IANA名、例: Asia/Tokyo）の検証を行います（空文字は未設定に戻す）
+func ValidateTimezone(timezone *string) error {
+	if timezone == nil {
+		return nil
+	}
+	name := strings.TrimSpace(*timezone)
+	if name == "" {
+		return nil
+	}
+	if len(name) > maxTimezoneLength {
+		return fmt.Errorf("timezone must be %d characters or less", maxTimezoneLength)
+	}
+	// "Local"はサーバーの設定に依存するため受け付けない
+	if name == "Local" {
+		return fmt.Errorf("invalid timezone: %s", name)
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("invalid timezone: %s", name)
+	}
+	return nil
+}
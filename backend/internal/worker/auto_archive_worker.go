@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// AutoArchiveWorker はユーザー設定の日数を過ぎた完了タスクを定期的にアーカイブします
+type AutoArchiveWorker struct {
+	usecase  interfaces.AutoArchiveUsecase
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewAutoArchiveWorker は新しいAutoArchiveWorkerを生成します
+func NewAutoArchiveWorker(usecase interfaces.AutoArchiveUsecase, interval time.Duration, logger *slog.Logger) *AutoArchiveWorker {
+	return &AutoArchiveWorker{
+		usecase:  usecase,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run は起動時とinterval毎に完了したタスクをアーカイブします（ctxがキャンセルされるまでブロック）
+func (w *AutoArchiveWorker) Run(ctx context.Context) {
+	w.logger.InfoContext(ctx, "Worker: AutoArchiveWorker started",
+		slog.Duration("interval", w.interval),
+	)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.archive(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Worker: AutoArchiveWorker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// archive は設定の日数を過ぎた完了タスクをアーカイブします（失敗しても次回に再試行）
+func (w *AutoArchiveWorker) archive(ctx context.Context) {
+	count, err := w.usecase.ArchiveDoneTasks(ctx, time.Now())
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		w.logger.ErrorContext(ctx, "Worker: Failed to archive done tasks",
+			slog.Int64("archived", count),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if count > 0 {
+		w.logger.InfoContext(ctx, "Worker: Done tasks archived",
+			slog.Int64("count", count),
+		)
+	}
+}
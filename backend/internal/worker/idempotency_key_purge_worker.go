@@ -0,0 +1,69 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// IdempotencyKeyPurgeWorker は有効期限を過ぎた冪等性キーを定期的に削除します
+type IdempotencyKeyPurgeWorker struct {
+	usecase  interfaces.IdempotencyUsecase
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewIdempotencyKeyPurgeWorker は新しいIdempotencyKeyPurgeWorkerを生成します
+func NewIdempotencyKeyPurgeWorker(usecase interfaces.IdempotencyUsecase, interval time.Duration, logger *slog.Logger) *IdempotencyKeyPurgeWorker {
+	return &IdempotencyKeyPurgeWorker{
+		usecase:  usecase,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run は起動時とinterval毎に期限切れのキーを削除します（ctxがキャンセルされるまでブロック）
+func (w *IdempotencyKeyPurgeWorker) Run(ctx context.Context) {
+	w.logger.InfoContext(ctx, "Worker: IdempotencyKeyPurgeWorker started",
+		slog.Duration("interval", w.interval),
+	)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Worker: IdempotencyKeyPurgeWorker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge は期限切れの冪等性キーを削除します（失敗しても次回に再試行）
+func (w *IdempotencyKeyPurgeWorker) purge(ctx context.Context) {
+	now := time.Now()
+
+	count, err := w.usecase.PurgeExpiredKeys(ctx, now)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		w.logger.ErrorContext(ctx, "Worker: Failed to purge expired idempotency keys",
+			slog.Int64("purged", count),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if count > 0 {
+		w.logger.InfoContext(ctx, "Worker: Expired idempotency keys purged",
+			slog.Int64("count", count),
+		)
+	}
+}
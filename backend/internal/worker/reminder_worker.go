@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// ReminderWorker は期限が近づいたタスクを定期的に走査してリマインダーを配信します
+type ReminderWorker struct {
+	usecase  interfaces.ReminderUsecase
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewReminderWorker は新しいReminderWorkerを生成します
+func NewReminderWorker(usecase interfaces.ReminderUsecase, interval time.Duration, logger *slog.Logger) *ReminderWorker {
+	return &ReminderWorker{
+		usecase:  usecase,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run は起動時とinterval毎にリマインダーを配信します（ctxがキャンセルされるまでブロック）
+func (w *ReminderWorker) Run(ctx context.Context) {
+	w.logger.InfoContext(ctx, "Worker: ReminderWorker started",
+		slog.Duration("interval", w.interval),
+	)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.deliver(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Worker: ReminderWorker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliver は期限がリマインダーの範囲に入ったタスクのリマインダーを配信します（失敗しても次回に再試行）
+func (w *ReminderWorker) deliver(ctx context.Context) {
+	count, err := w.usecase.DeliverDueReminders(ctx, time.Now())
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		w.logger.ErrorContext(ctx, "Worker: Failed to deliver reminders",
+			slog.Int("delivered", count),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if count > 0 {
+		w.logger.InfoContext(ctx, "Worker: Reminders delivered",
+			slog.Int("count", count),
+		)
+	}
+}
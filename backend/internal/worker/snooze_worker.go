@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// SnoozeWorker は解除日時を過ぎたタスクのスヌーズを定期的に解除します
+type SnoozeWorker struct {
+	usecase  interfaces.SnoozeUsecase
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewSnoozeWorker は新しいSnoozeWorkerを生成します
+func NewSnoozeWorker(usecase interfaces.SnoozeUsecase, interval time.Duration, logger *slog.Logger) *SnoozeWorker {
+	return &SnoozeWorker{
+		usecase:  usecase,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run は起動時とinterval毎にスヌーズを解除します（ctxがキャンセルされるまでブロック）
+func (w *SnoozeWorker) Run(ctx context.Context) {
+	w.logger.InfoContext(ctx, "Worker: SnoozeWorker started",
+		slog.Duration("interval", w.interval),
+	)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.wake(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Worker: SnoozeWorker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// wake は解除日時を過ぎたタスクのスヌーズを解除します（失敗しても次回に再試行）
+func (w *SnoozeWorker) wake(ctx context.Context) {
+	count, err := w.usecase.WakeSnoozedTasks(ctx, time.Now())
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		w.logger.ErrorContext(ctx, "Worker: Failed to wake snoozed tasks",
+			slog.Int("woken", count),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if count > 0 {
+		w.logger.InfoContext(ctx, "Worker: Snoozed tasks woken",
+			slog.Int("count", count),
+		)
+	}
+}
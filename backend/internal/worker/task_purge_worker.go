@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yoshioka0101/ai_plan_chat/internal/interfaces"
+)
+
+// TaskPurgeWorker はゴミ箱内で保持期間を過ぎたタスクを定期的に完全削除します
+// 完全削除するタスクの添付ファイルは、タスクより先にストレージから削除します
+type TaskPurgeWorker struct {
+	usecase           interfaces.TaskUsecase
+	attachmentUsecase interfaces.AttachmentUsecase
+	retention         time.Duration
+	interval          time.Duration
+	logger            *slog.Logger
+}
+
+// NewTaskPurgeWorker は新しいTaskPurgeWorkerを生成します
+func NewTaskPurgeWorker(usecase interfaces.TaskUsecase, attachmentUsecase interfaces.AttachmentUsecase, retention, interval time.Duration, logger *slog.Logger) *TaskPurgeWorker {
+	return &TaskPurgeWorker{
+		usecase:           usecase,
+		attachmentUsecase: attachmentUsecase,
+		retention:         retention,
+		interval:          interval,
+		logger:            logger,
+	}
+}
+
+// Run は起動時とinterval毎に完全削除を実行します（ctxがキャンセルされるまでブロック）
+func (w *TaskPurgeWorker) Run(ctx context.Context) {
+	w.logger.InfoContext(ctx, "Worker: TaskPurgeWorker started",
+		slog.Duration("retention", w.retention),
+		slog.Duration("interval", w.interval),
+	)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Worker: TaskPurgeWorker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge は保持期間より前に削除されたタスクを完全削除します（失敗しても次回に再試行）
+func (w *TaskPurgeWorker) purge(ctx context.Context) {
+	deletedBefore := time.Now().Add(-w.retention)
+
+	// 添付ファイルを削除できなかった場合は、ファイルが残らないようタスクの完全削除も次回に見送る
+	attachmentCount, err := w.attachmentUsecase.PurgeDeletedTaskAttachments(ctx, deletedBefore)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		w.logger.ErrorContext(ctx, "Worker: Failed to purge attachments of deleted tasks",
+			slog.Int64("purged", attachmentCount),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	count, err := w.usecase.PurgeDeletedTasks(ctx, deletedBefore)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		w.logger.ErrorContext(ctx, "Worker: Failed to purge deleted tasks",
+			slog.Int64("purged", count),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if count > 0 {
+		w.logger.InfoContext(ctx, "Worker: Deleted tasks purged",
+			slog.Int64("count", count),
+			slog.Time("deleted_before", deletedBefore),
+		)
+	}
+}
@@ -0,0 +1,2 @@
+-- Modify "tasks" table
+ALTER TABLE `tasks` ADD COLUMN `recurrence_rule` varchar(500) NULL COMMENT "繰り返しルール（RFC 5545 RRULE）" AFTER `ai_interpretation_id`, ADD COLUMN `recurrence_anchor_at` timestamp NULL COMMENT "繰り返しの起点日時（DTSTART）" AFTER `recurrence_rule`, ADD COLUMN `recurrence_series_id` char(36) NULL COMMENT "繰り返しシリーズID" AFTER `recurrence_anchor_at`, ADD INDEX `idx_tasks_recurrence_series` (`recurrence_series_id`, `due_at`);
@@ -0,0 +1,13 @@
+-- Create "task_dependencies" table
+CREATE TABLE `task_dependencies` (
+  `id` char(36) NOT NULL COMMENT "依存関係ID (UUID)",
+  `task_id` char(36) NOT NULL COMMENT "ブロックされるタスクID（後続）",
+  `depends_on_task_id` char(36) NOT NULL COMMENT "ブロックするタスクID（先行）",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "作成日時",
+  PRIMARY KEY (`id`),
+  INDEX `idx_task_dependencies_depends_on` (`depends_on_task_id`),
+  UNIQUE INDEX `uk_task_dependencies_edge` (`task_id`, `depends_on_task_id`),
+  CONSTRAINT `fk_task_dependencies_depends_on` FOREIGN KEY (`depends_on_task_id`) REFERENCES `tasks` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `fk_task_dependencies_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `chk_task_dependencies_not_self` CHECK (`task_id` <> `depends_on_task_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "タスク依存関係";
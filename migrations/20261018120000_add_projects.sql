@@ -0,0 +1,17 @@
+-- Create "projects" table
+CREATE TABLE `projects` (
+  `id` char(36) NOT NULL COMMENT "プロジェクトID (UUID)",
+  `user_id` char(36) NOT NULL COMMENT "ユーザーID",
+  `name` varchar(100) NOT NULL COMMENT "プロジェクト名",
+  `color` varchar(7) NULL COMMENT "表示色（#RRGGBB）",
+  `archived` bool NOT NULL DEFAULT 0 COMMENT "アーカイブ済みフラグ",
+  `sort_order` int NOT NULL DEFAULT 0 COMMENT "表示順",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "作成日時",
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT "更新日時",
+  PRIMARY KEY (`id`),
+  INDEX `idx_projects_user_sort` (`user_id`, `sort_order`),
+  UNIQUE INDEX `uk_projects_user_name` (`user_id`, `name`),
+  CONSTRAINT `fk_projects_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "プロジェクト";
+-- Modify "tasks" table
+ALTER TABLE `tasks` ADD COLUMN `project_id` char(36) NULL COMMENT "プロジェクトID" AFTER `user_id`, ADD INDEX `idx_tasks_user_project` (`user_id`, `project_id`), ADD CONSTRAINT `fk_tasks_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+-- Modify "tasks" table
+ALTER TABLE `tasks` ADD COLUMN `rank_key` varchar(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT "" COMMENT "列内の表示順キー（辞書順、空文字列は未配置）" AFTER `status`, ADD INDEX `idx_tasks_user_status_rank` (`user_id`, `status`, `rank_key`);
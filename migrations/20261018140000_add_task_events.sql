@@ -0,0 +1,19 @@
+-- Create "task_events" table
+CREATE TABLE `task_events` (
+  `id` char(36) NOT NULL COMMENT "イベントID (UUID)",
+  `task_id` char(36) NOT NULL COMMENT "タスクID（タスク削除後も履歴を残すため外部キーなし）",
+  `user_id` char(36) NOT NULL COMMENT "タスク所有者のユーザーID",
+  `actor_id` char(36) NULL COMMENT "変更を行ったユーザーID",
+  `event_type` varchar(20) NOT NULL COMMENT "イベント種別（created/updated/deleted）",
+  `source` varchar(20) NOT NULL COMMENT "変更元（manual/ai/api_token）",
+  `changes` json NOT NULL COMMENT "フィールドごとの変更前後の値",
+  `created_at` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT "記録日時（同一秒内の順序を保つためマイクロ秒まで保持）",
+  PRIMARY KEY (`id`),
+  INDEX `fk_task_events_actor` (`actor_id`),
+  INDEX `idx_task_events_task_created` (`task_id`, `created_at`),
+  INDEX `idx_task_events_user_created` (`user_id`, `created_at`),
+  CONSTRAINT `fk_task_events_actor` FOREIGN KEY (`actor_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT `fk_task_events_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `chk_task_events_event_type` CHECK (`event_type` IN ('created', 'updated', 'deleted')),
+  CONSTRAINT `chk_task_events_source` CHECK (`source` IN ('manual', 'ai', 'api_token'))
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "タスク変更履歴";
@@ -0,0 +1,4 @@
+-- Modify "tasks" table
+ALTER TABLE `tasks` ADD COLUMN `deleted_at` timestamp NULL COMMENT "削除日時（NULLは未削除、保持期間の経過後に完全削除）" AFTER `updated_at`, ADD INDEX `idx_tasks_user_deleted` (`user_id`, `deleted_at`), ADD INDEX `idx_tasks_deleted_at` (`deleted_at`);
+-- Modify "task_events" table
+ALTER TABLE `task_events` DROP CHECK `chk_task_events_event_type`, ADD CONSTRAINT `chk_task_events_event_type` CHECK (`event_type` IN ('created', 'updated', 'deleted', 'restored')), MODIFY COLUMN `event_type` varchar(20) NOT NULL COMMENT "イベント種別（created/updated/deleted/restored）";
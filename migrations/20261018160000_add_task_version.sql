@@ -0,0 +1,2 @@
+-- Modify "tasks" table
+ALTER TABLE `tasks` ADD COLUMN `version` int NOT NULL DEFAULT 1 COMMENT "楽観的排他制御用のバージョン（更新ごとに加算、ETagとして公開）" AFTER `recurrence_series_id`;
@@ -0,0 +1,18 @@
+-- Create "idempotency_keys" table
+CREATE TABLE `idempotency_keys` (
+  `id` char(36) NOT NULL COMMENT "冪等性キーレコードID (UUID)",
+  `user_id` char(36) NOT NULL COMMENT "ユーザーID",
+  `idempotency_key` varchar(255) NOT NULL COMMENT "クライアントが指定したIdempotency-Keyヘッダーの値",
+  `request_method` varchar(10) NOT NULL COMMENT "リクエストのHTTPメソッド",
+  `request_path` varchar(2048) NOT NULL COMMENT "リクエストのパス",
+  `request_hash` char(64) NOT NULL COMMENT "リクエストのフィンガープリント（メソッド・パス・ボディのSHA-256）",
+  `status_code` int NULL COMMENT "レスポンスのステータスコード（NULLは処理中）",
+  `response_headers` json NULL COMMENT "再送時に返すレスポンスヘッダー",
+  `response_body` mediumblob NULL COMMENT "レスポンスボディ",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "作成日時",
+  `expires_at` timestamp NOT NULL COMMENT "有効期限（期限切れのキーは再利用可能になり、定期的に削除される）",
+  PRIMARY KEY (`id`),
+  INDEX `idx_idempotency_keys_expires_at` (`expires_at`),
+  UNIQUE INDEX `uk_idempotency_keys_user_key` (`user_id`, `idempotency_key`),
+  CONSTRAINT `fk_idempotency_keys_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "POSTリクエストの冪等性キー";
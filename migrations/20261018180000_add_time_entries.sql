@@ -0,0 +1,20 @@
+-- Create "time_entries" table
+CREATE TABLE `time_entries` (
+  `id` char(36) NOT NULL COMMENT "時間記録ID (UUID)",
+  `user_id` char(36) NOT NULL COMMENT "ユーザーID",
+  `task_id` char(36) NOT NULL COMMENT "タスクID",
+  `started_at` timestamp NOT NULL COMMENT "開始日時",
+  `ended_at` timestamp NULL COMMENT "終了日時（NULLは計測中）",
+  `duration_seconds` int NOT NULL DEFAULT 0 COMMENT "記録時間（秒、計測中は0）",
+  `note` varchar(1000) NULL COMMENT "メモ",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "作成日時",
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT "更新日時",
+  PRIMARY KEY (`id`),
+  INDEX `idx_time_entries_task_started` (`task_id`, `started_at`),
+  INDEX `idx_time_entries_user_ended` (`user_id`, `ended_at`),
+  INDEX `idx_time_entries_user_started` (`user_id`, `started_at`),
+  CONSTRAINT `fk_time_entries_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `fk_time_entries_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `chk_time_entries_duration` CHECK (`duration_seconds` >= 0),
+  CONSTRAINT `chk_time_entries_range` CHECK ((`ended_at` IS NULL) OR (`ended_at` >= `started_at`))
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "タスクの作業時間記録";
@@ -0,0 +1,2 @@
+-- Modify "tasks" table
+ALTER TABLE `tasks` ADD COLUMN `estimate_minutes` int NULL COMMENT "見積もり工数（分）" AFTER `due_at`, ADD CONSTRAINT `chk_tasks_estimate_minutes` CHECK ((`estimate_minutes` IS NULL) OR (`estimate_minutes` > 0));
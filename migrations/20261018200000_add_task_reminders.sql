@@ -0,0 +1,36 @@
+-- Create "notifications" table
+CREATE TABLE `notifications` (
+  `id` char(36) NOT NULL COMMENT "通知ID (UUID)",
+  `user_id` char(36) NOT NULL COMMENT "通知先ユーザーID",
+  `type` varchar(50) NOT NULL COMMENT "通知の種類（task_reminder等）",
+  `title` varchar(500) NOT NULL COMMENT "通知のタイトル",
+  `body` text NULL COMMENT "通知の本文",
+  `task_id` char(36) NULL COMMENT "関連するタスクID",
+  `read_at` timestamp NULL COMMENT "既読日時（NULLは未読）",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "作成日時",
+  PRIMARY KEY (`id`),
+  INDEX `fk_notifications_task` (`task_id`),
+  INDEX `idx_notifications_user_created` (`user_id`, `created_at`),
+  INDEX `idx_notifications_user_read` (`user_id`, `read_at`),
+  CONSTRAINT `fk_notifications_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT `fk_notifications_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "アプリ内通知";
+-- Create "task_reminders" table
+CREATE TABLE `task_reminders` (
+  `id` char(36) NOT NULL COMMENT "リマインダー配信ID (UUID)",
+  `task_id` char(36) NOT NULL COMMENT "タスクID",
+  `due_at` timestamp NOT NULL COMMENT "通知対象の期限日時（期限が変わると別のリマインダーとして扱う）",
+  `offset_minutes` int NOT NULL COMMENT "期限の何分前のリマインダーか",
+  `channel` varchar(20) NOT NULL COMMENT "配信チャネル（in_app/email/webhook）",
+  `status` varchar(20) NOT NULL DEFAULT "pending" COMMENT "配信状態（pending/sent/failed）",
+  `attempts` int NOT NULL DEFAULT 0 COMMENT "配信の試行回数",
+  `last_error` varchar(1000) NULL COMMENT "直近の配信エラー",
+  `sent_at` timestamp NULL COMMENT "配信日時",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "作成日時",
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT "更新日時",
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_task_reminders_delivery` (`task_id`, `due_at`, `offset_minutes`, `channel`),
+  CONSTRAINT `fk_task_reminders_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `chk_task_reminders_offset` CHECK (`offset_minutes` >= 0),
+  CONSTRAINT `chk_task_reminders_status` CHECK (`status` in (_utf8mb4'pending',_utf8mb4'sent',_utf8mb4'failed'))
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "期限リマインダーの配信状態（再起動後の重複配信防止）";
@@ -0,0 +1,11 @@
+-- Create "calendar_feeds" table
+CREATE TABLE `calendar_feeds` (
+  `id` char(36) NOT NULL COMMENT "フィードID (UUID)",
+  `user_id` char(36) NOT NULL COMMENT "ユーザーID（ユーザーごとに1つ）",
+  `token_hash` char(64) NOT NULL COMMENT "フィードURLのトークンのSHA-256（トークン自体は保存しない）",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "発行日時",
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_calendar_feeds_token_hash` (`token_hash`),
+  UNIQUE INDEX `uk_calendar_feeds_user` (`user_id`),
+  CONSTRAINT `fk_calendar_feeds_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "iCalendar購読フィードのトークン";
@@ -0,0 +1,14 @@
+-- Create "task_imports" table
+CREATE TABLE `task_imports` (
+  `id` char(36) NOT NULL COMMENT "取り込み記録ID (UUID)",
+  `user_id` char(36) NOT NULL COMMENT "ユーザーID",
+  `source` varchar(20) NOT NULL COMMENT "取り込み元（ical等）",
+  `external_id` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL COMMENT "取り込み元での識別子（iCalendarのUID等、再取り込み時の重複判定に使用）",
+  `task_id` char(36) NOT NULL COMMENT "作成したタスクID",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "取り込み日時",
+  PRIMARY KEY (`id`),
+  INDEX `fk_task_imports_task` (`task_id`),
+  UNIQUE INDEX `uk_task_imports_user_source_external` (`user_id`, `source`, `external_id`),
+  CONSTRAINT `fk_task_imports_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `fk_task_imports_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "外部データから取り込んだタスクの対応表";
@@ -0,0 +1,19 @@
+-- Create "task_attachments" table
+CREATE TABLE `task_attachments` (
+  `id` char(36) NOT NULL COMMENT "添付ファイルID (UUID)",
+  `user_id` char(36) NOT NULL COMMENT "ユーザーID",
+  `task_id` char(36) NOT NULL COMMENT "タスクID",
+  `filename` varchar(255) NOT NULL COMMENT "アップロード時のファイル名",
+  `content_type` varchar(100) NOT NULL COMMENT "ファイルの内容から判定したMIMEタイプ",
+  `size_bytes` bigint NOT NULL COMMENT "ファイルサイズ（バイト）",
+  `checksum_sha256` char(64) NOT NULL COMMENT "ファイルの内容のSHA-256",
+  `storage_key` varchar(255) NOT NULL COMMENT "ストレージ上のキー（ファイル本体はストレージに保存）",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "アップロード日時",
+  PRIMARY KEY (`id`),
+  INDEX `fk_task_attachments_user` (`user_id`),
+  INDEX `idx_task_attachments_task_created` (`task_id`, `created_at`),
+  UNIQUE INDEX `uk_task_attachments_storage_key` (`storage_key`),
+  CONSTRAINT `fk_task_attachments_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `fk_task_attachments_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `chk_task_attachments_size` CHECK (`size_bytes` >= 0)
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "タスクの添付ファイルのメタデータ";
@@ -0,0 +1,16 @@
+-- Create "task_templates" table
+CREATE TABLE `task_templates` (
+  `id` char(36) NOT NULL COMMENT "テンプレートID (UUID)",
+  `user_id` char(36) NOT NULL COMMENT "ユーザーID",
+  `name` varchar(100) NOT NULL COMMENT "テンプレート名",
+  `description` text NULL COMMENT "テンプレートの説明",
+  `project_id` char(36) NULL COMMENT "作成したタスクを割り当てる既定のプロジェクトID",
+  `items` json NOT NULL COMMENT "タスク定義の一覧（相対期限・サブタスクを含む）",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "作成日時",
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT "更新日時",
+  PRIMARY KEY (`id`),
+  INDEX `fk_task_templates_project` (`project_id`),
+  UNIQUE INDEX `uk_task_templates_user_name` (`user_id`, `name`),
+  CONSTRAINT `fk_task_templates_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT `fk_task_templates_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "タスクテンプレート";
@@ -0,0 +1,12 @@
+-- Create "task_views" table
+CREATE TABLE `task_views` (
+  `id` char(36) NOT NULL COMMENT "ビューID (UUID)",
+  `user_id` char(36) NOT NULL COMMENT "ユーザーID",
+  `name` varchar(100) NOT NULL COMMENT "ビュー名",
+  `filter` json NOT NULL COMMENT "タスクの絞り込み条件と並び順",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "作成日時",
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT "更新日時",
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_task_views_user_name` (`user_id`, `name`),
+  CONSTRAINT `fk_task_views_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "保存したタスクのビュー（絞り込み条件）";
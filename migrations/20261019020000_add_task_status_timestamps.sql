@@ -0,0 +1,5 @@
+-- Modify "tasks" table
+ALTER TABLE `tasks` ADD COLUMN `started_at` timestamp NULL COMMENT "着手日時（in_progressに変更した日時、todoに戻すとNULL）" AFTER `rank_key`, ADD COLUMN `completed_at` timestamp NULL COMMENT "完了日時（doneに変更した日時、未完了に戻すとNULL）" AFTER `started_at`, ADD INDEX `idx_tasks_user_started` (`user_id`, `started_at`), ADD INDEX `idx_tasks_user_completed` (`user_id`, `completed_at`);
+-- Backfill from the status change history (falling back to updated_at), keeping updated_at unchanged
+UPDATE `tasks` t LEFT JOIN (SELECT `task_id`, MAX(`created_at`) AS `started_at` FROM `task_events` WHERE JSON_UNQUOTE(JSON_EXTRACT(`changes`, '$.status.after')) = 'in_progress' GROUP BY `task_id`) s ON s.`task_id` = t.`id` SET t.`started_at` = CASE WHEN t.`status` = 'in_progress' THEN COALESCE(s.`started_at`, t.`updated_at`) ELSE s.`started_at` END, t.`updated_at` = t.`updated_at` WHERE t.`status` IN ('in_progress', 'done');
+UPDATE `tasks` t LEFT JOIN (SELECT `task_id`, MAX(`created_at`) AS `completed_at` FROM `task_events` WHERE JSON_UNQUOTE(JSON_EXTRACT(`changes`, '$.status.after')) = 'done' GROUP BY `task_id`) c ON c.`task_id` = t.`id` SET t.`completed_at` = COALESCE(c.`completed_at`, t.`updated_at`), t.`updated_at` = t.`updated_at` WHERE t.`status` = 'done';
@@ -0,0 +1,25 @@
+-- Create "task_statuses" table
+CREATE TABLE `task_statuses` (
+  `id` char(36) NOT NULL COMMENT "ステータスID (UUID)",
+  `user_id` char(36) NOT NULL COMMENT "ユーザーID",
+  `name` varchar(20) NOT NULL COMMENT "ステータス名（tasks.statusに保存する値、変更不可）",
+  `category` varchar(10) NOT NULL COMMENT "カテゴリ（todo:未着手/doing:作業中/done:完了）",
+  `sort_order` int NOT NULL DEFAULT 0 COMMENT "表示順",
+  `wip_limit` int NULL COMMENT "同時に置けるタスクの上限（NULLは無制限）",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "作成日時",
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT "更新日時",
+  PRIMARY KEY (`id`),
+  INDEX `idx_task_statuses_user_sort` (`user_id`, `sort_order`),
+  UNIQUE INDEX `uk_task_statuses_user_name` (`user_id`, `name`),
+  CONSTRAINT `fk_task_statuses_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `chk_task_statuses_category` CHECK (`category` IN ('todo', 'doing', 'done')),
+  CONSTRAINT `chk_task_statuses_wip_limit` CHECK (`wip_limit` IS NULL OR `wip_limit` > 0)
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "ワークフローのステータス定義（ユーザーごと）";
+-- Modify "tasks" table
+ALTER TABLE `tasks` DROP CHECK `chk_tasks_status`, MODIFY COLUMN `status` varchar(20) NOT NULL DEFAULT "todo" COMMENT "ステータス名（ユーザーのtask_statuses.name）", ADD COLUMN `status_category` varchar(10) NOT NULL DEFAULT "todo" COMMENT "ステータスのカテゴリ（todo/doing/done、task_statuses.categoryの複製）" AFTER `status`, MODIFY COLUMN `started_at` timestamp NULL COMMENT "着手日時（doingカテゴリのステータスに変更した日時、todoカテゴリに戻すとNULL）", MODIFY COLUMN `completed_at` timestamp NULL COMMENT "完了日時（doneカテゴリのステータスに変更した日時、未完了に戻すとNULL）", ADD INDEX `idx_tasks_user_status_category` (`user_id`, `status_category`), ADD CONSTRAINT `chk_tasks_status_category` CHECK (`status_category` IN ('todo', 'doing', 'done'));
+-- Create the default statuses (todo/in_progress/done) for existing users
+INSERT INTO `task_statuses` (`id`, `user_id`, `name`, `category`, `sort_order`) SELECT UUID(), `id`, 'todo', 'todo', 0 FROM `users`;
+INSERT INTO `task_statuses` (`id`, `user_id`, `name`, `category`, `sort_order`) SELECT UUID(), `id`, 'in_progress', 'doing', 1 FROM `users`;
+INSERT INTO `task_statuses` (`id`, `user_id`, `name`, `category`, `sort_order`) SELECT UUID(), `id`, 'done', 'done', 2 FROM `users`;
+-- Backfill the status categories of existing tasks, keeping updated_at unchanged
+UPDATE `tasks` SET `status_category` = CASE `status` WHEN 'in_progress' THEN 'doing' WHEN 'done' THEN 'done' ELSE 'todo' END, `updated_at` = `updated_at`;
@@ -0,0 +1,2 @@
+-- Modify "tasks" table
+ALTER TABLE `tasks` ADD COLUMN `snoozed_until` timestamp NULL COMMENT "スヌーズの解除日時（この日時まで既定の一覧に表示しない、NULLはスヌーズなし）" AFTER `completed_at`, ADD COLUMN `snooze_notify` bool NOT NULL DEFAULT 0 COMMENT "スヌーズ解除時に通知するかどうか" AFTER `snoozed_until`, ADD INDEX `idx_tasks_snoozed_until` (`snoozed_until`);
@@ -0,0 +1,13 @@
+-- Modify "tasks" table
+ALTER TABLE `tasks` ADD COLUMN `archived_at` timestamp NULL COMMENT "アーカイブ日時（NULLは未アーカイブ、アーカイブしたタスクは既定の一覧に表示しない）" AFTER `snooze_notify`, ADD INDEX `idx_tasks_user_archived` (`user_id`, `archived_at`);
+-- Create "user_settings" table
+CREATE TABLE `user_settings` (
+  `user_id` char(36) NOT NULL COMMENT "ユーザーID",
+  `auto_archive_days` int NULL COMMENT "完了したタスクを自動でアーカイブするまでの日数（NULLは自動アーカイブしない）",
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT "作成日時",
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT "更新日時",
+  PRIMARY KEY (`user_id`),
+  INDEX `idx_user_settings_auto_archive_days` (`auto_archive_days`),
+  CONSTRAINT `fk_user_settings_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT `chk_user_settings_auto_archive_days` CHECK (`auto_archive_days` IS NULL OR `auto_archive_days` > 0)
+) CHARSET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT "ユーザーごとの設定";
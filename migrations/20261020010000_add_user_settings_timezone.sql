@@ -0,0 +1,2 @@
+-- Modify "user_settings" table
+ALTER TABLE `user_settings` ADD COLUMN `timezone` varchar(64) NULL COMMENT "タイムゾーン（IANA名、繰り返しタスクの曜日・日付をこのタイムゾーンで判定する。NULLはUTC）" AFTER `auto_archive_days`;
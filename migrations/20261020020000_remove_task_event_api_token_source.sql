@@ -0,0 +1,2 @@
+-- Modify "task_events" table
+ALTER TABLE `task_events` DROP CHECK `chk_task_events_source`, ADD CONSTRAINT `chk_task_events_source` CHECK (`source` IN ('manual', 'ai')), MODIFY COLUMN `source` varchar(20) NOT NULL COMMENT "変更元（manual/ai）";
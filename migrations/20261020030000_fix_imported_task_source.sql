@@ -0,0 +1,3 @@
+-- Tasks approved from importer review items (ai_model "import:*") were recorded as AI-created; mark them as manual, keeping updated_at unchanged
+UPDATE `tasks` t JOIN `ai_interpretations` i ON i.`id` = t.`ai_interpretation_id` SET t.`source` = 'manual', t.`updated_at` = t.`updated_at` WHERE t.`source` = 'ai' AND i.`ai_model` LIKE 'import:%';
+UPDATE `task_events` e JOIN `tasks` t ON t.`id` = e.`task_id` JOIN `ai_interpretations` i ON i.`id` = t.`ai_interpretation_id` SET e.`source` = 'manual' WHERE e.`source` = 'ai' AND i.`ai_model` LIKE 'import:%';
@@ -69,18 +69,48 @@ CREATE TABLE `interpretation_items` (
   CONSTRAINT `chk_interpretation_items_status` CHECK (`status` IN ('pending', 'created'))
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='AI解釈アイテム（レビュー対象）';
 
+-- projects（プロジェクト）
+CREATE TABLE `projects` (
+  `id` char(36) NOT NULL COMMENT 'プロジェクトID (UUID)',
+  `user_id` char(36) NOT NULL COMMENT 'ユーザーID',
+  `name` varchar(100) NOT NULL COMMENT 'プロジェクト名',
+  `color` varchar(7) NULL COMMENT '表示色（#RRGGBB）',
+  `archived` tinyint(1) NOT NULL DEFAULT 0 COMMENT 'アーカイブ済みフラグ',
+  `sort_order` int NOT NULL DEFAULT 0 COMMENT '表示順',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_projects_user_name` (`user_id`, `name`),
+  KEY `idx_projects_user_sort` (`user_id`, `sort_order`),
+  CONSTRAINT `fk_projects_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='プロジェクト';
+
 -- tasks（タスク）
 CREATE TABLE `tasks` (
   `id` char(36) NOT NULL COMMENT 'タスクID (UUID)',
   `user_id` char(36) NOT NULL COMMENT 'ユーザーID',
+  `project_id` char(36) NULL COMMENT 'プロジェクトID',
   `title` varchar(500) NOT NULL COMMENT 'タスクタイトル',
   `description` text NULL COMMENT 'タスク詳細',
   `due_at` timestamp NULL COMMENT '期限日時',
-  `status` varchar(20) NOT NULL DEFAULT 'todo' COMMENT 'ステータス（todo/in_progress/done）',
+  `estimate_minutes` int NULL COMMENT '見積もり工数（分）',
+  `status` varchar(20) NOT NULL DEFAULT 'todo' COMMENT 'ステータス名（ユーザーのtask_statuses.name）',
+  `status_category` varchar(10) NOT NULL DEFAULT 'todo' COMMENT 'ステータスのカテゴリ（todo/doing/done、task_statuses.categoryの複製）',
+  `rank_key` varchar(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '' COMMENT '列内の表示順キー（辞書順、空文字列は未配置）',
+  `started_at` timestamp NULL COMMENT '着手日時（doingカテゴリのステータスに変更した日時、todoカテゴリに戻すとNULL）',
+  `completed_at` timestamp NULL COMMENT '完了日時（doneカテゴリのステータスに変更した日時、未完了に戻すとNULL）',
+  `snoozed_until` timestamp NULL COMMENT 'スヌーズの解除日時（この日時まで既定の一覧に表示しない、NULLはスヌーズなし）',
+  `snooze_notify` tinyint(1) NOT NULL DEFAULT 0 COMMENT 'スヌーズ解除時に通知するかどうか',
+  `archived_at` timestamp NULL COMMENT 'アーカイブ日時（NULLは未アーカイブ、アーカイブしたタスクは既定の一覧に表示しない）',
   `source` varchar(20) NOT NULL DEFAULT 'manual' COMMENT '作成元',
   `ai_interpretation_id` char(36) NULL COMMENT '元のAI解釈ID',
+  `recurrence_rule` varchar(500) NULL COMMENT '繰り返しルール（RFC 5545 RRULE）',
+  `recurrence_anchor_at` timestamp NULL COMMENT '繰り返しの起点日時（DTSTART）',
+  `recurrence_series_id` char(36) NULL COMMENT '繰り返しシリーズID',
+  `version` int NOT NULL DEFAULT 1 COMMENT '楽観的排他制御用のバージョン（更新ごとに加算、ETagとして公開）',
   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
   `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  `deleted_at` timestamp NULL COMMENT '削除日時（NULLは未削除、保持期間の経過後に完全削除）',
   PRIMARY KEY (`id`),
   KEY `idx_tasks_status` (`status`),
   KEY `idx_tasks_due_at` (`due_at`),
@@ -89,8 +119,236 @@ CREATE TABLE `tasks` (
   KEY `idx_tasks_user_status` (`user_id`, `status`),
   KEY `idx_tasks_user_created` (`user_id`, `created_at` DESC),
   KEY `fk_tasks_ai_interpretation` (`ai_interpretation_id`),
+  KEY `idx_tasks_recurrence_series` (`recurrence_series_id`, `due_at`),
+  KEY `idx_tasks_user_project` (`user_id`, `project_id`),
+  KEY `idx_tasks_user_status_rank` (`user_id`, `status`, `rank_key`),
+  KEY `idx_tasks_user_deleted` (`user_id`, `deleted_at`),
+  KEY `idx_tasks_deleted_at` (`deleted_at`),
+  KEY `idx_tasks_user_started` (`user_id`, `started_at`),
+  KEY `idx_tasks_user_completed` (`user_id`, `completed_at`),
+  KEY `idx_tasks_user_status_category` (`user_id`, `status_category`),
+  KEY `idx_tasks_snoozed_until` (`snoozed_until`),
+  KEY `idx_tasks_user_archived` (`user_id`, `archived_at`),
   CONSTRAINT `fk_tasks_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE,
   CONSTRAINT `fk_tasks_ai_interpretation` FOREIGN KEY (`ai_interpretation_id`) REFERENCES `ai_interpretations` (`id`) ON DELETE SET NULL,
-  CONSTRAINT `chk_tasks_status` CHECK (`status` IN ('todo', 'in_progress', 'done')),
-  CONSTRAINT `chk_tasks_source` CHECK (`source` IN ('ai', 'manual'))
+  CONSTRAINT `fk_tasks_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE SET NULL,
+  CONSTRAINT `chk_tasks_status_category` CHECK (`status_category` IN ('todo', 'doing', 'done')),
+  CONSTRAINT `chk_tasks_source` CHECK (`source` IN ('ai', 'manual')),
+  CONSTRAINT `chk_tasks_estimate_minutes` CHECK (`estimate_minutes` IS NULL OR `estimate_minutes` > 0)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='タスク';
+
+-- task_dependencies（タスク依存関係）
+CREATE TABLE `task_dependencies` (
+  `id` char(36) NOT NULL COMMENT '依存関係ID (UUID)',
+  `task_id` char(36) NOT NULL COMMENT 'ブロックされるタスクID（後続）',
+  `depends_on_task_id` char(36) NOT NULL COMMENT 'ブロックするタスクID（先行）',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_task_dependencies_edge` (`task_id`, `depends_on_task_id`),
+  KEY `idx_task_dependencies_depends_on` (`depends_on_task_id`),
+  CONSTRAINT `fk_task_dependencies_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_task_dependencies_depends_on` FOREIGN KEY (`depends_on_task_id`) REFERENCES `tasks` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `chk_task_dependencies_not_self` CHECK (`task_id` <> `depends_on_task_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='タスク依存関係';
+
+-- task_events（タスク変更履歴）
+CREATE TABLE `task_events` (
+  `id` char(36) NOT NULL COMMENT 'イベントID (UUID)',
+  `task_id` char(36) NOT NULL COMMENT 'タスクID（タスク削除後も履歴を残すため外部キーなし）',
+  `user_id` char(36) NOT NULL COMMENT 'タスク所有者のユーザーID',
+  `actor_id` char(36) NULL COMMENT '変更を行ったユーザーID',
+  `event_type` varchar(20) NOT NULL COMMENT 'イベント種別（created/updated/deleted/restored）',
+  `source` varchar(20) NOT NULL COMMENT '変更元（manual/ai）',
+  `changes` json NOT NULL COMMENT 'フィールドごとの変更前後の値',
+  `created_at` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '記録日時（同一秒内の順序を保つためマイクロ秒まで保持）',
+  PRIMARY KEY (`id`),
+  KEY `idx_task_events_task_created` (`task_id`, `created_at`),
+  KEY `idx_task_events_user_created` (`user_id`, `created_at`),
+  KEY `fk_task_events_actor` (`actor_id`),
+  CONSTRAINT `fk_task_events_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_task_events_actor` FOREIGN KEY (`actor_id`) REFERENCES `users` (`id`) ON DELETE SET NULL,
+  CONSTRAINT `chk_task_events_event_type` CHECK (`event_type` IN ('created', 'updated', 'deleted', 'restored')),
+  CONSTRAINT `chk_task_events_source` CHECK (`source` IN ('manual', 'ai'))
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='タスク変更履歴';
+
+-- time_entries（タスクの作業時間記録）
+CREATE TABLE `time_entries` (
+  `id` char(36) NOT NULL COMMENT '時間記録ID (UUID)',
+  `user_id` char(36) NOT NULL COMMENT 'ユーザーID',
+  `task_id` char(36) NOT NULL COMMENT 'タスクID',
+  `started_at` timestamp NOT NULL COMMENT '開始日時',
+  `ended_at` timestamp NULL COMMENT '終了日時（NULLは計測中）',
+  `duration_seconds` int NOT NULL DEFAULT 0 COMMENT '記録時間（秒、計測中は0）',
+  `note` varchar(1000) NULL COMMENT 'メモ',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  PRIMARY KEY (`id`),
+  KEY `idx_time_entries_task_started` (`task_id`, `started_at`),
+  KEY `idx_time_entries_user_started` (`user_id`, `started_at`),
+  KEY `idx_time_entries_user_ended` (`user_id`, `ended_at`),
+  CONSTRAINT `fk_time_entries_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_time_entries_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `chk_time_entries_range` CHECK (`ended_at` IS NULL OR `ended_at` >= `started_at`),
+  CONSTRAINT `chk_time_entries_duration` CHECK (`duration_seconds` >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='タスクの作業時間記録';
+
+-- idempotency_keys（POSTリクエストの冪等性キー）
+CREATE TABLE `idempotency_keys` (
+  `id` char(36) NOT NULL COMMENT '冪等性キーレコードID (UUID)',
+  `user_id` char(36) NOT NULL COMMENT 'ユーザーID',
+  `idempotency_key` varchar(255) NOT NULL COMMENT 'クライアントが指定したIdempotency-Keyヘッダーの値',
+  `request_method` varchar(10) NOT NULL COMMENT 'リクエストのHTTPメソッド',
+  `request_path` varchar(2048) NOT NULL COMMENT 'リクエストのパス',
+  `request_hash` char(64) NOT NULL COMMENT 'リクエストのフィンガープリント（メソッド・パス・ボディのSHA-256）',
+  `status_code` int NULL COMMENT 'レスポンスのステータスコード（NULLは処理中）',
+  `response_headers` json NULL COMMENT '再送時に返すレスポンスヘッダー',
+  `response_body` mediumblob NULL COMMENT 'レスポンスボディ',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `expires_at` timestamp NOT NULL COMMENT '有効期限（期限切れのキーは再利用可能になり、定期的に削除される）',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_idempotency_keys_user_key` (`user_id`, `idempotency_key`),
+  KEY `idx_idempotency_keys_expires_at` (`expires_at`),
+  CONSTRAINT `fk_idempotency_keys_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='POSTリクエストの冪等性キー';
+
+-- notifications（アプリ内通知）
+CREATE TABLE `notifications` (
+  `id` char(36) NOT NULL COMMENT '通知ID (UUID)',
+  `user_id` char(36) NOT NULL COMMENT '通知先ユーザーID',
+  `type` varchar(50) NOT NULL COMMENT '通知の種類（task_reminder等）',
+  `title` varchar(500) NOT NULL COMMENT '通知のタイトル',
+  `body` text NULL COMMENT '通知の本文',
+  `task_id` char(36) NULL COMMENT '関連するタスクID',
+  `read_at` timestamp NULL COMMENT '既読日時（NULLは未読）',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  PRIMARY KEY (`id`),
+  KEY `idx_notifications_user_created` (`user_id`, `created_at`),
+  KEY `idx_notifications_user_read` (`user_id`, `read_at`),
+  CONSTRAINT `fk_notifications_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_notifications_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='アプリ内通知';
+
+-- task_reminders（期限リマインダーの配信状態）
+CREATE TABLE `task_reminders` (
+  `id` char(36) NOT NULL COMMENT 'リマインダー配信ID (UUID)',
+  `task_id` char(36) NOT NULL COMMENT 'タスクID',
+  `due_at` timestamp NOT NULL COMMENT '通知対象の期限日時（期限が変わると別のリマインダーとして扱う）',
+  `offset_minutes` int NOT NULL COMMENT '期限の何分前のリマインダーか',
+  `channel` varchar(20) NOT NULL COMMENT '配信チャネル（in_app/email/webhook）',
+  `status` varchar(20) NOT NULL DEFAULT 'pending' COMMENT '配信状態（pending/sent/failed）',
+  `attempts` int NOT NULL DEFAULT 0 COMMENT '配信の試行回数',
+  `last_error` varchar(1000) NULL COMMENT '直近の配信エラー',
+  `sent_at` timestamp NULL COMMENT '配信日時',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_task_reminders_delivery` (`task_id`, `due_at`, `offset_minutes`, `channel`),
+  CONSTRAINT `fk_task_reminders_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `chk_task_reminders_status` CHECK (`status` IN ('pending', 'sent', 'failed')),
+  CONSTRAINT `chk_task_reminders_offset` CHECK (`offset_minutes` >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='期限リマインダーの配信状態（再起動後の重複配信防止）';
+
+-- calendar_feeds（iCalendar購読フィードのトークン）
+CREATE TABLE `calendar_feeds` (
+  `id` char(36) NOT NULL COMMENT 'フィードID (UUID)',
+  `user_id` char(36) NOT NULL COMMENT 'ユーザーID（ユーザーごとに1つ）',
+  `token_hash` char(64) NOT NULL COMMENT 'フィードURLのトークンのSHA-256（トークン自体は保存しない）',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '発行日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_calendar_feeds_user` (`user_id`),
+  UNIQUE KEY `uk_calendar_feeds_token_hash` (`token_hash`),
+  CONSTRAINT `fk_calendar_feeds_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='iCalendar購読フィードのトークン';
+
+-- task_imports（外部データから取り込んだタスクの対応表）
+CREATE TABLE `task_imports` (
+  `id` char(36) NOT NULL COMMENT '取り込み記録ID (UUID)',
+  `user_id` char(36) NOT NULL COMMENT 'ユーザーID',
+  `source` varchar(20) NOT NULL COMMENT '取り込み元（ical等）',
+  `external_id` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL COMMENT '取り込み元での識別子（iCalendarのUID等、再取り込み時の重複判定に使用）',
+  `task_id` char(36) NOT NULL COMMENT '作成したタスクID',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '取り込み日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_task_imports_user_source_external` (`user_id`, `source`, `external_id`),
+  CONSTRAINT `fk_task_imports_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_task_imports_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='外部データから取り込んだタスクの対応表';
+
+-- task_attachments（タスクの添付ファイルのメタデータ）
+CREATE TABLE `task_attachments` (
+  `id` char(36) NOT NULL COMMENT '添付ファイルID (UUID)',
+  `user_id` char(36) NOT NULL COMMENT 'ユーザーID',
+  `task_id` char(36) NOT NULL COMMENT 'タスクID',
+  `filename` varchar(255) NOT NULL COMMENT 'アップロード時のファイル名',
+  `content_type` varchar(100) NOT NULL COMMENT 'ファイルの内容から判定したMIMEタイプ',
+  `size_bytes` bigint NOT NULL COMMENT 'ファイルサイズ（バイト）',
+  `checksum_sha256` char(64) NOT NULL COMMENT 'ファイルの内容のSHA-256',
+  `storage_key` varchar(255) NOT NULL COMMENT 'ストレージ上のキー（ファイル本体はストレージに保存）',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'アップロード日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_task_attachments_storage_key` (`storage_key`),
+  KEY `idx_task_attachments_task_created` (`task_id`, `created_at`),
+  CONSTRAINT `fk_task_attachments_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_task_attachments_task` FOREIGN KEY (`task_id`) REFERENCES `tasks` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `chk_task_attachments_size` CHECK (`size_bytes` >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='タスクの添付ファイルのメタデータ';
+
+-- task_templates（タスクテンプレート）
+CREATE TABLE `task_templates` (
+  `id` char(36) NOT NULL COMMENT 'テンプレートID (UUID)',
+  `user_id` char(36) NOT NULL COMMENT 'ユーザーID',
+  `name` varchar(100) NOT NULL COMMENT 'テンプレート名',
+  `description` text NULL COMMENT 'テンプレートの説明',
+  `project_id` char(36) NULL COMMENT '作成したタスクを割り当てる既定のプロジェクトID',
+  `items` json NOT NULL COMMENT 'タスク定義の一覧（相対期限・サブタスクを含む）',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_task_templates_user_name` (`user_id`, `name`),
+  CONSTRAINT `fk_task_templates_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fk_task_templates_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='タスクテンプレート';
+
+-- task_views（保存したタスクの絞り込み条件）
+CREATE TABLE `task_views` (
+  `id` char(36) NOT NULL COMMENT 'ビューID (UUID)',
+  `user_id` char(36) NOT NULL COMMENT 'ユーザーID',
+  `name` varchar(100) NOT NULL COMMENT 'ビュー名',
+  `filter` json NOT NULL COMMENT 'タスクの絞り込み条件と並び順',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_task_views_user_name` (`user_id`, `name`),
+  CONSTRAINT `fk_task_views_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='保存したタスクのビュー（絞り込み条件）';
+
+-- task_statuses（ワークフローのステータス定義）
+CREATE TABLE `task_statuses` (
+  `id` char(36) NOT NULL COMMENT 'ステータスID (UUID)',
+  `user_id` char(36) NOT NULL COMMENT 'ユーザーID',
+  `name` varchar(20) NOT NULL COMMENT 'ステータス名（tasks.statusに保存する値、変更不可）',
+  `category` varchar(10) NOT NULL COMMENT 'カテゴリ（todo:未着手/doing:作業中/done:完了）',
+  `sort_order` int NOT NULL DEFAULT 0 COMMENT '表示順',
+  `wip_limit` int NULL COMMENT '同時に置けるタスクの上限（NULLは無制限）',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_task_statuses_user_name` (`user_id`, `name`),
+  KEY `idx_task_statuses_user_sort` (`user_id`, `sort_order`),
+  CONSTRAINT `fk_task_statuses_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `chk_task_statuses_category` CHECK (`category` IN ('todo', 'doing', 'done')),
+  CONSTRAINT `chk_task_statuses_wip_limit` CHECK (`wip_limit` IS NULL OR `wip_limit` > 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='ワークフローのステータス定義（ユーザーごと）';
+
+-- user_settings（ユーザーごとの設定）
+CREATE TABLE `user_settings` (
+  `user_id` char(36) NOT NULL COMMENT 'ユーザーID',
+  `auto_archive_days` int NULL COMMENT '完了したタスクを自動でアーカイブするまでの日数（NULLは自動アーカイブしない）',
+  `timezone` varchar(64) NULL COMMENT 'タイムゾーン（IANA名、繰り返しタスクの曜日・日付をこのタイムゾーンで判定する。NULLはUTC）',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+  PRIMARY KEY (`user_id`),
+  KEY `idx_user_settings_auto_archive_days` (`auto_archive_days`),
+  CONSTRAINT `fk_user_settings_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `chk_user_settings_auto_archive_days` CHECK (`auto_archive_days` IS NULL OR `auto_archive_days` > 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='ユーザーごとの設定';